@@ -4,6 +4,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"time"
@@ -22,8 +23,13 @@ import (
 	"github.com/argoproj/argo-cd/errors"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/cli"
 	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/diff"
+	"github.com/argoproj/argo-cd/util/healthz"
+	"github.com/argoproj/argo-cd/util/secrets"
+	"github.com/argoproj/argo-cd/util/settings"
 	"github.com/argoproj/argo-cd/util/stats"
 )
 
@@ -32,17 +38,35 @@ const (
 	cliName = "argocd-application-controller"
 	// Default time in seconds for application resync period
 	defaultAppResyncPeriod = 180
+	// Default durations of the leader election among controller replicas
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewDeadline = 10 * time.Second
+	defaultRetryPeriod   = 2 * time.Second
+	// Default port of the metrics endpoint
+	defaultMetricsPort = 8082
 )
 
 func newCommand() *cobra.Command {
 	var (
 		clientConfig        clientcmd.ClientConfig
 		appResyncPeriod     int64
+		appResyncJitter     int64
 		repoServerAddress   string
 		statusProcessors    int
 		operationProcessors int
 		logLevel            string
 		glogLevel           int
+		leaderElect         bool
+		leaderElection      controller.LeaderElectionConfig
+		maxAppObjectSize    int64
+		maxQueueLatency     time.Duration
+		selfHealTimeout     time.Duration
+		selfHealBackoffCap  time.Duration
+		serverSideApply     bool
+		syncParallelism     int
+		metricsPort         int
+		shards              int
+		shard               int
 	)
 	var command = cobra.Command{
 		Use:   cliName,
@@ -66,15 +90,59 @@ func newCommand() *cobra.Command {
 			namespace, _, err := clientConfig.Namespace()
 			errors.CheckError(err)
 
+			if shards > 1 && (shard < 0 || shard >= shards) {
+				return fmt.Errorf("shard %d is out of range, expected 0 to %d", shard, shards-1)
+			}
+
+			settingsMgr := settings.NewSettingsManager(kubeClient, namespace)
+			argoSettings, err := settingsMgr.GetSettings()
+			if err != nil {
+				log.Warnf("Failed to load settings, using the resync flags: %v", err)
+				argoSettings = &settings.ArgoCDSettings{}
+			}
+			// the resync settings of the config map apply unless overridden by flags
+			resyncDuration := time.Duration(appResyncPeriod) * time.Second
+			if !c.Flags().Changed("app-resync") && argoSettings.AppResyncPeriod > 0 {
+				resyncDuration = argoSettings.AppResyncPeriod
+			}
+			resyncJitter := time.Duration(appResyncJitter) * time.Second
+			if !c.Flags().Changed("app-resync-jitter") && argoSettings.AppResyncJitter > 0 {
+				resyncJitter = argoSettings.AppResyncJitter
+			}
+
+			resourceTracking := argo.NewResourceTracking(argoSettings.ResourceTrackingMethod, namespace)
+			liveStateCache := controller.NewLiveStateCache(resourceTracking)
+			diffCache := diff.NewCache(diff.DefaultCacheMaxEntries)
+
 			// TODO (amatyushentsev): Use config map to store controller configuration
 			controllerConfig := controller.ApplicationControllerConfig{
-				Namespace:  namespace,
-				InstanceID: "",
+				Namespace:              namespace,
+				InstanceID:             "",
+				MaxAppObjectSize:       maxAppObjectSize,
+				MaxRefreshQueueLatency: maxQueueLatency,
+				SelfHealTimeout:        selfHealTimeout,
+				SelfHealBackoffCap:     selfHealBackoffCap,
+				AppResyncJitter:        resyncJitter,
+				ResourceFilter:         argoSettings,
+				ResourceTracking:       resourceTracking,
+				ProgressingDeadline:    argoSettings.ProgressingDeadline,
+				LiveStateCache:         liveStateCache,
+				Shards:                 shards,
+				Shard:                  shard,
 			}
 			db := db.NewDB(namespace, kubeClient)
-			resyncDuration := time.Duration(appResyncPeriod) * time.Second
 			repoClientset := reposerver.NewRepositoryServerClientset(repoServerAddress)
-			appStateManager := controller.NewAppStateManager(db, appClient, repoClientset, namespace)
+			secretResolver := secrets.NewResolver(settingsMgr, kubeClient, namespace)
+			appStateManager := controller.NewAppStateManager(db, appClient, repoClientset, namespace, &controller.AppStateManagerConfig{
+				SecretResolver:        secretResolver,
+				ServerSideApply:       serverSideApply,
+				ResourceFilter:        argoSettings,
+				ResourceTracking:      resourceTracking,
+				RevisionHistoryMaxAge: argoSettings.RevisionHistoryMaxAge,
+				LiveStateCache:        liveStateCache,
+				SyncParallelism:       syncParallelism,
+				DiffCache:             diffCache,
+			})
 
 			appController := controller.NewApplicationController(
 				namespace,
@@ -94,8 +162,26 @@ func newCommand() *cobra.Command {
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
 			stats.RegisterHeapDumper("memprofile")
+			if metricsPort > 0 {
+				go serveMetrics(metricsPort, diffCache, kubeClient, repoClientset)
+			}
+
+			if leaderElect {
+				if leaderElection.Identity == "" {
+					leaderElection.Identity, err = os.Hostname()
+					errors.CheckError(err)
+				}
+				return appController.RunWithLeaderElection(ctx, statusProcessors, operationProcessors, leaderElection, func(leaderCtx context.Context) {
+					// repository secrets are processed once, by the leader of the first shard
+					if shard == 0 {
+						go secretController.Run(leaderCtx)
+					}
+				})
+			}
 
-			go secretController.Run(ctx)
+			if shard == 0 {
+				go secretController.Run(ctx)
+			}
 			go appController.Run(ctx, statusProcessors, operationProcessors)
 			// Wait forever
 			select {}
@@ -104,14 +190,49 @@ func newCommand() *cobra.Command {
 
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().Int64Var(&appResyncPeriod, "app-resync", defaultAppResyncPeriod, "Time period in seconds for application resync.")
+	command.Flags().Int64Var(&appResyncJitter, "app-resync-jitter", 0, "Maximum time in seconds added to the resync period of each application, to spread application resyncs over time.")
 	command.Flags().StringVar(&repoServerAddress, "repo-server", "localhost:8081", "Repo server address.")
 	command.Flags().IntVar(&statusProcessors, "status-processors", 1, "Number of application status processors")
 	command.Flags().IntVar(&operationProcessors, "operation-processors", 1, "Number of application operation processors")
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
+	command.Flags().BoolVar(&leaderElect, "leader-elect", false, "Elect a leader among controller replicas. Standby replicas keep their caches warm and take over when the leader fails.")
+	command.Flags().StringVar(&leaderElection.Identity, "leader-elect-identity", "", "Identity of this replica in the leader election (defaults to the hostname)")
+	command.Flags().DurationVar(&leaderElection.LeaseDuration, "leader-elect-lease-duration", defaultLeaseDuration, "Duration standby replicas wait before taking over the lease of a leader which stopped renewing it")
+	command.Flags().DurationVar(&leaderElection.RenewDeadline, "leader-elect-renew-deadline", defaultRenewDeadline, "Duration the leader retries renewing its lease before giving up leadership")
+	command.Flags().DurationVar(&leaderElection.RetryPeriod, "leader-elect-retry-period", defaultRetryPeriod, "Interval at which replicas try to acquire or renew the lease")
+	command.Flags().Int64Var(&maxAppObjectSize, "guardrail-max-app-object-size", controller.DefaultMaxAppObjectSize, "Application object size in bytes at which the app-object-size guardrail is exceeded (0 to disable)")
+	command.Flags().DurationVar(&selfHealTimeout, "self-heal-timeout", controller.DefaultSelfHealTimeout, "Time to wait after a sync finished, before the drift of an application with self-heal enabled is synced again. The time doubles with each consecutive self-heal of the application")
+	command.Flags().DurationVar(&selfHealBackoffCap, "self-heal-backoff-cap", controller.DefaultSelfHealBackoffCap, "Maximum time to wait after a sync finished, before an application is self-healed again")
+	command.Flags().BoolVar(&serverSideApply, "server-side-apply", false, "Apply resources server-side by default, which requires Kubernetes 1.16 or later")
+	command.Flags().IntVar(&syncParallelism, "sync-parallelism", controller.DefaultSyncParallelism, "Maximum number of resources applied or pruned concurrently by the sync of an application")
+	command.Flags().DurationVar(&maxQueueLatency, "guardrail-max-refresh-queue-latency", controller.DefaultMaxRefreshQueueLatency, "Time applications wait to be refreshed at which the refresh-queue-latency guardrail is exceeded (0 to disable)")
+	command.Flags().IntVar(&shards, "shards", 1, "Number of shards the clusters are distributed to, each shard being processed by its own controller replicas")
+	command.Flags().IntVar(&shard, "shard", 0, "Shard processed by this controller replica, from 0 to the number of shards - 1")
+	command.Flags().IntVar(&metricsPort, "metrics-port", defaultMetricsPort, "Port on which the controller metrics and the /healthz and /readyz endpoints are served (0 to disable)")
 	return &command
 }
 
+// serveMetrics serves the metrics of the controller in the Prometheus text format, and the health
+// checks: the controller is live while it serves them, and ready while it reaches the Kubernetes API.
+// The status of the repo server is reported as well.
+func serveMetrics(port int, diffCache *diff.Cache, kubeClient kubernetes.Interface, repoClientset reposerver.Clientset) {
+	mux := http.NewServeMux()
+	healthz.ServeHealthChecks(mux,
+		nil,
+		[]healthz.Check{healthz.NewKubeAPICheck(kubeClient)},
+		[]healthz.Check{reposerver.NewHealthCheck(repoClientset)},
+	)
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if err := diffCache.WriteMetrics(w); err != nil {
+			log.Warnf("Failed to write diff cache metrics: %v", err)
+		}
+	})
+	log.Infof("Serving metrics on port %d", port)
+	errors.CheckError(http.ListenAndServe(fmt.Sprintf(":%d", port), mux))
+}
+
 func main() {
 	if err := newCommand().Execute(); err != nil {
 		fmt.Println(err)
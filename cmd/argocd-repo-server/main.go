@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"time"
 
+	"github.com/go-redis/redis"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
@@ -13,8 +16,11 @@ import (
 	"github.com/argoproj/argo-cd/errors"
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/reposerver/repository"
+	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/guardrail"
+	"github.com/argoproj/argo-cd/util/healthz"
 	"github.com/argoproj/argo-cd/util/ksonnet"
 	"github.com/argoproj/argo-cd/util/stats"
 )
@@ -23,11 +29,24 @@ const (
 	// CLIName is the name of the CLI
 	cliName = "argocd-repo-server"
 	port    = 8081
+	// manifestLockPrefix is the prefix of the keys of manifest generation locks stored in redis
+	manifestLockPrefix = "argocd-repo-server|lock|"
+	// manifestLockTTL is the expiration of manifest generation locks of crashed repo servers
+	manifestLockTTL = 1 * time.Minute
+	// defaultHealthPort is the default port of the health check endpoints
+	defaultHealthPort = 8084
 )
 
 func newCommand() *cobra.Command {
 	var (
-		logLevel string
+		logLevel                string
+		manifestGenerateTimeout time.Duration
+		redisAddress            string
+		maxCacheMemory          int64
+		prefetchRepos           []string
+		prefetchHotRepos        int
+		prefetchTimeout         time.Duration
+		healthPort              int
 	)
 	var command = cobra.Command{
 		Use:   cliName,
@@ -37,7 +56,42 @@ func newCommand() *cobra.Command {
 			errors.CheckError(err)
 			log.SetLevel(level)
 
-			server := reposerver.NewServer(git.NewFactory(), newCache())
+			var (
+				repoCache    cache.Cache
+				manifestLock util.Locker
+				statusChecks []healthz.Check
+			)
+			if redisAddress != "" {
+				// replicas sharing a redis share their manifest cache, and lock the generation of manifests
+				client := redis.NewClient(&redis.Options{Addr: redisAddress})
+				repoCache = cache.NewRedisCache(client, repository.DefaultRepoCacheExpiration)
+				manifestLock = util.NewRedisKeyLock(client, manifestLockPrefix, manifestLockTTL)
+				statusChecks = append(statusChecks, healthz.Check{
+					Name: "redis",
+					Check: func() error {
+						return client.Ping().Err()
+					},
+				})
+			} else {
+				repoCache = cache.NewInMemoryCache(repository.DefaultRepoCacheExpiration)
+			}
+			watchdog := repository.NewWatchdog(repoCache, maxCacheMemory)
+			go watchdog.Run(context.Background(), guardrail.DefaultCheckInterval, nil)
+			gitFactory := git.NewFactory()
+			if prefetchHotRepos > 0 || len(prefetchRepos) > 0 {
+				// the repositories are cloned before the server starts listening, so that a new
+				// replica is not ready until its first generations are fast
+				service := repository.NewService(gitFactory, repoCache, manifestLock, manifestGenerateTimeout, watchdog)
+				repos := prefetchRepos
+				if prefetchHotRepos > 0 {
+					repos = appendUnique(repos, service.HotRepos(prefetchHotRepos)...)
+				}
+				log.Infof("Pre-fetching %d repositories", len(repos))
+				ctx, cancel := context.WithTimeout(context.Background(), prefetchTimeout)
+				service.Prefetch(ctx, repos)
+				cancel()
+			}
+			server := reposerver.NewServer(gitFactory, repoCache, manifestLock, manifestGenerateTimeout, watchdog)
 			grpc := server.CreateGRPC()
 			listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 			errors.CheckError(err)
@@ -47,9 +101,15 @@ func newCommand() *cobra.Command {
 
 			log.Infof("argocd-repo-server %s serving on %s", argocd.GetVersion(), listener.Addr())
 			log.Infof("ksonnet version: %s", ksVers)
+			// the tool versions are cached, so that the version API does not run the tools
+			toolVersions := repository.ToolVersions()
+			log.Infof("helm version: %s, kubectl version: %s, kustomize version: %s", toolVersions.HelmVersion, toolVersions.KubectlVersion, toolVersions.KustomizeVersion)
 			stats.RegisterStackDumper()
 			stats.StartStatsTicker(10 * time.Minute)
 			stats.RegisterHeapDumper("memprofile")
+			if healthPort > 0 {
+				go serveHealthChecks(healthPort, statusChecks)
+			}
 			err = grpc.Serve(listener)
 			errors.CheckError(err)
 			return nil
@@ -57,17 +117,40 @@ func newCommand() *cobra.Command {
 	}
 
 	command.Flags().StringVar(&logLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().StringVar(&redisAddress, "redis", "", "Redis server address (host:port), used to share the manifest cache and manifest generation locks between repo server replicas")
+	command.Flags().DurationVar(&manifestGenerateTimeout, "manifest-generate-timeout", repository.DefaultManifestGenerateTimeout, "Duration after which manifest generation is aborted, unless overridden by the application")
+	command.Flags().Int64Var(&maxCacheMemory, "guardrail-max-cache-memory", repository.DefaultMaxCacheMemory, "Size in bytes of the items of the in-memory manifest cache at which the cache-memory guardrail is exceeded (0 to disable)")
+	command.Flags().StringArrayVar(&prefetchRepos, "prefetch-repo", []string{}, "URL of a repository to clone at startup, before serving requests (can be repeated multiple times)")
+	command.Flags().IntVar(&prefetchHotRepos, "prefetch-hot-repos", 0, "Number of the most used repositories, recorded in the manifest cache, to clone at startup before serving requests")
+	command.Flags().DurationVar(&prefetchTimeout, "prefetch-timeout", repository.DefaultPrefetchTimeout, "Duration after which the pre-fetch of repositories at startup is abandoned")
+	command.Flags().IntVar(&healthPort, "health-port", defaultHealthPort, "Port on which the /healthz and /readyz endpoints are served (0 to disable)")
 	return &command
 }
 
-func newCache() cache.Cache {
-	return cache.NewInMemoryCache(repository.DefaultRepoCacheExpiration)
-	// client := redis.NewClient(&redis.Options{
-	// 	Addr:     "localhost:6379",
-	// 	Password: "",
-	// 	DB:       0,
-	// })
-	// return cache.NewRedisCache(client, repository.DefaultRepoCacheExpiration)
+// serveHealthChecks serves the health check endpoints. The repo server is live and ready while it
+// serves them, and reports the status of the shared services it uses.
+func serveHealthChecks(port int, statusChecks []healthz.Check) {
+	mux := http.NewServeMux()
+	healthz.ServeHealthChecks(mux, nil, nil, statusChecks)
+	log.Infof("Serving health checks on port %d", port)
+	errors.CheckError(http.ListenAndServe(fmt.Sprintf(":%d", port), mux))
+}
+
+// appendUnique appends the values which are not already in the slice
+func appendUnique(slice []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range slice {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			slice = append(slice, value)
+		}
+	}
+	return slice
 }
 
 func main() {
@@ -15,20 +15,35 @@ import (
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/server"
+	"github.com/argoproj/argo-cd/server/application"
 	"github.com/argoproj/argo-cd/util/cli"
+	"github.com/argoproj/argo-cd/util/cors"
 	"github.com/argoproj/argo-cd/util/stats"
 )
 
+const (
+	// defaultMetricsPort is the default port of the metrics endpoint
+	defaultMetricsPort = 8083
+)
+
 // NewCommand returns a new instance of an argocd command
 func NewCommand() *cobra.Command {
 	var (
-		insecure          bool
-		logLevel          string
-		glogLevel         int
-		clientConfig      clientcmd.ClientConfig
-		staticAssetsDir   string
-		repoServerAddress string
-		disableAuth       bool
+		insecure             bool
+		logLevel             string
+		glogLevel            int
+		clientConfig         clientcmd.ClientConfig
+		staticAssetsDir      string
+		repoServerAddress    string
+		disableAuth          bool
+		terminalIdleTimeout  time.Duration
+		rateLimit            float64
+		rateLimitBurst       int
+		auditEvents          bool
+		corsAllowedOrigins   []string
+		corsAllowCredentials bool
+		streamKeepalive      time.Duration
+		metricsPort          int
 	)
 	var command = &cobra.Command{
 		Use:   cliName,
@@ -54,14 +69,25 @@ func NewCommand() *cobra.Command {
 			appclientset := appclientset.NewForConfigOrDie(config)
 			repoclientset := reposerver.NewRepositoryServerClientset(repoServerAddress)
 
+			corsPolicy := cors.Policy{AllowedOrigins: corsAllowedOrigins, AllowCredentials: corsAllowCredentials}
+			errors.CheckError(corsPolicy.Validate())
+
 			argoCDOpts := server.ArgoCDServerOpts{
-				Insecure:        insecure,
-				Namespace:       namespace,
-				StaticAssetsDir: staticAssetsDir,
-				KubeClientset:   kubeclientset,
-				AppClientset:    appclientset,
-				RepoClientset:   repoclientset,
-				DisableAuth:     disableAuth,
+				Insecure:                insecure,
+				Namespace:               namespace,
+				StaticAssetsDir:         staticAssetsDir,
+				KubeClientset:           kubeclientset,
+				AppClientset:            appclientset,
+				RepoClientset:           repoclientset,
+				DisableAuth:             disableAuth,
+				TerminalIdleTimeout:     terminalIdleTimeout,
+				RateLimit:               rateLimit,
+				RateLimitBurst:          rateLimitBurst,
+				AuditEvents:             auditEvents,
+				CORSAllowedOrigins:      corsAllowedOrigins,
+				CORSAllowCredentials:    corsAllowCredentials,
+				StreamKeepaliveInterval: streamKeepalive,
+				MetricsPort:             metricsPort,
 			}
 
 			stats.RegisterStackDumper()
@@ -85,6 +111,14 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
 	command.Flags().StringVar(&repoServerAddress, "repo-server", "localhost:8081", "Repo server address.")
 	command.Flags().BoolVar(&disableAuth, "disable-auth", false, "Disable client authentication")
+	command.Flags().DurationVar(&terminalIdleTimeout, "terminal-idle-timeout", application.DefaultTerminalIdleTimeout, "Close terminal sessions in application pods after this duration without input. Zero disables the timeout")
+	command.Flags().Float64Var(&rateLimit, "rate-limit", 0, "Requests per second each client IP and user may send to expensive endpoints (sync, manifest generation, repository listing). Zero disables rate limiting")
+	command.Flags().IntVar(&rateLimitBurst, "rate-limit-burst", 10, "Requests each client IP and user may burst over the rate limit")
+	command.Flags().BoolVar(&auditEvents, "audit-events", false, "Record the create, update, delete and sync API calls of applications and projects as Kubernetes events, in addition to the audit log")
+	command.Flags().StringSliceVar(&corsAllowedOrigins, "cors-allowed-origins", nil, "Origins allowed to call the REST API from browsers, e.g. https://dashboard.example.com, or * for any origin")
+	command.Flags().BoolVar(&corsAllowCredentials, "cors-allow-credentials", false, "Allow the cross-origin requests of the allowed origins to include cookies and authorization headers")
+	command.Flags().DurationVar(&streamKeepalive, "stream-keepalive-interval", application.DefaultStreamKeepaliveInterval, "Send keepalives on streams, e.g. application watches and logs, idle for this duration, so load balancers do not close them. Zero disables keepalives")
+	command.Flags().IntVar(&metricsPort, "metrics-port", defaultMetricsPort, "Port on which the Prometheus metrics of the guardrails are served (0 to disable)")
 	command.AddCommand(cli.NewVersionCmd(cliName))
 	return command
 }
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"os"
 	"syscall"
+	"time"
 
 	"github.com/argoproj/argo-cd/errors"
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
 	"github.com/argoproj/argo-cd/server/account"
+	"github.com/argoproj/argo-cd/server/session"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/settings"
 	"github.com/spf13/cobra"
@@ -25,6 +27,7 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		},
 	}
 	command.AddCommand(NewAccountUpdatePasswordCommand(clientOpts))
+	command.AddCommand(NewAccountRevokeTokensCommand(clientOpts))
 	return command
 }
 
@@ -70,3 +73,38 @@ func NewAccountUpdatePasswordCommand(clientOpts *argocdclient.ClientOptions) *co
 	command.Flags().StringVar(&newPassword, "new-password", "", "new password you want to update to")
 	return command
 }
+
+// NewAccountRevokeTokensCommand returns a new instance of an `argocd account revoke-tokens` command
+func NewAccountRevokeTokensCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		username     string
+		issuedBefore string
+	)
+	var command = &cobra.Command{
+		Use:   "revoke-tokens",
+		Short: "Revoke the tokens of an account issued before a time, e.g. after its credentials leaked",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			revokeRequest := session.SessionRevokeRequest{
+				Username: username,
+			}
+			if issuedBefore != "" {
+				t, err := time.Parse(time.RFC3339, issuedBefore)
+				errors.CheckError(err)
+				revokeRequest.IssuedBefore = t.Unix()
+			}
+
+			conn, sessionIf := argocdclient.NewClientOrDie(clientOpts).NewSessionClientOrDie()
+			defer util.Close(conn)
+			_, err := sessionIf.Revoke(context.Background(), &revokeRequest)
+			errors.CheckError(err)
+			fmt.Printf("Tokens revoked, log in again with `argocd login`\n")
+		},
+	}
+	command.Flags().StringVar(&username, "account", "", "the account whose tokens are revoked, defaults to the current account")
+	command.Flags().StringVar(&issuedBefore, "issued-before", "", "revoke the tokens issued before the RFC3339 time, defaults to now")
+	return command
+}
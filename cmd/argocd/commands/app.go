@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"text/tabwriter"
 	"time"
 
@@ -16,10 +19,9 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
-	"github.com/yudai/gojsondiff/formatter"
-	"golang.org/x/crypto/ssh/terminal"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -28,6 +30,7 @@ import (
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
 	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/server/application"
+	"github.com/argoproj/argo-cd/server/settings"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/config"
@@ -47,11 +50,15 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 		},
 	}
 	command.AddCommand(NewApplicationCreateCommand(clientOpts))
+	command.AddCommand(NewApplicationBulkApplyCommand(clientOpts))
 	command.AddCommand(NewApplicationGetCommand(clientOpts))
 	command.AddCommand(NewApplicationDiffCommand(clientOpts))
+	command.AddCommand(NewApplicationCompareRevisionsCommand(clientOpts))
 	command.AddCommand(NewApplicationSetCommand(clientOpts))
 	command.AddCommand(NewApplicationUnsetCommand(clientOpts))
+	command.AddCommand(NewApplicationPatchCommand(clientOpts))
 	command.AddCommand(NewApplicationSyncCommand(clientOpts))
+	command.AddCommand(NewApplicationBatchSyncCommand(clientOpts))
 	command.AddCommand(NewApplicationHistoryCommand(clientOpts))
 	command.AddCommand(NewApplicationRollbackCommand(clientOpts))
 	command.AddCommand(NewApplicationListCommand(clientOpts))
@@ -59,16 +66,23 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationWaitCommand(clientOpts))
 	command.AddCommand(NewApplicationManifestsCommand(clientOpts))
 	command.AddCommand(NewApplicationTerminateOpCommand(clientOpts))
+	command.AddCommand(NewApplicationHookOutputCommand(clientOpts))
+	command.AddCommand(NewApplicationResourcesCommand(clientOpts))
+	command.AddCommand(NewApplicationEventsCommand(clientOpts))
+	command.AddCommand(NewApplicationLiveManifestCommand(clientOpts))
+	command.AddCommand(NewApplicationLogsCommand(clientOpts))
+	command.AddCommand(NewApplicationActionsCommand(clientOpts))
 	return command
 }
 
 // NewApplicationCreateCommand returns a new instance of an `argocd app create` command
 func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		appOpts appOptions
-		fileURL string
-		appName string
-		upsert  bool
+		appOpts  appOptions
+		fileURL  string
+		appName  string
+		upsert   bool
+		validate bool
 	)
 	var command = &cobra.Command{
 		Use:   "create APPNAME",
@@ -114,28 +128,99 @@ func NewApplicationCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 			if appOpts.destNamespace != "" {
 				app.Spec.Destination.Namespace = appOpts.destNamespace
 			}
+			setSyncPolicy(c, &app.Spec, &appOpts)
 			setParameterOverrides(&app, appOpts.parameters)
 			if len(appOpts.valuesFiles) > 0 {
 				app.Spec.Source.ValuesFiles = appOpts.valuesFiles
 			}
+			if validate {
+				// servers unaware of validation would create the application
+				checkServerFeature(clientOpts, settings.FeatureValidateApp)
+			}
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appCreateRequest := application.ApplicationCreateRequest{
 				Application: app,
 				Upsert:      &upsert,
+				Validate:    validate,
 			}
 			created, err := appIf.Create(context.Background(), &appCreateRequest)
 			errors.CheckError(err)
+			if validate {
+				printValidationConditions(created)
+				return
+			}
 			fmt.Printf("application '%s' created\n", created.ObjectMeta.Name)
 		},
 	}
 	command.Flags().StringVarP(&fileURL, "file", "f", "", "Filename or URL to Kubernetes manifests for the app")
 	command.Flags().StringVar(&appName, "name", "", "A name for the app, ignored if a file is set (DEPRECATED)")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Allows to override application with the same name even if supplied application spec is different from existing spec")
+	command.Flags().BoolVar(&validate, "validate", false, "Validate the application, including repository access and destination reachability, without creating it")
 	addAppFlags(command, &appOpts)
 	return command
 }
 
+// NewApplicationBulkApplyCommand returns a new instance of an `argocd app bulk-apply` command
+func NewApplicationBulkApplyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		fileName string
+		dryRun   bool
+	)
+	var command = &cobra.Command{
+		Use:   "bulk-apply",
+		Short: "Create or update the applications of a multi-document YAML file, only if all of them are valid",
+		Run: func(c *cobra.Command, args []string) {
+			if fileName == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			data, err := ioutil.ReadFile(fileName)
+			errors.CheckError(err)
+			objs, err := kubeutil.SplitYAML(string(data))
+			errors.CheckError(err)
+			apps := make([]argoappv1.Application, len(objs))
+			for i, obj := range objs {
+				objBytes, err := json.Marshal(obj)
+				errors.CheckError(err)
+				err = json.Unmarshal(objBytes, &apps[i])
+				errors.CheckError(err)
+			}
+			checkServerFeature(clientOpts, settings.FeatureBulkApply)
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			res, err := appIf.BulkApply(context.Background(), &application.ApplicationBulkApplyRequest{Applications: apps, DryRun: dryRun})
+			errors.CheckError(err)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "NAME\tACTION\tERROR\n")
+			failed := false
+			for _, result := range res.Results {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", result.Name, result.Action, result.Error)
+				failed = failed || result.Error != ""
+			}
+			_ = w.Flush()
+			if failed {
+				errors.Fatal(errors.ExitCodeValidationFailure, "No application was applied")
+			}
+		},
+	}
+	command.Flags().StringVarP(&fileName, "file", "f", "", "Filename of the multi-document YAML file containing the applications")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the applications without creating or updating them")
+	return command
+}
+
+// getRefreshType returns the type of the refresh requested by the --refresh and --hard-refresh flags
+func getRefreshType(refresh bool, hardRefresh bool) string {
+	if hardRefresh {
+		return string(argoappv1.RefreshTypeHard)
+	}
+	if refresh {
+		return string(argoappv1.RefreshTypeNormal)
+	}
+	return ""
+}
+
 // NewApplicationGetCommand returns a new instance of an `argocd app get` command
 func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -143,6 +228,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 		showParams    bool
 		showOperation bool
 		refresh       bool
+		hardRefresh   bool
 	)
 	var command = &cobra.Command{
 		Use:   "get APPNAME",
@@ -156,7 +242,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appName := args[0]
-			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, Refresh: refresh})
+			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, RefreshType: getRefreshType(refresh, hardRefresh)})
 			errors.CheckError(err)
 			switch output {
 			case "yaml":
@@ -181,6 +267,10 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				if len(app.Spec.Source.ValuesFiles) > 0 {
 					fmt.Printf(printOpFmtStr, "Helm Values:", strings.Join(app.Spec.Source.ValuesFiles, ","))
 				}
+				fmt.Printf(printOpFmtStr, "Sync Policy:", formatSyncPolicy(app.Spec.SyncPolicy))
+				if app.Spec.SyncPolicy != nil && len(app.Spec.SyncPolicy.SyncOptions) > 0 {
+					fmt.Printf(printOpFmtStr, "Sync Options:", strings.Join(app.Spec.SyncPolicy.SyncOptions, ","))
+				}
 
 				if len(app.Status.Conditions) > 0 {
 					fmt.Println()
@@ -211,13 +301,31 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 	command.Flags().BoolVar(&showOperation, "show-operation", false, "Show application operation")
 	command.Flags().BoolVar(&showParams, "show-params", false, "Show application parameters and overrides")
 	command.Flags().BoolVar(&refresh, "refresh", false, "Refresh application data when retrieving")
+	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
 	return command
 }
 
+// printValidationConditions prints the errors of a validated application, and exits with the validation
+// failure code if there are any
+func printValidationConditions(app *argoappv1.Application) {
+	if len(app.Status.Conditions) == 0 {
+		fmt.Printf("application '%s' is valid\n", app.ObjectMeta.Name)
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	printAppConditions(w, app)
+	_ = w.Flush()
+	errors.Fatal(errors.ExitCodeValidationFailure, fmt.Sprintf("application '%s' is invalid", app.ObjectMeta.Name))
+}
+
 func printAppConditions(w io.Writer, app *argoappv1.Application) {
-	fmt.Fprintf(w, "CONDITION\tMESSAGE\n")
+	fmt.Fprintf(w, "CONDITION\tMESSAGE\tLAST TRANSITION\n")
 	for _, item := range app.Status.Conditions {
-		fmt.Fprintf(w, "%s\t%s", item.Type, item.Message)
+		lastTransition := ""
+		if item.LastTransitionTime != nil {
+			lastTransition = item.LastTransitionTime.String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", item.Type, item.Message, lastTransition)
 	}
 }
 
@@ -319,6 +427,7 @@ func NewApplicationSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			setSyncPolicy(c, &app.Spec, &appOpts)
 			setParameterOverrides(app, appOpts.parameters)
 			oldOverrides := app.Spec.Source.ComponentParameterOverrides
 			updatedSpec, err := appIf.UpdateSpec(context.Background(), &application.ApplicationUpdateSpecRequest{
@@ -357,18 +466,164 @@ type appOptions struct {
 	parameters    []string
 	valuesFiles   []string
 	project       string
+	syncPolicy    string
+	autoPrune     bool
+	selfHeal      bool
+	retry         retryOptions
+	// namespaceLabels and namespaceAnnotations are the metadata of the managed destination namespace
+	namespaceLabels      []string
+	namespaceAnnotations []string
+	// syncOptions are the sync options of the application, e.g. CreateNamespace=true
+	syncOptions []string
+}
+
+// retryOptions are the options of the retries of failed syncs
+type retryOptions struct {
+	limit              int64
+	backoffDuration    time.Duration
+	backoffMaxDuration time.Duration
+	backoffFactor      int64
+}
+
+// retryFlags are the names of the flags of the retry options
+var retryFlags = []string{"retry-limit", "retry-backoff-duration", "retry-backoff-max-duration", "retry-backoff-factor"}
+
+func addRetryFlags(command *cobra.Command, opts *retryOptions) {
+	command.Flags().Int64Var(&opts.limit, "retry-limit", 0, "Max number of retries of a failed sync")
+	command.Flags().DurationVar(&opts.backoffDuration, "retry-backoff-duration", argoappv1.DefaultSyncRetryDuration, "Delay before the first retry of a failed sync")
+	command.Flags().DurationVar(&opts.backoffMaxDuration, "retry-backoff-max-duration", argoappv1.DefaultSyncRetryMaxDuration, "Max delay between retries of a failed sync")
+	command.Flags().Int64Var(&opts.backoffFactor, "retry-backoff-factor", argoappv1.DefaultSyncRetryFactor, "Factor multiplying the delay after each retry of a failed sync")
+}
+
+// getRetryStrategy returns the retry strategy of the options, or nil if failed syncs are not retried
+func (opts *retryOptions) getRetryStrategy() *argoappv1.RetryStrategy {
+	if opts.limit <= 0 {
+		return nil
+	}
+	factor := opts.backoffFactor
+	return &argoappv1.RetryStrategy{
+		Limit: opts.limit,
+		Backoff: &argoappv1.Backoff{
+			Duration:    opts.backoffDuration.String(),
+			Factor:      &factor,
+			MaxDuration: opts.backoffMaxDuration.String(),
+		},
+	}
 }
 
 func addAppFlags(command *cobra.Command, opts *appOptions) {
 	command.Flags().StringVar(&opts.repoURL, "repo", "", "Repository URL, ignored if a file is set")
 	command.Flags().StringVar(&opts.appPath, "path", "", "Path in repository to the ksonnet app directory, ignored if a file is set")
 	command.Flags().StringVar(&opts.env, "env", "", "Application environment to monitor")
-	command.Flags().StringVar(&opts.revision, "revision", "HEAD", "The tracking source branch, tag, or commit the application will sync to")
+	command.Flags().StringVar(&opts.revision, "revision", "", "The tracking source branch, tag, or commit the application will sync to (defaults to the default revision of the repository, or HEAD)")
 	command.Flags().StringVar(&opts.destServer, "dest-server", "", "K8s cluster URL (overrides the server URL specified in the ksonnet app.yaml)")
 	command.Flags().StringVar(&opts.destNamespace, "dest-namespace", "", "K8s target namespace (overrides the namespace specified in the ksonnet app.yaml)")
 	command.Flags().StringArrayVarP(&opts.parameters, "parameter", "p", []string{}, "set a parameter override (e.g. -p guestbook=image=example/guestbook:latest)")
 	command.Flags().StringArrayVar(&opts.valuesFiles, "values", []string{}, "Helm values file(s) to use")
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
+	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: automated, none)")
+	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning when sync is automated")
+	command.Flags().BoolVar(&opts.selfHeal, "self-heal", false, "Set self healing when sync is automated")
+	addRetryFlags(command, &opts.retry)
+	command.Flags().StringArrayVar(&opts.namespaceLabels, "managed-namespace-label", []string{}, "Set a label of the destination namespace, which is created if missing when syncing (e.g. --managed-namespace-label istio-injection=enabled)")
+	command.Flags().StringArrayVar(&opts.namespaceAnnotations, "managed-namespace-annotation", []string{}, "Set an annotation of the destination namespace, which is created if missing when syncing")
+	command.Flags().StringArrayVar(&opts.syncOptions, "sync-option", []string{}, "Set a sync option of the application (e.g. --sync-option CreateNamespace=true)")
+}
+
+// setSyncPolicy updates the sync policy of the application spec from the --sync-policy, --auto-prune,
+// --self-heal, managed namespace and --sync-option flags
+func setSyncPolicy(c *cobra.Command, spec *argoappv1.ApplicationSpec, opts *appOptions) {
+	if c.Flags().Changed("sync-policy") {
+		switch opts.syncPolicy {
+		case "automated":
+			if spec.SyncPolicy == nil {
+				spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			if spec.SyncPolicy.Automated == nil {
+				spec.SyncPolicy.Automated = &argoappv1.SyncPolicyAutomated{}
+			}
+		case "none":
+			// the managed namespace and the sync options do not depend on automated syncs
+			if spec.SyncPolicy != nil && (spec.SyncPolicy.ManagedNamespaceMetadata != nil || len(spec.SyncPolicy.SyncOptions) > 0) {
+				spec.SyncPolicy = &argoappv1.SyncPolicy{
+					ManagedNamespaceMetadata: spec.SyncPolicy.ManagedNamespaceMetadata,
+					SyncOptions:              spec.SyncPolicy.SyncOptions,
+				}
+			} else {
+				spec.SyncPolicy = nil
+			}
+		default:
+			log.Fatalf("Invalid sync policy '%s', expected one of: automated, none", opts.syncPolicy)
+		}
+	}
+	if c.Flags().Changed("auto-prune") {
+		if spec.SyncPolicy == nil || spec.SyncPolicy.Automated == nil {
+			log.Fatal("--auto-prune requires an automated sync policy")
+		}
+		spec.SyncPolicy.Automated.Prune = opts.autoPrune
+	}
+	if c.Flags().Changed("self-heal") {
+		if spec.SyncPolicy == nil || spec.SyncPolicy.Automated == nil {
+			log.Fatal("--self-heal requires an automated sync policy")
+		}
+		spec.SyncPolicy.Automated.SelfHeal = opts.selfHeal
+	}
+	for _, flag := range retryFlags {
+		if !c.Flags().Changed(flag) {
+			continue
+		}
+		if spec.SyncPolicy == nil || spec.SyncPolicy.Automated == nil {
+			log.Fatalf("--%s requires an automated sync policy", flag)
+		}
+		if !c.Flags().Changed("retry-limit") && spec.SyncPolicy.Retry != nil {
+			opts.retry.limit = spec.SyncPolicy.Retry.Limit
+		}
+		spec.SyncPolicy.Retry = opts.retry.getRetryStrategy()
+		break
+	}
+	if c.Flags().Changed("managed-namespace-label") || c.Flags().Changed("managed-namespace-annotation") {
+		if spec.SyncPolicy == nil {
+			spec.SyncPolicy = &argoappv1.SyncPolicy{}
+		}
+		metadata := spec.SyncPolicy.ManagedNamespaceMetadata
+		if metadata == nil {
+			metadata = &argoappv1.ManagedNamespaceMetadata{}
+		}
+		if c.Flags().Changed("managed-namespace-label") {
+			metadata.Labels = parseKeyValuePairs("namespace label", opts.namespaceLabels)
+		}
+		if c.Flags().Changed("managed-namespace-annotation") {
+			metadata.Annotations = parseKeyValuePairs("namespace annotation", opts.namespaceAnnotations)
+		}
+		spec.SyncPolicy.ManagedNamespaceMetadata = metadata
+	}
+	if c.Flags().Changed("sync-option") {
+		if spec.SyncPolicy == nil {
+			spec.SyncPolicy = &argoappv1.SyncPolicy{}
+		}
+		spec.SyncPolicy.SyncOptions = opts.syncOptions
+	}
+}
+
+// formatSyncPolicy returns a short description of the sync policy of an application
+func formatSyncPolicy(policy *argoappv1.SyncPolicy) string {
+	if policy == nil || policy.Automated == nil {
+		return "<none>"
+	}
+	var options []string
+	if policy.Automated.Prune {
+		options = append(options, "Prune")
+	}
+	if policy.Automated.SelfHeal {
+		options = append(options, "Self Heal")
+	}
+	if policy.Retry != nil {
+		options = append(options, fmt.Sprintf("Retry Limit %d", policy.Retry.Limit))
+	}
+	if len(options) == 0 {
+		return "Automated"
+	}
+	return fmt.Sprintf("Automated (%s)", strings.Join(options, ", "))
 }
 
 // NewApplicationUnsetCommand returns a new instance of an `argocd app unset` command
@@ -443,16 +698,64 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	return command
 }
 
+// NewApplicationPatchCommand returns a new instance of an `argocd app patch` command
+func NewApplicationPatchCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		patch     string
+		patchType string
+	)
+	var command = &cobra.Command{
+		Use:   "patch APPNAME --patch PATCH",
+		Short: "Patch the spec of an application",
+		Example: `  # Update the target revision of an application with a JSON merge patch
+  argocd app patch guestbook --patch '{"spec": {"source": {"targetRevision": "v1.0.0"}}}'
+
+  # Update the target revision of an application with a JSON patch
+  argocd app patch guestbook --type json --patch '[{"op": "replace", "path": "/spec/source/targetRevision", "value": "v1.0.0"}]'`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 || patch == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			_, err := appIf.Patch(context.Background(), &application.ApplicationPatchRequest{
+				Name:      &appName,
+				Patch:     patch,
+				PatchType: patchType,
+			})
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVar(&patch, "patch", "", "the patch of the application")
+	command.Flags().StringVar(&patchType, "type", "merge", "the type of the patch: json, merge or strategic")
+	return command
+}
+
 // NewApplicationDiffCommand returns a new instance of an `argocd app diff` command
 func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		refresh bool
-		local   string
-		env     string
+		refresh     bool
+		hardRefresh bool
+		local       string
+		env         string
+		revision    string
 	)
 	var command = &cobra.Command{
 		Use:   "diff APPNAME",
 		Short: "Perform a diff against the target and live state",
+		Long: `Print the unified diff of the resources of an application, from their live state to their target
+state, i.e. the changes a sync would make. The command exits with code 14 if any resource differs,
+so it can be used to gate pipelines.`,
+		Example: `  # Diff the live state with the target revision
+  argocd app diff guestbook
+
+  # Diff the live state with the manifests of a pull request, without changing the target revision
+  argocd app diff guestbook --revision 9f2c1b7
+
+  # Diff the live state with a local ksonnet app
+  argocd app diff guestbook --local ./guestbook --env dev`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) == 0 {
 				c.HelpFunc()(c, args)
@@ -461,8 +764,34 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appName := args[0]
-			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, Refresh: refresh})
+			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName, RefreshType: getRefreshType(refresh, hardRefresh)})
 			errors.CheckError(err)
+			if revision != "" {
+				if local != "" {
+					log.Fatal("--revision and --local are mutually exclusive")
+				}
+				// only the server can render the manifests at a revision other than the target revision
+				checkServerFeature(clientOpts, settings.FeatureServerDiff)
+			}
+			if local == "" && hasServerFeature(clientOpts, settings.FeatureServerDiff) {
+				// the server diffs the resources exactly like the controller does
+				res, err := appIf.Diff(context.Background(), &application.ApplicationDiffQuery{Name: &appName, Revision: revision})
+				errors.CheckError(err)
+				for _, item := range res.Items {
+					if !item.Modified {
+						continue
+					}
+					targetObj, err := argoappv1.UnmarshalToUnstructured(item.TargetState)
+					errors.CheckError(err)
+					liveObj, err := argoappv1.UnmarshalToUnstructured(item.LiveState)
+					errors.CheckError(err)
+					printUnifiedDiff(targetObj, liveObj)
+				}
+				if res.Modified {
+					os.Exit(errors.ExitCodeDiffFound)
+				}
+				return
+			}
 			liveObjs, err := app.Status.ComparisonResult.LiveObjects()
 			errors.CheckError(err)
 
@@ -473,7 +802,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 				ksApp, err := ksonnet.NewKsonnetApp(local)
 				errors.CheckError(err)
-				compareObjs, err = ksApp.Show(env)
+				compareObjs, err = ksApp.Show(context.Background(), env)
 				errors.CheckError(err)
 				if len(app.Spec.Source.ComponentParameterOverrides) > 0 {
 					log.Warnf("Unable to display parameter overrides")
@@ -489,32 +818,118 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 
 			// In order for the diff to be clean, need to set our app labels
 			setAppLabels(appName, compareObjs)
+			compareObjs, err = argo.RemoveIgnoredDifferences(app.Spec.IgnoreDifferences, compareObjs)
+			errors.CheckError(err)
+			liveObjs, err = argo.RemoveIgnoredDifferences(app.Spec.IgnoreDifferences, liveObjs)
+			errors.CheckError(err)
 			diffResults, err := diff.DiffArray(compareObjs, liveObjs)
 			errors.CheckError(err)
 			for i := 0; i < len(compareObjs); i++ {
-				kind, name := getObjKindName(compareObjs[i], liveObjs[i])
-				diffRes := diffResults.Diffs[i]
-				fmt.Printf("===== %s %s ======\n", kind, name)
-				if diffRes.Modified {
-					formatOpts := formatter.AsciiFormatterConfig{
-						Coloring: terminal.IsTerminal(int(os.Stdout.Fd())),
-					}
-					out, err := diffResults.Diffs[i].ASCIIFormat(compareObjs[i], formatOpts)
-					errors.CheckError(err)
-					fmt.Println(out)
+				if diffResults.Diffs[i].Modified {
+					printUnifiedDiff(compareObjs[i], liveObjs[i])
 				}
 			}
 			if local != "" && len(app.Spec.Source.ComponentParameterOverrides) > 0 {
 				log.Warnf("Unable to display parameter overrides")
 			}
+			if diffResults.Modified {
+				os.Exit(errors.ExitCodeDiffFound)
+			}
 		},
 	}
 	command.Flags().BoolVar(&refresh, "refresh", false, "Refresh application data when retrieving")
+	command.Flags().BoolVar(&hardRefresh, "hard-refresh", false, "Refresh application data as well as target manifests cache")
 	command.Flags().StringVar(&local, "local", "", "Compare live app to a local ksonnet app")
 	command.Flags().StringVar(&env, "env", "", "Compare live app to a specific environment")
+	command.Flags().StringVar(&revision, "revision", "", "Compare live app to its manifests at a pinned revision, without changing its target revision")
+	return command
+}
+
+// NewApplicationCompareRevisionsCommand returns a new instance of an `argocd app compare-revisions` command
+func NewApplicationCompareRevisionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "compare-revisions APPNAME REVISION",
+		Short: "Preview the promotion of an application, by comparing the live state at the current and a proposed revision",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			checkServerFeature(clientOpts, settings.FeatureCompareRevisions)
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			res, err := appIf.CompareRevisions(context.Background(), &application.ApplicationCompareRevisionsQuery{Name: &appName, Revision: args[1]})
+			errors.CheckError(err)
+
+			current, err := resourceStatusByKey(res.Current.ComparisonResult.Resources)
+			errors.CheckError(err)
+			proposed, err := resourceStatusByKey(res.Proposed.ComparisonResult.Resources)
+			errors.CheckError(err)
+			keys := make([]resourceKey, 0)
+			for key := range current {
+				keys = append(keys, key)
+			}
+			for key := range proposed {
+				if _, ok := current[key]; !ok {
+					keys = append(keys, key)
+				}
+			}
+			sort.Slice(keys, func(i, j int) bool {
+				return fmt.Sprintf("%s/%s/%s", keys[i].Kind, keys[i].Namespace, keys[i].Name) < fmt.Sprintf("%s/%s/%s", keys[j].Kind, keys[j].Namespace, keys[j].Name)
+			})
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "KIND\tNAMESPACE\tNAME\tCURRENT (%s)\tPROPOSED (%s)\n", res.Current.Revision, res.Proposed.Revision)
+			for _, key := range keys {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", key.Kind, key.Namespace, key.Name, current[key], proposed[key])
+			}
+			_ = w.Flush()
+			for _, cond := range append(res.Current.Conditions, res.Proposed.Conditions...) {
+				log.Warnf("%s: %s", cond.Type, cond.Message)
+			}
+		},
+	}
 	return command
 }
 
+// resourceKey identifies a resource of an application
+type resourceKey struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// resourceStatusByKey returns the comparison status of the resources, keyed by kind, namespace and name
+func resourceStatusByKey(resources []argoappv1.ResourceState) (map[resourceKey]argoappv1.ComparisonStatus, error) {
+	statuses := make(map[resourceKey]argoappv1.ComparisonStatus)
+	for _, res := range resources {
+		obj, err := argoappv1.UnmarshalToUnstructured(res.TargetState)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			obj, err = argoappv1.UnmarshalToUnstructured(res.LiveState)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if obj == nil {
+			continue
+		}
+		statuses[resourceKey{Kind: obj.GetKind(), Namespace: obj.GetNamespace(), Name: obj.GetName()}] = res.Status
+	}
+	return statuses, nil
+}
+
+// printUnifiedDiff prints the unified diff from the live state to the target state of a resource
+func printUnifiedDiff(target, live *unstructured.Unstructured) {
+	kind, name := getObjKindName(target, live)
+	out, err := diff.UnifiedFormat(kind+"/"+name, target, live)
+	errors.CheckError(err)
+	fmt.Print(out)
+}
+
 func getObjKindName(compare, live *unstructured.Unstructured) (string, string) {
 	if compare == nil {
 		return live.GetKind(), live.GetName()
@@ -565,7 +980,14 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 // NewApplicationListCommand returns a new instance of an `argocd app list` command
 func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output string
+		output       string
+		selector     string
+		watch        bool
+		projects     []string
+		cluster      string
+		syncStatus   string
+		healthStatus string
+		chunkSize    int64
 	)
 	var command = &cobra.Command{
 		Use:   "list",
@@ -573,40 +995,85 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		Run: func(c *cobra.Command, args []string) {
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
-			apps, err := appIf.List(context.Background(), &application.ApplicationQuery{})
-			errors.CheckError(err)
+			ctx := context.Background()
+			query := application.ApplicationQuery{
+				Selector:     selector,
+				Projects:     projects,
+				Cluster:      cluster,
+				SyncStatus:   syncStatus,
+				HealthStatus: healthStatus,
+				Limit:        chunkSize,
+			}
+			var items []argoappv1.Application
+			for {
+				apps, err := appIf.List(ctx, &query)
+				errors.CheckError(err)
+				items = append(items, apps.Items...)
+				if apps.Continue == "" {
+					break
+				}
+				query.Continue = apps.Continue
+			}
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			var fmtStr string
 			headers := []interface{}{"NAME", "CLUSTER", "NAMESPACE", "PROJECT", "STATUS", "HEALTH", "CONDITIONS"}
 			if output == "wide" {
-				fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
 				headers = append(headers, "ENV", "REPO", "PATH", "TARGET")
-			} else {
-				fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
-			}
-			fmt.Fprintf(w, fmtStr, headers...)
-			for _, app := range apps.Items {
-				vals := []interface{}{
-					app.Name,
-					app.Spec.Destination.Server,
-					app.Spec.Destination.Namespace,
-					app.Spec.GetProject(),
-					app.Status.ComparisonResult.Status,
-					app.Status.Health.Status,
-					formatConditionsSummary(app),
-				}
-				if output == "wide" {
-					vals = append(vals, app.Spec.Source.Environment, app.Spec.Source.RepoURL, app.Spec.Source.Path, app.Spec.Source.TargetRevision)
-				}
-				fmt.Fprintf(w, fmtStr, vals...)
+			}
+			// when watching, rows are prefixed by the type of the event, which is empty for the listed applications
+			var prefix []interface{}
+			if watch {
+				headers = append([]interface{}{"EVENT"}, headers...)
+				prefix = []interface{}{""}
+			}
+			fmt.Fprintf(w, strings.Repeat("%s\t", len(headers)-1)+"%s\n", headers...)
+			for _, app := range items {
+				printAppListRow(w, prefix, app, output)
 			}
 			_ = w.Flush()
+			if !watch {
+				return
+			}
+			wc, err := appIf.Watch(ctx, &application.ApplicationQuery{Selector: selector, Projects: projects})
+			errors.CheckError(err)
+			for {
+				appEvent, err := wc.Recv()
+				if err == io.EOF {
+					return
+				}
+				errors.CheckError(err)
+				printAppListRow(w, []interface{}{appEvent.Type}, appEvent.Application, output)
+				_ = w.Flush()
+			}
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "List applications by label selector, e.g. app.kubernetes.io/part-of=guestbook")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "After listing the applications, watch for changes and print the added, modified or deleted applications")
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "List applications of the project")
+	command.Flags().StringVar(&cluster, "cluster", "", "List applications whose destination is the cluster with the server URL")
+	command.Flags().StringVar(&syncStatus, "sync-status", "", "List applications with the sync status, e.g. OutOfSync")
+	command.Flags().StringVar(&healthStatus, "health-status", "", "List applications with the health status, e.g. Degraded")
+	command.Flags().Int64Var(&chunkSize, "chunk-size", 500, "Number of applications listed per request, or 0 to list all applications at once")
 	return command
 }
 
+// printAppListRow prints an application in the table of `argocd app list`, preceded by the prefix columns
+func printAppListRow(w io.Writer, prefix []interface{}, app argoappv1.Application, output string) {
+	vals := append(prefix,
+		app.Name,
+		app.Spec.Destination.Server,
+		app.Spec.Destination.Namespace,
+		app.Spec.GetProject(),
+		app.Status.ComparisonResult.Status,
+		app.Status.Health.Status,
+		formatConditionsSummary(app),
+	)
+	if output == "wide" {
+		vals = append(vals, app.Spec.Source.Environment, app.Spec.Source.RepoURL, app.Spec.Source.Path, app.Spec.Source.TargetRevision)
+	}
+	fmt.Fprintf(w, strings.Repeat("%s\t", len(vals)-1)+"%s\n", vals...)
+}
+
 func formatConditionsSummary(app argoappv1.Application) string {
 	typeToCnt := make(map[string]int)
 	for i := range app.Status.Conditions {
@@ -643,6 +1110,17 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	var command = &cobra.Command{
 		Use:   "wait APPNAME",
 		Short: "Wait for an application to reach a synced and healthy state",
+		Long: `Wait for an application to reach the desired combination of states: synced (--sync), healthy
+(--health) and without a pending operation (--operation), or all of them if none is given. When waiting
+for the operation, the command stops as soon as an operation running or started during the wait
+completes, and exits with the sync failure exit code if it did not succeed. It exits with the timeout
+exit code if the desired state is not reached within --timeout seconds.`,
+		Example: `  # Wait for an application to be synced and healthy, and its operation to succeed
+  argocd app wait guestbook
+
+  # Wait up to five minutes for the syncs started by a batch sync to complete and the application to be healthy
+  argocd app batch-sync -l app.kubernetes.io/part-of=guestbook
+  argocd app wait guestbook --operation --health --timeout 300`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 1 {
 				c.HelpFunc()(c, args)
@@ -657,8 +1135,15 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 
-			_, err := waitOnApplicationStatus(appIf, appName, timeout, watchSync, watchHealth, watchOperations)
+			// the operation which completed before the wait does not fail it
+			prevApp, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName})
 			errors.CheckError(err)
+			prevOpState := prevApp.Status.OperationState
+			app, err := waitOnApplicationStatus(appIf, appName, timeout, watchSync, watchHealth, watchOperations, prevOpState)
+			errors.CheckError(err)
+			if watchOperations && newOperationFailed(app.Status.OperationState, prevOpState) {
+				errors.Fatal(errors.ExitCodeSyncFailure, fmt.Sprintf("operation of app %q %s: %s", appName, app.Status.OperationState.Phase, app.Status.OperationState.Message))
+			}
 		},
 	}
 	command.Flags().BoolVar(&watchSync, "sync", false, "Wait for sync")
@@ -767,7 +1252,7 @@ func printAppResources(w io.Writer, app *argoappv1.Application, showOperation bo
 	}
 	if showOperation && syncRes != nil {
 		for _, hook := range syncRes.Hooks {
-			if hook.Type == argoappv1.HookTypeSync || hook.Type == argoappv1.HookTypePostSync {
+			if hook.Type == argoappv1.HookTypeSync || hook.Type == argoappv1.HookTypePostSync || hook.Type == argoappv1.HookTypeSyncFail {
 				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", hook.Kind, hook.Name, hook.Status, "", hook.Type, hook.Message)
 			}
 		}
@@ -778,12 +1263,15 @@ func printAppResources(w io.Writer, app *argoappv1.Application, showOperation bo
 // NewApplicationSyncCommand returns a new instance of an `argocd app sync` command
 func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		revision string
-		prune    bool
-		dryRun   bool
-		timeout  uint
-		strategy string
-		force    bool
+		revision      string
+		prune         bool
+		dryRun        bool
+		timeout       uint
+		strategy      string
+		force         bool
+		hookNamespace string
+		resources     []string
+		retry         retryOptions
 	)
 	var command = &cobra.Command{
 		Use:   "sync APPNAME",
@@ -801,14 +1289,20 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				DryRun:   dryRun,
 				Revision: revision,
 				Prune:    prune,
+				Retry:    retry.getRetryStrategy(),
 			}
+			syncReq.Resources = parseSyncResources(resources)
 			switch strategy {
 			case "apply":
+				if hookNamespace != "" {
+					log.Fatal("--hook-namespace option invalid with apply sync strategy")
+				}
 				syncReq.Strategy = &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}}
 				syncReq.Strategy.Apply.Force = force
 			case "", "hook":
 				syncReq.Strategy = &argoappv1.SyncStrategy{Hook: &argoappv1.SyncStrategyHook{}}
 				syncReq.Strategy.Hook.Force = force
+				syncReq.Strategy.Hook.Namespace = hookNamespace
 			default:
 				log.Fatalf("Unknown sync strategy: '%s'", strategy)
 			}
@@ -816,7 +1310,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			_, err := appIf.Sync(ctx, &syncReq)
 			errors.CheckError(err)
 
-			app, err := waitOnApplicationStatus(appIf, appName, timeout, false, false, true)
+			app, err := waitOnApplicationStatus(appIf, appName, timeout, false, false, true, nil)
 			errors.CheckError(err)
 
 			pruningRequired := 0
@@ -826,11 +1320,11 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 			if pruningRequired > 0 {
-				log.Fatalf("%d resources require pruning", pruningRequired)
+				errors.Fatal(errors.ExitCodeSyncFailure, fmt.Sprintf("%d resources require pruning", pruningRequired))
 			}
 
 			if !app.Status.OperationState.Phase.Successful() && !dryRun {
-				os.Exit(1)
+				os.Exit(errors.ExitCodeSyncFailure)
 			}
 		},
 	}
@@ -840,9 +1334,82 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	command.Flags().StringVar(&strategy, "strategy", "", "Sync strategy (one of: apply|hook)")
 	command.Flags().BoolVar(&force, "force", false, "Use a force apply")
+	command.Flags().StringVar(&hookNamespace, "hook-namespace", "", "Create hook resources in this namespace instead of the application namespace")
+	command.Flags().StringArrayVar(&resources, "resource", []string{}, "Sync only specific resources as GROUP:KIND:NAME (e.g. apps:Deployment:guestbook-ui, or :Service:guestbook-ui for core resources). Hooks are not run.")
+	addRetryFlags(command, &retry)
+	return command
+}
+
+// NewApplicationBatchSyncCommand returns a new instance of an `argocd app batch-sync` command
+func NewApplicationBatchSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		selector    string
+		projects    []string
+		prune       bool
+		dryRun      bool
+		concurrency int64
+	)
+	var command = &cobra.Command{
+		Use:   "batch-sync",
+		Short: "Sync all applications matching a label selector or projects",
+		Example: `  # Sync all applications of a release
+  argocd app batch-sync -l app.kubernetes.io/part-of=guestbook
+
+  # Sync all applications of a project, five at a time
+  argocd app batch-sync --project staging --concurrency 5`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 0 || (selector == "" && len(projects) == 0) {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			checkServerFeature(clientOpts, settings.FeatureBatchSync)
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			res, err := appIf.BatchSync(context.Background(), &application.ApplicationBatchSyncRequest{
+				Selector:    selector,
+				Projects:    projects,
+				Prune:       prune,
+				DryRun:      dryRun,
+				Concurrency: concurrency,
+			})
+			errors.CheckError(err)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "NAME\tERROR\n")
+			failed := 0
+			for _, result := range res.Results {
+				fmt.Fprintf(w, "%s\t%s\n", result.Name, result.Error)
+				if result.Error != "" {
+					failed++
+				}
+			}
+			_ = w.Flush()
+			if failed > 0 {
+				errors.Fatal(errors.ExitCodeSyncFailure, fmt.Sprintf("%d of %d applications could not be synced", failed, len(res.Results)))
+			}
+		},
+	}
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Sync the applications matching this label selector")
+	command.Flags().StringArrayVarP(&projects, "project", "p", []string{}, "Sync the applications of these projects")
+	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Preview apply without affecting cluster")
+	command.Flags().Int64Var(&concurrency, "concurrency", 0, "Maximum number of applications synced concurrently (default 10)")
 	return command
 }
 
+// parseSyncResources parses the resources of a selective sync, given as GROUP:KIND:NAME
+func parseSyncResources(resources []string) []argoappv1.SyncOperationResource {
+	var syncResources []argoappv1.SyncOperationResource
+	for _, r := range resources {
+		fields := strings.Split(r, ":")
+		if len(fields) != 3 || fields[1] == "" || fields[2] == "" {
+			log.Fatalf("Resource should be of the form GROUP:KIND:NAME, but was '%s'", r)
+		}
+		syncResources = append(syncResources, argoappv1.SyncOperationResource{Group: fields[0], Kind: fields[1], Name: fields[2]})
+	}
+	return syncResources
+}
+
 // ResourceState tracks the state of a resource when waiting on an application status.
 type resourceState struct {
 	Kind      string
@@ -895,7 +1462,9 @@ func (rs *resourceState) Update(newState *resourceState) {
 	}
 }
 
-func waitOnApplicationStatus(appClient application.ApplicationServiceClient, appName string, timeout uint, watchSync, watchHealth, watchOperations bool) (*argoappv1.Application, error) {
+// waitOnApplicationStatus waits for the application to reach the desired states, or for an operation to
+// fail, other than the one which had completed in the previous operation state
+func waitOnApplicationStatus(appClient application.ApplicationServiceClient, appName string, timeout uint, watchSync, watchHealth, watchOperations bool, prevOpState *argoappv1.OperationState) (*argoappv1.Application, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -999,9 +1568,28 @@ func waitOnApplicationStatus(appClient application.ApplicationServiceClient, app
 			printFinalStatus()
 			return &app, nil
 		}
+		// the desired state will not be reached without another operation
+		if watchOperations && app.Operation == nil && newOperationFailed(app.Status.OperationState, prevOpState) {
+			printFinalStatus()
+			return &app, nil
+		}
 	}
 
-	return nil, fmt.Errorf("Timed out (%ds) waiting for app %q match desired state", timeout, appName)
+	return nil, status.Errorf(codes.DeadlineExceeded, "Timed out (%ds) waiting for app %q match desired state", timeout, appName)
+}
+
+// operationFailed returns whether the operation completed without succeeding
+func operationFailed(opState *argoappv1.OperationState) bool {
+	return opState != nil && opState.Phase.Completed() && !opState.Phase.Successful()
+}
+
+// newOperationFailed returns whether the operation failed, unless it is the operation which had already
+// completed in the previous operation state
+func newOperationFailed(opState *argoappv1.OperationState, prevOpState *argoappv1.OperationState) bool {
+	if !operationFailed(opState) {
+		return false
+	}
+	return prevOpState == nil || !prevOpState.Phase.Completed() || !opState.StartedAt.Equal(&prevOpState.StartedAt)
 }
 
 // setParameterOverrides updates an existing or appends a new parameter override in the application
@@ -1065,6 +1653,11 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 	var command = &cobra.Command{
 		Use:   "history APPNAME",
 		Short: "Show application deployment history",
+		Example: `  # List the deployments of an application, with their source and parameters
+  argocd app history guestbook -o wide
+
+  # Print the revision of the latest deployment
+  argocd app history guestbook -o json | jq -r '.[-1].revision'`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 1 {
 				c.HelpFunc()(c, args)
@@ -1073,31 +1666,62 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			appName := args[0]
-			app, err := appIf.Get(context.Background(), &application.ApplicationQuery{Name: &appName})
+			history, err := appIf.History(context.Background(), &application.ApplicationHistoryQuery{Name: &appName})
 			errors.CheckError(err)
-			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 			switch output {
-			case "wide":
-				fmt.Fprintf(w, "ID\tDATE\tCOMMIT\tPARAMETERS\n")
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(history.Items)
+				errors.CheckError(err)
+				fmt.Print(string(yamlBytes))
+			case "json":
+				jsonBytes, err := json.MarshalIndent(history.Items, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "wide", "":
+				printApplicationHistory(history.Items, output)
 			default:
-				fmt.Fprintf(w, "ID\tDATE\tCOMMIT\n")
-			}
-			for _, depInfo := range app.Status.History {
-				switch output {
-				case "wide":
-					paramStr := paramString(depInfo.Params)
-					fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, depInfo.Revision, paramStr)
-				default:
-					fmt.Fprintf(w, "%d\t%s\t%s\n", depInfo.ID, depInfo.DeployedAt, depInfo.Revision)
-				}
+				log.Fatalf("Unknown output format: %s", output)
 			}
-			_ = w.Flush()
 		},
 	}
-	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide")
 	return command
 }
 
+// printApplicationHistory prints the deployments of the history of an application as a table
+func printApplicationHistory(history []argoappv1.DeploymentInfo, output string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	switch output {
+	case "wide":
+		fmt.Fprintf(w, "ID\tDATE\tCOMMIT\tINITIATOR\tCAUSE\tREPO\tPATH\tPARAMETERS\n")
+	default:
+		fmt.Fprintf(w, "ID\tDATE\tCOMMIT\tINITIATOR\n")
+	}
+	for _, depInfo := range history {
+		deployedAt := depInfo.DeployedAt.Format(time.RFC3339)
+		switch output {
+		case "wide":
+			cause := string(depInfo.Cause)
+			if depInfo.RollbackID != nil {
+				cause = fmt.Sprintf("%s (%d)", cause, *depInfo.RollbackID)
+			}
+			paramStr := paramString(depInfo.Params)
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", depInfo.ID, deployedAt, depInfo.Revision, initiatorString(depInfo.InitiatedBy), cause, depInfo.Source.RepoURL, depInfo.Source.Path, paramStr)
+		default:
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", depInfo.ID, deployedAt, depInfo.Revision, initiatorString(depInfo.InitiatedBy))
+		}
+	}
+	_ = w.Flush()
+}
+
+// initiatorString returns who initiated an operation: the name of the user, or automated
+func initiatorString(initiatedBy argoappv1.OperationInitiator) string {
+	if initiatedBy.Automated {
+		return "automated"
+	}
+	return initiatedBy.Username
+}
+
 func paramString(params []argoappv1.ComponentParameter) string {
 	if len(params) == 0 {
 		return ""
@@ -1112,25 +1736,41 @@ func paramString(params []argoappv1.ComponentParameter) string {
 // NewApplicationRollbackCommand returns a new instance of an `argocd app rollback` command
 func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		prune   bool
-		timeout uint
+		prune           bool
+		disableAutoSync bool
+		timeout         uint
 	)
 	var command = &cobra.Command{
-		Use:   "rollback APPNAME",
+		Use:   "rollback APPNAME [ID]",
 		Short: "Rollback application to a previous deployed version",
+		Long: `Rollback application to a previous deployed version. Without an ID, the deployments of the history
+of the application are listed with their IDs.
+
+Automated sync is suspended after a rollback until the next sync of the application, which redeploys
+the target revision. --disable-auto-sync disables the automated sync policy of the application before
+rolling it back, so that the rollback is not undone.`,
+		Example: `  # List the deployments of the history of an application
+  argocd app rollback guestbook
+
+  # Rollback an application to a deployment, and delete the resources which are not part of it
+  argocd app rollback guestbook 3 --prune`,
 		Run: func(c *cobra.Command, args []string) {
-			if len(args) != 2 {
+			if len(args) != 1 && len(args) != 2 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
 			appName := args[0]
-			depID, err := strconv.Atoi(args[1])
-			errors.CheckError(err)
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			ctx := context.Background()
 			app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName})
 			errors.CheckError(err)
+			if len(args) == 1 {
+				printApplicationHistory(app.Status.History, "")
+				return
+			}
+			depID, err := strconv.Atoi(args[1])
+			errors.CheckError(err)
 			var depInfo *argoappv1.DeploymentInfo
 			for _, di := range app.Status.History {
 				if di.ID == int64(depID) {
@@ -1141,6 +1781,19 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 			if depInfo == nil {
 				log.Fatalf("Application '%s' does not have deployment id '%d' in history\n", app.ObjectMeta.Name, depID)
 			}
+			if app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil {
+				if disableAutoSync {
+					_, err = appIf.Patch(ctx, &application.ApplicationPatchRequest{
+						Name:      &appName,
+						Patch:     `{"spec": {"syncPolicy": {"automated": null}}}`,
+						PatchType: "merge",
+					})
+					errors.CheckError(err)
+					log.Infof("Disabled automated sync of application '%s'", appName)
+				} else {
+					log.Warnf("Automated sync of application '%s' is suspended until its next sync, which will undo the rollback. Use --disable-auto-sync to disable automated sync.", appName)
+				}
+			}
 
 			_, err = appIf.Rollback(ctx, &application.ApplicationRollbackRequest{
 				Name:  &appName,
@@ -1149,11 +1802,15 @@ func NewApplicationRollbackCommand(clientOpts *argocdclient.ClientOptions) *cobr
 			})
 			errors.CheckError(err)
 
-			_, err = waitOnApplicationStatus(appIf, appName, timeout, false, false, true)
+			app, err = waitOnApplicationStatus(appIf, appName, timeout, false, false, true, nil)
 			errors.CheckError(err)
+			if !app.Status.OperationState.Phase.Successful() {
+				os.Exit(errors.ExitCodeSyncFailure)
+			}
 		},
 	}
 	command.Flags().BoolVar(&prune, "prune", false, "Allow deleting unexpected resources")
+	command.Flags().BoolVar(&disableAutoSync, "disable-auto-sync", false, "Disable the automated sync policy of the application before rolling it back")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
 	return command
 }
@@ -1177,6 +1834,9 @@ func printOperationResult(opState *argoappv1.OperationState) {
 		duration = time.Second * time.Duration(time.Now().UTC().Unix()-opState.StartedAt.Unix())
 	}
 	fmt.Printf(printOpFmtStr, "Duration:", duration)
+	if opState.RetryCount > 0 {
+		fmt.Printf(printOpFmtStr, "Retries:", strconv.FormatInt(opState.RetryCount, 10))
+	}
 	if opState.Message != "" {
 		fmt.Printf(printOpFmtStr, "Message:", opState.Message)
 	}
@@ -1187,43 +1847,61 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	var (
 		source   string
 		revision string
+		output   string
 	)
 	var command = &cobra.Command{
 		Use:   "manifests APPNAME",
 		Short: "Print manifests of an application",
+		Long: `Print manifests of an application, from one of the following sources:
+
+  git:   the manifests generated from git at the target revision, or at --revision
+  cache: the target manifests the controller last compared the live state with
+  live:  the live state of the resources of the application`,
+		Example: `  # Print the manifests a sync would apply
+  argocd app manifests guestbook
+
+  # Print the manifests of a branch which is not deployed yet
+  argocd app manifests guestbook --revision feature-branch
+
+  # Print the manifests the application was last compared with, without generating them
+  argocd app manifests guestbook --source cache`,
 		Run: func(c *cobra.Command, args []string) {
 			if len(args) != 1 {
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			if !argo.IsValidManifestFormat(output) {
+				log.Fatalf("Unknown output format '%s', expected one of: yaml, json, jsonl", output)
+			}
+			if revision != "" && source != "git" {
+				log.Fatal("--revision is only supported with --source git")
+			}
 			appName := args[0]
 			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
 			defer util.Close(conn)
 			ctx := context.Background()
-			app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName})
-			errors.CheckError(err)
 
 			var unstructureds []*unstructured.Unstructured
 			switch source {
 			case "git":
-				if revision != "" {
-					q := application.ApplicationManifestQuery{
-						Name:     &appName,
-						Revision: revision,
-					}
-					res, err := appIf.GetManifests(ctx, &q)
-					errors.CheckError(err)
-					for _, mfst := range res.Manifests {
-						obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-						errors.CheckError(err)
-						unstructureds = append(unstructureds, obj)
-					}
-				} else {
-					targetObjs, err := app.Status.ComparisonResult.TargetObjects()
-					errors.CheckError(err)
-					unstructureds = targetObjs
+				q := application.ApplicationManifestQuery{
+					Name:     &appName,
+					Revision: revision,
+					Format:   output,
 				}
+				res, err := appIf.GetManifests(ctx, &q)
+				errors.CheckError(err)
+				fmt.Print(res.Formatted)
+				return
+			case "cache":
+				app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName})
+				errors.CheckError(err)
+				targetObjs, err := app.Status.ComparisonResult.TargetObjects()
+				errors.CheckError(err)
+				unstructureds = targetObjs
 			case "live":
+				app, err := appIf.Get(ctx, &application.ApplicationQuery{Name: &appName})
+				errors.CheckError(err)
 				liveObjs, err := app.Status.ComparisonResult.LiveObjects()
 				errors.CheckError(err)
 				unstructureds = liveObjs
@@ -1231,16 +1909,23 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				log.Fatalf("Unknown source type '%s'", source)
 			}
 
+			manifests := make([]string, 0)
 			for _, obj := range unstructureds {
-				fmt.Println("---")
-				yamlBytes, err := yaml.Marshal(obj)
+				if obj == nil {
+					continue
+				}
+				jsonBytes, err := json.Marshal(obj)
 				errors.CheckError(err)
-				fmt.Printf("%s\n", yamlBytes)
+				manifests = append(manifests, string(jsonBytes))
 			}
+			out, err := argo.FormatManifests(manifests, output)
+			errors.CheckError(err)
+			fmt.Print(out)
 		},
 	}
-	command.Flags().StringVar(&source, "source", "git", "Source of manifests. One of: live|git")
-	command.Flags().StringVar(&revision, "revision", "", "Show manifests at a specific revision")
+	command.Flags().StringVar(&source, "source", "git", "Source of manifests. One of: git|cache|live")
+	command.Flags().StringVar(&revision, "revision", "", "Show manifests generated from git at a specific revision instead of the target revision")
+	command.Flags().StringVarP(&output, "output", "o", argo.ManifestFormatYAML, "Output format. One of: yaml, json, jsonl")
 	return command
 }
 
@@ -1265,3 +1950,430 @@ func NewApplicationTerminateOpCommand(clientOpts *argocdclient.ClientOptions) *c
 	}
 	return command
 }
+
+// NewApplicationHookOutputCommand returns a new instance of an `argocd app hook-output` command
+func NewApplicationHookOutputCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		kind string
+	)
+	var command = &cobra.Command{
+		Use:   "hook-output APPNAME HOOKNAME",
+		Short: "Print the captured output of a hook of the current or most recent operation of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			hookName := args[1]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			ctx := context.Background()
+			hook, err := appIf.HookOutput(ctx, &application.ApplicationHookQuery{Name: &appName, HookName: &hookName, Kind: kind})
+			errors.CheckError(err)
+			fmt.Printf("%s %s/%s: %s %s\n", hook.Type, hook.Kind, hook.Name, hook.Status, hook.Message)
+			fmt.Print(hook.Output)
+		},
+	}
+	command.Flags().StringVar(&kind, "kind", "", "Kind of the hook resource")
+	return command
+}
+
+// NewApplicationResourcesCommand returns a new instance of an `argocd app resources` command
+func NewApplicationResourcesCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		tree bool
+	)
+	var command = &cobra.Command{
+		Use:   "resources APPNAME",
+		Short: "List the resources of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			if tree {
+				checkServerFeature(clientOpts, settings.FeatureResourceTree)
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			ctx := context.Background()
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			if tree {
+				appTree, err := appIf.ResourceTree(ctx, &application.ResourceTreeQuery{Name: &appName})
+				errors.CheckError(err)
+				printResourceTree(w, appTree.Nodes)
+				_ = w.Flush()
+				return
+			}
+			resources, err := appIf.ManagedResources(ctx, &application.ManagedResourcesQuery{Name: &appName})
+			errors.CheckError(err)
+			fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tSTATUS\tHEALTH\n")
+			for _, res := range resources.Items {
+				obj, err := argoappv1.UnmarshalToUnstructured(res.LiveState)
+				errors.CheckError(err)
+				if obj == nil {
+					obj, err = argoappv1.UnmarshalToUnstructured(res.TargetState)
+					errors.CheckError(err)
+				}
+				if obj == nil {
+					continue
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", obj.GroupVersionKind().Group, obj.GetKind(), obj.GetNamespace(), obj.GetName(), res.Status, res.Health.Status)
+			}
+			_ = w.Flush()
+		},
+	}
+	command.Flags().BoolVar(&tree, "tree", false, "List the live resources with the resources they created (e.g. pods of a deployment), and their images")
+	return command
+}
+
+// printResourceTree prints the nodes of a resource tree, with the names of the created resources
+// indented by their depth in the tree
+func printResourceTree(w io.Writer, nodes []application.ResourceTreeNode) {
+	depths := make(map[string]int)
+	fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tSTATUS\tHEALTH\tIMAGES\n")
+	for _, node := range nodes {
+		depth := 0
+		if node.ParentUID != "" {
+			depth = depths[node.ParentUID] + 1
+		}
+		depths[node.UID] = depth
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s%s\t%s\t%s\t%s\n", node.Group, node.Kind, node.Namespace, strings.Repeat("  ", depth), node.Name, node.Status, node.Health.Status, strings.Join(node.Images, ","))
+	}
+}
+
+// NewApplicationEventsCommand returns a new instance of an `argocd app events` command
+func NewApplicationEventsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		warnings bool
+	)
+	var command = &cobra.Command{
+		Use:   "events APPNAME",
+		Short: "List the events of the live resources of an application",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			checkServerFeature(clientOpts, settings.FeatureResourceEvents)
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			query := application.ApplicationEventsQuery{Name: &appName}
+			if warnings {
+				query.Type = v1.EventTypeWarning
+			}
+			events, err := appIf.ListEvents(context.Background(), &query)
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "LAST SEEN\tTYPE\tREASON\tKIND\tNAMESPACE\tNAME\tMESSAGE\n")
+			for _, event := range events.Items {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", event.LastTimestamp.Format(time.RFC3339), event.Type, event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Namespace, event.InvolvedObject.Name, event.Message)
+			}
+			_ = w.Flush()
+		},
+	}
+	command.Flags().BoolVar(&warnings, "warnings", false, "Only list warning events, e.g. failed image pulls or crashing containers")
+	return command
+}
+
+// NewApplicationLiveManifestCommand returns a new instance of an `argocd app live-manifest` command
+func NewApplicationLiveManifestCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		flags  resourceActionFlags
+		output string
+	)
+	var command = &cobra.Command{
+		Use:   "live-manifest APPNAME",
+		Short: "Print the live manifest of a resource of an application",
+		Long:  "Print the live manifest of a resource of the resource tree of an application, with the values of the data of secrets masked.",
+		Example: `  # Print the live manifest of a pod created by a deployment of the application
+  argocd app live-manifest guestbook --kind Pod --namespace default --resource-name guestbook-ui-5d8f6b6b9c-x7k2p`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 || flags.kind == "" || flags.resourceName == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			checkServerFeature(clientOpts, settings.FeatureResourceManifest)
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			resp, err := appIf.GetResource(context.Background(), &application.ApplicationResourceRequest{
+				Name:         &appName,
+				Group:        flags.group,
+				Kind:         flags.kind,
+				Namespace:    flags.namespace,
+				ResourceName: flags.resourceName,
+			})
+			errors.CheckError(err)
+			switch output {
+			case "yaml":
+				yamlBytes, err := yaml.JSONToYAML([]byte(resp.Manifest))
+				errors.CheckError(err)
+				fmt.Print(string(yamlBytes))
+			case "json":
+				var obj map[string]interface{}
+				errors.CheckError(json.Unmarshal([]byte(resp.Manifest), &obj))
+				jsonBytes, err := json.MarshalIndent(obj, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			default:
+				log.Fatalf("Unknown output format: %s", output)
+			}
+		},
+	}
+	flags.addFlags(command)
+	command.Flags().StringVarP(&output, "output", "o", "yaml", "Output format. One of: yaml|json")
+	return command
+}
+
+// NewApplicationLogsCommand returns a new instance of an `argocd app logs` command
+func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		group        string
+		kind         string
+		name         string
+		container    string
+		follow       bool
+		tailLines    int64
+		sinceSeconds int64
+		sinceTime    string
+	)
+	var command = &cobra.Command{
+		Use:   "logs APPNAME",
+		Short: "Print the logs of the pods of an application",
+		Long:  "Print the logs of the pods of an application. The pods can be narrowed down to the ones managed by, or created by, the resources matching --group, --kind and --name.",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			var since *metav1.Time
+			if sinceTime != "" {
+				t, err := time.Parse(time.RFC3339, sinceTime)
+				errors.CheckError(err)
+				since = &metav1.Time{Time: t}
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			ctx := context.Background()
+			resources, err := appIf.ManagedResources(ctx, &application.ManagedResourcesQuery{Name: &appName})
+			errors.CheckError(err)
+			podNames := getAppPodNames(resources.Items, group, kind, name)
+			if len(podNames) == 0 {
+				log.Fatalf("No pods found in application '%s'", appName)
+			}
+
+			var wg sync.WaitGroup
+			for _, podName := range podNames {
+				prefix := ""
+				if len(podNames) > 1 {
+					prefix = podName + ": "
+				}
+				query := application.ApplicationPodLogsQuery{
+					Name:         &appName,
+					PodName:      &podName,
+					Container:    container,
+					Follow:       follow,
+					TailLines:    tailLines,
+					SinceSeconds: sinceSeconds,
+					SinceTime:    since,
+				}
+				stream, err := appIf.PodLogs(ctx, &query)
+				errors.CheckError(err)
+				printLogs := func() {
+					for {
+						entry, err := stream.Recv()
+						if err == io.EOF {
+							return
+						}
+						errors.CheckError(err)
+						fmt.Printf("%s%s\n", prefix, entry.Content)
+					}
+				}
+				if follow {
+					// pods are followed concurrently, since their streams never end
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						printLogs()
+					}()
+				} else {
+					printLogs()
+				}
+			}
+			wg.Wait()
+		},
+	}
+	command.Flags().StringVar(&group, "group", "", "Only print the logs of pods of resources with the given API group")
+	command.Flags().StringVar(&kind, "kind", "", "Only print the logs of pods of resources with the given kind")
+	command.Flags().StringVar(&name, "name", "", "Only print the logs of pods of resources with the given name")
+	command.Flags().StringVarP(&container, "container", "c", "", "Print the logs of this container. Required for pods with multiple containers")
+	command.Flags().BoolVarP(&follow, "follow", "f", false, "Stream the logs")
+	command.Flags().Int64Var(&tailLines, "tail", 0, "Number of most recent lines to print. Zero prints all lines")
+	command.Flags().Int64Var(&sinceSeconds, "since-seconds", 0, "Only print logs newer than a relative duration in seconds")
+	command.Flags().StringVar(&sinceTime, "since-time", "", "Only print logs after a time in RFC3339 format, e.g. 2019-01-02T15:04:05Z")
+	return command
+}
+
+// getAppPodNames returns the names of the pods which are either managed resources matching the
+// group, kind and name, or live resources created by them. Empty filters match all resources.
+func getAppPodNames(resources []argoappv1.ResourceState, group, kind, name string) []string {
+	var podNames []string
+	seen := make(map[string]bool)
+	addPod := func(obj *unstructured.Unstructured) {
+		if obj.GetKind() == kubeutil.PodKind && !seen[obj.GetName()] {
+			seen[obj.GetName()] = true
+			podNames = append(podNames, obj.GetName())
+		}
+	}
+	var addChildPods func(nodes []argoappv1.ResourceNode, matched bool)
+	addChildPods = func(nodes []argoappv1.ResourceNode, matched bool) {
+		for _, node := range nodes {
+			obj, err := argoappv1.UnmarshalToUnstructured(node.State)
+			errors.CheckError(err)
+			if obj == nil {
+				continue
+			}
+			nodeMatched := matched || resourceMatches(obj, group, kind, name)
+			if nodeMatched {
+				addPod(obj)
+			}
+			addChildPods(node.Children, nodeMatched)
+		}
+	}
+	for _, res := range resources {
+		obj, err := argoappv1.UnmarshalToUnstructured(res.LiveState)
+		errors.CheckError(err)
+		if obj == nil {
+			continue
+		}
+		matched := resourceMatches(obj, group, kind, name)
+		if matched {
+			addPod(obj)
+		}
+		addChildPods(res.ChildLiveResources, matched)
+	}
+	return podNames
+}
+
+// resourceMatches returns whether the resource matches the group, kind and name. Empty filters match any value.
+func resourceMatches(obj *unstructured.Unstructured, group, kind, name string) bool {
+	return (group == "" || obj.GroupVersionKind().Group == group) &&
+		(kind == "" || obj.GetKind() == kind) &&
+		(name == "" || obj.GetName() == name)
+}
+
+// resourceActionFlags are the flags selecting the resource of an `argocd app actions` or an
+// `argocd app live-manifest` command
+type resourceActionFlags struct {
+	group        string
+	kind         string
+	namespace    string
+	resourceName string
+}
+
+func (f *resourceActionFlags) addFlags(command *cobra.Command) {
+	command.Flags().StringVar(&f.group, "group", "", "API group of the resource (empty for core resources)")
+	command.Flags().StringVar(&f.kind, "kind", "", "Kind of the resource")
+	command.Flags().StringVar(&f.namespace, "namespace", "", "Namespace of the resource")
+	command.Flags().StringVar(&f.resourceName, "resource-name", "", "Name of the resource")
+}
+
+// NewApplicationActionsCommand returns a new instance of an `argocd app actions` command
+func NewApplicationActionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "actions",
+		Short: "Manage actions of the resources of an application",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationListActionsCommand(clientOpts))
+	command.AddCommand(NewApplicationRunActionCommand(clientOpts))
+	return command
+}
+
+// NewApplicationListActionsCommand returns a new instance of an `argocd app actions list` command
+func NewApplicationListActionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var flags resourceActionFlags
+	var command = &cobra.Command{
+		Use:   "list APPNAME",
+		Short: "List the actions available for a resource of an application",
+		Example: `  # List the actions of a deployment
+  argocd app actions list guestbook --group apps --kind Deployment --resource-name guestbook-ui`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 || flags.kind == "" || flags.resourceName == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			resp, err := appIf.ListResourceActions(context.Background(), &application.ResourceActionsListQuery{
+				Name:         &appName,
+				Group:        flags.group,
+				Kind:         flags.kind,
+				Namespace:    flags.namespace,
+				ResourceName: flags.resourceName,
+			})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "ACTION\tPARAMETERS\n")
+			for _, action := range resp.Actions {
+				fmt.Fprintf(w, "%s\t%s\n", action.Name, strings.Join(action.Params, ","))
+			}
+			_ = w.Flush()
+		},
+	}
+	flags.addFlags(command)
+	return command
+}
+
+// NewApplicationRunActionCommand returns a new instance of an `argocd app actions run` command
+func NewApplicationRunActionCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		flags  resourceActionFlags
+		params []string
+	)
+	var command = &cobra.Command{
+		Use:   "run APPNAME ACTION",
+		Short: "Run an action on a resource of an application",
+		Example: `  # Restart the pods of a deployment
+  argocd app actions run guestbook restart --group apps --kind Deployment --resource-name guestbook-ui
+
+  # Scale a deployment
+  argocd app actions run guestbook scale --group apps --kind Deployment --resource-name guestbook-ui --param replicas=3`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 || flags.kind == "" || flags.resourceName == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			parameters := make([]application.ResourceActionParameter, 0)
+			for name, value := range parseKeyValuePairs("parameter", params) {
+				parameters = append(parameters, application.ResourceActionParameter{Name: name, Value: value})
+			}
+			conn, appIf := argocdclient.NewClientOrDie(clientOpts).NewApplicationClientOrDie()
+			defer util.Close(conn)
+			_, err := appIf.RunResourceAction(context.Background(), &application.ResourceActionRunRequest{
+				Name:         &appName,
+				Group:        flags.group,
+				Kind:         flags.kind,
+				Namespace:    flags.namespace,
+				ResourceName: flags.resourceName,
+				Action:       args[1],
+				Parameters:   parameters,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Ran action %s on %s %s\n", args[1], flags.kind, flags.resourceName)
+		},
+	}
+	flags.addFlags(command)
+	command.Flags().StringArrayVar(&params, "param", []string{}, "Set a parameter of the action (e.g. --param replicas=3)")
+	return command
+}
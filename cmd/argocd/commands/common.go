@@ -1,5 +1,38 @@
 package commands
 
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/errors"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	"github.com/argoproj/argo-cd/server/settings"
+	"github.com/argoproj/argo-cd/util"
+)
+
 const (
 	cliName = "argocd"
 )
+
+// checkServerFeature exits if the feature is not enabled on the server, so that commands fail with a
+// clear message instead of the error of an unknown API
+func checkServerFeature(clientOpts *argocdclient.ClientOptions, feature string) {
+	if !hasServerFeature(clientOpts, feature) {
+		errors.Fatal(errors.ExitCodeGeneric, "The server does not support ", feature)
+	}
+}
+
+// hasServerFeature returns whether or not the feature is enabled on the server. Servers without the
+// Capabilities API are considered to support none of the features.
+func hasServerFeature(clientOpts *argocdclient.ClientOptions, feature string) bool {
+	conn, setIf := argocdclient.NewClientOrDie(clientOpts).NewSettingsClientOrDie()
+	defer util.Close(conn)
+	capabilities, err := setIf.Capabilities(context.Background(), &settings.CapabilitiesQuery{})
+	if status.Code(err) == codes.Unimplemented {
+		return false
+	}
+	errors.CheckError(err)
+	return capabilities.HasFeature(feature)
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/errors"
+	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
+	"github.com/argoproj/argo-cd/server/guardrail"
+	"github.com/argoproj/argo-cd/util"
+)
+
+// NewGuardrailCommand returns a new instance of an `argocd guardrail` command
+func NewGuardrailCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "guardrail",
+		Short: "Inspect the resource usage guardrails of the ArgoCD components",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewGuardrailListCommand(clientOpts))
+	return command
+}
+
+// NewGuardrailListCommand returns a new instance of an `argocd guardrail list` command
+func NewGuardrailListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "List the last measured guardrail states",
+		Run: func(c *cobra.Command, args []string) {
+			conn, guardrailIf := argocdclient.NewClientOrDie(clientOpts).NewGuardrailClientOrDie()
+			defer util.Close(conn)
+			list, err := guardrailIf.List(context.Background(), &guardrail.GuardrailQuery{})
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "COMPONENT\tNAME\tLEVEL\tVALUE\tLIMIT\tUNIT\n")
+			for _, state := range list.Items {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\n", state.Component, state.Name, state.Level, state.Value, state.Limit, state.Unit)
+			}
+			_ = w.Flush()
+		},
+	}
+	return command
+}
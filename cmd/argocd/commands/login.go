@@ -86,7 +86,7 @@ func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Comman
 				if !ssoConfigured(acdSet) {
 					log.Fatalf("ArgoCD instance is not configured with SSO")
 				}
-				tokenString, refreshToken = oauth2Login(server, clientOpts.PlainText)
+				tokenString, refreshToken = oauth2Login(server, clientOpts.PlainText, acdSet)
 			}
 
 			parser := &jwt.Parser{
@@ -145,7 +145,7 @@ func userDisplayName(claims jwt.MapClaims) string {
 }
 
 func ssoConfigured(set *settings.Settings) bool {
-	return set.DexConfig != nil && len(set.DexConfig.Connectors) > 0
+	return set.Issuer != "" || set.DexConfig != nil && len(set.DexConfig.Connectors) > 0
 }
 
 // getFreePort asks the kernel for a free open port that is ready to use.
@@ -158,8 +158,9 @@ func getFreePort() (int, error) {
 }
 
 // oauth2Login opens a browser, runs a temporary HTTP server to delegate OAuth2 login flow and
-// returns the JWT token and a refresh token (if supported)
-func oauth2Login(host string, plaintext bool) (string, string) {
+// returns the JWT token and a refresh token (if supported). The issuer and client ID are taken from
+// the settings of the server, falling back to the bundled dex of servers which do not report them.
+func oauth2Login(host string, plaintext bool, set *settings.Settings) (string, string) {
 	ctx := context.Background()
 	port, err := getFreePort()
 	errors.CheckError(err)
@@ -167,12 +168,20 @@ func oauth2Login(host string, plaintext bool) (string, string) {
 	if plaintext {
 		scheme = "http"
 	}
+	issuer := set.Issuer
+	if issuer == "" {
+		issuer = fmt.Sprintf("%s://%s%s", scheme, host, common.DexAPIEndpoint)
+	}
+	clientID := set.CLIClientID
+	if clientID == "" {
+		clientID = common.ArgoCDCLIClientAppID
+	}
 	conf := &oauth2.Config{
-		ClientID: common.ArgoCDCLIClientAppID,
+		ClientID: clientID,
 		Scopes:   []string{"openid", "profile", "email", "groups", "offline_access"},
 		Endpoint: oauth2.Endpoint{
-			AuthURL:  fmt.Sprintf("%s://%s%s/auth", scheme, host, common.DexAPIEndpoint),
-			TokenURL: fmt.Sprintf("%s://%s%s/token", scheme, host, common.DexAPIEndpoint),
+			AuthURL:  issuer + "/auth",
+			TokenURL: issuer + "/token",
 		},
 		RedirectURL: fmt.Sprintf("http://localhost:%d/auth/callback", port),
 	}
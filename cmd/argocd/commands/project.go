@@ -11,8 +11,11 @@ import (
 	"context"
 
 	"fmt"
+	"io/ioutil"
 	"text/tabwriter"
 
+	"github.com/ghodss/yaml"
+
 	"github.com/argoproj/argo-cd/errors"
 	argocdclient "github.com/argoproj/argo-cd/pkg/apiclient"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
@@ -24,9 +27,15 @@ import (
 )
 
 type projectOpts struct {
-	description  string
-	destinations []string
-	sources      []string
+	description      string
+	destinations     []string
+	sources          []string
+	sourceTools      []string
+	signatureKeys    []string
+	secretReferences []string
+
+	orphanedResources       bool
+	orphanedResourcesIgnore []string
 }
 
 func (opts *projectOpts) GetDestinations() []v1alpha1.ApplicationDestination {
@@ -45,6 +54,27 @@ func (opts *projectOpts) GetDestinations() []v1alpha1.ApplicationDestination {
 	return destinations
 }
 
+// GetOrphanedResources returns the orphaned resources monitor settings, or nil if disabled
+func (opts *projectOpts) GetOrphanedResources() *v1alpha1.OrphanedResourcesMonitorSettings {
+	if !opts.orphanedResources {
+		return nil
+	}
+	return &v1alpha1.OrphanedResourcesMonitorSettings{Ignore: opts.GetOrphanedResourcesIgnore()}
+}
+
+// GetOrphanedResourcesIgnore returns the resources which are never reported as orphaned
+func (opts *projectOpts) GetOrphanedResourcesIgnore() []v1alpha1.OrphanedResourceKey {
+	var keys []v1alpha1.OrphanedResourceKey
+	for _, r := range opts.orphanedResourcesIgnore {
+		fields := strings.Split(r, ":")
+		if len(fields) != 3 {
+			log.Fatalf("Ignored orphaned resource should be of the form GROUP:KIND:NAME, but was '%s'", r)
+		}
+		keys = append(keys, v1alpha1.OrphanedResourceKey{Group: fields[0], Kind: fields[1], Name: fields[2]})
+	}
+	return keys
+}
+
 // NewProjectCommand returns a new instance of an `argocd proj` command
 func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
@@ -63,6 +93,7 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectRemoveDestinationCommand(clientOpts))
 	command.AddCommand(NewProjectAddSourceCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveSourceCommand(clientOpts))
+	command.AddCommand(NewProjectSimulatePolicyCommand(clientOpts))
 	return command
 }
 
@@ -71,6 +102,11 @@ func addProjFlags(command *cobra.Command, opts *projectOpts) {
 	command.Flags().StringArrayVarP(&opts.destinations, "dest", "d", []string{},
 		"Allowed deployment destination. Includes comma separated server url and namespace (e.g. https://192.168.99.100:8443,default")
 	command.Flags().StringArrayVarP(&opts.sources, "src", "s", []string{}, "Allowed deployment source repository URL.")
+	command.Flags().StringArrayVar(&opts.sourceTools, "source-tool", []string{}, "Allowed config management tool (one of: ksonnet, helm, kustomize, directory). All tools are allowed if unspecified.")
+	command.Flags().StringArrayVar(&opts.signatureKeys, "signature-key", []string{}, "Fingerprint of a GPG key which may sign the revisions synced by the applications of the project. Revisions are not required to be signed if unspecified.")
+	command.Flags().StringArrayVar(&opts.secretReferences, "secret-reference", []string{}, "Glob pattern of the secret references, of the form BACKEND:PATH, which are resolved in the manifests of the applications of the project (e.g. vault:secret/data/guestbook/*). No reference is resolved if unspecified.")
+	command.Flags().BoolVar(&opts.orphanedResources, "orphaned-resources", false, "Warn about resources in the destination namespaces of applications which are not managed by any application")
+	command.Flags().StringArrayVar(&opts.orphanedResourcesIgnore, "orphaned-resources-ignore", []string{}, "Resource which is never reported as orphaned, of the form GROUP:KIND:NAME. Empty groups match the core group, empty kinds and names match all, and names may contain glob patterns")
 }
 
 // NewProjectCreateCommand returns a new instance of an `argocd proj create` command
@@ -90,9 +126,14 @@ func NewProjectCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 			proj := v1alpha1.AppProject{
 				ObjectMeta: v1.ObjectMeta{Name: projName},
 				Spec: v1alpha1.AppProjectSpec{
-					Description:  opts.description,
-					Destinations: opts.GetDestinations(),
-					SourceRepos:  opts.sources,
+					Description:      opts.description,
+					Destinations:     opts.GetDestinations(),
+					SourceRepos:      opts.sources,
+					SourceTools:      opts.sourceTools,
+					SignatureKeys:    opts.signatureKeys,
+					SecretReferences: opts.secretReferences,
+
+					OrphanedResources: opts.GetOrphanedResources(),
 				},
 			}
 			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
@@ -136,6 +177,23 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 					proj.Spec.Destinations = opts.GetDestinations()
 				case "src":
 					proj.Spec.SourceRepos = opts.sources
+				case "source-tool":
+					proj.Spec.SourceTools = opts.sourceTools
+				case "signature-key":
+					proj.Spec.SignatureKeys = opts.signatureKeys
+				case "secret-reference":
+					proj.Spec.SecretReferences = opts.secretReferences
+				case "orphaned-resources":
+					if !opts.orphanedResources {
+						proj.Spec.OrphanedResources = nil
+					} else if proj.Spec.OrphanedResources == nil {
+						proj.Spec.OrphanedResources = &v1alpha1.OrphanedResourcesMonitorSettings{}
+					}
+				case "orphaned-resources-ignore":
+					if proj.Spec.OrphanedResources == nil {
+						log.Fatal("Orphaned resources detection is not enabled, use --orphaned-resources")
+					}
+					proj.Spec.OrphanedResources.Ignore = opts.GetOrphanedResourcesIgnore()
 				}
 			})
 			if visited == 0 {
@@ -333,3 +391,51 @@ func NewProjectListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	}
 	return command
 }
+
+// NewProjectSimulatePolicyCommand returns a new instance of an `argocd proj simulate-policy` command
+func NewProjectSimulatePolicyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		policyFile  string
+		defaultRole string
+	)
+	var command = &cobra.Command{
+		Use:   "simulate-policy REQUESTS_FILE",
+		Short: "Evaluate recorded or synthetic requests against the RBAC policy and project rules",
+		Long:  "Evaluate requests, listed in a YAML or JSON file, against the RBAC policy and project rules, optionally replacing the configured policy with a candidate policy",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			var simulationReq project.PolicySimulationRequest
+			requestsBytes, err := ioutil.ReadFile(args[0])
+			errors.CheckError(err)
+			err = yaml.Unmarshal(requestsBytes, &simulationReq.Requests)
+			errors.CheckError(err)
+			if policyFile != "" {
+				policyBytes, err := ioutil.ReadFile(policyFile)
+				errors.CheckError(err)
+				simulationReq.Policy = string(policyBytes)
+				simulationReq.DefaultRole = defaultRole
+			}
+			conn, projIf := argocdclient.NewClientOrDie(clientOpts).NewProjectClientOrDie()
+			defer util.Close(conn)
+			res, err := projIf.SimulatePolicy(context.Background(), &simulationReq)
+			errors.CheckError(err)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintf(w, "SUBJECT\tRESOURCE\tACTION\tOBJECT\tRESULT\tMESSAGE\n")
+			for _, r := range res.Results {
+				result := "deny"
+				if r.Allowed {
+					result = "allow"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", r.Request.Subject, r.Request.Resource, r.Request.Action, r.Request.Object, result, r.Message)
+			}
+			_ = w.Flush()
+			fmt.Printf("\n%d allowed, %d denied\n", res.Allowed, res.Denied)
+		},
+	}
+	command.Flags().StringVar(&policyFile, "policy-file", "", "Candidate policy CSV file, evaluated instead of the configured policy")
+	command.Flags().StringVar(&defaultRole, "default-role", "", "Default role used along with the candidate policy")
+	return command
+}
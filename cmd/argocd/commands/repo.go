@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	log "github.com/sirupsen/logrus"
@@ -33,6 +34,7 @@ func NewRepoCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewRepoAddCommand(clientOpts))
 	command.AddCommand(NewRepoListCommand(clientOpts))
 	command.AddCommand(NewRepoRemoveCommand(clientOpts))
+	command.AddCommand(NewRepoUpdateCredentialsCommand(clientOpts))
 	return command
 }
 
@@ -42,6 +44,8 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		repo              appsv1.Repository
 		upsert            bool
 		sshPrivateKeyPath string
+		labels            []string
+		annotations       []string
 	)
 	var command = &cobra.Command{
 		Use:   "add REPO",
@@ -52,6 +56,8 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				os.Exit(1)
 			}
 			repo.Repo = args[0]
+			repo.Labels = parseKeyValuePairs("label", labels)
+			repo.Annotations = parseKeyValuePairs("annotation", annotations)
 			if sshPrivateKeyPath != "" {
 				keyData, err := ioutil.ReadFile(sshPrivateKeyPath)
 				if err != nil {
@@ -88,7 +94,63 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.Flags().StringVar(&repo.Username, "username", "", "username to the repository")
 	command.Flags().StringVar(&repo.Password, "password", "", "password to the repository")
 	command.Flags().StringVar(&sshPrivateKeyPath, "sshPrivateKeyPath", "", "path to the private ssh key (e.g. ~/.ssh/id_rsa)")
+	command.Flags().StringVar(&repo.DefaultRevision, "default-revision", "", "Revision used by applications which do not specify a target revision (defaults to HEAD)")
 	command.Flags().BoolVar(&upsert, "upsert", false, "Override an existing repository with the same name even if the spec differs")
+	command.Flags().StringArrayVar(&labels, "label", []string{}, "Set a label on the repository (e.g. --label team=payments)")
+	command.Flags().StringArrayVar(&annotations, "annotation", []string{}, "Set an annotation on the repository (e.g. --annotation owner=jane@example.com)")
+	return command
+}
+
+// parseKeyValuePairs parses a list of key=value strings into a map, or returns nil if the list is empty
+func parseKeyValuePairs(name string, pairs []string) map[string]string {
+	if len(pairs) == 0 {
+		return nil
+	}
+	res := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			log.Fatalf("Expected %s of the form: key=value. Received: %s", name, pair)
+		}
+		res[parts[0]] = parts[1]
+	}
+	return res
+}
+
+// NewRepoUpdateCredentialsCommand returns a new instance of an `argocd repo update-credentials` command
+func NewRepoUpdateCredentialsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		req               repository.RepoUpdateCredentialsRequest
+		sshPrivateKeyPath string
+	)
+	var command = &cobra.Command{
+		Use:   "update-credentials REPO",
+		Short: "Replace the credentials of a git repository, e.g. to rotate an access token",
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			req.Repo = args[0]
+			if sshPrivateKeyPath != "" {
+				keyData, err := ioutil.ReadFile(sshPrivateKeyPath)
+				if err != nil {
+					log.Fatal(err)
+				}
+				req.SshPrivateKey = string(keyData)
+			} else if !git.IsSSHURL(req.Repo) {
+				req.Username, req.Password = cli.PromptCredentials(req.Username, req.Password)
+			}
+			conn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoClientOrDie()
+			defer util.Close(conn)
+			repo, err := repoIf.UpdateCredentials(context.Background(), &req)
+			errors.CheckError(err)
+			fmt.Printf("repository '%s' credentials updated\n", repo.Repo)
+		},
+	}
+	command.Flags().StringVar(&req.Username, "username", "", "username to the repository")
+	command.Flags().StringVar(&req.Password, "password", "", "password to the repository")
+	command.Flags().StringVar(&sshPrivateKeyPath, "sshPrivateKeyPath", "", "path to the private ssh key (e.g. ~/.ssh/id_rsa)")
 	return command
 }
 
@@ -115,13 +177,14 @@ func NewRepoRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 
 // NewRepoListCommand returns a new instance of an `argocd repo rm` command
 func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var selector string
 	var command = &cobra.Command{
 		Use:   "list",
 		Short: "List configured repositories",
 		Run: func(c *cobra.Command, args []string) {
 			conn, repoIf := argocdclient.NewClientOrDie(clientOpts).NewRepoClientOrDie()
 			defer util.Close(conn)
-			repos, err := repoIf.List(context.Background(), &repository.RepoQuery{})
+			repos, err := repoIf.List(context.Background(), &repository.RepoQuery{Selector: selector})
 			errors.CheckError(err)
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 			fmt.Fprintf(w, "REPO\tUSER\tSTATUS\tMESSAGE\n")
@@ -131,5 +194,6 @@ func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 			_ = w.Flush()
 		},
 	}
+	command.Flags().StringVarP(&selector, "selector", "l", "", "List repositories by label selector (e.g. team=payments,env!=dev)")
 	return command
 }
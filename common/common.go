@@ -30,6 +30,9 @@ const (
 	ArgoCDSecretName        = "argocd-secret"
 	ArgoCDConfigMapName     = "argocd-cm"
 	ArgoCDRBACConfigMapName = "argocd-rbac-cm"
+	// ArgoCDServerTLSSecretName is the optional secret of type kubernetes.io/tls holding the TLS
+	// certificate of the API server, which takes precedence over the certificate in argocd-secret
+	ArgoCDServerTLSSecretName = "argocd-server-tls"
 )
 
 const (
@@ -75,6 +78,11 @@ var (
 	AnnotationHookDeletePolicy = MetadataPrefix + "/hook-delete-policy"
 	// AnnotationHelmHook is the helm hook annotation
 	AnnotationHelmHook = "helm.sh/hook"
+	// AnnotationSyncOptions contains the comma separated sync options of a resource, e.g. Prune=false
+	AnnotationSyncOptions = MetadataPrefix + "/sync-options"
+	// AnnotationKeyAppInstance is the tracking id of a resource, which identifies the application
+	// it belongs to when resources are tracked by annotation
+	AnnotationKeyAppInstance = MetadataPrefix + "/tracking-id"
 
 	// LabelKeyApplicationControllerInstanceID is the label which allows to separate application among multiple running application controllers.
 	LabelKeyApplicationControllerInstanceID = application.ApplicationFullName + "/controller-instanceid"
@@ -86,6 +94,38 @@ var (
 	// arbitrary value (i.e. timestamp) on a git event, to  force the controller to wake up and
 	// re-evaluate the application
 	AnnotationKeyRefresh = application.ApplicationFullName + "/refresh"
+	// AnnotationKeyHardRefresh is the annotation key in the application which is updated with the
+	// timestamp of a hard refresh request, to force the controller to regenerate the manifests of the
+	// application bypassing the repo server cache
+	AnnotationKeyHardRefresh = application.ApplicationFullName + "/hard-refresh"
+	// AnnotationKeyRefreshPeriod is the annotation key in the application which overrides the
+	// resync period of the controller for the application (e.g. 10m)
+	AnnotationKeyRefreshPeriod = application.ApplicationFullName + "/refresh-period"
+	// AnnotationKeyPrunePropagationPolicy is the annotation key in the application which sets how the
+	// dependents of the resources pruned by its syncs are deleted (foreground, background or orphan)
+	AnnotationKeyPrunePropagationPolicy = application.ApplicationFullName + "/prune-propagation-policy"
+	// AnnotationKeyServerSideApply is the annotation key in the application which, when set to "true"
+	// or "false", overrides whether the controller applies the resources of the application server-side
+	AnnotationKeyServerSideApply = application.ApplicationFullName + "/server-side-apply"
+
+	// AnnotationKeyRefreshSchedule is the annotation key in the application containing a cron
+	// expression (e.g. "0 2 * * *"), on which the controller forces a hard refresh of the application
+	AnnotationKeyRefreshSchedule = application.ApplicationFullName + "/refresh-schedule"
+	// AnnotationKeyRefreshScheduleSync is the annotation key in the application which, when set to
+	// "true", causes the controller to also sync the application on its refresh schedule
+	AnnotationKeyRefreshScheduleSync = application.ApplicationFullName + "/refresh-schedule-sync"
+
+	// AnnotationKeyManifestGenerateTimeout is the annotation key in the application containing a
+	// duration (e.g. "5m"), which overrides the repo server's default manifest generation timeout
+	AnnotationKeyManifestGenerateTimeout = application.ApplicationFullName + "/manifest-generate-timeout"
+	// AnnotationKeyManifestFormat is the annotation key in the application containing the format
+	// (yaml, json or jsonl) in which the API server returns its manifests, unless a query overrides it
+	AnnotationKeyManifestFormat = application.ApplicationFullName + "/manifest-format"
+	// AnnotationKeyManifestPaths is the annotation key in the application containing the semicolon
+	// separated paths (e.g. ".;../base") its manifests are generated from, relative to its source path or,
+	// if they start with a slash, to the root of the repository. Webhook events only refresh annotated
+	// applications if one of the changed files is in one of the paths.
+	AnnotationKeyManifestPaths = application.ApplicationFullName + "/manifest-paths"
 )
 
 // ArgoCDManagerServiceAccount is the name of the service account for managing a cluster
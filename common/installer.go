@@ -2,9 +2,11 @@ package common
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/argoproj/argo-cd/errors"
+	jwt "github.com/dgrijalva/jwt-go"
 	log "github.com/sirupsen/logrus"
 	apiv1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -186,3 +188,113 @@ func UninstallRBAC(clientset kubernetes.Interface, namespace, bindingName, roleN
 		fmt.Printf("ServiceAccount '%s' deleted\n", serviceAccount)
 	}
 }
+
+// ServiceAccountClaims are the claims of the token of a service account
+type ServiceAccountClaims struct {
+	Sub                string `json:"sub"`
+	Iss                string `json:"iss"`
+	Namespace          string `json:"kubernetes.io/serviceaccount/namespace"`
+	SecretName         string `json:"kubernetes.io/serviceaccount/secret.name"`
+	ServiceAccountName string `json:"kubernetes.io/serviceaccount/service-account.name"`
+	ServiceAccountUID  string `json:"kubernetes.io/serviceaccount/service-account.uid"`
+}
+
+// Valid satisfies the jwt.Claims interface. Service account tokens do not expire.
+func (c *ServiceAccountClaims) Valid() error {
+	return nil
+}
+
+// ParseServiceAccountToken parses the claims of the token of a service account, without verifying it
+func ParseServiceAccountToken(token string) (*ServiceAccountClaims, error) {
+	parser := &jwt.Parser{
+		SkipClaimsValidation: true,
+	}
+	var claims ServiceAccountClaims
+	_, _, err := parser.ParseUnverified(token, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse service account token: %v", err)
+	}
+	if claims.Namespace == "" || claims.SecretName == "" || claims.ServiceAccountName == "" {
+		return nil, fmt.Errorf("token is not a service account token")
+	}
+	return &claims, nil
+}
+
+// GenerateNewClusterManagerSecret creates a new token secret for the service account of the claims,
+// with the annotations of its current secret, and waits until it is populated with a token
+func GenerateNewClusterManagerSecret(clientset kubernetes.Interface, claims *ServiceAccountClaims) (*apiv1.Secret, error) {
+	secretsClient := clientset.CoreV1().Secrets(claims.Namespace)
+	existingSecret, err := secretsClient.Get(claims.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// token secrets are named after their service account followed by a random suffix
+	prefix := claims.SecretName
+	if i := strings.LastIndex(prefix, "-"); i > 0 {
+		prefix = prefix[:i]
+	}
+	newSecret := apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: prefix + "-",
+			Annotations:  make(map[string]string),
+		},
+		Type: apiv1.SecretTypeServiceAccountToken,
+	}
+	for k, v := range existingSecret.Annotations {
+		// the token controller populates the secret of the service account with the name annotation
+		if k != apiv1.ServiceAccountUIDKey {
+			newSecret.Annotations[k] = v
+		}
+	}
+	createdSecret, err := secretsClient.Create(&newSecret)
+	if err != nil {
+		return nil, err
+	}
+	secretName := createdSecret.Name
+	err = wait.Poll(500*time.Millisecond, 30*time.Second, func() (bool, error) {
+		createdSecret, err = secretsClient.Get(secretName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return len(createdSecret.Data[apiv1.ServiceAccountTokenKey]) > 0, nil
+	})
+	if err != nil {
+		_ = secretsClient.Delete(secretName, &metav1.DeleteOptions{})
+		return nil, fmt.Errorf("failed to wait for the token of secret '%s': %v", secretName, err)
+	}
+	return createdSecret, nil
+}
+
+// RotateServiceAccountSecrets replaces the secret of the claims by the new secret in the secrets of the
+// service account, and deletes the old secret, which revokes its token
+func RotateServiceAccountSecrets(clientset kubernetes.Interface, claims *ServiceAccountClaims, newSecret *apiv1.Secret) error {
+	saClient := clientset.CoreV1().ServiceAccounts(claims.Namespace)
+	sa, err := saClient.Get(claims.ServiceAccountName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	secrets := make([]apiv1.ObjectReference, 0)
+	present := false
+	for _, ref := range sa.Secrets {
+		if ref.Name == claims.SecretName {
+			continue
+		}
+		if ref.Name == newSecret.Name {
+			present = true
+		}
+		secrets = append(secrets, ref)
+	}
+	if !present {
+		secrets = append(secrets, apiv1.ObjectReference{Name: newSecret.Name})
+	}
+	sa.Secrets = secrets
+	_, err = saClient.Update(sa)
+	if err != nil {
+		return err
+	}
+	err = clientset.CoreV1().Secrets(claims.Namespace).Delete(claims.SecretName, &metav1.DeleteOptions{})
+	if err != nil && !apierr.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
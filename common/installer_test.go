@@ -0,0 +1,57 @@
+package common
+
+import (
+	"testing"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseServiceAccountToken(t *testing.T) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss":                                    "kubernetes/serviceaccount",
+		"kubernetes.io/serviceaccount/namespace": "kube-system",
+		"kubernetes.io/serviceaccount/secret.name":          "argocd-manager-token-x7k2p",
+		"kubernetes.io/serviceaccount/service-account.name": "argocd-manager",
+	}).SignedString([]byte("secret"))
+	assert.Nil(t, err)
+
+	claims, err := ParseServiceAccountToken(token)
+	assert.Nil(t, err)
+	assert.Equal(t, "kube-system", claims.Namespace)
+	assert.Equal(t, "argocd-manager-token-x7k2p", claims.SecretName)
+	assert.Equal(t, "argocd-manager", claims.ServiceAccountName)
+
+	token, err = jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": "argocd", "sub": "admin"}).SignedString([]byte("secret"))
+	assert.Nil(t, err)
+	_, err = ParseServiceAccountToken(token)
+	assert.NotNil(t, err)
+}
+
+func TestRotateServiceAccountSecrets(t *testing.T) {
+	claims := &ServiceAccountClaims{
+		Namespace:          "kube-system",
+		SecretName:         "argocd-manager-token-x7k2p",
+		ServiceAccountName: "argocd-manager",
+	}
+	clientset := fake.NewSimpleClientset(
+		&apiv1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: "argocd-manager", Namespace: "kube-system"},
+			Secrets:    []apiv1.ObjectReference{{Name: "argocd-manager-token-x7k2p"}},
+		},
+		&apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "argocd-manager-token-x7k2p", Namespace: "kube-system"}},
+	)
+	newSecret := &apiv1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "argocd-manager-token-b9m4q", Namespace: "kube-system"}}
+
+	err := RotateServiceAccountSecrets(clientset, claims, newSecret)
+	assert.Nil(t, err)
+	sa, err := clientset.CoreV1().ServiceAccounts("kube-system").Get("argocd-manager", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []apiv1.ObjectReference{{Name: "argocd-manager-token-b9m4q"}}, sa.Secrets)
+	_, err = clientset.CoreV1().Secrets("kube-system").Get("argocd-manager-token-x7k2p", metav1.GetOptions{})
+	assert.True(t, apierr.IsNotFound(err))
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"reflect"
 	"runtime/debug"
 	"sync"
 	"time"
 
+	"github.com/robfig/cron"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -23,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
@@ -31,8 +34,12 @@ import (
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	appinformers "github.com/argoproj/argo-cd/pkg/client/informers/externalversions"
 	"github.com/argoproj/argo-cd/reposerver"
+	"github.com/argoproj/argo-cd/reposerver/repository"
+	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/guardrail"
 	"github.com/argoproj/argo-cd/util/health"
 	"github.com/argoproj/argo-cd/util/kube"
 )
@@ -40,6 +47,26 @@ import (
 const (
 	watchResourcesRetryTimeout  = 10 * time.Second
 	updateOperationStateTimeout = 1 * time.Second
+	// refreshScheduleInterval is how often application refresh schedules are evaluated. Cron
+	// expressions have minute granularity, so there is no point in checking more often.
+	refreshScheduleInterval = 1 * time.Minute
+	// revisionTrackingInterval is how often applications targeting a semver constraint are checked
+	// for newly pushed tags matching the constraint
+	revisionTrackingInterval = 3 * time.Minute
+	// DefaultSelfHealTimeout is the default time to wait before self-healing the drift of an application
+	DefaultSelfHealTimeout = 5 * time.Second
+	// DefaultSelfHealBackoffCap is the default maximum time to wait before self-healing the drift of an
+	// application again
+	DefaultSelfHealBackoffCap = 5 * time.Minute
+	// selfHealBackoffFactor is the factor by which the time to wait increases with each consecutive
+	// self-heal of an application
+	selfHealBackoffFactor = 2
+	// deletionPollInterval is how often the resources of an application being deleted are checked,
+	// until all of them are gone
+	deletionPollInterval = 5 * time.Second
+	// liveStateResyncPeriod is how often the resources of applications in clusters are listed again
+	// into the live state cache, which is otherwise kept up to date from the watches of the clusters
+	liveStateResyncPeriod = 10 * time.Minute
 )
 
 // ApplicationController is the controller for application resources.
@@ -53,15 +80,56 @@ type ApplicationController struct {
 	appInformer           cache.SharedIndexInformer
 	appStateManager       AppStateManager
 	statusRefreshTimeout  time.Duration
+	statusRefreshJitter   time.Duration
 	repoClientset         reposerver.Clientset
 	db                    db.ArgoDB
 	forceRefreshApps      map[string]bool
 	forceRefreshAppsMutex *sync.Mutex
+	watchdog              *guardrail.Watchdog
+	maxAppObjectSize      int64
+	selfHealTimeout       time.Duration
+	selfHealBackoffCap    time.Duration
+	resourceFilter        kube.ResourceFilter
+	resourceTracking      argo.ResourceTracking
+	progressingDeadline   time.Duration
+	liveStateCache        *LiveStateCache
+	shard                 int
+	shards                int
 }
 
 type ApplicationControllerConfig struct {
 	InstanceID string
 	Namespace  string
+	// MaxAppObjectSize is the application object size in bytes at which the app-object-size guardrail
+	// is exceeded. Zero disables the guardrail.
+	MaxAppObjectSize int64
+	// MaxRefreshQueueLatency is the time in the refresh queue at which the refresh-queue-latency
+	// guardrail is exceeded. Zero disables the guardrail.
+	MaxRefreshQueueLatency time.Duration
+	// SelfHealTimeout is the time the controller waits after a sync finished, before it self-heals the
+	// drift of an application from the synced revision
+	SelfHealTimeout time.Duration
+	// SelfHealBackoffCap is the maximum time the controller waits before it self-heals an application,
+	// as the time doubles with each consecutive self-heal
+	SelfHealBackoffCap time.Duration
+	// AppResyncJitter is the maximum delay added to the resync period of each application. The delay
+	// is derived from the application name, so that comparisons of applications are spread over time.
+	AppResyncJitter time.Duration
+	// ResourceFilter excludes resources from the watches of clusters
+	ResourceFilter kube.ResourceFilter
+	// ResourceTracking identifies the applications which the resources of clusters belong to
+	ResourceTracking argo.ResourceTracking
+	// ProgressingDeadline is the time after which resources which are still progressing are
+	// considered degraded. Zero disables the deadline.
+	ProgressingDeadline time.Duration
+	// LiveStateCache holds the live state of the resources of applications, which the controller
+	// maintains from the watches of clusters. The cache is shared with the app state manager.
+	LiveStateCache *LiveStateCache
+	// Shards is the number of shards the clusters are distributed to. Each shard is processed by its
+	// own controller replicas, and zero or one process all clusters in a single shard.
+	Shards int
+	// Shard is the shard processed by the controller, from 0 to Shards - 1
+	Shard int
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -75,9 +143,9 @@ func NewApplicationController(
 	appResyncPeriod time.Duration,
 	config *ApplicationControllerConfig,
 ) *ApplicationController {
-	appRefreshQueue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	appRefreshQueue := newLatencyTrackingQueue(workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()))
 	appOperationQueue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
-	return &ApplicationController{
+	ctrl := &ApplicationController{
 		namespace:             namespace,
 		kubeClientset:         kubeClientset,
 		applicationClientset:  applicationClientset,
@@ -88,10 +156,25 @@ func NewApplicationController(
 		appInformer:           newApplicationInformer(applicationClientset, appRefreshQueue, appOperationQueue, appResyncPeriod, config),
 		db:                    db,
 		statusRefreshTimeout:  appResyncPeriod,
+		statusRefreshJitter:   config.AppResyncJitter,
 		forceRefreshApps:      make(map[string]bool),
 		forceRefreshAppsMutex: &sync.Mutex{},
 		auditLogger:           argo.NewAuditLogger(namespace, kubeClientset, "application-controller"),
+		selfHealTimeout:       config.SelfHealTimeout,
+		selfHealBackoffCap:    config.SelfHealBackoffCap,
+		resourceFilter:        config.ResourceFilter,
+		resourceTracking:      config.ResourceTracking,
+		progressingDeadline:   config.ProgressingDeadline,
+		liveStateCache:        config.LiveStateCache,
+		shard:                 config.Shard,
+		shards:                config.Shards,
+		maxAppObjectSize:      config.MaxAppObjectSize,
 	}
+	if ctrl.liveStateCache == nil {
+		ctrl.liveStateCache = NewLiveStateCache(config.ResourceTracking)
+	}
+	ctrl.watchdog = ctrl.newWatchdog(config, appRefreshQueue)
+	return ctrl
 }
 
 // Run starts the Application CRD controller.
@@ -99,13 +182,32 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	defer runtime.HandleCrash()
 	defer ctrl.appRefreshQueue.ShutDown()
 
+	if !ctrl.warmUpCaches(ctx) {
+		return
+	}
+	ctrl.runProcessors(ctx, statusProcessors, operationProcessors)
+
+	<-ctx.Done()
+}
+
+// warmUpCaches starts the application informer and the cluster resource watches, and waits until
+// the application cache is synced. Returns false if the context is done before caches are synced.
+func (ctrl *ApplicationController) warmUpCaches(ctx context.Context) bool {
 	go ctrl.appInformer.Run(ctx.Done())
 	go ctrl.watchAppsResources()
 
 	if !cache.WaitForCacheSync(ctx.Done(), ctrl.appInformer.HasSynced) {
 		log.Error("Timed out waiting for caches to sync")
-		return
+		return false
 	}
+	return true
+}
+
+// runProcessors starts the goroutines which refresh and sync applications
+func (ctrl *ApplicationController) runProcessors(ctx context.Context, statusProcessors int, operationProcessors int) {
+	go ctrl.runRefreshSchedules(ctx)
+	go ctrl.runRevisionTracking(ctx)
+	go ctrl.runWatchdog(ctx)
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -120,48 +222,253 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 			}
 		}, time.Second, ctx.Done())
 	}
-
-	<-ctx.Done()
 }
 
-func (ctrl *ApplicationController) forceAppRefresh(appName string) {
+func (ctrl *ApplicationController) forceAppRefresh(appName string, hard bool) {
 	ctrl.forceRefreshAppsMutex.Lock()
 	defer ctrl.forceRefreshAppsMutex.Unlock()
-	ctrl.forceRefreshApps[appName] = true
+	ctrl.forceRefreshApps[appName] = ctrl.forceRefreshApps[appName] || hard
 }
 
-func (ctrl *ApplicationController) isRefreshForced(appName string) bool {
+// isRefreshForced returns whether a refresh of the app was requested, and if so, whether it was a hard refresh
+func (ctrl *ApplicationController) isRefreshForced(appName string) (bool, bool) {
 	ctrl.forceRefreshAppsMutex.Lock()
 	defer ctrl.forceRefreshAppsMutex.Unlock()
-	_, ok := ctrl.forceRefreshApps[appName]
+	hard, ok := ctrl.forceRefreshApps[appName]
 	if ok {
 		delete(ctrl.forceRefreshApps, appName)
 	}
-	return ok
+	return ok, hard
+}
+
+// runRefreshSchedules periodically evaluates the refresh schedules of applications and triggers
+// the ones which became due since the previous evaluation.
+func (ctrl *ApplicationController) runRefreshSchedules(ctx context.Context) {
+	ticker := time.NewTicker(refreshScheduleInterval)
+	defer ticker.Stop()
+	lastCheck := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			ctrl.processRefreshSchedules(lastCheck, now)
+			lastCheck = now
+		}
+	}
+}
+
+// processRefreshSchedules forces a hard refresh of every application whose refresh schedule fired
+// in the (since, now] interval, and requests a sync of those which opted into scheduled syncs.
+func (ctrl *ApplicationController) processRefreshSchedules(since time.Time, now time.Time) {
+	for _, obj := range ctrl.appInformer.GetIndexer().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok || !ctrl.isAppInShard(app) {
+			continue
+		}
+		due, err := isRefreshScheduleDue(app, since, now)
+		if err != nil {
+			log.Warnf("Invalid refresh schedule of application '%s': %v", app.Name, err)
+			continue
+		}
+		if !due {
+			continue
+		}
+		log.Infof("Refresh schedule of application '%s' is due", app.Name)
+		ctrl.forceAppRefresh(app.Name, true)
+		ctrl.appRefreshQueue.Add(ctrl.namespace + "/" + app.Name)
+		if app.Annotations[common.AnnotationKeyRefreshScheduleSync] == "true" {
+			ctrl.requestScheduledSync(app)
+		}
+	}
+}
+
+// runRevisionTracking periodically checks whether the semver constraints of applications resolve to
+// a different revision than the one they were last compared to.
+func (ctrl *ApplicationController) runRevisionTracking(ctx context.Context) {
+	ticker := time.NewTicker(revisionTrackingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ctrl.processRevisionTracking()
+		}
+	}
+}
+
+// processRevisionTracking refreshes every application targeting a semver constraint for which a new
+// matching tag appeared since the last comparison
+func (ctrl *ApplicationController) processRevisionTracking() {
+	var apps []*appv1.Application
+	for _, obj := range ctrl.appInformer.GetIndexer().List() {
+		app, ok := obj.(*appv1.Application)
+		if ok && ctrl.isAppInShard(app) && isTrackingSemverConstraint(app) {
+			apps = append(apps, app)
+		}
+	}
+	if len(apps) == 0 {
+		return
+	}
+	conn, repoClient, err := ctrl.repoClientset.NewRepositoryClient()
+	if err != nil {
+		log.Warnf("Failed to connect to repo server to track revisions: %v", err)
+		return
+	}
+	defer util.Close(conn)
+	for _, app := range apps {
+		repo, err := ctrl.db.GetRepository(context.Background(), app.Spec.Source.RepoURL)
+		if err != nil {
+			// If we couldn't retrieve from the repo service, assume public repositories
+			repo = &appv1.Repository{Repo: app.Spec.Source.RepoURL}
+		}
+		res, err := repoClient.ResolveRevision(context.Background(), &repository.ResolveRevisionRequest{
+			Repo:     repo,
+			Revision: app.Spec.Source.TargetRevision,
+		})
+		if err != nil {
+			log.Warnf("Failed to resolve revision '%s' of application '%s': %v", app.Spec.Source.TargetRevision, app.Name, err)
+			continue
+		}
+		if res.Revision == app.Status.ComparisonResult.Revision {
+			continue
+		}
+		log.Infof("Revision '%s' of application '%s' now resolves to %s (previously %s)", app.Spec.Source.TargetRevision, app.Name, res.Revision, app.Status.ComparisonResult.Revision)
+		ctrl.forceAppRefresh(app.Name, false)
+		ctrl.appRefreshQueue.Add(ctrl.namespace + "/" + app.Name)
+	}
+}
+
+// isTrackingSemverConstraint returns whether the application targets a semver constraint and has
+// already been compared to a revision the constraint resolved to
+func isTrackingSemverConstraint(app *appv1.Application) bool {
+	return git.IsSemverConstraint(app.Spec.Source.TargetRevision) && app.Status.ComparisonResult.Revision != ""
+}
+
+// isRefreshScheduleDue returns whether the refresh schedule of the application fired in the (since, now] interval
+func isRefreshScheduleDue(app *appv1.Application, since time.Time, now time.Time) (bool, error) {
+	expr, ok := app.Annotations[common.AnnotationKeyRefreshSchedule]
+	if !ok || expr == "" {
+		return false, nil
+	}
+	schedule, err := cron.ParseStandard(expr)
+	if err != nil {
+		return false, err
+	}
+	return !schedule.Next(since).After(now), nil
+}
+
+// requestScheduledSync initiates a sync operation of the application to its target revision,
+// unless another operation is already requested or in progress.
+func (ctrl *ApplicationController) requestScheduledSync(app *appv1.Application) {
+	if app.Operation != nil || isOperationInProgress(app) {
+		log.Infof("Skipping scheduled sync of application '%s': another operation is in progress", app.Name)
+		return
+	}
+	if permitted, err := ctrl.isSyncPermitted(app); err != nil {
+		log.Warnf("Skipping scheduled sync of application '%s': %v", app.Name, err)
+		return
+	} else if !permitted {
+		log.Infof("Skipping scheduled sync of application '%s': denied by the sync windows of project %s", app.Name, app.Spec.GetProject())
+		return
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"operation": appv1.Operation{
+			Sync: &appv1.SyncOperation{
+				Revision: app.Spec.Source.TargetRevision,
+			},
+			InitiatedBy: appv1.OperationInitiator{Automated: true},
+		},
+	})
+	if err == nil {
+		_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(app.Name, types.MergePatchType, patch)
+	}
+	if err != nil {
+		log.Errorf("Unable to initiate scheduled sync of application '%s': %v", app.Name, err)
+		return
+	}
+	log.Infof("Initiated scheduled sync of application '%s'", app.Name)
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Action: "sync"}, v1.EventTypeNormal)
 }
 
 // watchClusterResources watches for resource changes annotated with application label on specified cluster and schedule corresponding app refresh.
+// The live state cache of the cluster is maintained from the watch, and relisted periodically.
 func (ctrl *ApplicationController) watchClusterResources(ctx context.Context, item appv1.Cluster) {
 	config := item.RESTConfig()
+	clusterState := ctrl.liveStateCache.getCluster(item.Server)
 	retryUntilSucceed(func() error {
-		ch, err := kube.WatchResourcesWithLabel(ctx, config, "", common.LabelApplicationName)
-		if err != nil {
-			return err
-		}
-		for event := range ch {
-			eventObj := event.Object.(*unstructured.Unstructured)
-			objLabels := eventObj.GetLabels()
-			if objLabels == nil {
-				objLabels = make(map[string]string)
-			}
-			if appName, ok := objLabels[common.LabelApplicationName]; ok {
-				ctrl.forceAppRefresh(appName)
-				ctrl.appRefreshQueue.Add(ctrl.namespace + "/" + appName)
+		for ctx.Err() == nil {
+			if err := ctrl.watchClusterResourcesUntilResync(ctx, config, clusterState); err != nil {
+				return err
 			}
 		}
 		return fmt.Errorf("resource updates channel has closed")
 	}, fmt.Sprintf("watch app resources on %s", config.Host), ctx, watchResourcesRetryTimeout)
+	ctrl.liveStateCache.removeCluster(item.Server)
+}
 
+// watchClusterResourcesUntilResync lists the resources of applications in the cluster into its live
+// state, and keeps the state up to date from the watch of the resources until the resync period is
+// over. The watch is started before listing, so that no change is missed.
+func (ctrl *ApplicationController) watchClusterResourcesUntilResync(ctx context.Context, config *rest.Config, clusterState *clusterLiveState) error {
+	defer clusterState.invalidate()
+	watchCtx, cancel := context.WithTimeout(ctx, liveStateResyncPeriod)
+	defer cancel()
+	labelSelector := ""
+	filter := ctrl.resourceFilter
+	if ctrl.resourceTracking.UsesLabel() {
+		labelSelector = common.LabelApplicationName
+	}
+	// resources tracked by annotation only are watched without label selector, so only the kinds
+	// managed by applications are watched. The watch restarts when applications manage new kinds.
+	kinds := clusterState.watchKinds()
+	if kinds != nil {
+		filter = &managedKindsFilter{filter: ctrl.resourceFilter, kinds: kinds}
+		go func() {
+			select {
+			case <-clusterState.kindsChanged:
+				cancel()
+			case <-watchCtx.Done():
+			}
+		}()
+		if len(kinds) == 0 {
+			clusterState.replace(nil, kinds)
+			<-watchCtx.Done()
+			return ctx.Err()
+		}
+	}
+	ch, err := kube.WatchResourcesWithLabel(watchCtx, config, "", labelSelector, filter)
+	if err != nil {
+		return err
+	}
+	objs, err := kube.GetResources(config, "", labelSelector, func(obj *unstructured.Unstructured) bool {
+		return ctrl.resourceTracking.GetAppName(obj) != ""
+	}, filter)
+	if err != nil {
+		cancel()
+		for range ch {
+		}
+		return err
+	}
+	clusterState.replace(objs, kinds)
+	for event := range ch {
+		eventObj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		prevAppName, appName := clusterState.processEvent(event.Type, eventObj)
+		for _, name := range []string{prevAppName, appName} {
+			if name != "" {
+				ctrl.forceAppRefresh(name, false)
+				ctrl.appRefreshQueue.Add(ctrl.namespace + "/" + name)
+			}
+		}
+	}
+	if watchCtx.Err() != nil && ctx.Err() == nil {
+		return nil
+	}
+	return fmt.Errorf("resource updates channel has closed")
 }
 
 // WatchAppsResources watches for resource changes annotated with application label on all registered clusters and schedule corresponding app refresh.
@@ -174,7 +481,7 @@ func (ctrl *ApplicationController) watchAppsResources() {
 			if event.Type == watch.Deleted && ok {
 				cancel()
 				delete(watchingClusters, event.Cluster.Server)
-			} else if event.Type != watch.Deleted && !ok {
+			} else if event.Type != watch.Deleted && !ok && ctrl.isClusterInShard(event.Cluster.Server) {
 				ctx, cancel := context.WithCancel(context.Background())
 				watchingClusters[event.Cluster.Server] = cancel
 				go ctrl.watchClusterResources(ctx, *event.Cluster)
@@ -242,6 +549,9 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
+	if !ctrl.isAppInShard(app) {
+		return
+	}
 	if app.Operation != nil {
 		ctrl.processRequestedAppOperation(app)
 	} else if app.DeletionTimestamp != nil && app.CascadedDeletion() {
@@ -266,7 +576,27 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 
 	if err == nil {
 		config := clst.RESTConfig()
-		err = kube.DeleteResourceWithLabel(config, app.Spec.Destination.Namespace, common.LabelApplicationName, app.Name)
+		var remaining []*unstructured.Unstructured
+		var filter kube.ResourceFilter
+		if !ctrl.resourceTracking.UsesLabel() {
+			// resources tracked by annotation only are listed among the kinds the application manages
+			if kinds := getManagedKinds(app, nil); len(kinds) > 0 {
+				filter = &managedKindsFilter{kinds: kinds}
+			}
+		}
+		remaining, err = ctrl.resourceTracking.GetAppResources(config, app.Spec.Destination.Namespace, app.Name, filter)
+		if err == nil && len(remaining) > 0 {
+			// the finalizer is only removed once all resources are gone, so that the application
+			// remains visible while resources with finalizers (or foreground dependents) are deleted
+			if !allBeingDeleted(remaining) {
+				err = ctrl.resourceTracking.DeleteAppResources(config, app.Spec.Destination.Namespace, app.Name, remaining)
+			}
+			if err == nil {
+				log.Infof("Waiting for %d resources of application %s to be deleted", len(remaining), app.Name)
+				ctrl.appOperationQueue.AddAfter(ctrl.namespace+"/"+app.Name, deletionPollInterval)
+				return
+			}
+		}
 		if err == nil {
 			app.SetCascadedDeletion(false)
 			var patch []byte
@@ -292,19 +622,18 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(app *appv1.Applic
 	}
 }
 
-func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condition appv1.ApplicationCondition) {
-	index := -1
-	for i, exiting := range app.Status.Conditions {
-		if exiting.Type == condition.Type {
-			index = i
-			break
+// allBeingDeleted returns whether or not the deletion of all the objects was requested
+func allBeingDeleted(objs []*unstructured.Unstructured) bool {
+	for _, obj := range objs {
+		if obj.GetDeletionTimestamp() == nil {
+			return false
 		}
 	}
-	if index > -1 {
-		app.Status.Conditions[index] = condition
-	} else {
-		app.Status.Conditions = append(app.Status.Conditions, condition)
-	}
+	return true
+}
+
+func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condition appv1.ApplicationCondition) {
+	app.Status.SetConditions([]appv1.ApplicationCondition{condition}, map[appv1.ApplicationConditionType]bool{condition.Type: true})
 	var patch []byte
 	patch, err := json.Marshal(map[string]interface{}{
 		"status": map[string]interface{}{
@@ -350,14 +679,28 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		}
 		app = freshApp
 		state = app.Status.OperationState.DeepCopy()
+		if delay := getOperationRetryDelay(state, time.Now()); delay > 0 {
+			log.Infof("Delaying retry #%d of operation on application '%s' for %v", state.RetryCount, app.ObjectMeta.Name, delay)
+			ctrl.appOperationQueue.AddAfter(ctrl.namespace+"/"+app.ObjectMeta.Name, delay)
+			return
+		}
 		log.Infof("Resuming in-progress operation. app: %s, phase: %s, message: %s", app.ObjectMeta.Name, state.Phase, state.Message)
 	} else {
 		state = &appv1.OperationState{Phase: appv1.OperationRunning, Operation: *app.Operation, StartedAt: metav1.Now()}
 		ctrl.setOperationState(app, state)
 		log.Infof("Initialized new operation. app: %s, operation: %v", app.ObjectMeta.Name, *app.Operation)
 	}
+	terminating := state.Phase == appv1.OperationTerminating
 	ctrl.appStateManager.SyncAppState(app, state)
 
+	// terminated operations are never retried
+	if !terminating {
+		if delay, retried := retryOperation(state, metav1.Now()); retried {
+			log.Infof("Retrying failed operation on application '%s' in %v (retry #%d)", app.ObjectMeta.Name, delay, state.RetryCount)
+			ctrl.appOperationQueue.AddAfter(ctrl.namespace+"/"+app.ObjectMeta.Name, delay)
+		}
+	}
+
 	if state.Phase == appv1.OperationRunning {
 		// It's possible for an app to be terminated while we were operating on it. We do not want
 		// to clobber the Terminated state with Running. Get the latest app state to check for this.
@@ -377,16 +720,59 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 	if state.Phase.Completed() {
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
-		ctrl.forceAppRefresh(app.ObjectMeta.Name)
+		ctrl.forceAppRefresh(app.ObjectMeta.Name, false)
 	}
 }
 
+// retryOperation prepares a failed operation for its next attempt, if its retry strategy allows
+// it. Returns the delay before the next attempt, and whether the operation is retried.
+func retryOperation(state *appv1.OperationState, now metav1.Time) (time.Duration, bool) {
+	retry := state.Operation.Retry
+	if retry == nil || !state.Phase.Completed() || state.Phase.Successful() || state.RetryCount >= retry.Limit {
+		return 0, false
+	}
+	retryAt, err := retry.NextRetryAt(now.Time, state.RetryCount)
+	if err != nil {
+		state.Message = fmt.Sprintf("%s (not retried: %v)", state.Message, err)
+		return 0, false
+	}
+	state.RetryCount++
+	state.FinishedAt = &now
+	state.Message = fmt.Sprintf("Retrying attempt #%d at %s: %s", state.RetryCount, retryAt.UTC().Format(time.RFC3339), state.Message)
+	state.Phase = appv1.OperationRunning
+	// the next attempt starts at the retry time, so that the hooks created by the failed attempt are
+	// recognized as previous hooks, and hooks with generated names get new names
+	state.StartedAt = metav1.NewTime(retryAt)
+	// the next attempt starts over, but syncs to the revision of the failed attempt
+	if state.SyncResult != nil {
+		state.SyncResult = &appv1.SyncOperationResult{Revision: state.SyncResult.Revision}
+	}
+	if state.RollbackResult != nil {
+		state.RollbackResult = &appv1.SyncOperationResult{Revision: state.RollbackResult.Revision}
+	}
+	return retryAt.Sub(now.Time), true
+}
+
+// getOperationRetryDelay returns the remaining delay before the next attempt of a retried operation
+func getOperationRetryDelay(state *appv1.OperationState, now time.Time) time.Duration {
+	retry := state.Operation.Retry
+	if retry == nil || state.Phase != appv1.OperationRunning || state.RetryCount == 0 || state.FinishedAt == nil {
+		return 0
+	}
+	retryAt, err := retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount-1)
+	if err != nil {
+		return 0
+	}
+	return retryAt.Sub(now)
+}
+
 func (ctrl *ApplicationController) setOperationState(app *appv1.Application, state *appv1.OperationState) {
 	retryUntilSucceed(func() error {
 		if state.Phase == "" {
 			// expose any bugs where we neglect to set phase
 			panic("no phase was set")
 		}
+		compactOperationState(state)
 		if state.Phase.Completed() {
 			now := metav1.Now()
 			state.FinishedAt = &now
@@ -448,22 +834,37 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		log.Warnf("Key '%s' in index is not an application", appKey)
 		return
 	}
-	if !ctrl.needRefreshAppStatus(app, ctrl.statusRefreshTimeout) {
+	if !ctrl.isAppInShard(app) {
+		return
+	}
+	refreshPeriod, overridden := ctrl.getAppRefreshPeriod(app)
+	needRefresh, hardRefresh := ctrl.needRefreshAppStatus(app, refreshPeriod)
+	if overridden || ctrl.statusRefreshJitter > 0 {
+		// the informer resyncs applications once per controller resync period, which is not aligned
+		// with the expiry of the comparison result of the application
+		requeueAfter := refreshPeriod
+		if !needRefresh {
+			requeueAfter = app.Status.ComparisonResult.ComparedAt.Add(refreshPeriod).Sub(time.Now().UTC())
+		}
+		defer ctrl.appRefreshQueue.AddAfter(appKey, requeueAfter)
+	}
+	if !needRefresh {
 		return
 	}
 
 	app = app.DeepCopy()
 	conditions, hasErrors := ctrl.refreshAppConditions(app)
+	conditions = append(conditions, ctrl.getObjectSizeConditions(app)...)
 	if hasErrors {
 		comparisonResult := app.Status.ComparisonResult.DeepCopy()
 		comparisonResult.Status = appv1.ComparisonStatusUnknown
 		health := app.Status.Health.DeepCopy()
 		health.Status = appv1.HealthStatusUnknown
-		ctrl.updateAppStatus(app, comparisonResult, health, nil, conditions)
+		ctrl.updateAppStatus(app, comparisonResult, health, nil, mergeAppConditions(app, conditions, refreshedConditionTypes))
 		return
 	}
 
-	comparisonResult, manifestInfo, compConditions, err := ctrl.appStateManager.CompareAppState(app, "", nil)
+	comparisonResult, manifestInfo, compConditions, err := ctrl.appStateManager.CompareAppState(app, "", nil, hardRefresh)
 	if err != nil {
 		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionComparisonError, Message: err.Error()})
 	} else {
@@ -475,19 +876,225 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		parameters = manifestInfo.Params
 	}
 
-	healthState, err := setApplicationHealth(comparisonResult)
+	healthState, err := setApplicationHealth(comparisonResult, app.Status.ComparisonResult.Resources, ctrl.progressingDeadline)
 	if err != nil {
 		conditions = append(conditions, appv1.ApplicationCondition{Type: appv1.ApplicationConditionComparisonError, Message: err.Error()})
 	}
-	ctrl.updateAppStatus(app, comparisonResult, healthState, parameters, conditions)
+	if signatureErrCond := ctrl.verifyRevisionSignature(app, manifestInfo); signatureErrCond != nil {
+		// the revision is refused by the sync, so the automated sync is not attempted
+		conditions = append(conditions, *signatureErrCond)
+	} else if syncErrCond := ctrl.autoSync(app, comparisonResult); syncErrCond != nil {
+		conditions = append(conditions, *syncErrCond)
+	}
+	// the automated sync is only evaluated by a complete refresh
+	evaluatedTypes := map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true}
+	for conditionType := range refreshedConditionTypes {
+		evaluatedTypes[conditionType] = true
+	}
+	ctrl.updateAppStatus(app, comparisonResult, healthState, parameters, mergeAppConditions(app, conditions, evaluatedTypes))
 	return
 }
 
+// autoSync initiates a sync operation of an application with an automated sync policy, when its
+// comparison result is OutOfSync. A revision is synced to only once, so that a failing sync, or
+// resources which cannot be pruned, do not cause the application to be synced in a loop, unless
+// self-heal is enabled, in which case a successfully synced revision is synced to again, after a
+// backoff which grows with the consecutive self-heals of the application. A condition is returned if
+// the last automated sync to the revision failed.
+func (ctrl *ApplicationController) autoSync(app *appv1.Application, comparisonResult *appv1.ComparisonResult) *appv1.ApplicationCondition {
+	if app.Spec.SyncPolicy == nil || app.Spec.SyncPolicy.Automated == nil {
+		return nil
+	}
+	if app.DeletionTimestamp != nil || app.Operation != nil || isOperationInProgress(app) {
+		return nil
+	}
+	if comparisonResult != nil && comparisonResult.Status == appv1.ComparisonStatusSynced {
+		ctrl.resetSelfHealAttempts(app)
+	}
+	if comparisonResult == nil || comparisonResult.Status != appv1.ComparisonStatusOutOfSync {
+		return nil
+	}
+	desiredCommitSHA := comparisonResult.Revision
+	if desiredCommitSHA == "" {
+		return nil
+	}
+	if rolledBack(app) {
+		log.Infof("Skipping automated sync of application '%s': rolled back to a previous deployment", app.Name)
+		return nil
+	}
+	if permitted, err := ctrl.isSyncPermitted(app); err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
+	} else if !permitted {
+		log.Infof("Skipping automated sync of application '%s': denied by the sync windows of project %s", app.Name, app.Spec.GetProject())
+		return nil
+	}
+	var selfHealAttempts int64
+	if alreadyAttemptedSync(app, desiredCommitSHA) {
+		if app.Status.OperationState.Phase != appv1.OperationSucceeded {
+			return &appv1.ApplicationCondition{
+				Type:    appv1.ApplicationConditionSyncError,
+				Message: fmt.Sprintf("Failed sync attempt to %s: %s", desiredCommitSHA, app.Status.OperationState.Message),
+			}
+		}
+		if !app.Spec.SyncPolicy.Automated.SelfHeal {
+			log.Infof("Skipping automated sync of application '%s': already synced to %s", app.Name, desiredCommitSHA)
+			return nil
+		}
+		selfHealAttempts = app.Status.OperationState.Operation.Sync.SelfHealAttemptsCount
+		if remaining := ctrl.selfHealRemainingBackoff(app, selfHealAttempts); remaining > 0 {
+			log.Infof("Skipping self-heal of application '%s' for %v", app.Name, remaining)
+			ctrl.forceAppRefresh(app.Name, false)
+			ctrl.appRefreshQueue.AddAfter(ctrl.namespace+"/"+app.Name, remaining)
+			return nil
+		}
+		selfHealAttempts++
+		log.Infof("Self-healing application '%s' (attempt %d): live state drifted from %s", app.Name, selfHealAttempts, desiredCommitSHA)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"operation": appv1.Operation{
+			Sync: &appv1.SyncOperation{
+				Revision:              desiredCommitSHA,
+				Prune:                 app.Spec.SyncPolicy.Automated.Prune,
+				SelfHealAttemptsCount: selfHealAttempts,
+			},
+			Retry:       app.Spec.SyncPolicy.Retry,
+			InitiatedBy: appv1.OperationInitiator{Automated: true},
+		},
+	})
+	if err == nil {
+		_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(app.Name, types.MergePatchType, patch)
+	}
+	if err != nil {
+		log.Errorf("Unable to initiate automated sync of application '%s': %v", app.Name, err)
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
+	}
+	log.Infof("Initiated automated sync of application '%s' to %s", app.Name, desiredCommitSHA)
+	ctrl.auditLogger.LogAppEvent(app, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Action: "sync"}, v1.EventTypeNormal)
+	return nil
+}
+
+// selfHealRemainingBackoff returns how long the self-heal of the application must still wait, since its
+// last sync finished, given the number of consecutive self-heals which led to that sync
+func (ctrl *ApplicationController) selfHealRemainingBackoff(app *appv1.Application, attempts int64) time.Duration {
+	finishedAt := app.Status.OperationState.FinishedAt
+	if finishedAt == nil {
+		return 0
+	}
+	return ctrl.selfHealBackoff(attempts) - time.Since(finishedAt.Time)
+}
+
+// selfHealBackoff returns the time to wait after a sync before a self-heal. The time starts at the
+// self-heal timeout and is multiplied with each consecutive self-heal, up to the self-heal backoff cap.
+func (ctrl *ApplicationController) selfHealBackoff(attempts int64) time.Duration {
+	backoff := ctrl.selfHealTimeout
+	for i := int64(0); i < attempts && backoff < ctrl.selfHealBackoffCap; i++ {
+		backoff *= selfHealBackoffFactor
+	}
+	if backoff > ctrl.selfHealBackoffCap && ctrl.selfHealBackoffCap >= ctrl.selfHealTimeout {
+		backoff = ctrl.selfHealBackoffCap
+	}
+	return backoff
+}
+
+// resetSelfHealAttempts resets the self-heal attempts recorded by the last sync of the application once
+// the sync succeeded and the application is in sync, so that the next drift is self-healed without
+// backoff
+func (ctrl *ApplicationController) resetSelfHealAttempts(app *appv1.Application) {
+	opState := app.Status.OperationState
+	if opState == nil || opState.Phase != appv1.OperationSucceeded || opState.Operation.Sync == nil || opState.Operation.Sync.SelfHealAttemptsCount == 0 {
+		return
+	}
+	patch := []byte(`{"status":{"operationState":{"operation":{"sync":{"selfHealAttemptsCount":null}}}}}`)
+	_, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(app.Name, types.MergePatchType, patch)
+	if err != nil {
+		log.Warnf("Failed to reset self-heal attempts of application '%s': %v", app.Name, err)
+		return
+	}
+	log.Infof("Reset self-heal attempts of application '%s'", app.Name)
+}
+
+// isSyncPermitted returns whether the sync windows of the project of the application currently permit
+// the controller to sync it
+func (ctrl *ApplicationController) isSyncPermitted(app *appv1.Application) (bool, error) {
+	proj, err := argo.GetAppProject(&app.Spec, ctrl.applicationClientset, ctrl.namespace)
+	if err != nil {
+		return false, err
+	}
+	return proj.IsSyncPermitted(app, false, time.Now())
+}
+
+// verifyRevisionSignature returns a condition if the project of the application requires signed
+// revisions and the target revision is not signed by one of the GPG keys of the project
+func (ctrl *ApplicationController) verifyRevisionSignature(app *appv1.Application, manifestInfo *repository.ManifestResponse) *appv1.ApplicationCondition {
+	if manifestInfo == nil {
+		return nil
+	}
+	proj, err := argo.GetAppProject(&app.Spec, ctrl.applicationClientset, ctrl.namespace)
+	if err == nil {
+		err = verifyRevisionSignature(proj, manifestInfo)
+	}
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
+	}
+	return nil
+}
+
+// rolledBack returns whether the most recent operation of the application was a rollback. Automated
+// sync would immediately undo the rollback, so it is suspended until the next sync of the application.
+func rolledBack(app *appv1.Application) bool {
+	opState := app.Status.OperationState
+	return opState != nil && opState.Operation.Rollback != nil
+}
+
+// alreadyAttemptedSync returns whether the most recent sync operation of the application was to the given
+// commit SHA. Selective syncs only synced some of the resources of the revision, so they do not count as
+// an attempt to sync to it.
+func alreadyAttemptedSync(app *appv1.Application, commitSHA string) bool {
+	opState := app.Status.OperationState
+	if opState == nil || opState.Operation.Sync == nil || opState.SyncResult == nil {
+		return false
+	}
+	if len(opState.Operation.Sync.Resources) > 0 {
+		return false
+	}
+	return opState.SyncResult.Revision == commitSHA
+}
+
+// getAppRefreshPeriod returns the period after which the comparison result of the application
+// expires, and whether the period of the controller is overridden by the application
+func (ctrl *ApplicationController) getAppRefreshPeriod(app *appv1.Application) (time.Duration, bool) {
+	period, overridden := ctrl.statusRefreshTimeout, false
+	if periodStr := app.Annotations[common.AnnotationKeyRefreshPeriod]; periodStr != "" {
+		appPeriod, err := time.ParseDuration(periodStr)
+		if err != nil || appPeriod <= 0 {
+			log.Warnf("Ignoring invalid %s '%s' of application '%s'", common.AnnotationKeyRefreshPeriod, periodStr, app.Name)
+		} else {
+			period, overridden = appPeriod, true
+		}
+	}
+	return period + getAppRefreshJitter(app.Name, ctrl.statusRefreshJitter), overridden
+}
+
+// getAppRefreshJitter returns a delay in [0, maxJitter), which is stable for the application name
+func getAppRefreshJitter(appName string, maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(appName))
+	return time.Duration(h.Sum64() % uint64(maxJitter))
+}
+
 // needRefreshAppStatus answers if application status needs to be refreshed.
 // Returns true if application never been compared, has changed or comparison result has expired.
-func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application, statusRefreshTimeout time.Duration) bool {
+// The second return value indicates whether manifests should be regenerated bypassing the cache.
+func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application, statusRefreshTimeout time.Duration) (bool, bool) {
 	var reason string
-	if ctrl.isRefreshForced(app.Name) {
+	forced, hard := ctrl.isRefreshForced(app.Name)
+	hard = hard || isHardRefreshRequested(app)
+	if hard {
+		reason = "force hard refresh"
+	} else if forced {
 		reason = "force refresh"
 	} else if app.Status.ComparisonResult.Status == appv1.ComparisonStatusUnknown {
 		reason = "comparison status unknown"
@@ -498,11 +1105,50 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 	}
 	if reason != "" {
 		log.Infof("Refreshing application '%s' status (%s)", app.Name, reason)
-		return true
+		return true, hard
 	}
-	return false
+	return false, false
 }
 
+// isHardRefreshRequested returns whether a hard refresh of the application was requested, through its
+// hard refresh annotation, since its last comparison
+func isHardRefreshRequested(app *appv1.Application) bool {
+	requestedAtStr, ok := app.Annotations[common.AnnotationKeyHardRefresh]
+	if !ok {
+		return false
+	}
+	requestedAt, err := time.Parse(time.RFC3339, requestedAtStr)
+	if err != nil {
+		log.Warnf("Ignoring invalid hard refresh annotation '%s' of application '%s'", requestedAtStr, app.Name)
+		return false
+	}
+	return !app.Status.ComparisonResult.ComparedAt.After(requestedAt)
+}
+
+// refreshedConditionTypes are the types of the conditions which are re-evaluated by each refresh of an
+// application; conditions of the remaining types stay as is
+var refreshedConditionTypes = map[appv1.ApplicationConditionType]bool{
+	appv1.ApplicationConditionInvalidSpecError:        true,
+	appv1.ApplicationConditionUnknownError:            true,
+	appv1.ApplicationConditionComparisonError:         true,
+	appv1.ApplicationConditionManifestGenerationError: true,
+	appv1.ApplicationConditionSharedResourceWarning:   true,
+	appv1.ApplicationConditionSelfManagementWarning:   true,
+	appv1.ApplicationConditionOrphanedResourceWarning: true,
+	appv1.ApplicationConditionExcludedResourceWarning: true,
+	appv1.ApplicationConditionObjectSizeWarning:       true,
+}
+
+// mergeAppConditions returns the conditions of the application, with the conditions of the evaluated
+// types replaced by the given conditions
+func mergeAppConditions(app *appv1.Application, conditions []appv1.ApplicationCondition, evaluatedTypes map[appv1.ApplicationConditionType]bool) []appv1.ApplicationCondition {
+	status := app.Status.DeepCopy()
+	status.SetConditions(conditions, evaluatedTypes)
+	return status.Conditions
+}
+
+// refreshAppConditions evaluates the conditions of the spec of the application, and returns whether
+// or not any of them is an error
 func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application) ([]appv1.ApplicationCondition, bool) {
 	conditions := make([]appv1.ApplicationCondition, 0)
 	proj, err := argo.GetAppProject(&app.Spec, ctrl.applicationClientset, ctrl.namespace)
@@ -530,35 +1176,23 @@ func (ctrl *ApplicationController) refreshAppConditions(app *appv1.Application)
 		}
 	}
 
-	// List of condition types which have to be reevaluated by controller; all remaining conditions should stay as is.
-	reevaluateTypes := map[appv1.ApplicationConditionType]bool{
-		appv1.ApplicationConditionInvalidSpecError:      true,
-		appv1.ApplicationConditionUnknownError:          true,
-		appv1.ApplicationConditionComparisonError:       true,
-		appv1.ApplicationConditionSharedResourceWarning: true,
-	}
-	appConditions := make([]appv1.ApplicationCondition, 0)
-	for i := 0; i < len(app.Status.Conditions); i++ {
-		condition := app.Status.Conditions[i]
-		if _, ok := reevaluateTypes[condition.Type]; !ok {
-			appConditions = append(appConditions, condition)
-		}
-	}
 	hasErrors := false
 	for i := range conditions {
-		condition := conditions[i]
-		appConditions = append(appConditions, condition)
-		if condition.IsError() {
+		if conditions[i].IsError() {
 			hasErrors = true
 		}
-
 	}
-	return appConditions, hasErrors
+	return conditions, hasErrors
 }
 
-// setApplicationHealth updates the health statuses of all resources performed in the comparison
-func setApplicationHealth(comparisonResult *appv1.ComparisonResult) (*appv1.HealthStatus, error) {
+// setApplicationHealth updates the health statuses of all resources performed in the comparison.
+// Resources which have been progressing for longer than the progressing deadline, according to the
+// health statuses of the previously compared resources, are considered degraded. A zero deadline
+// lets resources progress forever.
+func setApplicationHealth(comparisonResult *appv1.ComparisonResult, prevResources []appv1.ResourceState, progressingDeadline time.Duration) (*appv1.HealthStatus, error) {
 	var savedErr error
+	progressingSince := progressingSinceByResource(prevResources)
+	now := metav1.Now()
 	appHealth := appv1.HealthStatus{Status: appv1.HealthStatusHealthy}
 	if comparisonResult.Status == appv1.ComparisonStatusUnknown {
 		appHealth.Status = appv1.HealthStatusUnknown
@@ -577,6 +1211,9 @@ func setApplicationHealth(comparisonResult *appv1.ComparisonResult) (*appv1.Heal
 				savedErr = err
 			}
 			resource.Health = *healthState
+			if progressingDeadline > 0 {
+				applyProgressingDeadline(&resource.Health, progressingSince[resourceKey(&obj)], progressingDeadline, now)
+			}
 		}
 		comparisonResult.Resources[i] = resource
 		if health.IsWorse(appHealth.Status, resource.Health.Status) {
@@ -586,6 +1223,49 @@ func setApplicationHealth(comparisonResult *appv1.ComparisonResult) (*appv1.Heal
 	return &appHealth, savedErr
 }
 
+// applyProgressingDeadline records since when a progressing resource has been progressing, and
+// degrades the resource once it has been progressing for longer than the deadline
+func applyProgressingDeadline(healthState *appv1.HealthStatus, since *metav1.Time, deadline time.Duration, now metav1.Time) {
+	if healthState.Status != appv1.HealthStatusProgressing {
+		return
+	}
+	if since == nil {
+		since = &now
+	}
+	healthState.ProgressingSince = since
+	if now.Sub(since.Time) > deadline {
+		healthState.Status = appv1.HealthStatusDegraded
+		details := fmt.Sprintf("Progressing for more than %v", deadline)
+		if healthState.StatusDetails != "" {
+			details = fmt.Sprintf("%s: %s", details, healthState.StatusDetails)
+		}
+		healthState.StatusDetails = details
+	}
+}
+
+// progressingSinceByResource returns the times since which the given resources have been
+// progressing, keyed by resource
+func progressingSinceByResource(resources []appv1.ResourceState) map[string]*metav1.Time {
+	progressingSince := make(map[string]*metav1.Time)
+	for _, resource := range resources {
+		if resource.Health.ProgressingSince == nil || resource.LiveState == "null" {
+			continue
+		}
+		var obj unstructured.Unstructured
+		if err := json.Unmarshal([]byte(resource.LiveState), &obj); err != nil {
+			continue
+		}
+		progressingSince[resourceKey(&obj)] = resource.Health.ProgressingSince
+	}
+	return progressingSince
+}
+
+// resourceKey identifies a live resource among the resources of an application
+func resourceKey(obj *unstructured.Unstructured) string {
+	gvk := obj.GroupVersionKind()
+	return fmt.Sprintf("%s/%s/%s/%s", gvk.Group, gvk.Kind, obj.GetNamespace(), obj.GetName())
+}
+
 // updateAppStatus persists updates to application status. Detects if there patch
 func (ctrl *ApplicationController) updateAppStatus(
 	app *appv1.Application,
@@ -0,0 +1,466 @@
+package controller
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	testcore "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+func newScheduledApp(schedule string) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-app",
+			Annotations: map[string]string{common.AnnotationKeyRefreshSchedule: schedule},
+		},
+	}
+}
+
+func TestIsRefreshScheduleDue(t *testing.T) {
+	since := time.Date(2018, 6, 1, 1, 59, 30, 0, time.UTC)
+
+	// schedule fires at 02:00, which is within the interval
+	due, err := isRefreshScheduleDue(newScheduledApp("0 2 * * *"), since, since.Add(time.Minute))
+	assert.Nil(t, err)
+	assert.True(t, due)
+
+	// schedule fires at 03:00, which is outside the interval
+	due, err = isRefreshScheduleDue(newScheduledApp("0 3 * * *"), since, since.Add(time.Minute))
+	assert.Nil(t, err)
+	assert.False(t, due)
+
+	// no schedule
+	due, err = isRefreshScheduleDue(&v1alpha1.Application{}, since, since.Add(time.Minute))
+	assert.Nil(t, err)
+	assert.False(t, due)
+
+	_, err = isRefreshScheduleDue(newScheduledApp("not a schedule"), since, since.Add(time.Minute))
+	assert.NotNil(t, err)
+}
+
+func TestForceAppRefresh(t *testing.T) {
+	ctrl := ApplicationController{
+		forceRefreshApps:      make(map[string]bool),
+		forceRefreshAppsMutex: &sync.Mutex{},
+	}
+
+	forced, _ := ctrl.isRefreshForced("my-app")
+	assert.False(t, forced)
+
+	// a hard refresh request is not downgraded by a subsequent normal one
+	ctrl.forceAppRefresh("my-app", true)
+	ctrl.forceAppRefresh("my-app", false)
+	forced, hard := ctrl.isRefreshForced("my-app")
+	assert.True(t, forced)
+	assert.True(t, hard)
+
+	forced, _ = ctrl.isRefreshForced("my-app")
+	assert.False(t, forced)
+}
+
+func TestIsHardRefreshRequested(t *testing.T) {
+	requestedAt := time.Date(2018, 6, 1, 2, 0, 0, 0, time.UTC)
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-app",
+			Annotations: map[string]string{common.AnnotationKeyHardRefresh: requestedAt.Format(time.RFC3339)},
+		},
+	}
+
+	// never compared since the request
+	app.Status.ComparisonResult.ComparedAt = metav1.NewTime(requestedAt.Add(-time.Minute))
+	assert.True(t, isHardRefreshRequested(app))
+
+	// compared since the request
+	app.Status.ComparisonResult.ComparedAt = metav1.NewTime(requestedAt.Add(time.Minute))
+	assert.False(t, isHardRefreshRequested(app))
+
+	app.Annotations[common.AnnotationKeyHardRefresh] = "not a timestamp"
+	assert.False(t, isHardRefreshRequested(app))
+
+	assert.False(t, isHardRefreshRequested(&v1alpha1.Application{}))
+}
+
+func TestIsTrackingSemverConstraint(t *testing.T) {
+	app := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{Source: v1alpha1.ApplicationSource{TargetRevision: "1.2.*"}},
+	}
+	// not compared yet
+	assert.False(t, isTrackingSemverConstraint(app))
+
+	app.Status.ComparisonResult.Revision = "9d921f65f3c5373b682e2eb4b37afba6592e8f8b"
+	assert.True(t, isTrackingSemverConstraint(app))
+
+	app.Spec.Source.TargetRevision = "master"
+	assert.False(t, isTrackingSemverConstraint(app))
+}
+
+// newAutoSyncTestController returns a controller which records the operations requested by patches of the application
+func newAutoSyncTestController(app *v1alpha1.Application) (*ApplicationController, *[]v1alpha1.Operation) {
+	appClientset := appclientset.NewSimpleClientset(app)
+	operations := make([]v1alpha1.Operation, 0)
+	appClientset.PrependReactor("patch", "applications", func(action testcore.Action) (bool, runtime.Object, error) {
+		var patch struct {
+			Operation *v1alpha1.Operation `json:"operation"`
+		}
+		err := json.Unmarshal(action.(testcore.PatchAction).GetPatch(), &patch)
+		if err == nil && patch.Operation != nil {
+			operations = append(operations, *patch.Operation)
+		}
+		return true, app, err
+	})
+	return &ApplicationController{
+		namespace:             "argocd",
+		applicationClientset:  appClientset,
+		auditLogger:           argo.NewAuditLogger("argocd", fake.NewSimpleClientset(), "application-controller"),
+		appRefreshQueue:       workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		forceRefreshApps:      make(map[string]bool),
+		forceRefreshAppsMutex: &sync.Mutex{},
+		selfHealTimeout:       time.Minute,
+		selfHealBackoffCap:    10 * time.Minute,
+	}, &operations
+}
+
+func newAutoSyncApp() *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			SyncPolicy: &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{Prune: true}},
+		},
+	}
+}
+
+func TestAutoSync(t *testing.T) {
+	app := newAutoSyncApp()
+	ctrl, operations := newAutoSyncTestController(app)
+	cond := ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "aaaaaaa"})
+	assert.Nil(t, cond)
+	assert.Len(t, *operations, 1)
+	assert.Equal(t, "aaaaaaa", (*operations)[0].Sync.Revision)
+	assert.True(t, (*operations)[0].Sync.Prune)
+}
+
+func TestSkipAutoSync(t *testing.T) {
+	// synced applications
+	app := newAutoSyncApp()
+	ctrl, operations := newAutoSyncTestController(app)
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusSynced, Revision: "aaaaaaa"}))
+	assert.Len(t, *operations, 0)
+
+	// applications without an automated sync policy
+	app.Spec.SyncPolicy = nil
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "aaaaaaa"}))
+	assert.Len(t, *operations, 0)
+
+	// applications with an operation in progress
+	app = newAutoSyncApp()
+	app.Status.OperationState = &v1alpha1.OperationState{Phase: v1alpha1.OperationRunning}
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "aaaaaaa"}))
+	assert.Len(t, *operations, 0)
+
+	// revisions which were already synced to successfully
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}},
+		Phase:      v1alpha1.OperationSucceeded,
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "aaaaaaa"},
+	}
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "aaaaaaa"}))
+	assert.Len(t, *operations, 0)
+
+	// applications rolled back to a previous deployment
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation:      v1alpha1.Operation{Rollback: &v1alpha1.RollbackOperation{ID: 1}},
+		Phase:          v1alpha1.OperationSucceeded,
+		RollbackResult: &v1alpha1.SyncOperationResult{Revision: "aaaaaaa"},
+	}
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "bbbbbbb"}))
+	assert.Len(t, *operations, 0)
+}
+
+func TestAutoSyncFailedAttempt(t *testing.T) {
+	app := newAutoSyncApp()
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}},
+		Phase:      v1alpha1.OperationFailed,
+		Message:    "one or more objects failed to apply",
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "aaaaaaa"},
+	}
+	ctrl, operations := newAutoSyncTestController(app)
+	cond := ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "aaaaaaa"})
+	assert.NotNil(t, cond)
+	assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+	assert.Contains(t, cond.Message, "one or more objects failed to apply")
+	assert.Len(t, *operations, 0)
+
+	// a new revision is synced to, regardless of the failure
+	cond = ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "bbbbbbb"})
+	assert.Nil(t, cond)
+	assert.Len(t, *operations, 1)
+}
+
+func TestAutoSyncAfterSelectiveSync(t *testing.T) {
+	app := newAutoSyncApp()
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{
+			Resources: []v1alpha1.SyncOperationResource{{Group: "apps", Kind: "Deployment", Name: "guestbook-ui"}},
+		}},
+		Phase:      v1alpha1.OperationSucceeded,
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "aaaaaaa"},
+	}
+	ctrl, operations := newAutoSyncTestController(app)
+
+	// a selective sync to the revision does not prevent the automated sync of the remaining resources
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "aaaaaaa"}))
+	assert.Len(t, *operations, 1)
+	assert.Empty(t, (*operations)[0].Sync.Resources)
+}
+
+func TestAutoSyncSelfHeal(t *testing.T) {
+	app := newAutoSyncApp()
+	app.Spec.SyncPolicy.Automated.SelfHeal = true
+	finishedAt := metav1.NewTime(time.Now().Add(-30 * time.Second))
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}},
+		Phase:      v1alpha1.OperationSucceeded,
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "aaaaaaa"},
+		FinishedAt: &finishedAt,
+	}
+	ctrl, operations := newAutoSyncTestController(app)
+
+	// the self-heal is delayed until the timeout elapsed since the last sync finished
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "aaaaaaa"}))
+	assert.Len(t, *operations, 0)
+	forced, _ := ctrl.isRefreshForced("my-app")
+	assert.True(t, forced)
+
+	finishedAt = metav1.NewTime(time.Now().Add(-2 * time.Minute))
+	app.Status.OperationState.FinishedAt = &finishedAt
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "aaaaaaa"}))
+	assert.Len(t, *operations, 1)
+	assert.Equal(t, "aaaaaaa", (*operations)[0].Sync.Revision)
+	assert.Equal(t, int64(1), (*operations)[0].Sync.SelfHealAttemptsCount)
+
+	// the backoff doubles with each consecutive self-heal
+	app.Status.OperationState.Operation.Sync.SelfHealAttemptsCount = 1
+	finishedAt = metav1.NewTime(time.Now().Add(-90 * time.Second))
+	app.Status.OperationState.FinishedAt = &finishedAt
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "aaaaaaa"}))
+	assert.Len(t, *operations, 1)
+
+	finishedAt = metav1.NewTime(time.Now().Add(-3 * time.Minute))
+	app.Status.OperationState.FinishedAt = &finishedAt
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusOutOfSync, Revision: "aaaaaaa"}))
+	assert.Len(t, *operations, 2)
+	assert.Equal(t, int64(2), (*operations)[1].Sync.SelfHealAttemptsCount)
+}
+
+func TestSelfHealBackoff(t *testing.T) {
+	ctrl := ApplicationController{selfHealTimeout: 5 * time.Second, selfHealBackoffCap: time.Minute}
+	assert.Equal(t, 5*time.Second, ctrl.selfHealBackoff(0))
+	assert.Equal(t, 10*time.Second, ctrl.selfHealBackoff(1))
+	assert.Equal(t, 40*time.Second, ctrl.selfHealBackoff(3))
+	assert.Equal(t, time.Minute, ctrl.selfHealBackoff(4))
+	assert.Equal(t, time.Minute, ctrl.selfHealBackoff(100))
+
+	// a cap below the timeout does not shorten the timeout
+	ctrl.selfHealBackoffCap = 0
+	assert.Equal(t, 5*time.Second, ctrl.selfHealBackoff(3))
+}
+
+func TestResetSelfHealAttempts(t *testing.T) {
+	app := newAutoSyncApp()
+	app.Spec.SyncPolicy.Automated.SelfHeal = true
+	app.Status.OperationState = &v1alpha1.OperationState{
+		Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{SelfHealAttemptsCount: 3}},
+		Phase:      v1alpha1.OperationSucceeded,
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "aaaaaaa"},
+	}
+	ctrl, operations := newAutoSyncTestController(app)
+	appClientset := ctrl.applicationClientset.(*appclientset.Clientset)
+
+	// the attempts are reset once the successful sync brought the application in sync
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusSynced, Revision: "aaaaaaa"}))
+	assert.Len(t, *operations, 0)
+	actions := appClientset.Actions()
+	assert.Len(t, actions, 1)
+	assert.Equal(t, `{"status":{"operationState":{"operation":{"sync":{"selfHealAttemptsCount":null}}}}}`, string(actions[0].(testcore.PatchAction).GetPatch()))
+
+	// the attempts of failed syncs are kept
+	appClientset.ClearActions()
+	app.Status.OperationState.Phase = v1alpha1.OperationFailed
+	assert.Nil(t, ctrl.autoSync(app, &v1alpha1.ComparisonResult{Status: v1alpha1.ComparisonStatusSynced, Revision: "aaaaaaa"}))
+	assert.Len(t, appClientset.Actions(), 0)
+}
+
+func TestGetAppRefreshPeriod(t *testing.T) {
+	ctrl := ApplicationController{statusRefreshTimeout: 3 * time.Minute}
+	app := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "my-app"}}
+
+	period, overridden := ctrl.getAppRefreshPeriod(app)
+	assert.Equal(t, 3*time.Minute, period)
+	assert.False(t, overridden)
+
+	app.Annotations = map[string]string{common.AnnotationKeyRefreshPeriod: "10m"}
+	period, overridden = ctrl.getAppRefreshPeriod(app)
+	assert.Equal(t, 10*time.Minute, period)
+	assert.True(t, overridden)
+
+	app.Annotations[common.AnnotationKeyRefreshPeriod] = "soon"
+	period, overridden = ctrl.getAppRefreshPeriod(app)
+	assert.Equal(t, 3*time.Minute, period)
+	assert.False(t, overridden)
+
+	// the jitter is stable for an application, and within the maximum
+	ctrl.statusRefreshJitter = time.Minute
+	period, _ = ctrl.getAppRefreshPeriod(app)
+	assert.Equal(t, 3*time.Minute+getAppRefreshJitter("my-app", time.Minute), period)
+	assert.True(t, period >= 3*time.Minute && period < 4*time.Minute)
+	assert.Equal(t, time.Duration(0), getAppRefreshJitter("my-app", 0))
+}
+
+func TestRetryOperation(t *testing.T) {
+	factor := int64(3)
+	state := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{
+			Sync:  &v1alpha1.SyncOperation{},
+			Retry: &v1alpha1.RetryStrategy{Limit: 2, Backoff: &v1alpha1.Backoff{Duration: "10s", Factor: &factor, MaxDuration: "20s"}},
+		},
+		Phase:      v1alpha1.OperationFailed,
+		Message:    "one or more objects failed to apply",
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "abc", Resources: []*v1alpha1.ResourceDetails{{Name: "guestbook"}}},
+	}
+	now := metav1.NewTime(time.Date(2018, 6, 1, 2, 0, 0, 0, time.UTC))
+
+	delay, retried := retryOperation(state, now)
+	assert.True(t, retried)
+	assert.Equal(t, 10*time.Second, delay)
+	assert.Equal(t, v1alpha1.OperationRunning, state.Phase)
+	assert.Equal(t, int64(1), state.RetryCount)
+	assert.Equal(t, "Retrying attempt #1 at 2018-06-01T02:00:10Z: one or more objects failed to apply", state.Message)
+	assert.Equal(t, &v1alpha1.SyncOperationResult{Revision: "abc"}, state.SyncResult)
+	assert.Equal(t, metav1.NewTime(now.Add(10*time.Second)), state.StartedAt)
+	assert.Equal(t, 4*time.Second, getOperationRetryDelay(state, now.Add(6*time.Second)))
+
+	// the delay grows by the factor, up to the max duration
+	state.Phase = v1alpha1.OperationError
+	delay, retried = retryOperation(state, now)
+	assert.True(t, retried)
+	assert.Equal(t, 20*time.Second, delay)
+
+	// the limit is reached
+	state.Phase = v1alpha1.OperationFailed
+	_, retried = retryOperation(state, now)
+	assert.False(t, retried)
+	assert.Equal(t, v1alpha1.OperationFailed, state.Phase)
+
+	// succeeded operations and operations without retry strategy are not retried
+	_, retried = retryOperation(&v1alpha1.OperationState{Phase: v1alpha1.OperationSucceeded, Operation: state.Operation}, now)
+	assert.False(t, retried)
+	_, retried = retryOperation(&v1alpha1.OperationState{Phase: v1alpha1.OperationFailed}, now)
+	assert.False(t, retried)
+}
+
+func TestAllBeingDeleted(t *testing.T) {
+	obj := &unstructured.Unstructured{}
+	assert.True(t, allBeingDeleted(nil))
+	assert.False(t, allBeingDeleted([]*unstructured.Unstructured{obj}))
+
+	now := metav1.Now()
+	obj.SetDeletionTimestamp(&now)
+	assert.True(t, allBeingDeleted([]*unstructured.Unstructured{obj}))
+}
+
+func TestFilterExcludedObjs(t *testing.T) {
+	deploy := newObj("Deployment", "default", "guestbook")
+	deploy.SetAPIVersion("apps/v1")
+	svc := newObj("Service", "default", "guestbook")
+	svc.SetAPIVersion("v1")
+	event := newObj("Event", "default", "guestbook")
+	event.SetAPIVersion("events.k8s.io/v1beta1")
+	targetObjs := []*unstructured.Unstructured{deploy, svc, event}
+
+	objs, conditions := filterExcludedObjs(nil, "https://kubernetes.default.svc", targetObjs)
+	assert.Equal(t, targetObjs, objs)
+	assert.Empty(t, conditions)
+
+	argoSettings := &settings.ArgoCDSettings{
+		ResourceExclusions: []settings.FilteredResource{{APIGroups: []string{"*.k8s.io"}}},
+	}
+	objs, conditions = filterExcludedObjs(argoSettings, "https://kubernetes.default.svc", targetObjs)
+	assert.Equal(t, []*unstructured.Unstructured{deploy, svc}, objs)
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionExcludedResourceWarning, conditions[0].Type)
+	assert.Contains(t, conditions[0].Message, "Event/guestbook")
+
+	// only the included kinds remain, and exclusions apply only to their clusters
+	argoSettings = &settings.ArgoCDSettings{
+		ResourceInclusions: []settings.FilteredResource{{APIGroups: []string{"", "apps"}}},
+		ResourceExclusions: []settings.FilteredResource{{Kinds: []string{"Service"}, Clusters: []string{"https://prod.*"}}},
+	}
+	objs, _ = filterExcludedObjs(argoSettings, "https://kubernetes.default.svc", targetObjs)
+	assert.Equal(t, []*unstructured.Unstructured{deploy, svc}, objs)
+	objs, _ = filterExcludedObjs(argoSettings, "https://prod.example.com", targetObjs)
+	assert.Equal(t, []*unstructured.Unstructured{deploy}, objs)
+}
+
+func TestApplyProgressingDeadline(t *testing.T) {
+	now := metav1.Now()
+
+	// the progressing deadline starts with the first progressing health status
+	healthState := v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing}
+	applyProgressingDeadline(&healthState, nil, 10*time.Minute, now)
+	assert.Equal(t, v1alpha1.HealthStatusProgressing, healthState.Status)
+	assert.Equal(t, &now, healthState.ProgressingSince)
+
+	since := metav1.NewTime(now.Add(-5 * time.Minute))
+	healthState = v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing}
+	applyProgressingDeadline(&healthState, &since, 10*time.Minute, now)
+	assert.Equal(t, v1alpha1.HealthStatusProgressing, healthState.Status)
+	assert.Equal(t, &since, healthState.ProgressingSince)
+
+	since = metav1.NewTime(now.Add(-time.Hour))
+	healthState = v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing, StatusDetails: "Waiting for rollout to finish"}
+	applyProgressingDeadline(&healthState, &since, 10*time.Minute, now)
+	assert.Equal(t, v1alpha1.HealthStatusDegraded, healthState.Status)
+	assert.Equal(t, "Progressing for more than 10m0s: Waiting for rollout to finish", healthState.StatusDetails)
+	assert.Equal(t, &since, healthState.ProgressingSince)
+
+	// resources which are no longer progressing are not tracked anymore
+	healthState = v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusHealthy}
+	applyProgressingDeadline(&healthState, &since, 10*time.Minute, now)
+	assert.Equal(t, v1alpha1.HealthStatusHealthy, healthState.Status)
+	assert.Nil(t, healthState.ProgressingSince)
+}
+
+func TestProgressingSinceByResource(t *testing.T) {
+	since := metav1.Now()
+	deploy := newObj("Deployment", "default", "guestbook")
+	deploy.SetAPIVersion("apps/v1")
+	liveState, err := json.Marshal(deploy)
+	assert.NoError(t, err)
+	svc := newObj("Service", "default", "guestbook")
+	svcLiveState, err := json.Marshal(svc)
+	assert.NoError(t, err)
+
+	progressingSince := progressingSinceByResource([]v1alpha1.ResourceState{
+		{LiveState: string(liveState), Health: v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusProgressing, ProgressingSince: &since}},
+		{LiveState: string(svcLiveState), Health: v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusHealthy}},
+		{LiveState: "null", Health: v1alpha1.HealthStatus{Status: v1alpha1.HealthStatusMissing}},
+	})
+	assert.Len(t, progressingSince, 1)
+	assert.Equal(t, &since, progressingSince[resourceKey(deploy)])
+}
@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/util/workqueue"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/guardrail"
+)
+
+const (
+	// guardrailComponent is the component name of the controller guardrails
+	guardrailComponent = "application-controller"
+	// guardrailAppObjectSize is the guardrail on the size of the largest application object
+	guardrailAppObjectSize = "app-object-size"
+	// guardrailRefreshQueueLatency is the guardrail on the time applications wait in the refresh queue
+	guardrailRefreshQueueLatency = "refresh-queue-latency"
+
+	// DefaultMaxAppObjectSize is the default maximum size of an application object, which is the
+	// default maximum request size of etcd
+	DefaultMaxAppObjectSize = 1536 * 1024
+	// DefaultMaxRefreshQueueLatency is the default maximum time an application waits to be refreshed
+	DefaultMaxRefreshQueueLatency = 2 * time.Minute
+)
+
+// newWatchdog returns the watchdog measuring the guardrails of the controller
+func (ctrl *ApplicationController) newWatchdog(config *ApplicationControllerConfig, refreshQueue *latencyTrackingQueue) *guardrail.Watchdog {
+	return guardrail.NewWatchdog(guardrailComponent,
+		guardrail.Check{
+			Name:    guardrailAppObjectSize,
+			Limit:   config.MaxAppObjectSize,
+			Unit:    guardrail.UnitBytes,
+			Measure: ctrl.maxAppObjectSize,
+		},
+		guardrail.Check{
+			Name:  guardrailRefreshQueueLatency,
+			Limit: int64(config.MaxRefreshQueueLatency / time.Millisecond),
+			Unit:  guardrail.UnitMilliseconds,
+			Measure: func() (int64, error) {
+				return int64(refreshQueue.resetMaxLatency() / time.Millisecond), nil
+			},
+		},
+	)
+}
+
+// maxAppObjectSize returns the size of the largest application object
+func (ctrl *ApplicationController) maxAppObjectSize() (int64, error) {
+	var maxSize int64
+	for _, obj := range ctrl.appInformer.GetIndexer().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		data, err := json.Marshal(app)
+		if err != nil {
+			return 0, err
+		}
+		if size := int64(len(data)); size > maxSize {
+			maxSize = size
+		}
+	}
+	return maxSize, nil
+}
+
+// getObjectSizeConditions returns a warning condition if the size of the application object reached
+// the warning level of the app-object-size guardrail, so that the owners of the application learn
+// that its updates are about to be refused by etcd
+func (ctrl *ApplicationController) getObjectSizeConditions(app *appv1.Application) []appv1.ApplicationCondition {
+	if ctrl.maxAppObjectSize <= 0 {
+		return nil
+	}
+	data, err := json.Marshal(app)
+	if err != nil {
+		return nil
+	}
+	check := guardrail.Check{Name: guardrailAppObjectSize, Limit: ctrl.maxAppObjectSize, Unit: guardrail.UnitBytes}
+	state := guardrail.NewState(guardrailComponent, check, int64(len(data)))
+	if state.Level == appv1.GuardrailLevelOK {
+		return nil
+	}
+	return []appv1.ApplicationCondition{{
+		Type:    appv1.ApplicationConditionObjectSizeWarning,
+		Message: fmt.Sprintf("Application object size is at %s level of guardrail %s: %s", state.Level, guardrailAppObjectSize, state.Message),
+	}}
+}
+
+// runWatchdog measures the guardrails of the controller and publishes their states until the
+// context is done
+func (ctrl *ApplicationController) runWatchdog(ctx context.Context) {
+	ctrl.watchdog.Run(ctx, guardrail.DefaultCheckInterval, func(states []appv1.GuardrailState) {
+		if err := guardrail.Publish(ctrl.kubeClientset, ctrl.namespace, guardrailComponent, states); err != nil {
+			log.Warnf("Failed to publish guardrail states: %v", err)
+		}
+	})
+}
+
+// latencyTrackingQueue records the longest time an item waited between being added to the queue and
+// being processed. Items added with a delay or rate limit are not tracked.
+type latencyTrackingQueue struct {
+	workqueue.RateLimitingInterface
+	addedAt    map[interface{}]time.Time
+	maxLatency time.Duration
+	lock       *sync.Mutex
+}
+
+func newLatencyTrackingQueue(queue workqueue.RateLimitingInterface) *latencyTrackingQueue {
+	return &latencyTrackingQueue{
+		RateLimitingInterface: queue,
+		addedAt:               make(map[interface{}]time.Time),
+		lock:                  &sync.Mutex{},
+	}
+}
+
+func (q *latencyTrackingQueue) Add(item interface{}) {
+	q.lock.Lock()
+	if _, ok := q.addedAt[item]; !ok {
+		q.addedAt[item] = time.Now()
+	}
+	q.lock.Unlock()
+	q.RateLimitingInterface.Add(item)
+}
+
+func (q *latencyTrackingQueue) Get() (interface{}, bool) {
+	item, shutdown := q.RateLimitingInterface.Get()
+	if shutdown {
+		return item, shutdown
+	}
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if addedAt, ok := q.addedAt[item]; ok {
+		delete(q.addedAt, item)
+		if latency := time.Since(addedAt); latency > q.maxLatency {
+			q.maxLatency = latency
+		}
+	}
+	return item, shutdown
+}
+
+// resetMaxLatency returns the longest latency since the previous call, including the time items
+// still in the queue have been waiting so far
+func (q *latencyTrackingQueue) resetMaxLatency() time.Duration {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	maxLatency := q.maxLatency
+	for _, addedAt := range q.addedAt {
+		if latency := time.Since(addedAt); latency > maxLatency {
+			maxLatency = latency
+		}
+	}
+	q.maxLatency = 0
+	return maxLatency
+}
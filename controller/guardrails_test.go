@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestLatencyTrackingQueue(t *testing.T) {
+	queue := newLatencyTrackingQueue(workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()))
+	defer queue.ShutDown()
+	assert.Equal(t, time.Duration(0), queue.resetMaxLatency())
+
+	queue.Add("default/app")
+	time.Sleep(10 * time.Millisecond)
+	item, _ := queue.Get()
+	assert.Equal(t, "default/app", item)
+	queue.Done(item)
+	assert.True(t, queue.resetMaxLatency() >= 10*time.Millisecond)
+	assert.Equal(t, time.Duration(0), queue.resetMaxLatency())
+
+	// items still waiting in the queue count towards the latency
+	queue.Add("default/other")
+	time.Sleep(10 * time.Millisecond)
+	assert.True(t, queue.resetMaxLatency() >= 10*time.Millisecond)
+}
+
+func TestGetObjectSizeConditions(t *testing.T) {
+	app := &appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "my-app"}}
+	data, err := json.Marshal(app)
+	assert.Nil(t, err)
+	size := int64(len(data))
+
+	ctrl := &ApplicationController{maxAppObjectSize: size * 2}
+	assert.Empty(t, ctrl.getObjectSizeConditions(app))
+
+	ctrl.maxAppObjectSize = size
+	conditions := ctrl.getObjectSizeConditions(app)
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, appv1.ApplicationConditionObjectSizeWarning, conditions[0].Type)
+	assert.Contains(t, conditions[0].Message, string(appv1.GuardrailLevelCritical))
+
+	// the guardrail is disabled
+	ctrl.maxAppObjectSize = 0
+	assert.Empty(t, ctrl.getObjectSizeConditions(app))
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// leaderElectionLockName is the name of the config map which application controller replicas
+	// compete for. The replica holding it is the only one processing applications.
+	leaderElectionLockName = "argocd-application-controller-leader"
+)
+
+// getLeaderElectionLockName returns the name of the lock of the shard. Each shard elects its own
+// leader, and the lock of a single shard keeps the name used without sharding.
+func getLeaderElectionLockName(shard, shards int) string {
+	if shards <= 1 {
+		return leaderElectionLockName
+	}
+	return fmt.Sprintf("%s-%d", leaderElectionLockName, shard)
+}
+
+// LeaderElectionConfig configures the leader election among application controller replicas
+type LeaderElectionConfig struct {
+	// Identity is the unique name of this replica, typically the pod name
+	Identity string
+	// LeaseDuration is how long standby replicas wait before taking over a lease which was not renewed
+	LeaseDuration time.Duration
+	// RenewDeadline is how long the leader keeps retrying to renew its lease before giving up leadership
+	RenewDeadline time.Duration
+	// RetryPeriod is how often replicas try to acquire or renew the lease
+	RetryPeriod time.Duration
+}
+
+// newLeaderElectionLock returns the config map lock held by the leading replica. Leadership
+// changes are recorded as events of the config map.
+func newLeaderElectionLock(kubeClientset kubernetes.Interface, namespace string, name string, identity string) (resourcelock.Interface, error) {
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClientset.CoreV1().Events(namespace)})
+	recorder := broadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "application-controller"})
+	return resourcelock.New(
+		resourcelock.ConfigMapsResourceLock,
+		namespace,
+		name,
+		kubeClientset.CoreV1(),
+		resourcelock.ResourceLockConfig{Identity: identity, EventRecorder: recorder})
+}
+
+// RunWithLeaderElection starts the Application CRD controller as one of several replicas of its
+// shard. Every replica keeps its application cache and cluster resource watches up to date, but only the elected
+// leader refreshes and syncs applications. Standby replicas are therefore able to take over as soon
+// as the lease of a failed leader expires, without having to rebuild their caches first.
+// onStartedLeading is called once this replica becomes the leader. The process exits when
+// leadership is lost, so that it is restarted as a standby replica.
+func (ctrl *ApplicationController) RunWithLeaderElection(
+	ctx context.Context,
+	statusProcessors int,
+	operationProcessors int,
+	config LeaderElectionConfig,
+	onStartedLeading func(ctx context.Context),
+) error {
+	defer runtime.HandleCrash()
+	defer ctrl.appRefreshQueue.ShutDown()
+
+	lock, err := newLeaderElectionLock(ctrl.kubeClientset, ctrl.namespace, getLeaderElectionLockName(ctrl.shard, ctrl.shards), config.Identity)
+	if err != nil {
+		return err
+	}
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:          lock,
+		LeaseDuration: config.LeaseDuration,
+		RenewDeadline: config.RenewDeadline,
+		RetryPeriod:   config.RetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(stop <-chan struct{}) {
+				log.Infof("%s became the leader, starting application processors", config.Identity)
+				leaderCtx, cancel := context.WithCancel(ctx)
+				go func() {
+					<-stop
+					cancel()
+				}()
+				ctrl.runProcessors(leaderCtx, statusProcessors, operationProcessors)
+				if onStartedLeading != nil {
+					onStartedLeading(leaderCtx)
+				}
+			},
+			OnStoppedLeading: func() {
+				log.Fatalf("%s lost leadership", config.Identity)
+			},
+			OnNewLeader: func(identity string) {
+				if identity != config.Identity {
+					log.Infof("Running as standby, current leader is %s", identity)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if !ctrl.warmUpCaches(ctx) {
+		return nil
+	}
+	log.Infof("Caches are synced, %s is waiting for leadership", config.Identity)
+	elector.Run()
+	return nil
+}
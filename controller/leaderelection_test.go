@@ -0,0 +1,15 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNewLeaderElectionLock(t *testing.T) {
+	lock, err := newLeaderElectionLock(fake.NewSimpleClientset(), "argocd", leaderElectionLockName, "application-controller-0")
+	assert.Nil(t, err)
+	assert.Equal(t, "application-controller-0", lock.Identity())
+	assert.Equal(t, "argocd/argocd-application-controller-leader", lock.Describe())
+}
@@ -0,0 +1,268 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// LiveStateCache holds the live state of the resources of applications in all clusters. The state
+// of a cluster is listed once, and then maintained incrementally from the watch of the cluster
+// resources, so that comparisons do not need to list the resources of the cluster.
+type LiveStateCache struct {
+	lock             sync.Mutex
+	clusters         map[string]*clusterLiveState
+	resourceTracking argo.ResourceTracking
+}
+
+// clusterLiveState holds the live state of the resources of applications in a cluster
+type clusterLiveState struct {
+	lock             sync.RWMutex
+	resourceTracking argo.ResourceTracking
+	// synced indicates whether the resources were listed since the watch started, i.e. the state
+	// is complete
+	synced bool
+	// appResources holds the resources by application name and resource key
+	appResources map[string]map[string]*unstructured.Unstructured
+	// appNames holds the application names by resource key
+	appNames map[string]string
+	// watchAllKinds indicates whether resources of all kinds are watched. Resources tracked by
+	// annotation only cannot be selected by label, so only the kinds managed by applications are
+	// watched instead.
+	watchAllKinds bool
+	// managedKinds holds the kinds registered by the comparisons of applications
+	managedKinds map[schema.GroupKind]bool
+	// watchedKinds holds the kinds which were listed and are watched
+	watchedKinds map[schema.GroupKind]bool
+	// kindsChanged is notified when kinds which are not watched are registered, so that the watch
+	// restarts with them
+	kindsChanged chan struct{}
+}
+
+// NewLiveStateCache creates a live state cache, which identifies the applications of resources
+// with the resource tracking
+func NewLiveStateCache(resourceTracking argo.ResourceTracking) *LiveStateCache {
+	return &LiveStateCache{
+		clusters:         make(map[string]*clusterLiveState),
+		resourceTracking: resourceTracking,
+	}
+}
+
+// getCluster returns the live state of the cluster, which is created if needed
+func (c *LiveStateCache) getCluster(server string) *clusterLiveState {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	cluster, ok := c.clusters[server]
+	if !ok {
+		cluster = &clusterLiveState{
+			resourceTracking: c.resourceTracking,
+			watchAllKinds:    c.resourceTracking.UsesLabel(),
+			managedKinds:     make(map[schema.GroupKind]bool),
+			kindsChanged:     make(chan struct{}, 1),
+		}
+		c.clusters[server] = cluster
+	}
+	return cluster
+}
+
+// removeCluster drops the live state of a cluster, which is no longer watched
+func (c *LiveStateCache) removeCluster(server string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.clusters, server)
+}
+
+// getAppResources returns copies of the cached resources of the application in the namespace of
+// the cluster, including cluster scoped resources. False is returned if the state of the cluster
+// is not complete, or if some of the given kinds managed by the application are not watched yet, in
+// which case resources need to be listed from the cluster.
+func (c *LiveStateCache) getAppResources(server, namespace, appName string, kinds map[schema.GroupKind]bool) ([]*unstructured.Unstructured, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.lock.Lock()
+	cluster, ok := c.clusters[server]
+	c.lock.Unlock()
+	if !ok {
+		return nil, false
+	}
+	if !cluster.registerKinds(kinds) {
+		return nil, false
+	}
+	return cluster.getAppResources(namespace, appName)
+}
+
+func (s *clusterLiveState) getAppResources(namespace, appName string) ([]*unstructured.Unstructured, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if !s.synced {
+		return nil, false
+	}
+	objs := make([]*unstructured.Unstructured, 0)
+	for _, obj := range s.appResources[appName] {
+		if namespace == "" || obj.GetNamespace() == "" || obj.GetNamespace() == namespace {
+			objs = append(objs, obj.DeepCopy())
+		}
+	}
+	return objs, true
+}
+
+// registerKinds registers kinds managed by an application, and returns whether they are all watched.
+// The watch is notified to restart if they are not.
+func (s *clusterLiveState) registerKinds(kinds map[schema.GroupKind]bool) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.watchAllKinds {
+		return true
+	}
+	watched := true
+	for kind := range kinds {
+		s.managedKinds[kind] = true
+		if !s.watchedKinds[kind] {
+			watched = false
+		}
+	}
+	if !watched {
+		select {
+		case s.kindsChanged <- struct{}{}:
+		default:
+		}
+	}
+	return watched
+}
+
+// watchKinds returns the kinds to watch, or nil if resources of all kinds are watched
+func (s *clusterLiveState) watchKinds() map[schema.GroupKind]bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.watchAllKinds {
+		return nil
+	}
+	kinds := make(map[schema.GroupKind]bool)
+	for kind := range s.managedKinds {
+		kinds[kind] = true
+	}
+	return kinds
+}
+
+// replace replaces the state of the cluster with the listed resources of the watched kinds, and marks
+// it complete
+func (s *clusterLiveState) replace(objs []*unstructured.Unstructured, watchedKinds map[schema.GroupKind]bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.watchedKinds = watchedKinds
+	s.appResources = make(map[string]map[string]*unstructured.Unstructured)
+	s.appNames = make(map[string]string)
+	for _, obj := range objs {
+		s.set(obj)
+	}
+	s.synced = true
+}
+
+// invalidate marks the state of the cluster incomplete, e.g. when the watch has stopped
+func (s *clusterLiveState) invalidate() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.synced = false
+}
+
+// processEvent updates the state of the cluster with a watch event, and returns the names of the
+// applications which the resource belonged to before and after the event
+func (s *clusterLiveState) processEvent(eventType watch.EventType, obj *unstructured.Unstructured) (string, string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.appNames == nil {
+		// events received before the resources are listed are reflected by the listing
+		return "", s.resourceTracking.GetAppName(obj)
+	}
+	prevAppName := s.unset(obj)
+	if eventType == watch.Deleted {
+		return prevAppName, ""
+	}
+	return prevAppName, s.set(obj)
+}
+
+func (s *clusterLiveState) set(obj *unstructured.Unstructured) string {
+	appName := s.resourceTracking.GetAppName(obj)
+	if appName == "" {
+		return ""
+	}
+	key := liveStateKey(obj)
+	resources, ok := s.appResources[appName]
+	if !ok {
+		resources = make(map[string]*unstructured.Unstructured)
+		s.appResources[appName] = resources
+	}
+	resources[key] = obj
+	s.appNames[key] = appName
+	return appName
+}
+
+func (s *clusterLiveState) unset(obj *unstructured.Unstructured) string {
+	key := liveStateKey(obj)
+	appName, ok := s.appNames[key]
+	if !ok {
+		return ""
+	}
+	delete(s.appNames, key)
+	delete(s.appResources[appName], key)
+	if len(s.appResources[appName]) == 0 {
+		delete(s.appResources, appName)
+	}
+	return appName
+}
+
+// liveStateKey identifies a resource in the cluster. The API version is part of the key, since the
+// same resource is listed and watched in every version served by the cluster.
+func liveStateKey(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s/%s/%s", obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
+
+// managedKindsFilter excludes the kinds which are not managed by applications, in addition to the
+// resources excluded by the wrapped filter
+type managedKindsFilter struct {
+	filter kube.ResourceFilter
+	kinds  map[schema.GroupKind]bool
+}
+
+func (f *managedKindsFilter) IsExcludedResource(apiGroup, kind, cluster string) bool {
+	if !f.kinds[schema.GroupKind{Group: apiGroup, Kind: kind}] {
+		return true
+	}
+	return f.filter != nil && f.filter.IsExcludedResource(apiGroup, kind, cluster)
+}
+
+// newManagedKindsFilter returns a filter which only includes the kinds of the objects
+func newManagedKindsFilter(filter kube.ResourceFilter, objs []*unstructured.Unstructured) *managedKindsFilter {
+	kinds := make(map[schema.GroupKind]bool)
+	addObjectKinds(kinds, objs)
+	return &managedKindsFilter{filter: filter, kinds: kinds}
+}
+
+// getManagedKinds returns the kinds of the target objects of the application, and of the resources
+// of its last comparison, which may still be live
+func getManagedKinds(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured) map[schema.GroupKind]bool {
+	kinds := make(map[schema.GroupKind]bool)
+	addObjectKinds(kinds, targetObjs)
+	for _, res := range app.Status.ComparisonResult.Resources {
+		targetObj, _ := res.TargetObject()
+		liveObj, _ := res.LiveObject()
+		addObjectKinds(kinds, []*unstructured.Unstructured{targetObj, liveObj})
+	}
+	return kinds
+}
+
+func addObjectKinds(kinds map[schema.GroupKind]bool, objs []*unstructured.Unstructured) {
+	for _, obj := range objs {
+		if obj != nil {
+			kinds[obj.GroupVersionKind().GroupKind()] = true
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+)
+
+func newAppObj(kind, namespace, name, appName string) *unstructured.Unstructured {
+	obj := newObj(kind, namespace, name)
+	obj.SetAPIVersion("v1")
+	obj.SetLabels(map[string]string{common.LabelApplicationName: appName})
+	return obj
+}
+
+func TestLiveStateCache(t *testing.T) {
+	cache := NewLiveStateCache(argo.ResourceTracking{})
+	clusterState := cache.getCluster("https://kubernetes.default.svc")
+
+	// the state is incomplete until resources are listed
+	_, ok := cache.getAppResources("https://kubernetes.default.svc", "default", "guestbook", nil)
+	assert.False(t, ok)
+	prevAppName, appName := clusterState.processEvent(watch.Added, newAppObj("Service", "default", "guestbook-ui", "guestbook"))
+	assert.Equal(t, "", prevAppName)
+	assert.Equal(t, "guestbook", appName)
+
+	clusterState.replace([]*unstructured.Unstructured{
+		newAppObj("Service", "default", "guestbook-ui", "guestbook"),
+		newAppObj("Service", "other", "guestbook-ui", "guestbook"),
+		newAppObj("Namespace", "", "guestbook", "guestbook"),
+		newAppObj("Service", "default", "helm-guestbook", "helm-guestbook"),
+	}, nil)
+	objs, ok := cache.getAppResources("https://kubernetes.default.svc", "default", "guestbook", nil)
+	assert.True(t, ok)
+	assert.Len(t, objs, 2)
+
+	// resources moved to another application are removed from the previous one
+	prevAppName, appName = clusterState.processEvent(watch.Modified, newAppObj("Service", "default", "guestbook-ui", "helm-guestbook"))
+	assert.Equal(t, "guestbook", prevAppName)
+	assert.Equal(t, "helm-guestbook", appName)
+	objs, _ = cache.getAppResources("https://kubernetes.default.svc", "default", "guestbook", nil)
+	assert.Len(t, objs, 1)
+	objs, _ = cache.getAppResources("https://kubernetes.default.svc", "default", "helm-guestbook", nil)
+	assert.Len(t, objs, 2)
+
+	prevAppName, appName = clusterState.processEvent(watch.Deleted, newAppObj("Service", "default", "helm-guestbook", "helm-guestbook"))
+	assert.Equal(t, "helm-guestbook", prevAppName)
+	assert.Equal(t, "", appName)
+	objs, _ = cache.getAppResources("https://kubernetes.default.svc", "default", "helm-guestbook", nil)
+	assert.Len(t, objs, 1)
+
+	clusterState.invalidate()
+	_, ok = cache.getAppResources("https://kubernetes.default.svc", "default", "guestbook", nil)
+	assert.False(t, ok)
+
+	var nilCache *LiveStateCache
+	_, ok = nilCache.getAppResources("https://kubernetes.default.svc", "default", "guestbook")
+	assert.False(t, ok)
+}
+
+func TestLiveStateCacheManagedKinds(t *testing.T) {
+	cache := NewLiveStateCache(argo.ResourceTracking{Method: argo.TrackingMethodAnnotation, Namespace: "argocd"})
+	clusterState := cache.getCluster("https://kubernetes.default.svc")
+	assert.Empty(t, clusterState.watchKinds())
+	clusterState.replace(nil, clusterState.watchKinds())
+
+	// kinds which are not watched yet are registered, and the watch is notified to restart
+	serviceKinds := map[schema.GroupKind]bool{{Kind: "Service"}: true}
+	_, ok := cache.getAppResources("https://kubernetes.default.svc", "default", "guestbook", serviceKinds)
+	assert.False(t, ok)
+	assert.Len(t, clusterState.kindsChanged, 1)
+	<-clusterState.kindsChanged
+
+	kinds := clusterState.watchKinds()
+	assert.Equal(t, serviceKinds, kinds)
+	clusterState.replace(nil, kinds)
+	_, ok = cache.getAppResources("https://kubernetes.default.svc", "default", "guestbook", serviceKinds)
+	assert.True(t, ok)
+	assert.Len(t, clusterState.kindsChanged, 0)
+
+	// resources tracked by label are watched in all kinds
+	cache = NewLiveStateCache(argo.ResourceTracking{})
+	clusterState = cache.getCluster("https://kubernetes.default.svc")
+	assert.Nil(t, clusterState.watchKinds())
+	clusterState.replace(nil, nil)
+	_, ok = cache.getAppResources("https://kubernetes.default.svc", "default", "guestbook", serviceKinds)
+	assert.True(t, ok)
+}
+
+func TestGetManagedKinds(t *testing.T) {
+	app := &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{ComparisonResult: v1alpha1.ComparisonResult{
+		Resources: []v1alpha1.ResourceState{
+			{TargetState: "null", LiveState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"obsolete"}}`},
+		},
+	}}}
+	kinds := getManagedKinds(app, []*unstructured.Unstructured{newAppObj("Service", "default", "guestbook-ui", "guestbook"), nil})
+	assert.Equal(t, map[schema.GroupKind]bool{{Kind: "Service"}: true, {Group: "apps", Kind: "Deployment"}: true}, kinds)
+
+	filter := &managedKindsFilter{kinds: kinds}
+	assert.False(t, filter.IsExcludedResource("apps", "Deployment", "https://kubernetes.default.svc"))
+	assert.True(t, filter.IsExcludedResource("", "Secret", "https://kubernetes.default.svc"))
+}
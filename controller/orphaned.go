@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+)
+
+const (
+	// maxReportedOrphanedResources is the maximum number of orphaned resources named in the warning
+	maxReportedOrphanedResources = 10
+	// serviceAccountTokenSecretType is the type of the secrets created for service accounts
+	serviceAccountTokenSecretType = "kubernetes.io/service-account-token"
+)
+
+// defaultOrphanedResourcesIgnore are the resources created by Kubernetes itself, which are never
+// reported as orphaned
+var defaultOrphanedResourcesIgnore = v1alpha1.OrphanedResourcesMonitorSettings{
+	Ignore: []v1alpha1.OrphanedResourceKey{
+		{Kind: "Event"},
+		{Group: "events.k8s.io", Kind: "Event"},
+		{Kind: "Endpoints"},
+		{Group: "metrics.k8s.io"},
+		{Kind: "ServiceAccount", Name: "default"},
+		{Kind: "ConfigMap", Name: "kube-root-ca.crt"},
+	},
+}
+
+// getOrphanedResources returns the resources of the namespace which are neither managed by any
+// application nor owned by another resource, sorted by kind and name. Resources of the namespace are
+// expected to be listed in all their API versions, and are deduplicated.
+func getOrphanedResources(settings *v1alpha1.OrphanedResourcesMonitorSettings, resourceTracking argo.ResourceTracking, targetObjs []*unstructured.Unstructured, namespaceObjs []*unstructured.Unstructured) []*unstructured.Unstructured {
+	managed := make(map[string]bool)
+	for _, obj := range targetObjs {
+		if obj != nil {
+			managed[getResourceFullName(obj)] = true
+		}
+	}
+	orphans := make([]*unstructured.Unstructured, 0)
+	for _, obj := range namespaceObjs {
+		fullName := getResourceFullName(obj)
+		if managed[fullName] || resourceTracking.GetAppName(obj) != "" || len(obj.GetOwnerReferences()) > 0 {
+			continue
+		}
+		if obj.GetKind() == "Secret" && obj.Object["type"] == serviceAccountTokenSecretType {
+			continue
+		}
+		if defaultOrphanedResourcesIgnore.IsIgnored(obj) || settings.IsIgnored(obj) {
+			continue
+		}
+		managed[fullName] = true
+		orphans = append(orphans, obj)
+	}
+	sort.Slice(orphans, func(i, j int) bool {
+		return getResourceFullName(orphans[i]) < getResourceFullName(orphans[j])
+	})
+	return orphans
+}
+
+// getOrphanedResourcesConditions returns a warning condition if the destination namespace of the
+// application contains orphaned resources
+func getOrphanedResourcesConditions(app *v1alpha1.Application, orphans []*unstructured.Unstructured) []v1alpha1.ApplicationCondition {
+	if len(orphans) == 0 {
+		return nil
+	}
+	var names []string
+	for i, obj := range orphans {
+		if i == maxReportedOrphanedResources {
+			names = append(names, fmt.Sprintf("and %d more", len(orphans)-maxReportedOrphanedResources))
+			break
+		}
+		names = append(names, fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()))
+	}
+	return []v1alpha1.ApplicationCondition{{
+		Type:    v1alpha1.ApplicationConditionOrphanedResourceWarning,
+		Message: fmt.Sprintf("Namespace %s contains %d resources not managed by any application: %s", app.Spec.Destination.Namespace, len(orphans), strings.Join(names, ", ")),
+	}}
+}
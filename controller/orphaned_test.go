@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/argo"
+)
+
+func newTestObj(apiVersion, kind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace("default")
+	return obj
+}
+
+func TestGetOrphanedResources(t *testing.T) {
+	managed := newTestObj("v1", "ConfigMap", "managed")
+	otherApp := newTestObj("v1", "ConfigMap", "other-app")
+	otherApp.SetLabels(map[string]string{common.LabelApplicationName: "other"})
+	pod := newTestObj("v1", "Pod", "guestbook-12345")
+	pod.SetOwnerReferences([]metav1.OwnerReference{{Kind: "ReplicaSet", Name: "guestbook"}})
+	token := newTestObj("v1", "Secret", "default-token-abcde")
+	token.Object["type"] = "kubernetes.io/service-account-token"
+	orphan := newTestObj("v1", "Secret", "orphan")
+	ignored := newTestObj("v1", "ConfigMap", "leader-election")
+	namespaceObjs := []*unstructured.Unstructured{
+		managed, otherApp, pod, token, orphan, ignored,
+		newTestObj("v1", "Event", "guestbook.1234"),
+		newTestObj("v1", "ServiceAccount", "default"),
+		newTestObj("apps/v1", "Deployment", "orphan"),
+		// the same deployment listed in another API version
+		newTestObj("extensions/v1beta1", "Deployment", "orphan"),
+	}
+	settings := &v1alpha1.OrphanedResourcesMonitorSettings{Ignore: []v1alpha1.OrphanedResourceKey{{Kind: "ConfigMap", Name: "leader-*"}}}
+
+	orphans := getOrphanedResources(settings, argo.ResourceTracking{}, []*unstructured.Unstructured{managed, nil}, namespaceObjs)
+	assert.Len(t, orphans, 2)
+	assert.Equal(t, "Deployment", orphans[0].GetKind())
+	assert.Equal(t, orphan, orphans[1])
+
+	app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{Destination: v1alpha1.ApplicationDestination{Namespace: "default"}}}
+	conditions := getOrphanedResourcesConditions(app, orphans)
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionOrphanedResourceWarning, conditions[0].Type)
+	assert.Equal(t, "Namespace default contains 2 resources not managed by any application: Deployment/orphan, Secret/orphan", conditions[0].Message)
+	assert.Empty(t, getOrphanedResourcesConditions(app, nil))
+}
+
+type excludeAllFilter struct{}
+
+func (excludeAllFilter) IsExcludedResource(apiGroup, kind, cluster string) bool {
+	return true
+}
+
+func TestManagedKindsFilter(t *testing.T) {
+	targetObjs := []*unstructured.Unstructured{newTestObj("v1", "ConfigMap", "managed"), newTestObj("apps/v1", "Deployment", "managed"), nil}
+
+	filter := newManagedKindsFilter(nil, targetObjs)
+	assert.False(t, filter.IsExcludedResource("", "ConfigMap", "https://kubernetes.default.svc"))
+	assert.False(t, filter.IsExcludedResource("apps", "Deployment", "https://kubernetes.default.svc"))
+	assert.True(t, filter.IsExcludedResource("", "Secret", "https://kubernetes.default.svc"))
+	assert.True(t, filter.IsExcludedResource("extensions", "Deployment", "https://kubernetes.default.svc"))
+
+	filter = newManagedKindsFilter(excludeAllFilter{}, targetObjs)
+	assert.True(t, filter.IsExcludedResource("", "ConfigMap", "https://kubernetes.default.svc"))
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// argoCDDeployments are the names of the deployments running the ArgoCD components
+var argoCDDeployments = map[string]bool{
+	"application-controller": true,
+	"argocd-server":          true,
+	"argocd-repo-server":     true,
+}
+
+// isArgoCDComponent returns whether or not the object is one of ArgoCD's own components, i.e. its
+// CRDs or the deployments running in the ArgoCD namespace of the local cluster. namespace is the
+// namespace the object is deployed to when it does not specify one.
+func isArgoCDComponent(obj *unstructured.Unstructured, server, namespace, argoCDNamespace string) bool {
+	if server != common.KubernetesInternalAPIServerAddr {
+		return false
+	}
+	switch obj.GetKind() {
+	case "CustomResourceDefinition":
+		return obj.GetName() == application.ApplicationFullName || obj.GetName() == application.AppProjectFullName
+	case "Deployment":
+		if obj.GetNamespace() != "" {
+			namespace = obj.GetNamespace()
+		}
+		return namespace == argoCDNamespace && argoCDDeployments[obj.GetName()]
+	}
+	return false
+}
+
+// getSelfManagementConditions returns a warning condition if the application manages any of
+// ArgoCD's own components
+func getSelfManagementConditions(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured, argoCDNamespace string) []v1alpha1.ApplicationCondition {
+	var components []string
+	for _, obj := range targetObjs {
+		if obj != nil && isArgoCDComponent(obj, app.Spec.Destination.Server, app.Spec.Destination.Namespace, argoCDNamespace) {
+			components = append(components, fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()))
+		}
+	}
+	if len(components) == 0 {
+		return nil
+	}
+	return []v1alpha1.ApplicationCondition{{
+		Type:    v1alpha1.ApplicationConditionSelfManagementWarning,
+		Message: fmt.Sprintf("Application manages ArgoCD components (%s), which are synced last and never pruned", strings.Join(components, ", ")),
+	}}
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func newObj(kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestIsArgoCDComponent(t *testing.T) {
+	server := common.KubernetesInternalAPIServerAddr
+	assert.True(t, isArgoCDComponent(newObj("Deployment", "argocd", "argocd-server"), server, "default", "argocd"))
+	assert.True(t, isArgoCDComponent(newObj("Deployment", "", "application-controller"), server, "argocd", "argocd"))
+	assert.True(t, isArgoCDComponent(newObj("CustomResourceDefinition", "", "applications.argoproj.io"), server, "default", "argocd"))
+
+	assert.False(t, isArgoCDComponent(newObj("Deployment", "", "argocd-server"), server, "default", "argocd"))
+	assert.False(t, isArgoCDComponent(newObj("Deployment", "argocd", "guestbook-ui"), server, "argocd", "argocd"))
+	assert.False(t, isArgoCDComponent(newObj("Service", "argocd", "argocd-server"), server, "argocd", "argocd"))
+	assert.False(t, isArgoCDComponent(newObj("Deployment", "argocd", "argocd-server"), "https://remote-cluster", "argocd", "argocd"))
+}
+
+func TestGetSelfManagementConditions(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Destination: v1alpha1.ApplicationDestination{Server: common.KubernetesInternalAPIServerAddr, Namespace: "argocd"},
+		},
+	}
+	conditions := getSelfManagementConditions(app, []*unstructured.Unstructured{newObj("Deployment", "", "argocd-repo-server"), nil}, "argocd")
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionSelfManagementWarning, conditions[0].Type)
+	assert.Contains(t, conditions[0].Message, "Deployment/argocd-repo-server")
+
+	assert.Empty(t, getSelfManagementConditions(app, []*unstructured.Unstructured{newObj("Deployment", "", "guestbook-ui")}, "argocd"))
+}
@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"hash/fnv"
+
+	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+// GetClusterShard returns the shard of controller replicas which processes the applications deployed
+// to the cluster. Clusters are assigned to shards by the hash of their server URL, so that the
+// assignment is stable without coordination between shards.
+func GetClusterShard(server string, shards int) int {
+	if shards <= 1 {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(server))
+	return int(h.Sum32() % uint32(shards))
+}
+
+// isClusterInShard returns whether or not the applications deployed to the cluster are processed by
+// the shard of this controller
+func (ctrl *ApplicationController) isClusterInShard(server string) bool {
+	return GetClusterShard(server, ctrl.shards) == ctrl.shard
+}
+
+// isAppInShard returns whether or not the application is processed by the shard of this controller
+func (ctrl *ApplicationController) isAppInShard(app *appv1.Application) bool {
+	return ctrl.isClusterInShard(app.Spec.Destination.Server)
+}
@@ -0,0 +1,29 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetClusterShard(t *testing.T) {
+	assert.Equal(t, 0, GetClusterShard("https://kubernetes.default.svc", 0))
+	assert.Equal(t, 0, GetClusterShard("https://kubernetes.default.svc", 1))
+
+	counts := make([]int, 3)
+	for i := 0; i < 300; i++ {
+		server := fmt.Sprintf("https://cluster-%d.example.com", i)
+		shard := GetClusterShard(server, 3)
+		assert.Equal(t, shard, GetClusterShard(server, 3))
+		counts[shard]++
+	}
+	for _, count := range counts {
+		assert.True(t, count > 0)
+	}
+}
+
+func TestGetLeaderElectionLockName(t *testing.T) {
+	assert.Equal(t, "argocd-application-controller-leader", getLeaderElectionLockName(0, 1))
+	assert.Equal(t, "argocd-application-controller-leader-2", getLeaderElectionLockName(2, 3))
+}
@@ -4,43 +4,59 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 
-	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/diff"
 	kubeutil "github.com/argoproj/argo-cd/util/kube"
-)
-
-const (
-	maxHistoryCnt = 5
+	"github.com/argoproj/argo-cd/util/secrets"
 )
 
 // AppStateManager defines methods which allow to compare application spec and actual application state.
 type AppStateManager interface {
-	CompareAppState(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) (
+	CompareAppState(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter, noCache bool) (
 		*v1alpha1.ComparisonResult, *repository.ManifestResponse, []v1alpha1.ApplicationCondition, error)
 	SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState)
 }
 
 // ksonnetAppStateManager allows to compare application using KSonnet CLI
 type ksonnetAppStateManager struct {
-	db            db.ArgoDB
-	appclientset  appclientset.Interface
-	repoClientset reposerver.Clientset
-	namespace     string
+	db             db.ArgoDB
+	appclientset   appclientset.Interface
+	repoClientset  reposerver.Clientset
+	namespace      string
+	secretResolver *secrets.Resolver
+	// serverSideApply indicates whether resources are applied server-side by default
+	serverSideApply bool
+	// resourceFilter excludes resources from the comparison
+	resourceFilter kubeutil.ResourceFilter
+	// resourceTracking identifies the live resources of applications
+	resourceTracking argo.ResourceTracking
+	// revisionHistoryMaxAge is the age after which deployments are removed from the history of
+	// applications. Zero keeps deployments of any age.
+	revisionHistoryMaxAge time.Duration
+	// liveStateCache holds the live state of the resources of applications, which is listed from
+	// clusters if not available
+	liveStateCache *LiveStateCache
+	// syncParallelism is the maximum number of resources applied or pruned concurrently by a sync
+	syncParallelism int
+	// diffCache holds the diff results of unchanged resources. Every resource is diffed if nil.
+	diffCache *diff.Cache
 }
 
 // groupLiveObjects deduplicate list of kubernetes resources and choose correct version of resource: if resource has corresponding expected application resource then method pick
@@ -83,8 +99,12 @@ func groupLiveObjects(liveObjs []*unstructured.Unstructured, targetObjs []*unstr
 	return liveByFullName
 }
 
-func (s *ksonnetAppStateManager) getTargetObjs(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) ([]*unstructured.Unstructured, *repository.ManifestResponse, error) {
+func (s *ksonnetAppStateManager) getTargetObjs(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter, noCache bool) ([]*unstructured.Unstructured, *repository.ManifestResponse, error) {
 	repo := s.getRepo(app.Spec.Source.RepoURL)
+	proj, err := argo.GetAppProject(&app.Spec, s.appclientset, s.namespace)
+	if err != nil {
+		return nil, nil, err
+	}
 	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
 	if err != nil {
 		return nil, nil, err
@@ -121,6 +141,11 @@ func (s *ksonnetAppStateManager) getTargetObjs(app *v1alpha1.Application, revisi
 		ComponentParameterOverrides: mfReqOverrides,
 		AppLabel:                    app.Name,
 		ValueFiles:                  app.Spec.Source.ValuesFiles,
+		NoCache:                     noCache,
+		TimeoutSeconds:              argo.GetManifestGenerateTimeoutSeconds(app),
+		AllowedSourceTypes:          proj.Spec.SourceTools,
+		NoAppLabel:                  !s.resourceTracking.UsesLabel(),
+		VerifySignature:             len(proj.Spec.SignatureKeys) > 0,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -132,15 +157,21 @@ func (s *ksonnetAppStateManager) getTargetObjs(app *v1alpha1.Application, revisi
 		if err != nil {
 			return nil, nil, err
 		}
-		if isHook(obj) {
+		if IsHook(obj) {
 			continue
 		}
+		if s.resourceTracking.UsesAnnotation() {
+			err = s.resourceTracking.SetAppInstance(obj, app.Name, app.Spec.Destination.Namespace)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
 		targetObjs = append(targetObjs, obj)
 	}
 	return targetObjs, manifestInfo, nil
 }
 
-func (s *ksonnetAppStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (
+func (s *ksonnetAppStateManager) getLiveObjs(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured, noCache bool) (
 	[]*unstructured.Unstructured, map[string]*unstructured.Unstructured, error) {
 
 	// Get the REST config for the cluster corresponding to the environment
@@ -150,14 +181,30 @@ func (s *ksonnetAppStateManager) getLiveObjs(app *v1alpha1.Application, targetOb
 	}
 	restConfig := clst.RESTConfig()
 
-	// Retrieve the live versions of the objects. exclude any hook objects
-	labeledObjs, err := kubeutil.GetResourcesWithLabel(restConfig, app.Spec.Destination.Namespace, common.LabelApplicationName, app.Name)
-	if err != nil {
-		return nil, nil, err
+	// Retrieve the live versions of the objects, from the live state cache unless the cache is
+	// bypassed or the cluster state is not listed yet. exclude any hook objects
+	var labeledObjs []*unstructured.Unstructured
+	var managedKinds map[schema.GroupKind]bool
+	filter := s.resourceFilter
+	if !s.resourceTracking.UsesLabel() {
+		// resources tracked by annotation only cannot be selected by label, so only the kinds
+		// managed by the application are listed
+		managedKinds = getManagedKinds(app, targetObjs)
+		filter = &managedKindsFilter{filter: s.resourceFilter, kinds: managedKinds}
+	}
+	cached := false
+	if !noCache {
+		labeledObjs, cached = s.liveStateCache.getAppResources(app.Spec.Destination.Server, app.Spec.Destination.Namespace, app.Name, managedKinds)
+	}
+	if !cached {
+		labeledObjs, err = s.resourceTracking.GetAppResources(restConfig, app.Spec.Destination.Namespace, app.Name, filter)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 	liveObjs := make([]*unstructured.Unstructured, 0)
 	for _, obj := range labeledObjs {
-		if isHook(obj) {
+		if IsHook(obj) {
 			continue
 		}
 		liveObjs = append(liveObjs, obj)
@@ -202,22 +249,49 @@ func (s *ksonnetAppStateManager) getLiveObjs(app *v1alpha1.Application, targetOb
 	return controlledLiveObj, liveObjByFullName, nil
 }
 
+// filterExcludedObjs removes the target objects of kinds excluded from the cluster, which the
+// controller neither compares nor syncs, and returns a warning condition naming them
+func filterExcludedObjs(filter kubeutil.ResourceFilter, cluster string, targetObjs []*unstructured.Unstructured) ([]*unstructured.Unstructured, []v1alpha1.ApplicationCondition) {
+	if filter == nil {
+		return targetObjs, nil
+	}
+	includedObjs := make([]*unstructured.Unstructured, 0, len(targetObjs))
+	var excluded []string
+	for _, obj := range targetObjs {
+		if filter.IsExcludedResource(obj.GroupVersionKind().Group, obj.GetKind(), cluster) {
+			excluded = append(excluded, fmt.Sprintf("%s/%s", obj.GetKind(), obj.GetName()))
+			continue
+		}
+		includedObjs = append(includedObjs, obj)
+	}
+	if len(excluded) == 0 {
+		return includedObjs, nil
+	}
+	return includedObjs, []v1alpha1.ApplicationCondition{{
+		Type:    v1alpha1.ApplicationConditionExcludedResourceWarning,
+		Message: fmt.Sprintf("Resources of excluded kinds are ignored: %s", strings.Join(excluded, ", ")),
+	}}
+}
+
 // CompareAppState compares application git state to the live app state, using the specified
 // revision and supplied overrides. If revision or overrides are empty, then compares against
-// revision and overrides in the app spec.
-func (s *ksonnetAppStateManager) CompareAppState(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter) (
+// revision and overrides in the app spec. If noCache is set, manifests are regenerated by the repo
+// server rather than served from its cache.
+func (s *ksonnetAppStateManager) CompareAppState(app *v1alpha1.Application, revision string, overrides []v1alpha1.ComponentParameter, noCache bool) (
 	*v1alpha1.ComparisonResult, *repository.ManifestResponse, []v1alpha1.ApplicationCondition, error) {
 
 	failedToLoadObjs := false
 	conditions := make([]v1alpha1.ApplicationCondition, 0)
-	targetObjs, manifestInfo, err := s.getTargetObjs(app, revision, overrides)
+	targetObjs, manifestInfo, err := s.getTargetObjs(app, revision, overrides, noCache)
 	if err != nil {
 		targetObjs = make([]*unstructured.Unstructured, 0)
-		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error()})
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionManifestGenerationError, Message: err.Error()})
 		failedToLoadObjs = true
 	}
+	targetObjs, excludedConditions := filterExcludedObjs(s.resourceFilter, app.Spec.Destination.Server, targetObjs)
+	conditions = append(conditions, excludedConditions...)
 
-	controlledLiveObj, liveObjByFullName, err := s.getLiveObjs(app, targetObjs)
+	controlledLiveObj, liveObjByFullName, err := s.getLiveObjs(app, targetObjs, noCache)
 	if err != nil {
 		controlledLiveObj = make([]*unstructured.Unstructured, len(targetObjs))
 		liveObjByFullName = make(map[string]*unstructured.Unstructured)
@@ -226,16 +300,26 @@ func (s *ksonnetAppStateManager) CompareAppState(app *v1alpha1.Application, revi
 	}
 
 	for _, liveObj := range controlledLiveObj {
-		if liveObj != nil && liveObj.GetLabels() != nil {
-			if appLabelVal, ok := liveObj.GetLabels()[common.LabelApplicationName]; ok && appLabelVal != "" && appLabelVal != app.Name {
+		if liveObj != nil {
+			if appLabelVal := s.resourceTracking.GetAppName(liveObj); appLabelVal != "" && appLabelVal != app.Name {
 				conditions = append(conditions, v1alpha1.ApplicationCondition{
 					Type:    v1alpha1.ApplicationConditionSharedResourceWarning,
-					Message: fmt.Sprintf("Resource %s/%s is controller by applications '%s' and '%s'", liveObj.GetKind(), liveObj.GetName(), app.Name, appLabelVal),
+					Message: fmt.Sprintf("Resource %s/%s is managed by applications '%s' and '%s'", liveObj.GetKind(), liveObj.GetName(), app.Name, appLabelVal),
 				})
 			}
 		}
 	}
 
+	conditions = append(conditions, getSelfManagementConditions(app, targetObjs, s.namespace)...)
+	orphanedConditions, err := s.detectOrphanedResources(app, targetObjs)
+	if err != nil {
+		orphanedConditions = []v1alpha1.ApplicationCondition{{
+			Type:    v1alpha1.ApplicationConditionOrphanedResourceWarning,
+			Message: fmt.Sprintf("Failed to detect orphaned resources: %v", err),
+		}}
+	}
+	conditions = append(conditions, orphanedConditions...)
+
 	// Move root level live resources to controlledLiveObj and add nil to targetObjs to indicate that target object is missing
 	for fullName := range liveObjByFullName {
 		liveObj := liveObjByFullName[fullName]
@@ -248,7 +332,11 @@ func (s *ksonnetAppStateManager) CompareAppState(app *v1alpha1.Application, revi
 	log.Infof("Comparing app %s state in cluster %s (namespace: %s)", app.ObjectMeta.Name, app.Spec.Destination.Server, app.Spec.Destination.Namespace)
 
 	// Do the actual comparison
-	diffResults, err := diff.DiffArray(targetObjs, controlledLiveObj)
+	compareTargetObjs, compareLiveObjs, err := NormalizeDiffObjects(app, targetObjs, controlledLiveObj)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	diffResults, err := s.diffCache.DiffArray(compareTargetObjs, compareLiveObjs, diffNormalizationKey(app))
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -288,7 +376,8 @@ func (s *ksonnetAppStateManager) CompareAppState(app *v1alpha1.Application, revi
 			resState.Status = v1alpha1.ComparisonStatusOutOfSync
 			comparisonStatus = v1alpha1.ComparisonStatusOutOfSync
 		} else {
-			liveObjBytes, err := json.Marshal(controlledLiveObj[i].Object)
+			// the values resolved from secret references are not stored in the status
+			liveObjBytes, err := json.Marshal(secrets.RedactReferences(targetObjs[i], controlledLiveObj[i]).Object)
 			if err != nil {
 				return nil, nil, nil, err
 			}
@@ -318,9 +407,76 @@ func (s *ksonnetAppStateManager) CompareAppState(app *v1alpha1.Application, revi
 		Resources:  resources,
 		Status:     comparisonStatus,
 	}
+	if manifestInfo != nil {
+		compResult.Revision = manifestInfo.Revision
+	}
 	return &compResult, manifestInfo, conditions, nil
 }
 
+// NormalizeDiffObjects returns copies of the target and live objects of an application, matched by
+// index, normalized the way they are before they are diffed to compare the state of the application:
+// the ignored differences of the application are removed, and secret references, which are resolved
+// only when applying, are compared with the live values.
+func NormalizeDiffObjects(app *v1alpha1.Application, targetObjs, liveObjs []*unstructured.Unstructured) ([]*unstructured.Unstructured, []*unstructured.Unstructured, error) {
+	compareTargetObjs, err := argo.RemoveIgnoredDifferences(app.Spec.IgnoreDifferences, secrets.MaskReferences(targetObjs, liveObjs))
+	if err != nil {
+		return nil, nil, err
+	}
+	compareLiveObjs, err := argo.RemoveIgnoredDifferences(app.Spec.IgnoreDifferences, liveObjs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return compareTargetObjs, compareLiveObjs, nil
+}
+
+// diffNormalizationKey identifies how the resources of the application are normalized before they are
+// diffed, so that cached diff results are not reused once the ignored differences change
+func diffNormalizationKey(app *v1alpha1.Application) string {
+	ignored, _ := json.Marshal(app.Spec.IgnoreDifferences)
+	return fmt.Sprintf("%s/%s|%s", app.Namespace, app.Name, string(ignored))
+}
+
+// detectOrphanedResources returns a warning condition if the destination namespace of the application
+// contains orphaned resources. Orphaned resources are only detected if enabled in the project.
+func (s *ksonnetAppStateManager) detectOrphanedResources(app *v1alpha1.Application, targetObjs []*unstructured.Unstructured) ([]v1alpha1.ApplicationCondition, error) {
+	proj, err := argo.GetAppProject(&app.Spec, s.appclientset, s.namespace)
+	if err != nil {
+		return nil, err
+	}
+	if proj.Spec.OrphanedResources == nil || app.Spec.Destination.Namespace == "" {
+		return nil, nil
+	}
+	kindsFilter := newManagedKindsFilter(s.resourceFilter, targetObjs)
+	if len(kindsFilter.kinds) == 0 {
+		return nil, nil
+	}
+	clst, err := s.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	if err != nil {
+		return nil, err
+	}
+	namespaceObjs, err := kubeutil.GetNamespacedResources(clst.RESTConfig(), app.Spec.Destination.Namespace, kindsFilter)
+	if err != nil {
+		return nil, err
+	}
+	orphans := getOrphanedResources(proj.Spec.OrphanedResources, s.resourceTracking, targetObjs, namespaceObjs)
+	return getOrphanedResourcesConditions(app, orphans), nil
+}
+
+// verifyRevisionSignature returns an error if the project requires signed revisions and the revision
+// of the manifests is not signed by one of the GPG keys of the project
+func verifyRevisionSignature(proj *v1alpha1.AppProject, manifestInfo *repository.ManifestResponse) error {
+	if len(proj.Spec.SignatureKeys) == 0 || manifestInfo == nil {
+		return nil
+	}
+	if manifestInfo.SignatureKeyFingerprint == "" {
+		return fmt.Errorf("revision %s is not signed with a valid GPG signature, which is required by project %s", manifestInfo.Revision, proj.Name)
+	}
+	if !proj.IsSignatureKeyPermitted(manifestInfo.SignatureKeyFingerprint) {
+		return fmt.Errorf("revision %s is signed with GPG key %s, which is not permitted by project %s", manifestInfo.Revision, manifestInfo.SignatureKeyFingerprint, proj.Name)
+	}
+	return nil
+}
+
 func hasParent(obj *unstructured.Unstructured) bool {
 	// TODO: remove special case after Service and Endpoint get explicit relationship ( https://github.com/kubernetes/kubernetes/issues/28483 )
 	return obj.GetKind() == kubeutil.EndpointsKind || metav1.GetControllerOf(obj) != nil
@@ -369,8 +525,28 @@ func (s *ksonnetAppStateManager) getRepo(repoURL string) *v1alpha1.Repository {
 	return repo
 }
 
+// trimHistory removes the oldest deployments of the history beyond the limit, as well as the
+// deployments older than maxAge. The latest deployment is kept regardless of its age, and a zero
+// maxAge keeps deployments of any age.
+func trimHistory(history []v1alpha1.DeploymentInfo, limit int64, maxAge time.Duration, now time.Time) []v1alpha1.DeploymentInfo {
+	start := 0
+	if int64(len(history)) > limit {
+		start = len(history) - int(limit)
+	}
+	if maxAge > 0 {
+		for start < len(history)-1 && now.Sub(history[start].DeployedAt.Time) > maxAge {
+			start++
+		}
+	}
+	return history[start:]
+}
+
+// persistDeploymentInfo appends a deployment to the history of the application, along with a
+// snapshot of the deployed source and who initiated it. The deployment of a rollback records the ID
+// of the redeployed deployment, whose overrides are deployed in place of the overrides of the
+// application spec.
 func (s *ksonnetAppStateManager) persistDeploymentInfo(
-	app *v1alpha1.Application, revision string, envParams []*v1alpha1.ComponentParameter, overrides *[]v1alpha1.ComponentParameter) error {
+	app *v1alpha1.Application, revision string, envParams []*v1alpha1.ComponentParameter, overrides []v1alpha1.ComponentParameter, rollbackID *int64, initiatedBy v1alpha1.OperationInitiator) error {
 
 	params := make([]v1alpha1.ComponentParameter, len(envParams))
 	for i := range envParams {
@@ -381,17 +557,27 @@ func (s *ksonnetAppStateManager) persistDeploymentInfo(
 	if len(app.Status.History) > 0 {
 		nextID = app.Status.History[len(app.Status.History)-1].ID + 1
 	}
+	cause := v1alpha1.DeploymentCauseSync
+	if rollbackID != nil {
+		cause = v1alpha1.DeploymentCauseRollback
+	} else {
+		overrides = app.Spec.Source.ComponentParameterOverrides
+	}
+	source := app.Spec.Source.DeepCopy()
+	source.ComponentParameterOverrides = overrides
+	now := time.Now().UTC()
 	history := append(app.Status.History, v1alpha1.DeploymentInfo{
-		ComponentParameterOverrides: app.Spec.Source.ComponentParameterOverrides,
+		ComponentParameterOverrides: overrides,
 		Revision:                    revision,
 		Params:                      params,
-		DeployedAt:                  metav1.NewTime(time.Now().UTC()),
+		DeployedAt:                  metav1.NewTime(now),
 		ID:                          nextID,
+		Cause:                       cause,
+		RollbackID:                  rollbackID,
+		Source:                      *source,
+		InitiatedBy:                 initiatedBy,
 	})
-
-	if len(history) > maxHistoryCnt {
-		history = history[1 : maxHistoryCnt+1]
-	}
+	history = trimHistory(history, app.Spec.GetRevisionHistoryLimit(), s.revisionHistoryMaxAge, now)
 
 	patch, err := json.Marshal(map[string]map[string][]v1alpha1.DeploymentInfo{
 		"status": {
@@ -405,17 +591,52 @@ func (s *ksonnetAppStateManager) persistDeploymentInfo(
 	return err
 }
 
-// NewAppStateManager creates new instance of Ksonnet app comparator
+// AppStateManagerConfig holds the optional settings of the app state manager
+type AppStateManagerConfig struct {
+	// SecretResolver resolves the secret references of manifests. References are not resolved if nil.
+	SecretResolver *secrets.Resolver
+	// ServerSideApply indicates whether resources are applied server-side by default
+	ServerSideApply bool
+	// ResourceFilter excludes resources from the comparison
+	ResourceFilter kubeutil.ResourceFilter
+	// ResourceTracking identifies the live resources of applications
+	ResourceTracking argo.ResourceTracking
+	// RevisionHistoryMaxAge is the age after which deployments are removed from the history of
+	// applications. Zero keeps deployments of any age.
+	RevisionHistoryMaxAge time.Duration
+	// LiveStateCache holds the live state of the resources of applications. Resources are listed
+	// from clusters if nil.
+	LiveStateCache *LiveStateCache
+	// SyncParallelism is the maximum number of resources applied or pruned concurrently by a sync
+	SyncParallelism int
+	// DiffCache holds the diff results of unchanged resources. Every resource is diffed if nil.
+	DiffCache *diff.Cache
+}
+
+// NewAppStateManager creates new instance of Ksonnet app comparator. A nil config uses the defaults.
 func NewAppStateManager(
 	db db.ArgoDB,
 	appclientset appclientset.Interface,
 	repoClientset reposerver.Clientset,
 	namespace string,
+	config *AppStateManagerConfig,
 ) AppStateManager {
+	if config == nil {
+		config = &AppStateManagerConfig{}
+	}
 	return &ksonnetAppStateManager{
-		db:            db,
-		appclientset:  appclientset,
-		repoClientset: repoClientset,
-		namespace:     namespace,
+		db:               db,
+		appclientset:     appclientset,
+		repoClientset:    repoClientset,
+		namespace:        namespace,
+		secretResolver:   config.SecretResolver,
+		serverSideApply:  config.ServerSideApply,
+		resourceFilter:   config.ResourceFilter,
+		resourceTracking: config.ResourceTracking,
+
+		revisionHistoryMaxAge: config.RevisionHistoryMaxAge,
+		liveStateCache:        config.LiveStateCache,
+		syncParallelism:       config.SyncParallelism,
+		diffCache:             config.DiffCache,
 	}
 }
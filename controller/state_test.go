@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/reposerver/repository"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTrimHistory(t *testing.T) {
+	now := time.Now()
+	history := make([]v1alpha1.DeploymentInfo, 0)
+	for i := 0; i < 4; i++ {
+		history = append(history, v1alpha1.DeploymentInfo{ID: int64(i), DeployedAt: metav1.NewTime(now.Add(time.Duration(i-3) * time.Hour))})
+	}
+
+	assert.Len(t, trimHistory(history, 5, 0, now), 4)
+
+	trimmed := trimHistory(history, 2, 0, now)
+	assert.Len(t, trimmed, 2)
+	assert.Equal(t, int64(2), trimmed[0].ID)
+
+	trimmed = trimHistory(history, 5, 90*time.Minute, now)
+	assert.Len(t, trimmed, 2)
+	assert.Equal(t, int64(2), trimmed[0].ID)
+
+	// the latest deployment is kept regardless of its age
+	trimmed = trimHistory(history, 5, time.Minute, now.Add(time.Hour))
+	assert.Len(t, trimmed, 1)
+	assert.Equal(t, int64(3), trimmed[0].ID)
+
+	assert.Len(t, trimHistory(history, 0, 0, now), 0)
+}
+
+func TestVerifyRevisionSignature(t *testing.T) {
+	proj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "signed"}}
+	sha := "a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2"
+	assert.Nil(t, verifyRevisionSignature(proj, &repository.ManifestResponse{Revision: sha}))
+
+	proj.Spec.SignatureKeys = []string{"4AEE18F83AFDEB23D5D9A1C34D9E8E13A7E3C96A"}
+	assert.Nil(t, verifyRevisionSignature(proj, &repository.ManifestResponse{Revision: sha, SignatureKeyFingerprint: "4aee18f83afdeb23d5d9a1c34d9e8e13a7e3c96a"}))
+	err := verifyRevisionSignature(proj, &repository.ManifestResponse{Revision: sha})
+	assert.EqualError(t, err, "revision "+sha+" is not signed with a valid GPG signature, which is required by project signed")
+	err = verifyRevisionSignature(proj, &repository.ManifestResponse{Revision: sha, SignatureKeyFingerprint: "0123456789ABCDEF0123456789ABCDEF01234567"})
+	assert.EqualError(t, err, "revision "+sha+" is signed with GPG key 0123456789ABCDEF0123456789ABCDEF01234567, which is not permitted by project signed")
+}
@@ -1,15 +1,20 @@
 package controller
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -17,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/kubernetes/pkg/apis/batch"
 
@@ -25,6 +31,49 @@ import (
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/secrets"
+)
+
+const (
+	// hookOutputTailLines is the number of log lines captured from each container of a hook
+	hookOutputTailLines = 100
+	// hookOutputLimitBytes is the maximum size of the output captured from a hook
+	hookOutputLimitBytes = 8 * 1024
+	// resultMessageLimitBytes is the maximum size of the messages of operations, resources and hooks
+	// persisted in the operation state
+	resultMessageLimitBytes = 2 * 1024
+	// DefaultSyncParallelism is the default maximum number of resources applied or pruned concurrently
+	// by a sync
+	DefaultSyncParallelism = 20
+
+	// syncOptionValidateFalse disables the schema validation of a resource by kubectl
+	syncOptionValidateFalse = "Validate=false"
+	// syncOptionPruneFalse prevents a resource from being pruned
+	syncOptionPruneFalse = "Prune=false"
+	// syncOptionReplaceTrue replaces an existing resource instead of applying it
+	syncOptionReplaceTrue = "Replace=true"
+	// syncOptionForceTrue deletes and re-creates a resource when its immutable fields changed
+	syncOptionForceTrue = "Force=true"
+	// syncOptionServerSideApply is the name of the sync option which enables or disables server-side
+	// applies of a resource
+	syncOptionServerSideApply = "ServerSideApply"
+	// syncOptionPrunePropagationPolicy is the name of the sync option which overrides the propagation
+	// policy used when pruning a resource
+	syncOptionPrunePropagationPolicy = "PrunePropagationPolicy"
+	// syncOptionSkipDryRunOnMissingResource skips the dry run of a resource whose type is not known to
+	// the cluster, e.g. because its CRD is created by a previous step of the sync
+	syncOptionSkipDryRunOnMissingResource = "SkipDryRunOnMissingResource=true"
+	// syncOptionApplyOutOfSyncOnly only applies the resources of an application which are out of sync
+	syncOptionApplyOutOfSyncOnly = "ApplyOutOfSyncOnly=true"
+	// syncOptionFailOnSharedResource fails the sync of an application when one of its resources is
+	// already managed by another application
+	syncOptionFailOnSharedResource = "FailOnSharedResource=true"
+
+	// crdEstablishTimeout is the maximum duration a sync waits for the types defined by the CRDs it
+	// created to be served, before applying the resources of these types
+	crdEstablishTimeout = 30 * time.Second
+	// crdEstablishPollInterval is the interval at which the served types are checked
+	crdEstablishPollInterval = 1 * time.Second
 )
 
 type syncContext struct {
@@ -32,13 +81,36 @@ type syncContext struct {
 	comparison    *appv1.ComparisonResult
 	config        *rest.Config
 	dynClientPool dynamic.ClientPool
-	disco         *discovery.DiscoveryClient
+	disco         discovery.DiscoveryInterface
+	server        string
 	namespace     string
+	argoNamespace string
 	syncOp        *appv1.SyncOperation
 	syncRes       *appv1.SyncOperationResult
 	opState       *appv1.OperationState
 	manifestInfo  *repository.ManifestResponse
+	secrets       *secrets.Resolver
+	proj          *appv1.AppProject
 	log           *log.Entry
+	// prunePropagationPolicy is the propagation policy of the application used when pruning resources
+	prunePropagationPolicy metav1.DeletionPropagation
+	// serverSideApply indicates whether the resources of the application are applied server-side
+	serverSideApply bool
+	// resourceTracking marks the hooks as resources of the application
+	resourceTracking argo.ResourceTracking
+	// managedNamespaceMetadata is the metadata of the destination namespace managed by the application
+	managedNamespaceMetadata *appv1.ManagedNamespaceMetadata
+	// syncParallelism is the maximum number of resources applied or pruned concurrently
+	syncParallelism int
+	// skipDryRunOnMissingResource skips the dry run of all resources whose type is not known to the
+	// cluster yet, as set by the sync option of the application
+	skipDryRunOnMissingResource bool
+	// applyOutOfSyncOnly skips the resources which are already synced, as set by the sync option of
+	// the application
+	applyOutOfSyncOnly bool
+	// failOnSharedResource fails the sync if a resource is already managed by another application, as
+	// set by the sync option of the application
+	failOnSharedResource bool
 	// lock to protect concurrent updates of the result list
 	lock sync.Mutex
 }
@@ -53,6 +125,7 @@ func (s *ksonnetAppStateManager) SyncAppState(app *appv1.Application, state *app
 	var syncOp appv1.SyncOperation
 	var syncRes *appv1.SyncOperationResult
 	var overrides []appv1.ComponentParameter
+	var rollbackID *int64
 
 	if state.Operation.Sync != nil {
 		syncOp = *state.Operation.Sync
@@ -84,6 +157,7 @@ func (s *ksonnetAppStateManager) SyncAppState(app *appv1.Application, state *app
 			SyncStrategy: &appv1.SyncStrategy{Apply: &appv1.SyncStrategyApply{}},
 		}
 		overrides = deploymentInfo.ComponentParameterOverrides
+		rollbackID = &deploymentInfo.ID
 		if state.RollbackResult != nil {
 			syncRes = state.RollbackResult
 			revision = state.RollbackResult.Revision
@@ -103,7 +177,7 @@ func (s *ksonnetAppStateManager) SyncAppState(app *appv1.Application, state *app
 		// Take the value in the requested operation. We will resolve this to a SHA later.
 		revision = syncOp.Revision
 	}
-	comparison, manifestInfo, conditions, err := s.CompareAppState(app, revision, overrides)
+	comparison, manifestInfo, conditions, err := s.CompareAppState(app, revision, overrides, false)
 	if err != nil {
 		state.Phase = appv1.OperationError
 		state.Message = err.Error()
@@ -120,6 +194,18 @@ func (s *ksonnetAppStateManager) SyncAppState(app *appv1.Application, state *app
 		state.Message = argo.FormatAppConditions(errConditions)
 		return
 	}
+	proj, err := argo.GetAppProject(&app.Spec, s.appclientset, s.namespace)
+	if err != nil {
+		state.Phase = appv1.OperationError
+		state.Message = err.Error()
+		return
+	}
+	err = verifyRevisionSignature(proj, manifestInfo)
+	if err != nil {
+		state.Phase = appv1.OperationFailed
+		state.Message = err.Error()
+		return
+	}
 	// We now have a concrete commit SHA. Set this in the sync result revision so that we remember
 	// what we should be syncing to when resuming operations.
 	syncRes.Revision = manifestInfo.Revision
@@ -131,6 +217,21 @@ func (s *ksonnetAppStateManager) SyncAppState(app *appv1.Application, state *app
 		return
 	}
 
+	var prunePropagationPolicy metav1.DeletionPropagation
+	if policy := app.Annotations[common.AnnotationKeyPrunePropagationPolicy]; policy != "" {
+		prunePropagationPolicy, err = parsePropagationPolicy(policy)
+		if err != nil {
+			state.Phase = appv1.OperationError
+			state.Message = err.Error()
+			return
+		}
+	}
+
+	serverSideApply := s.serverSideApply
+	if value := app.Annotations[common.AnnotationKeyServerSideApply]; value != "" {
+		serverSideApply = strings.ToLower(value) == "true"
+	}
+
 	restConfig := clst.RESTConfig()
 	dynClientPool := dynamic.NewDynamicClientPool(restConfig)
 	disco, err := discovery.NewDiscoveryClientForConfig(restConfig)
@@ -146,12 +247,31 @@ func (s *ksonnetAppStateManager) SyncAppState(app *appv1.Application, state *app
 		config:        restConfig,
 		dynClientPool: dynClientPool,
 		disco:         disco,
+		server:        app.Spec.Destination.Server,
 		namespace:     app.Spec.Destination.Namespace,
+		argoNamespace: s.namespace,
 		syncOp:        &syncOp,
 		syncRes:       syncRes,
 		opState:       state,
 		manifestInfo:  manifestInfo,
+		secrets:       s.secretResolver,
+		proj:          proj,
 		log:           log.WithFields(log.Fields{"application": app.Name}),
+
+		prunePropagationPolicy: prunePropagationPolicy,
+		serverSideApply:        serverSideApply,
+		resourceTracking:       s.resourceTracking,
+		syncParallelism:        s.syncParallelism,
+	}
+	if app.Spec.SyncPolicy != nil {
+		syncCtx.managedNamespaceMetadata = app.Spec.SyncPolicy.ManagedNamespaceMetadata
+		// a namespace created by the sync option is managed without metadata
+		if syncCtx.managedNamespaceMetadata == nil && app.Spec.SyncPolicy.HasSyncOption(appv1.SyncOptionCreateNamespace) {
+			syncCtx.managedNamespaceMetadata = &appv1.ManagedNamespaceMetadata{}
+		}
+		syncCtx.skipDryRunOnMissingResource = app.Spec.SyncPolicy.HasSyncOption(syncOptionSkipDryRunOnMissingResource)
+		syncCtx.applyOutOfSyncOnly = app.Spec.SyncPolicy.HasSyncOption(syncOptionApplyOutOfSyncOnly)
+		syncCtx.failOnSharedResource = app.Spec.SyncPolicy.HasSyncOption(syncOptionFailOnSharedResource)
 	}
 
 	if state.Phase == appv1.OperationTerminating {
@@ -160,8 +280,9 @@ func (s *ksonnetAppStateManager) SyncAppState(app *appv1.Application, state *app
 		syncCtx.sync()
 	}
 
-	if !syncOp.DryRun && syncCtx.opState.Phase.Successful() {
-		err := s.persistDeploymentInfo(app, manifestInfo.Revision, manifestInfo.Params, nil)
+	// a selective sync leaves the other resources untouched, so the application was not deployed as a whole
+	if !syncOp.DryRun && len(syncOp.Resources) == 0 && syncCtx.opState.Phase.Successful() {
+		err := s.persistDeploymentInfo(app, manifestInfo.Revision, manifestInfo.Params, overrides, rollbackID, state.Operation.InitiatedBy)
 		if err != nil {
 			state.Phase = appv1.OperationError
 			state.Message = fmt.Sprintf("failed to record sync to history: %v", err)
@@ -175,6 +296,8 @@ func (s *ksonnetAppStateManager) SyncAppState(app *appv1.Application, state *app
 type syncTask struct {
 	liveObj   *unstructured.Unstructured
 	targetObj *unstructured.Unstructured
+	// syncStatus is the comparison status of the resource before the sync
+	syncStatus appv1.ComparisonStatus
 }
 
 // sync has performs the actual apply or hook based sync
@@ -191,6 +314,15 @@ func (sc *syncContext) sync() {
 		// Optimization: we only wish to do this once per operation, performing additional dry-runs
 		// is harmless, but redundant. The indicator we use to detect if we have already performed
 		// the dry-run for this operation, is if the resource or hook list is empty.
+		if sc.failOnSharedResource {
+			if message := sc.getSharedResourceMessage(syncTasks); message != "" {
+				sc.setOperationPhase(appv1.OperationFailed, message)
+				return
+			}
+		}
+		if !sc.syncManagedNamespace(syncTasks) {
+			return
+		}
 		if !sc.doApplySync(syncTasks, true, false, sc.syncOp.DryRun) {
 			sc.setOperationPhase(appv1.OperationFailed, "one or more objects failed to apply (dry run)")
 			return
@@ -219,10 +351,15 @@ func (sc *syncContext) sync() {
 		}
 		sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced")
 	} else if sc.syncOp.SyncStrategy.Hook != nil {
-		hooks, err := sc.getHooks()
-		if err != nil {
-			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to generate hooks resources: %v", err))
-			return
+		var hooks []*unstructured.Unstructured
+		// hooks are not run by a selective sync, which only applies the selected resources
+		if len(sc.syncOp.Resources) == 0 {
+			var err error
+			hooks, err = sc.getHooks()
+			if err != nil {
+				sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to generate hooks resources: %v", err))
+				return
+			}
 		}
 		sc.doHookSync(syncTasks, hooks)
 	} else {
@@ -231,11 +368,59 @@ func (sc *syncContext) sync() {
 	}
 }
 
+// syncManagedNamespace creates the destination namespace if missing, and applies the metadata
+// declared in the sync policy of the application. This is done when the sync policy has either
+// managed namespace metadata or the CreateNamespace=true sync option. Namespaces which are part of the manifests of the
+// application are left to the sync of the manifests. Returns false if the namespace failed to apply.
+func (sc *syncContext) syncManagedNamespace(syncTasks []syncTask) bool {
+	if sc.managedNamespaceMetadata == nil || sc.namespace == "" {
+		return true
+	}
+	for _, task := range syncTasks {
+		if task.targetObj != nil && task.targetObj.GetKind() == kube.NamespaceKind && task.targetObj.GetName() == sc.namespace {
+			sc.log.Infof("Namespace %s is part of the manifests, ignoring managed namespace metadata", sc.namespace)
+			return true
+		}
+	}
+	namespace := &unstructured.Unstructured{}
+	namespace.SetAPIVersion("v1")
+	namespace.SetKind(kube.NamespaceKind)
+	namespace.SetName(sc.namespace)
+	namespace.SetLabels(sc.managedNamespaceMetadata.Labels)
+	namespace.SetAnnotations(sc.managedNamespaceMetadata.Annotations)
+	message, err := kube.ApplyResource(sc.config, namespace, sc.namespace, sc.dryRunStrategy(sc.syncOp.DryRun), false, true, sc.serverSideApply)
+	if err != nil {
+		sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("failed to apply managed namespace %s: %v", sc.namespace, err))
+		return false
+	}
+	sc.log.Infof("Applied managed namespace %s: %s", sc.namespace, message)
+	return true
+}
+
+// getSharedResourceMessage returns a message naming the resources of the sync which are already
+// managed by another application, or an empty string if there are none
+func (sc *syncContext) getSharedResourceMessage(syncTasks []syncTask) string {
+	var shared []string
+	for _, task := range syncTasks {
+		if task.targetObj == nil || task.liveObj == nil {
+			continue
+		}
+		if appName := sc.resourceTracking.GetAppName(task.liveObj); appName != "" && appName != sc.appName {
+			shared = append(shared, fmt.Sprintf("%s/%s (application '%s')", task.liveObj.GetKind(), task.liveObj.GetName(), appName))
+		}
+	}
+	if len(shared) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("resources are already managed by other applications: %s", strings.Join(shared, ", "))
+}
+
 func (sc *syncContext) forceAppRefresh() {
 	sc.comparison.ComparedAt = metav1.Time{}
 }
 
 // generateSyncTasks() generates the list of sync tasks we will be performing during this sync.
+// Resources which were not selected by a selective sync are skipped.
 func (sc *syncContext) generateSyncTasks() ([]syncTask, bool) {
 	syncTasks := make([]syncTask, 0)
 	for _, resourceState := range sc.comparison.Resources {
@@ -249,9 +434,17 @@ func (sc *syncContext) generateSyncTasks() ([]syncTask, bool) {
 			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("Failed to unmarshal target object: %v", err))
 			return nil, false
 		}
+		obj := targetObj
+		if obj == nil {
+			obj = liveObj
+		}
+		if obj == nil || !sc.syncOp.IsResourceIncluded(obj) {
+			continue
+		}
 		syncTask := syncTask{
-			liveObj:   liveObj,
-			targetObj: targetObj,
+			liveObj:    liveObj,
+			targetObj:  targetObj,
+			syncStatus: resourceState.Status,
 		}
 		syncTasks = append(syncTasks, syncTask)
 	}
@@ -295,6 +488,16 @@ func (sc *syncContext) startedPostSyncPhase() bool {
 	return false
 }
 
+// startedSyncFailPhase detects if we have already started running SyncFail hooks
+func (sc *syncContext) startedSyncFailPhase() bool {
+	for _, hookStatus := range sc.syncRes.Hooks {
+		if hookStatus.Type == appv1.HookTypeSyncFail {
+			return true
+		}
+	}
+	return false
+}
+
 func (sc *syncContext) setOperationPhase(phase appv1.OperationPhase, message string) {
 	if sc.opState.Phase != phase || sc.opState.Message != message {
 		sc.log.Infof("Updating operation state. phase: %s -> %s, message: '%s' -> '%s'", sc.opState.Phase, phase, sc.opState.Message, message)
@@ -303,14 +506,31 @@ func (sc *syncContext) setOperationPhase(phase appv1.OperationPhase, message str
 	sc.opState.Message = message
 }
 
-// applyObject performs a `kubectl apply` of a single resource
-func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRun bool, force bool) appv1.ResourceDetails {
+// applyObject performs a `kubectl apply` of a single resource, or a `kubectl replace` if the
+// resource already exists and has the Replace=true sync option
+func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, exists, dryRun, force bool) appv1.ResourceDetails {
 	resDetails := appv1.ResourceDetails{
 		Name:      targetObj.GetName(),
 		Kind:      targetObj.GetKind(),
 		Namespace: sc.namespace,
 	}
-	message, err := kube.ApplyResource(sc.config, targetObj, sc.namespace, dryRun, force)
+	targetObj, err := sc.resolveSecrets(targetObj)
+	if err != nil {
+		resDetails.Message = err.Error()
+		resDetails.Status = appv1.ResourceDetailsSyncFailed
+		return resDetails
+	}
+	validate := !hasSyncOption(targetObj, syncOptionValidateFalse)
+	var message string
+	if exists && !dryRun && hasSyncOption(targetObj, syncOptionReplaceTrue) {
+		message, err = kube.ReplaceResource(sc.config, targetObj, sc.namespace, validate, false)
+	} else {
+		message, err = kube.ApplyResource(sc.config, targetObj, sc.namespace, sc.dryRunStrategy(dryRun), force, validate, sc.isServerSideApply(targetObj))
+	}
+	if err != nil && exists && !dryRun && hasSyncOption(targetObj, syncOptionForceTrue) && kube.IsImmutableFieldError(err) {
+		sc.log.Infof("Re-creating %s '%s' to change immutable fields: %v", targetObj.GetKind(), targetObj.GetName(), err)
+		message, err = kube.ReplaceResource(sc.config, targetObj, sc.namespace, validate, true)
+	}
 	if err != nil {
 		resDetails.Message = err.Error()
 		resDetails.Status = appv1.ResourceDetailsSyncFailed
@@ -321,6 +541,38 @@ func (sc *syncContext) applyObject(targetObj *unstructured.Unstructured, dryRun
 	return resDetails
 }
 
+// dryRunStrategy returns how an apply is dry-run. Dry-run sync operations are dry-run by the API
+// server, so that their results preview the sync as accurately as possible, while the validation
+// preceding actual syncs only relies on kubectl.
+func (sc *syncContext) dryRunStrategy(dryRun bool) kube.DryRunStrategy {
+	if !dryRun {
+		return kube.DryRunNone
+	}
+	if sc.syncOp.DryRun {
+		return kube.DryRunServer
+	}
+	return kube.DryRunClient
+}
+
+// isServerSideApply returns whether or not the object is applied server-side, according to its sync
+// option, or else to the setting of the application
+func (sc *syncContext) isServerSideApply(obj *unstructured.Unstructured) bool {
+	if value, ok := getSyncOptionValue(obj, syncOptionServerSideApply); ok {
+		return strings.ToLower(value) == "true"
+	}
+	return sc.serverSideApply
+}
+
+// resolveSecrets returns the object with its secret references, as far as permitted by the project
+// of the application, replaced by the values of the secrets. This is done right before applying, so
+// that secret values are never cached or stored in the application status.
+func (sc *syncContext) resolveSecrets(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if sc.secrets == nil {
+		return obj, nil
+	}
+	return sc.secrets.Resolve(obj, sc.proj)
+}
+
 // pruneObject deletes the object if both prune is true and dryRun is false. Otherwise appropriate message
 func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dryRun bool) appv1.ResourceDetails {
 	resDetails := appv1.ResourceDetails{
@@ -328,12 +580,23 @@ func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dr
 		Kind:      liveObj.GetKind(),
 		Namespace: liveObj.GetNamespace(),
 	}
-	if prune {
-		if dryRun {
+	if prune && sc.isArgoCDComponent(liveObj) {
+		// pruning the controller or API server could abort the very sync which is pruning it
+		resDetails.Message = "ignored (ArgoCD components are never pruned)"
+		resDetails.Status = appv1.ResourceDetailsPruningRequired
+	} else if prune && hasSyncOption(liveObj, syncOptionPruneFalse) {
+		resDetails.Message = "ignored (pruning disabled by sync option)"
+		resDetails.Status = appv1.ResourceDetailsPruningRequired
+	} else if prune {
+		propagationPolicy, err := sc.getPrunePropagationPolicy(liveObj)
+		if err != nil {
+			resDetails.Message = err.Error()
+			resDetails.Status = appv1.ResourceDetailsSyncFailed
+		} else if dryRun {
 			resDetails.Message = "pruned (dry run)"
 			resDetails.Status = appv1.ResourceDetailsSyncedAndPruned
 		} else {
-			err := kube.DeleteResource(sc.config, liveObj, sc.namespace)
+			err := kube.DeleteResource(sc.config, liveObj, sc.namespace, propagationPolicy)
 			if err != nil {
 				resDetails.Message = err.Error()
 				resDetails.Status = appv1.ResourceDetailsSyncFailed
@@ -349,28 +612,189 @@ func (sc *syncContext) pruneObject(liveObj *unstructured.Unstructured, prune, dr
 	return resDetails
 }
 
+// getPrunePropagationPolicy returns the propagation policy used when pruning the resource, which is
+// the one of its sync option, or else the one of the application. Dependents are deleted in the
+// foreground by default.
+func (sc *syncContext) getPrunePropagationPolicy(liveObj *unstructured.Unstructured) (metav1.DeletionPropagation, error) {
+	if policy, ok := getSyncOptionValue(liveObj, syncOptionPrunePropagationPolicy); ok {
+		return parsePropagationPolicy(policy)
+	}
+	if sc.prunePropagationPolicy != "" {
+		return sc.prunePropagationPolicy, nil
+	}
+	return metav1.DeletePropagationForeground, nil
+}
+
+// parsePropagationPolicy parses a propagation policy, one of foreground, background or orphan
+func parsePropagationPolicy(policy string) (metav1.DeletionPropagation, error) {
+	switch strings.ToLower(policy) {
+	case "foreground":
+		return metav1.DeletePropagationForeground, nil
+	case "background":
+		return metav1.DeletePropagationBackground, nil
+	case "orphan":
+		return metav1.DeletePropagationOrphan, nil
+	}
+	return "", fmt.Errorf("invalid prune propagation policy '%s', expected one of: foreground, background, orphan", policy)
+}
+
 // performs a apply based sync of the given sync tasks (possibly pruning the objects).
 // If update is true, will updates the resource details with the result.
 // Or if the prune/apply failed, will also update the result.
 func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update bool) bool {
+	if sc.applyOutOfSyncOnly {
+		syncTasks = sc.skipSyncedResources(syncTasks, update)
+	}
+	crds := getCRDGroupKinds(syncTasks)
+	if dryRun {
+		syncTasks = sc.skipDryRunOfMissingResources(syncTasks, crds, update)
+	}
+	// CRDs are applied first, so that the resources they define can be created by the same sync.
+	// ArgoCD's own components are applied last, so that a restart of the controller caused by
+	// syncing itself does not interrupt the sync of the remaining resources.
+	var crdTasks, tasks, argoCDTasks []syncTask
+	for _, task := range syncTasks {
+		if task.targetObj != nil && sc.isArgoCDComponent(task.targetObj) {
+			argoCDTasks = append(argoCDTasks, task)
+		} else if task.targetObj != nil && !dryRun && kube.IsCRD(task.targetObj) {
+			crdTasks = append(crdTasks, task)
+		} else {
+			tasks = append(tasks, task)
+		}
+	}
 	syncSuccessful := true
-	// apply all resources in parallel
+	if len(crdTasks) > 0 {
+		syncSuccessful = sc.doApplyTasks(crdTasks, dryRun, force, update)
+		sc.waitForResourceTypes(tasks, crds)
+	}
+	if !sc.doApplyTasks(tasks, dryRun, force, update) {
+		syncSuccessful = false
+	}
+	if len(argoCDTasks) > 0 && !sc.doApplyTasks(argoCDTasks, dryRun, force, update) {
+		syncSuccessful = false
+	}
+	return syncSuccessful
+}
+
+// getCRDGroupKinds returns the API groups and kinds of the resources defined by the CRDs of the sync
+func getCRDGroupKinds(syncTasks []syncTask) map[schema.GroupKind]bool {
+	crds := make(map[schema.GroupKind]bool)
+	for _, task := range syncTasks {
+		if task.targetObj != nil && kube.IsCRD(task.targetObj) {
+			crds[kube.GetCRDGroupKind(task.targetObj)] = true
+		}
+	}
+	return crds
+}
+
+// isResourceTypeKnown returns whether or not the cluster serves the API of the object
+func (sc *syncContext) isResourceTypeKnown(obj *unstructured.Unstructured) bool {
+	_, err := kube.ServerResourceForGroupVersionKind(sc.disco, obj.GroupVersionKind())
+	return err == nil
+}
+
+// skipDryRunOfMissingResources returns the sync tasks without the resources whose dry run is
+// skipped, because their type is not known to the cluster yet. This is done for resources defined
+// by a CRD of the same sync, and for resources with the SkipDryRunOnMissingResource=true sync
+// option, either set on the resource or on the application.
+func (sc *syncContext) skipDryRunOfMissingResources(syncTasks []syncTask, crds map[schema.GroupKind]bool, update bool) []syncTask {
+	var tasks []syncTask
+	for _, task := range syncTasks {
+		obj := task.targetObj
+		if obj == nil || task.liveObj != nil || IsHook(obj) ||
+			!(crds[obj.GroupVersionKind().GroupKind()] || sc.skipDryRunOnMissingResource || hasSyncOption(obj, syncOptionSkipDryRunOnMissingResource)) ||
+			sc.isResourceTypeKnown(obj) {
+			tasks = append(tasks, task)
+			continue
+		}
+		sc.log.Infof("Skipping dry run of %s '%s', its type is not known to the cluster", obj.GetKind(), obj.GetName())
+		if update {
+			sc.setResourceDetails(&appv1.ResourceDetails{
+				Name:      obj.GetName(),
+				Kind:      obj.GetKind(),
+				Namespace: sc.namespace,
+				Message:   "skipped dry run (resource type not known to the cluster)",
+				Status:    appv1.ResourceDetailsSynced,
+			})
+		}
+	}
+	return tasks
+}
+
+// skipSyncedResources returns the sync tasks without the resources which are already synced, so that
+// only the resources detected as out of sync are applied, as set by the ApplyOutOfSyncOnly=true sync
+// option of the application. Resources to prune are out of sync, and are never skipped.
+func (sc *syncContext) skipSyncedResources(syncTasks []syncTask, update bool) []syncTask {
+	var tasks []syncTask
+	for _, task := range syncTasks {
+		obj := task.targetObj
+		if obj == nil || task.liveObj == nil || IsHook(obj) || task.syncStatus != appv1.ComparisonStatusSynced {
+			tasks = append(tasks, task)
+			continue
+		}
+		if update {
+			sc.setResourceDetails(&appv1.ResourceDetails{
+				Name:      obj.GetName(),
+				Kind:      obj.GetKind(),
+				Namespace: sc.namespace,
+				Message:   "skipped (already synced)",
+				Status:    appv1.ResourceDetailsSynced,
+			})
+		}
+	}
+	return tasks
+}
+
+// waitForResourceTypes waits until the cluster serves the types of the resources defined by the
+// CRDs of the sync, since a CRD needs to be established before its resources can be created. If the
+// types are still unknown after crdEstablishTimeout, the resources are applied anyway and fail.
+func (sc *syncContext) waitForResourceTypes(syncTasks []syncTask, crds map[schema.GroupKind]bool) {
+	deadline := time.Now().Add(crdEstablishTimeout)
+	for _, task := range syncTasks {
+		obj := task.targetObj
+		if obj == nil || !crds[obj.GroupVersionKind().GroupKind()] {
+			continue
+		}
+		for !sc.isResourceTypeKnown(obj) && time.Now().Before(deadline) {
+			time.Sleep(crdEstablishPollInterval)
+		}
+	}
+}
+
+// isArgoCDComponent returns whether or not the object is one of ArgoCD's own components
+func (sc *syncContext) isArgoCDComponent(obj *unstructured.Unstructured) bool {
+	return isArgoCDComponent(obj, sc.server, sc.namespace, sc.argoNamespace)
+}
+
+// doApplyTasks applies or prunes the given sync tasks in parallel, at most the sync parallelism of
+// them at a time. Returns whether all succeeded.
+func (sc *syncContext) doApplyTasks(syncTasks []syncTask, dryRun, force, update bool) bool {
+	parallelism := sc.syncParallelism
+	if parallelism <= 0 {
+		parallelism = DefaultSyncParallelism
+	}
+	var failed int32
 	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, parallelism)
 	for _, task := range syncTasks {
 		wg.Add(1)
+		semaphore <- struct{}{}
 		go func(t syncTask) {
-			defer wg.Done()
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
 			var resDetails appv1.ResourceDetails
 			if t.targetObj == nil {
 				resDetails = sc.pruneObject(t.liveObj, sc.syncOp.Prune, dryRun)
 			} else {
-				if isHook(t.targetObj) {
+				if IsHook(t.targetObj) {
 					return
 				}
-				resDetails = sc.applyObject(t.targetObj, dryRun, force)
+				resDetails = sc.applyObject(t.targetObj, t.liveObj != nil, dryRun, force)
 			}
 			if !resDetails.Status.Successful() {
-				syncSuccessful = false
+				atomic.StoreInt32(&failed, 1)
 			}
 			if update || !resDetails.Status.Successful() {
 				sc.setResourceDetails(&resDetails)
@@ -378,12 +802,25 @@ func (sc *syncContext) doApplySync(syncTasks []syncTask, dryRun, force, update b
 		}(task)
 	}
 	wg.Wait()
-	return syncSuccessful
+	return atomic.LoadInt32(&failed) == 0
 }
 
 // doHookSync initiates (or continues) a hook-based sync. This method will be invoked when there may
 // already be in-flight (potentially incomplete) jobs/workflows, and should be idempotent.
 func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Unstructured) {
+	// Once SyncFail hooks were started, the sync already failed and only the SyncFail hooks remain
+	if sc.startedSyncFailPhase() {
+		sc.runSyncFailHooks(hooks)
+		return
+	}
+	sc.runSyncPhases(syncTasks, hooks)
+	if sc.opState.Phase == appv1.OperationFailed {
+		sc.runSyncFailHooks(hooks)
+	}
+}
+
+// runSyncPhases runs the PreSync, Sync and PostSync phases of a hook-based sync
+func (sc *syncContext) runSyncPhases(syncTasks []syncTask, hooks []*unstructured.Unstructured) {
 	// 1. Run PreSync hooks
 	if !sc.runHooks(hooks, appv1.HookTypePreSync) {
 		return
@@ -412,7 +849,7 @@ func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Un
 	// already started the post-sync phase, then we do not need to perform the health check.
 	postSyncHooks, _ := sc.getHooks(appv1.HookTypePostSync)
 	if len(postSyncHooks) > 0 && !sc.startedPostSyncPhase() {
-		healthState, err := setApplicationHealth(sc.comparison)
+		healthState, err := setApplicationHealth(sc.comparison, nil, 0)
 		sc.log.Infof("PostSync application health check: %s", healthState.Status)
 		if err != nil {
 			sc.setOperationPhase(appv1.OperationError, fmt.Sprintf("failed to check application health: %v", err))
@@ -431,6 +868,42 @@ func (sc *syncContext) doHookSync(syncTasks []syncTask, hooks []*unstructured.Un
 	sc.setOperationPhase(appv1.OperationSucceeded, "successfully synced")
 }
 
+// runSyncFailHooks runs the SyncFail hooks of a failed sync. The operation is set back to running
+// until the hooks completed, so that it is processed again rather than terminated, and then fails
+// with the reason of the sync failure.
+func (sc *syncContext) runSyncFailHooks(hooks []*unstructured.Unstructured) {
+	hasSyncFailHooks := false
+	for _, hook := range hooks {
+		if isHookType(hook, appv1.HookTypeSyncFail) {
+			hasSyncFailHooks = true
+			break
+		}
+	}
+	if !hasSyncFailHooks {
+		return
+	}
+	message := sc.syncFailureMessage()
+	sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("%s, running %s hooks", message, appv1.HookTypeSyncFail))
+	if !sc.runHooks(hooks, appv1.HookTypeSyncFail) {
+		if sc.opState.Phase == appv1.OperationFailed {
+			sc.setOperationPhase(appv1.OperationFailed, fmt.Sprintf("%s, %s hook failed", message, appv1.HookTypeSyncFail))
+		}
+		return
+	}
+	sc.setOperationPhase(appv1.OperationFailed, message)
+}
+
+// syncFailureMessage returns the reason of a sync failure. Since the operation message is replaced
+// while SyncFail hooks run, the reason is derived from the hook and resource results.
+func (sc *syncContext) syncFailureMessage() string {
+	for _, hookType := range []appv1.HookType{appv1.HookTypePreSync, appv1.HookTypeSync, appv1.HookTypePostSync} {
+		if completed, successful := areHooksCompletedSuccessful(hookType, sc.syncRes.Hooks); completed && !successful {
+			return fmt.Sprintf("%s hook failed", hookType)
+		}
+	}
+	return "one or more objects failed to apply"
+}
+
 // getHooks returns all ArgoCD hooks, optionally filtered by ones of the specific type(s)
 func (sc *syncContext) getHooks(hookTypes ...appv1.HookType) ([]*unstructured.Unstructured, error) {
 	var hooks []*unstructured.Unstructured
@@ -542,7 +1015,8 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 	if err != nil {
 		return false, err
 	}
-	resIf := dclient.Resource(apiResource, sc.namespace)
+	hookNamespace := sc.getHookNamespace()
+	resIf := dclient.Resource(apiResource, hookNamespace)
 
 	var liveObj *unstructured.Unstructured
 	existing, err := resIf.Get(hook.GetName(), metav1.GetOptions{})
@@ -551,11 +1025,18 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 			return false, fmt.Errorf("Failed to get status of %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
 		}
 		hook = hook.DeepCopy()
-		err = kube.SetLabel(hook, common.LabelApplicationName, sc.appName)
+		if hookNamespace != sc.namespace {
+			hook.SetNamespace(hookNamespace)
+		}
+		err = sc.resourceTracking.SetAppInstance(hook, sc.appName, hookNamespace)
 		if err != nil {
-			sc.log.Warnf("Failed to set application label on hook %v: %v", hook, err)
+			sc.log.Warnf("Failed to set application tracking on hook %v: %v", hook, err)
 		}
-		_, err := kube.ApplyResource(sc.config, hook, sc.namespace, false, false)
+		resolvedHook, err := sc.resolveSecrets(hook)
+		if err != nil {
+			return false, fmt.Errorf("Failed to create %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
+		}
+		_, err = kube.ApplyResource(sc.config, resolvedHook, hookNamespace, kube.DryRunNone, false, !hasSyncOption(hook, syncOptionValidateFalse), sc.isServerSideApply(hook))
 		if err != nil {
 			return false, fmt.Errorf("Failed to create %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
 		}
@@ -566,13 +1047,31 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 		sc.log.Infof("%s hook %s '%s' created", hookType, gvk, created.GetName())
 		sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("running %s hooks", hookType))
 		liveObj = created
+	} else if prevStatus == nil && isPreviousHook(existing, sc.opState.StartedAt) && hasHookDeletePolicy(hook, appv1.HookDeletePolicyBeforeHookCreation) {
+		// the hook resource was left by a previous sync and has to be deleted before the hook can
+		// be created again. Deletion is asynchronous, so the hook is created once the deletion
+		// completed, during a later iteration of the operation.
+		if existing.GetDeletionTimestamp() == nil {
+			err = sc.deleteHook(hookNamespace, existing.GetName(), existing.GetKind(), existing.GetAPIVersion())
+			if err != nil {
+				return false, fmt.Errorf("Failed to delete previous %s hook %s '%s': %v", hookType, gvk, hook.GetName(), err)
+			}
+			sc.log.Infof("Previous %s hook %s '%s' deleted", hookType, gvk, hook.GetName())
+		}
+		sc.setOperationPhase(appv1.OperationRunning, fmt.Sprintf("waiting for previous %s hook %s '%s' to be deleted", hookType, gvk, hook.GetName()))
+		return true, nil
 	} else {
 		liveObj = existing
 	}
 	hookStatus := newHookStatus(liveObj, hookType)
 	if hookStatus.Status.Completed() {
-		if enforceDeletePolicy(hook, hookStatus.Status) {
-			err = sc.deleteHook(hook.GetName(), hook.GetKind(), hook.GetAPIVersion())
+		if hookStatus.Kind == "Job" {
+			// capture the output before the hook (and its pods) may get deleted
+			hookStatus.Output = sc.getJobOutput(hookNamespace, hookStatus.Name)
+		}
+		// hooks in a dedicated namespace are not tracked by the application, so are always cleaned up
+		if enforceDeletePolicy(hook, hookStatus.Status) || hookNamespace != sc.namespace {
+			err = sc.deleteHook(hookNamespace, hook.GetName(), hook.GetKind(), hook.GetAPIVersion())
 			if err != nil {
 				hookStatus.Status = appv1.OperationFailed
 				hookStatus.Message = fmt.Sprintf("failed to delete %s hook: %v", hookStatus.Status, err)
@@ -582,25 +1081,157 @@ func (sc *syncContext) runHook(hook *unstructured.Unstructured, hookType appv1.H
 	return sc.updateHookStatus(hookStatus), nil
 }
 
+// getHookNamespace returns the namespace in which the hooks of the sync operation are created
+func (sc *syncContext) getHookNamespace() string {
+	strategy := sc.syncOp.SyncStrategy
+	if strategy != nil && strategy.Hook != nil && strategy.Hook.Namespace != "" {
+		return strategy.Hook.Namespace
+	}
+	return sc.namespace
+}
+
+// getJobOutput returns a bounded copy of the logs and exit codes of the containers of a Job's pods
+func (sc *syncContext) getJobOutput(namespace, jobName string) string {
+	kubeClientset, err := kubernetes.NewForConfig(sc.config)
+	if err != nil {
+		return fmt.Sprintf("failed to capture output: %v", err)
+	}
+	pods, err := kubeClientset.CoreV1().Pods(namespace).List(metav1.ListOptions{LabelSelector: "job-name=" + jobName})
+	if err != nil {
+		return fmt.Sprintf("failed to capture output: %v", err)
+	}
+	var output bytes.Buffer
+	tailLines := int64(hookOutputTailLines)
+	limitBytes := int64(hookOutputLimitBytes)
+	for _, pod := range pods.Items {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			fmt.Fprintf(&output, "==> pod/%s container/%s", pod.Name, containerStatus.Name)
+			if containerStatus.State.Terminated != nil {
+				fmt.Fprintf(&output, " (exit code: %d)", containerStatus.State.Terminated.ExitCode)
+			}
+			output.WriteString(" <==\n")
+			logs, err := kubeClientset.CoreV1().Pods(namespace).GetLogs(pod.Name, &apiv1.PodLogOptions{
+				Container:  containerStatus.Name,
+				TailLines:  &tailLines,
+				LimitBytes: &limitBytes,
+			}).DoRaw()
+			if err != nil {
+				fmt.Fprintf(&output, "failed to get logs: %v\n", err)
+			} else {
+				output.Write(logs)
+			}
+		}
+	}
+	return truncateHookOutput(output.String(), hookOutputLimitBytes)
+}
+
+// truncateHookOutput truncates the beginning of the output to keep at most maxBytes
+func truncateHookOutput(output string, maxBytes int) string {
+	if len(output) <= maxBytes {
+		return output
+	}
+	start := len(output) - maxBytes
+	for start < len(output) && !utf8.RuneStart(output[start]) {
+		start++
+	}
+	return "...(truncated)\n" + output[start:]
+}
+
+// truncateMessage truncates the end of the message to keep at most maxBytes
+func truncateMessage(message string, maxBytes int) string {
+	if len(message) <= maxBytes {
+		return message
+	}
+	end := maxBytes
+	for end > 0 && !utf8.RuneStart(message[end]) {
+		end--
+	}
+	return message[:end] + "...(truncated)"
+}
+
+// compactOperationState truncates the oversized messages of the operation state, which is persisted
+// in the application object. Messages of failed resources and hooks are typically kubectl errors,
+// which may list every invalid field of a manifest.
+func compactOperationState(state *appv1.OperationState) {
+	state.Message = truncateMessage(state.Message, resultMessageLimitBytes)
+	if state.SyncResult == nil {
+		return
+	}
+	for _, res := range state.SyncResult.Resources {
+		res.Message = truncateMessage(res.Message, resultMessageLimitBytes)
+	}
+	for _, hook := range state.SyncResult.Hooks {
+		hook.Message = truncateMessage(hook.Message, resultMessageLimitBytes)
+	}
+}
+
 // enforceDeletePolicy examines the hook deletion policy of a object and deletes it based on the status
 func enforceDeletePolicy(hook *unstructured.Unstructured, phase appv1.OperationPhase) bool {
+	if phase == appv1.OperationSucceeded {
+		return hasHookDeletePolicy(hook, appv1.HookDeletePolicyHookSucceeded)
+	}
+	if phase == appv1.OperationFailed {
+		return hasHookDeletePolicy(hook, appv1.HookDeletePolicyHookFailed)
+	}
+	return false
+}
+
+// hasHookDeletePolicy returns whether or not the hook has the given policy in its deletion policy
+// annotation
+func hasHookDeletePolicy(hook *unstructured.Unstructured, policy appv1.HookDeletePolicy) bool {
 	annotations := hook.GetAnnotations()
 	if annotations == nil {
 		return false
 	}
-	deletePolicies := strings.Split(annotations[common.AnnotationHookDeletePolicy], ",")
-	for _, dp := range deletePolicies {
-		policy := appv1.HookDeletePolicy(strings.TrimSpace(dp))
-		if policy == appv1.HookDeletePolicyHookSucceeded && phase == appv1.OperationSucceeded {
+	for _, dp := range strings.Split(annotations[common.AnnotationHookDeletePolicy], ",") {
+		if appv1.HookDeletePolicy(strings.TrimSpace(dp)) == policy {
 			return true
 		}
-		if policy == appv1.HookDeletePolicyHookFailed && phase == appv1.OperationFailed {
+	}
+	return false
+}
+
+// isPreviousHook returns whether or not the live hook resource was created before the operation
+// started, by the sync of a previous operation
+func isPreviousHook(liveObj *unstructured.Unstructured, operationStartedAt metav1.Time) bool {
+	if liveObj.GetDeletionTimestamp() != nil {
+		return true
+	}
+	created := liveObj.GetCreationTimestamp()
+	return created.Before(&operationStartedAt)
+}
+
+// hasSyncOption returns whether or not the object has the given sync option in its sync options
+// annotation
+func hasSyncOption(obj *unstructured.Unstructured, option string) bool {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		return false
+	}
+	for _, opt := range strings.Split(annotations[common.AnnotationSyncOptions], ",") {
+		if strings.TrimSpace(opt) == option {
 			return true
 		}
 	}
 	return false
 }
 
+// getSyncOptionValue returns the value of the sync option of the object given as NAME=VALUE in its
+// sync options annotation, and whether or not the option is set
+func getSyncOptionValue(obj *unstructured.Unstructured, name string) (string, bool) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		return "", false
+	}
+	for _, opt := range strings.Split(annotations[common.AnnotationSyncOptions], ",") {
+		parts := strings.SplitN(strings.TrimSpace(opt), "=", 2)
+		if len(parts) == 2 && parts[0] == name {
+			return parts[1], true
+		}
+	}
+	return "", false
+}
+
 // isHookType tells whether or not the supplied object is a hook of the specified type
 func isHookType(hook *unstructured.Unstructured, hookType appv1.HookType) bool {
 	annotations := hook.GetAnnotations()
@@ -616,8 +1247,8 @@ func isHookType(hook *unstructured.Unstructured, hookType appv1.HookType) bool {
 	return false
 }
 
-// isHook indicates if the object is either a ArgoCD or Helm hook
-func isHook(obj *unstructured.Unstructured) bool {
+// IsHook indicates if the object is either a ArgoCD or Helm hook
+func IsHook(obj *unstructured.Unstructured) bool {
 	return isArgoHook(obj) || isHelmHook(obj)
 }
 
@@ -642,7 +1273,7 @@ func isArgoHook(obj *unstructured.Unstructured) bool {
 	for _, hookType := range resHookTypes {
 		hookType = strings.TrimSpace(hookType)
 		switch appv1.HookType(hookType) {
-		case appv1.HookTypePreSync, appv1.HookTypeSync, appv1.HookTypePostSync:
+		case appv1.HookTypePreSync, appv1.HookTypeSync, appv1.HookTypePostSync, appv1.HookTypeSyncFail:
 			return true
 		}
 	}
@@ -706,6 +1337,7 @@ func newHookStatus(hook *unstructured.Unstructured, hookType appv1.HookType) app
 		Kind:       hook.GetKind(),
 		APIVersion: hook.GetAPIVersion(),
 		Type:       hookType,
+		Namespace:  hook.GetNamespace(),
 	}
 	switch hookStatus.Kind {
 	case "Job":
@@ -771,6 +1403,7 @@ func newHookStatus(hook *unstructured.Unstructured, hookType appv1.HookType) app
 func (sc *syncContext) updateHookStatus(hookStatus appv1.HookStatus) bool {
 	sc.lock.Lock()
 	defer sc.lock.Unlock()
+	hookStatus.Message = truncateMessage(hookStatus.Message, resultMessageLimitBytes)
 	for i, prev := range sc.syncRes.Hooks {
 		if prev.Name == hookStatus.Name && prev.Kind == hookStatus.Kind && prev.Type == hookStatus.Type {
 			if reflect.DeepEqual(prev, hookStatus) {
@@ -818,7 +1451,11 @@ func (sc *syncContext) terminate() {
 		switch hookStatus.Kind {
 		case "Job", "Workflow":
 			hookStatus.Status = appv1.OperationFailed
-			err := sc.deleteHook(hookStatus.Name, hookStatus.Kind, hookStatus.APIVersion)
+			namespace := hookStatus.Namespace
+			if namespace == "" {
+				namespace = sc.namespace
+			}
+			err := sc.deleteHook(namespace, hookStatus.Name, hookStatus.Kind, hookStatus.APIVersion)
 			if err != nil {
 				hookStatus.Message = fmt.Sprintf("Failed to delete %s hook %s/%s: %v", hookStatus.Type, hookStatus.Kind, hookStatus.Name, err)
 				terminateSuccessful = false
@@ -835,16 +1472,12 @@ func (sc *syncContext) terminate() {
 	}
 }
 
-func (sc *syncContext) deleteHook(name, kind, apiVersion string) error {
-	groupVersion := strings.Split(apiVersion, "/")
-	if len(groupVersion) != 2 {
-		return fmt.Errorf("Failed to terminate app. Unrecognized group/version: %s", apiVersion)
-	}
-	gvk := schema.GroupVersionKind{
-		Group:   groupVersion[0],
-		Version: groupVersion[1],
-		Kind:    kind,
+func (sc *syncContext) deleteHook(namespace, name, kind, apiVersion string) error {
+	groupVersion, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return fmt.Errorf("Failed to delete hook. Unrecognized group/version: %s", apiVersion)
 	}
+	gvk := groupVersion.WithKind(kind)
 	dclient, err := sc.dynClientPool.ClientForGroupVersionKind(gvk)
 	if err != nil {
 		return err
@@ -853,6 +1486,8 @@ func (sc *syncContext) deleteHook(name, kind, apiVersion string) error {
 	if err != nil {
 		return err
 	}
-	resIf := dclient.Resource(apiResource, sc.namespace)
-	return resIf.Delete(name, &metav1.DeleteOptions{})
+	resIf := dclient.Resource(apiResource, namespace)
+	// the pods of Jobs are orphaned by default, so dependents are explicitly deleted
+	propagationPolicy := metav1.DeletePropagationBackground
+	return resIf.Delete(name, &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 }
@@ -1,11 +1,24 @@
 package controller
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/rest"
+	kubetesting "k8s.io/client-go/testing"
 )
 
 func newTestSyncCtx() *syncContext {
@@ -14,6 +27,7 @@ func newTestSyncCtx() *syncContext {
 		config:     &rest.Config{},
 		namespace:  "test-namespace",
 		syncOp:     &v1alpha1.SyncOperation{},
+		syncRes:    &v1alpha1.SyncOperationResult{},
 		opState:    &v1alpha1.OperationState{},
 		log:        log.WithFields(log.Fields{"application": "fake-app"}),
 	}
@@ -24,3 +38,411 @@ func TestRunWorkflows(t *testing.T) {
 	// syncCtx.doWorkflowSync(nil, nil)
 
 }
+
+func TestTruncateHookOutput(t *testing.T) {
+	assert.Equal(t, "hello", truncateHookOutput("hello", 10))
+	assert.Equal(t, "...(truncated)\nworld", truncateHookOutput("hello world", 5))
+	// never split a multi-byte character
+	assert.Equal(t, "...(truncated)\nb", truncateHookOutput("aéb", 2))
+}
+
+func TestGetHookNamespace(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	assert.Equal(t, "test-namespace", syncCtx.getHookNamespace())
+
+	syncCtx.syncOp.SyncStrategy = &v1alpha1.SyncStrategy{Hook: &v1alpha1.SyncStrategyHook{Namespace: "hooks"}}
+	assert.Equal(t, "hooks", syncCtx.getHookNamespace())
+}
+
+func newTestHook(name string, hookTypes string) *unstructured.Unstructured {
+	hook := &unstructured.Unstructured{}
+	hook.SetAPIVersion("batch/v1")
+	hook.SetKind("Job")
+	hook.SetName(name)
+	hook.SetAnnotations(map[string]string{common.AnnotationHook: hookTypes})
+	return hook
+}
+
+func TestIsArgoHookSyncFail(t *testing.T) {
+	assert.True(t, isArgoHook(newTestHook("cleanup", "SyncFail")))
+	assert.True(t, isHookType(newTestHook("cleanup", "PostSync, SyncFail"), v1alpha1.HookTypeSyncFail))
+}
+
+func TestSyncFailureMessage(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	assert.Equal(t, "one or more objects failed to apply", syncCtx.syncFailureMessage())
+
+	syncCtx.syncRes.Hooks = []*v1alpha1.HookStatus{{Name: "migrate", Kind: "Job", Type: v1alpha1.HookTypePreSync, Status: v1alpha1.OperationFailed}}
+	assert.Equal(t, "PreSync hook failed", syncCtx.syncFailureMessage())
+}
+
+// withTestJobs serves the Job hooks of the sync context from a fake dynamic client, which returns
+// the given jobs by name
+func withTestJobs(syncCtx *syncContext, jobs ...*unstructured.Unstructured) {
+	syncCtx.disco = &fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{Resources: []*metav1.APIResourceList{{
+		GroupVersion: "batch/v1",
+		APIResources: []metav1.APIResource{{Name: "jobs", Namespaced: true, Kind: "Job"}},
+	}}}}
+	pool := &fakedynamic.FakeClientPool{}
+	pool.AddReactor("get", "jobs", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		name := action.(kubetesting.GetAction).GetName()
+		for _, job := range jobs {
+			if job.GetName() == name {
+				return true, job.DeepCopy(), nil
+			}
+		}
+		return true, nil, apierr.NewNotFound(schema.GroupResource{Group: "batch", Resource: "jobs"}, name)
+	})
+	pool.AddReactor("delete", "jobs", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		return true, nil, nil
+	})
+	syncCtx.dynClientPool = pool
+}
+
+func newTestJob(name string, hookTypes string, createdAt time.Time, conditionType string) *unstructured.Unstructured {
+	job := newTestHook(name, hookTypes)
+	job.SetCreationTimestamp(metav1.NewTime(createdAt))
+	job.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{map[string]interface{}{"type": conditionType, "status": "True"}},
+	}
+	return job
+}
+
+func TestRunHookAfterRetry(t *testing.T) {
+	startedAt := time.Date(2018, 6, 1, 2, 0, 0, 0, time.UTC)
+	hook := newTestHook("migrate", "PreSync")
+	hook.SetAnnotations(map[string]string{
+		common.AnnotationHook:             "PreSync",
+		common.AnnotationHookDeletePolicy: string(v1alpha1.HookDeletePolicyBeforeHookCreation),
+	})
+	// the hook failed during the first attempt
+	job := newTestJob("migrate", "PreSync", startedAt, "Failed")
+	opState := &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}, Retry: &v1alpha1.RetryStrategy{Limit: 1}},
+		Phase:     v1alpha1.OperationFailed,
+		StartedAt: metav1.NewTime(startedAt),
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "abcdef0123456789", Hooks: []*v1alpha1.HookStatus{
+			{Name: "migrate", Kind: "Job", Type: v1alpha1.HookTypePreSync, Status: v1alpha1.OperationFailed},
+		}},
+	}
+	_, retried := retryOperation(opState, metav1.NewTime(startedAt.Add(time.Minute)))
+	assert.True(t, retried)
+
+	syncCtx := newTestSyncCtx()
+	syncCtx.opState = opState
+	syncCtx.syncRes = opState.SyncResult
+	withTestJobs(syncCtx, job)
+
+	// the failed hook of the first attempt is deleted before the hook is created again
+	changed, err := syncCtx.runHook(hook, v1alpha1.HookTypePreSync)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Nil(t, syncCtx.getHookStatus(hook, v1alpha1.HookTypePreSync))
+
+	// the hook created by the retry succeeds
+	*job = *newTestJob("migrate", "PreSync", opState.StartedAt.Add(time.Second), "Complete")
+	_, err = syncCtx.runHook(hook, v1alpha1.HookTypePreSync)
+	assert.NoError(t, err)
+	status := syncCtx.getHookStatus(hook, v1alpha1.HookTypePreSync)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, v1alpha1.OperationSucceeded, status.Status)
+	}
+}
+
+func TestRunSyncFailHooks(t *testing.T) {
+	// without SyncFail hooks, the failure is left as is
+	syncCtx := newTestSyncCtx()
+	syncCtx.setOperationPhase(v1alpha1.OperationFailed, "one or more objects failed to apply")
+	syncCtx.runSyncFailHooks([]*unstructured.Unstructured{newTestHook("migrate", "PreSync")})
+	assert.False(t, syncCtx.startedSyncFailPhase())
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+
+	// the sync failed in the PreSync phase, so the operation keeps running while the SyncFail hooks run
+	syncCtx = newTestSyncCtx()
+	syncCtx.syncRes.Hooks = []*v1alpha1.HookStatus{
+		{Name: "migrate", Kind: "Job", Type: v1alpha1.HookTypePreSync, Status: v1alpha1.OperationFailed},
+	}
+	syncCtx.setOperationPhase(v1alpha1.OperationFailed, "PreSync hook failed")
+	withTestJobs(syncCtx, newTestHook("cleanup", "SyncFail"))
+	hooks := []*unstructured.Unstructured{newTestHook("migrate", "PreSync"), newTestHook("cleanup", "SyncFail")}
+	syncCtx.runSyncFailHooks(hooks)
+	assert.True(t, syncCtx.startedSyncFailPhase())
+	assert.Equal(t, v1alpha1.OperationRunning, syncCtx.opState.Phase)
+	assert.Equal(t, "PreSync hook failed, running SyncFail hooks", syncCtx.opState.Message)
+
+	// once the SyncFail hooks completed, the operation fails with the reason of the sync failure
+	syncCtx.syncRes.Hooks[1].Status = v1alpha1.OperationSucceeded
+	syncCtx.runSyncFailHooks(hooks)
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Equal(t, "PreSync hook failed", syncCtx.opState.Message)
+
+	// failing SyncFail hooks are reported in addition to the sync failure
+	syncCtx.syncRes.Hooks[1].Status = v1alpha1.OperationFailed
+	syncCtx.runSyncFailHooks(hooks)
+	assert.Equal(t, v1alpha1.OperationFailed, syncCtx.opState.Phase)
+	assert.Equal(t, "PreSync hook failed, SyncFail hook failed", syncCtx.opState.Message)
+}
+
+func TestGenerateSyncTasksSelective(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.comparison.Resources = []v1alpha1.ResourceState{
+		{TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook-ui"}}`, LiveState: "null"},
+		{TargetState: `{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui"}}`, LiveState: "null"},
+		{TargetState: "null", LiveState: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"obsolete"}}`},
+	}
+	tasks, successful := syncCtx.generateSyncTasks()
+	assert.True(t, successful)
+	assert.Len(t, tasks, 3)
+
+	syncCtx.syncOp.Resources = []v1alpha1.SyncOperationResource{
+		{Group: "apps", Kind: "Deployment", Name: "guestbook-ui"},
+		{Kind: "ConfigMap", Name: "obsolete"},
+	}
+	tasks, successful = syncCtx.generateSyncTasks()
+	assert.True(t, successful)
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, "Deployment", tasks[0].targetObj.GetKind())
+	assert.Nil(t, tasks[1].targetObj)
+	assert.Equal(t, "obsolete", tasks[1].liveObj.GetName())
+}
+
+func TestHasSyncOption(t *testing.T) {
+	obj := newTestHook("migrate", "PreSync")
+	assert.False(t, hasSyncOption(obj, syncOptionPruneFalse))
+
+	obj.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "Validate=false, Prune=false"})
+	assert.True(t, hasSyncOption(obj, syncOptionValidateFalse))
+	assert.True(t, hasSyncOption(obj, syncOptionPruneFalse))
+	assert.False(t, hasSyncOption(obj, syncOptionReplaceTrue))
+}
+
+func TestPruneObjectSyncOption(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	liveObj := &unstructured.Unstructured{}
+	liveObj.SetAPIVersion("v1")
+	liveObj.SetKind("ConfigMap")
+	liveObj.SetName("obsolete")
+	liveObj.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "Prune=false"})
+
+	resDetails := syncCtx.pruneObject(liveObj, true, false)
+	assert.Equal(t, v1alpha1.ResourceDetailsPruningRequired, resDetails.Status)
+	assert.Equal(t, "ignored (pruning disabled by sync option)", resDetails.Message)
+}
+
+func TestEnforceDeletePolicy(t *testing.T) {
+	hook := newTestHook("migrate", "PreSync")
+	assert.False(t, enforceDeletePolicy(hook, v1alpha1.OperationSucceeded))
+
+	hook.SetAnnotations(map[string]string{common.AnnotationHookDeletePolicy: "BeforeHookCreation, HookSucceeded"})
+	assert.True(t, enforceDeletePolicy(hook, v1alpha1.OperationSucceeded))
+	assert.False(t, enforceDeletePolicy(hook, v1alpha1.OperationFailed))
+	assert.True(t, hasHookDeletePolicy(hook, v1alpha1.HookDeletePolicyBeforeHookCreation))
+	assert.False(t, hasHookDeletePolicy(hook, v1alpha1.HookDeletePolicyHookFailed))
+}
+
+func TestIsPreviousHook(t *testing.T) {
+	startedAt := metav1.Now()
+	hook := newTestHook("migrate", "PreSync")
+	hook.SetCreationTimestamp(metav1.NewTime(startedAt.Add(-time.Minute)))
+	assert.True(t, isPreviousHook(hook, startedAt))
+
+	hook.SetCreationTimestamp(metav1.NewTime(startedAt.Add(time.Second)))
+	assert.False(t, isPreviousHook(hook, startedAt))
+
+	deletedAt := metav1.NewTime(startedAt.Add(time.Minute))
+	hook.SetDeletionTimestamp(&deletedAt)
+	assert.True(t, isPreviousHook(hook, startedAt))
+}
+
+func TestGetPrunePropagationPolicy(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	liveObj := &unstructured.Unstructured{}
+	liveObj.SetKind("StatefulSet")
+	liveObj.SetName("db")
+
+	policy, err := syncCtx.getPrunePropagationPolicy(liveObj)
+	assert.Nil(t, err)
+	assert.Equal(t, metav1.DeletePropagationForeground, policy)
+
+	syncCtx.prunePropagationPolicy = metav1.DeletePropagationBackground
+	policy, err = syncCtx.getPrunePropagationPolicy(liveObj)
+	assert.Nil(t, err)
+	assert.Equal(t, metav1.DeletePropagationBackground, policy)
+
+	// the sync option of the resource overrides the policy of the application
+	liveObj.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "Validate=false,PrunePropagationPolicy=orphan"})
+	policy, err = syncCtx.getPrunePropagationPolicy(liveObj)
+	assert.Nil(t, err)
+	assert.Equal(t, metav1.DeletePropagationOrphan, policy)
+
+	liveObj.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "PrunePropagationPolicy=later"})
+	resDetails := syncCtx.pruneObject(liveObj, true, true)
+	assert.Equal(t, v1alpha1.ResourceDetailsSyncFailed, resDetails.Status)
+	assert.Contains(t, resDetails.Message, "invalid prune propagation policy 'later'")
+}
+
+func TestIsServerSideApply(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	obj := newTestHook("migrate", "PreSync")
+	assert.False(t, syncCtx.isServerSideApply(obj))
+
+	syncCtx.serverSideApply = true
+	assert.True(t, syncCtx.isServerSideApply(obj))
+
+	// the sync option of the resource overrides the setting of the application
+	obj.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "ServerSideApply=false"})
+	assert.False(t, syncCtx.isServerSideApply(obj))
+
+	syncCtx.serverSideApply = false
+	obj.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "ServerSideApply=true"})
+	assert.True(t, syncCtx.isServerSideApply(obj))
+}
+
+func TestSyncManagedNamespaceInManifests(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	// nothing is applied without managed namespace metadata
+	assert.True(t, syncCtx.syncManagedNamespace(nil))
+
+	namespace := &unstructured.Unstructured{}
+	namespace.SetAPIVersion("v1")
+	namespace.SetKind("Namespace")
+	namespace.SetName("test-namespace")
+	syncCtx.managedNamespaceMetadata = &v1alpha1.ManagedNamespaceMetadata{Labels: map[string]string{"istio-injection": "enabled"}}
+	// the namespace of the manifests is synced as any other resource
+	assert.True(t, syncCtx.syncManagedNamespace([]syncTask{{targetObj: namespace}}))
+	assert.Empty(t, syncCtx.opState.Phase)
+}
+
+func TestTruncateMessage(t *testing.T) {
+	assert.Equal(t, "hello", truncateMessage("hello", 10))
+	assert.Equal(t, "hello...(truncated)", truncateMessage("hello world", 5))
+	// never split a multi-byte character
+	assert.Equal(t, "a...(truncated)", truncateMessage("aéb", 2))
+}
+
+func TestCompactOperationState(t *testing.T) {
+	long := strings.Repeat("x", resultMessageLimitBytes+1)
+	state := &v1alpha1.OperationState{
+		Message: long,
+		SyncResult: &v1alpha1.SyncOperationResult{
+			Resources: []*v1alpha1.ResourceDetails{{Message: long}},
+			Hooks:     []*v1alpha1.HookStatus{{Message: "ok"}},
+		},
+	}
+	compactOperationState(state)
+	assert.Len(t, state.Message, resultMessageLimitBytes+len("...(truncated)"))
+	assert.Len(t, state.SyncResult.Resources[0].Message, resultMessageLimitBytes+len("...(truncated)"))
+	assert.Equal(t, "ok", state.SyncResult.Hooks[0].Message)
+
+	// operations without sync result are compacted as well
+	compactOperationState(&v1alpha1.OperationState{Message: long})
+}
+
+func TestDoApplyTasksParallelism(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.syncOp.Prune = true
+	syncCtx.syncParallelism = 2
+	var syncTasks []syncTask
+	for i := 0; i < 50; i++ {
+		liveObj := &unstructured.Unstructured{}
+		liveObj.SetAPIVersion("v1")
+		liveObj.SetKind("ConfigMap")
+		liveObj.SetName(fmt.Sprintf("obsolete-%d", i))
+		syncTasks = append(syncTasks, syncTask{liveObj: liveObj})
+	}
+
+	assert.True(t, syncCtx.doApplyTasks(syncTasks, true, false, true))
+	assert.Len(t, syncCtx.syncRes.Resources, 50)
+	for _, res := range syncCtx.syncRes.Resources {
+		assert.Equal(t, v1alpha1.ResourceDetailsSyncedAndPruned, res.Status)
+	}
+}
+
+func TestSkipDryRunOfMissingResources(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.disco = &fakediscovery.FakeDiscovery{Fake: &kubetesting.Fake{Resources: []*metav1.APIResourceList{{
+		GroupVersion: "argoproj.io/v1alpha1",
+		APIResources: []metav1.APIResource{{Name: "applications", Namespaced: true, Kind: "Application"}},
+	}}}}
+	crd := &unstructured.Unstructured{}
+	crd.SetAPIVersion("apiextensions.k8s.io/v1beta1")
+	crd.SetKind("CustomResourceDefinition")
+	crd.SetName("rollouts.argoproj.io")
+	_ = unstructured.SetNestedField(crd.Object, "argoproj.io", "spec", "group")
+	_ = unstructured.SetNestedField(crd.Object, "Rollout", "spec", "names", "kind")
+	newObj := func(kind, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("argoproj.io/v1alpha1")
+		obj.SetKind(kind)
+		obj.SetName(name)
+		return obj
+	}
+	workflow := newObj("Workflow", "build")
+	tasks := []syncTask{{targetObj: crd}, {targetObj: newObj("Rollout", "guestbook")}, {targetObj: workflow}, {targetObj: newObj("Application", "guestbook")}}
+
+	// the dry run of the rollout is skipped, since its CRD is part of the sync
+	remaining := syncCtx.skipDryRunOfMissingResources(tasks, getCRDGroupKinds(tasks), true)
+	assert.Len(t, remaining, 3)
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "guestbook", syncCtx.syncRes.Resources[0].Name)
+	assert.Equal(t, v1alpha1.ResourceDetailsSynced, syncCtx.syncRes.Resources[0].Status)
+
+	// the dry run of resources with the sync option is skipped if their type is missing
+	workflow.SetAnnotations(map[string]string{common.AnnotationSyncOptions: "SkipDryRunOnMissingResource=true"})
+	remaining = syncCtx.skipDryRunOfMissingResources(tasks, getCRDGroupKinds(tasks), false)
+	assert.Len(t, remaining, 2)
+	assert.Equal(t, "Application", remaining[1].targetObj.GetKind())
+}
+
+func TestSkipSyncedResources(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	newObj := func(name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		obj.SetName(name)
+		return obj
+	}
+	tasks := []syncTask{
+		{targetObj: newObj("synced"), liveObj: newObj("synced"), syncStatus: v1alpha1.ComparisonStatusSynced},
+		{targetObj: newObj("modified"), liveObj: newObj("modified"), syncStatus: v1alpha1.ComparisonStatusOutOfSync},
+		{targetObj: newObj("missing"), syncStatus: v1alpha1.ComparisonStatusOutOfSync},
+		{liveObj: newObj("obsolete"), syncStatus: v1alpha1.ComparisonStatusOutOfSync},
+	}
+
+	remaining := syncCtx.skipSyncedResources(tasks, false)
+	assert.Len(t, remaining, 3)
+	assert.Len(t, syncCtx.syncRes.Resources, 0)
+
+	remaining = syncCtx.skipSyncedResources(tasks, true)
+	assert.Len(t, remaining, 3)
+	assert.Equal(t, "modified", remaining[0].targetObj.GetName())
+	assert.Len(t, syncCtx.syncRes.Resources, 1)
+	assert.Equal(t, "synced", syncCtx.syncRes.Resources[0].Name)
+	assert.Equal(t, v1alpha1.ResourceDetailsSynced, syncCtx.syncRes.Resources[0].Status)
+}
+
+func TestGetSharedResourceMessage(t *testing.T) {
+	syncCtx := newTestSyncCtx()
+	syncCtx.appName = "guestbook"
+	newObj := func(name, appName string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		obj.SetName(name)
+		if appName != "" {
+			obj.SetLabels(map[string]string{common.LabelApplicationName: appName})
+		}
+		return obj
+	}
+	tasks := []syncTask{
+		{targetObj: newObj("owned", "guestbook"), liveObj: newObj("owned", "guestbook")},
+		{targetObj: newObj("unmanaged", "guestbook"), liveObj: newObj("unmanaged", "")},
+		{targetObj: newObj("missing", "guestbook")},
+	}
+	assert.Equal(t, "", syncCtx.getSharedResourceMessage(tasks))
+
+	tasks = append(tasks, syncTask{targetObj: newObj("shared", "guestbook"), liveObj: newObj("shared", "other")})
+	assert.Equal(t, "resources are already managed by other applications: ConfigMap/shared (application 'other')", syncCtx.getSharedResourceMessage(tasks))
+}
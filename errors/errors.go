@@ -1,12 +1,51 @@
 package errors
 
 import (
+	"os"
+
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Exit codes of the CLI, which allow scripts to tell apart the reasons of a failure
+const (
+	// ExitCodeGeneric is used for failures without a more specific exit code
+	ExitCodeGeneric = 1
+	// ExitCodeAuthFailure is used when the user is not logged in, or is not permitted to perform the action
+	ExitCodeAuthFailure = 10
+	// ExitCodeValidationFailure is used when a request was rejected as invalid
+	ExitCodeValidationFailure = 11
+	// ExitCodeSyncFailure is used when a sync operation did not succeed
+	ExitCodeSyncFailure = 12
+	// ExitCodeTimeout is used when a command timed out waiting for an operation or a desired state
+	ExitCodeTimeout = 13
+	// ExitCodeDiffFound is used when `argocd app diff` found differences between the live and target state
+	ExitCodeDiffFound = 14
 )
 
 // CheckError is a convenience function to exit if an error is non-nil and exit if it was
 func CheckError(err error) {
 	if err != nil {
-		log.Fatal(err)
+		Fatal(ExitCode(err), err)
+	}
+}
+
+// Fatal logs the arguments and exits with the given exit code
+func Fatal(exitCode int, args ...interface{}) {
+	log.Error(args...)
+	os.Exit(exitCode)
+}
+
+// ExitCode returns the exit code for the error, based on its gRPC status code
+func ExitCode(err error) int {
+	switch status.Code(err) {
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return ExitCodeAuthFailure
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return ExitCodeValidationFailure
+	case codes.DeadlineExceeded:
+		return ExitCodeTimeout
 	}
+	return ExitCodeGeneric
 }
@@ -0,0 +1,19 @@
+package errors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, ExitCodeAuthFailure, ExitCode(status.Errorf(codes.Unauthenticated, "invalid session")))
+	assert.Equal(t, ExitCodeAuthFailure, ExitCode(status.Errorf(codes.PermissionDenied, "permission denied")))
+	assert.Equal(t, ExitCodeValidationFailure, ExitCode(status.Errorf(codes.InvalidArgument, "invalid spec")))
+	assert.Equal(t, ExitCodeTimeout, ExitCode(status.Errorf(codes.DeadlineExceeded, "timed out")))
+	assert.Equal(t, ExitCodeGeneric, ExitCode(status.Errorf(codes.NotFound, "not found")))
+	assert.Equal(t, ExitCodeGeneric, ExitCode(fmt.Errorf("failed")))
+}
@@ -99,7 +99,20 @@ collect_swagger() {
     "description": "Description of all APIs",
     "version": "version not set"
   },
-  "paths": {}
+  "paths": {},
+  "securityDefinitions": {
+    "bearer": {
+      "description": "Session token of the argocd.token cookie, or a project role token, prefixed with 'Bearer '",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "bearer": []
+    }
+  ]
 }
 EOF
 
@@ -117,6 +130,6 @@ clean_swagger() {
     /usr/bin/find "${SWAGGER_ROOT}" -name '*.swagger.json' -delete
 }
 
-collect_swagger server 15
+collect_swagger server 16
 clean_swagger server
 clean_swagger reposerver
@@ -25,6 +25,7 @@ import (
 	"github.com/argoproj/argo-cd/server/account"
 	"github.com/argoproj/argo-cd/server/application"
 	"github.com/argoproj/argo-cd/server/cluster"
+	"github.com/argoproj/argo-cd/server/guardrail"
 	"github.com/argoproj/argo-cd/server/project"
 	"github.com/argoproj/argo-cd/server/repository"
 	"github.com/argoproj/argo-cd/server/session"
@@ -62,6 +63,8 @@ type Client interface {
 	NewProjectClientOrDie() (*grpc.ClientConn, project.ProjectServiceClient)
 	NewAccountClient() (*grpc.ClientConn, account.AccountServiceClient, error)
 	NewAccountClientOrDie() (*grpc.ClientConn, account.AccountServiceClient)
+	NewGuardrailClient() (*grpc.ClientConn, guardrail.GuardrailServiceClient, error)
+	NewGuardrailClientOrDie() (*grpc.ClientConn, guardrail.GuardrailServiceClient)
 }
 
 // ClientOptions hold address, security, and other settings for the API client.
@@ -73,6 +76,8 @@ type ClientOptions struct {
 	AuthToken  string
 	ConfigPath string
 	Context    string
+	// GRPCWeb sends requests as gRPC-web over HTTP/1.1, rather than as gRPC over HTTP/2
+	GRPCWeb bool
 }
 
 type client struct {
@@ -82,6 +87,12 @@ type client struct {
 	CertPEMData  []byte
 	AuthToken    string
 	RefreshToken string
+	GRPCWeb      bool
+
+	// grpcWebProbed is set once it was detected whether the server is reachable over HTTP/2
+	grpcWebProbed bool
+	// grpcWebProxyAddr is the address of the local proxy forwarding calls as gRPC-web requests
+	grpcWebProxyAddr string
 }
 
 // NewClient creates a new API client from a set of config options.
@@ -149,6 +160,9 @@ func NewClient(opts *ClientOptions) (Client, error) {
 	if opts.Insecure {
 		c.Insecure = true
 	}
+	if opts.GRPCWeb {
+		c.GRPCWeb = true
+	}
 	if localCfg != nil {
 		err = c.refreshAuthToken(localCfg, ctxName, opts.ConfigPath)
 		if err != nil {
@@ -265,7 +279,43 @@ func (c jwtCredentials) GetRequestMetadata(context.Context, ...string) (map[stri
 	}, nil
 }
 
+// NewConn returns a connection to the server. If the server cannot be reached over HTTP/2, e.g.
+// because a proxy in between only supports HTTP/1.1, the connection falls back to gRPC-web.
 func (c *client) NewConn() (*grpc.ClientConn, error) {
+	if c.GRPCWeb {
+		return c.newGRPCWebConn()
+	}
+	conn, err := c.newGRPCConn()
+	if err != nil || c.grpcWebProbed {
+		return conn, err
+	}
+	c.grpcWebProbed = true
+	if !isHTTP2Unsupported(conn) {
+		return conn, nil
+	}
+	log.Debugf("Server %s is not reachable over HTTP/2, falling back to gRPC-web", c.ServerAddr)
+	_ = conn.Close()
+	c.GRPCWeb = true
+	return c.newGRPCWebConn()
+}
+
+// newGRPCWebConn returns a connection to the local proxy which forwards calls to the server as
+// gRPC-web requests
+func (c *client) newGRPCWebConn() (*grpc.ClientConn, error) {
+	if c.grpcWebProxyAddr == "" {
+		addr, err := c.startGRPCWebProxy()
+		if err != nil {
+			return nil, err
+		}
+		c.grpcWebProxyAddr = addr
+	}
+	endpointCredentials := jwtCredentials{
+		Token: c.AuthToken,
+	}
+	return grpc_util.BlockingDial(context.Background(), "tcp", c.grpcWebProxyAddr, nil, grpc.WithPerRPCCredentials(endpointCredentials))
+}
+
+func (c *client) newGRPCConn() (*grpc.ClientConn, error) {
 	var creds credentials.TransportCredentials
 	if !c.PlainText {
 		tlsConfig, err := c.tlsConfig()
@@ -300,6 +350,7 @@ func (c *client) ClientOptions() ClientOptions {
 		ServerAddr: c.ServerAddr,
 		PlainText:  c.PlainText,
 		Insecure:   c.Insecure,
+		GRPCWeb:    c.GRPCWeb,
 		AuthToken:  c.AuthToken,
 	}
 }
@@ -439,3 +490,20 @@ func (c *client) NewAccountClientOrDie() (*grpc.ClientConn, account.AccountServi
 	}
 	return conn, usrIf
 }
+
+func (c *client) NewGuardrailClient() (*grpc.ClientConn, guardrail.GuardrailServiceClient, error) {
+	conn, err := c.NewConn()
+	if err != nil {
+		return nil, nil, err
+	}
+	guardrailIf := guardrail.NewGuardrailServiceClient(conn)
+	return conn, guardrailIf, nil
+}
+
+func (c *client) NewGuardrailClientOrDie() (*grpc.ClientConn, guardrail.GuardrailServiceClient) {
+	conn, guardrailIf, err := c.NewGuardrailClient()
+	if err != nil {
+		log.Fatalf("Failed to establish connection to %s: %v", c.ServerAddr, err)
+	}
+	return conn, guardrailIf
+}
@@ -0,0 +1,177 @@
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+)
+
+const (
+	// grpcWebProbeTimeout is how long the probe call, which detects whether the server is reachable
+	// over HTTP/2, may take
+	grpcWebProbeTimeout = 10 * time.Second
+	// grpcWebProbeMethod is called to detect whether the server is reachable over HTTP/2. Any response
+	// of the server, including an authentication error, proves that it is.
+	grpcWebProbeMethod = "/version.VersionService/Version"
+)
+
+// rawCodec passes messages through without decoding them, so that the gRPC-web proxy does not need
+// to know the message types of the ArgoCD services
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	return *(v.(*[]byte)), nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	*(v.(*[]byte)) = data
+	return nil
+}
+
+func (rawCodec) String() string {
+	return "raw"
+}
+
+// isHTTP2Unsupported probes whether the server cannot be reached over HTTP/2 with the connection,
+// e.g. because a proxy in between only supports HTTP/1.1
+func isHTTP2Unsupported(conn *grpc.ClientConn) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), grpcWebProbeTimeout)
+	defer cancel()
+	var req, resp []byte
+	err := conn.Invoke(ctx, grpcWebProbeMethod, &req, &resp, grpc.CallCustomCodec(rawCodec{}))
+	if err == nil {
+		return false
+	}
+	code := status.Code(err)
+	return code == codes.Unavailable || code == codes.Internal
+}
+
+// startGRPCWebProxy starts a local gRPC server which forwards the calls it receives to the ArgoCD
+// server as gRPC-web requests over HTTP/1.1. Returns the address of the local server.
+func (c *client) startGRPCWebProxy() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	httpClient := &http.Client{Transport: &http.Transport{Proxy: http.ProxyFromEnvironment}}
+	scheme := "http"
+	if !c.PlainText {
+		tlsConfig, err := c.tlsConfig()
+		if err != nil {
+			return "", err
+		}
+		httpClient.Transport = &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+			// force HTTP/1.1, since HTTP/2 is what the proxy is working around
+			TLSNextProto: map[string]func(string, *tls.Conn) http.RoundTripper{},
+		}
+		scheme = "https"
+	}
+	server := grpc.NewServer(grpc.CustomCodec(rawCodec{}), grpc.UnknownServiceHandler(func(srv interface{}, stream grpc.ServerStream) error {
+		fullMethod, ok := grpc.MethodFromServerStream(stream)
+		if !ok {
+			return status.Errorf(codes.Internal, "unable to determine the called method")
+		}
+		var req []byte
+		if err := stream.RecvMsg(&req); err != nil {
+			return err
+		}
+		return forwardGRPCWebCall(stream, httpClient, fmt.Sprintf("%s://%s%s", scheme, c.ServerAddr, fullMethod), req)
+	}))
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Warnf("gRPC-web proxy stopped: %v", err)
+		}
+	}()
+	return listener.Addr().String(), nil
+}
+
+// forwardGRPCWebCall sends the request message as gRPC-web request, and forwards the messages and
+// status of the response to the stream
+func forwardGRPCWebCall(stream grpc.ServerStream, httpClient *http.Client, callURL string, req []byte) error {
+	var body bytes.Buffer
+	if err := grpc_util.WriteGRPCWebFrame(&body, 0, req); err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, callURL, &body)
+	if err != nil {
+		return err
+	}
+	httpReq = httpReq.WithContext(stream.Context())
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	for k, values := range md {
+		// skip pseudo and connection specific headers
+		if strings.HasPrefix(k, ":") || k == "content-type" || k == "user-agent" || k == "te" {
+			continue
+		}
+		for _, v := range values {
+			httpReq.Header.Add(k, v)
+		}
+	}
+	httpReq.Header.Set("Content-Type", grpc_util.GRPCWebContentType)
+	resp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "%v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return status.Errorf(codes.Unavailable, "%s: %s", callURL, resp.Status)
+	}
+	for {
+		flags, data, err := grpc_util.ReadGRPCWebFrame(resp.Body)
+		if err == io.EOF {
+			// trailers-only responses carry the status in the headers
+			return grpcWebStatus(resp.Header)
+		}
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "%v", err)
+		}
+		if flags&grpc_util.GRPCWebTrailerFlag != 0 {
+			trailers, err := grpc_util.ParseGRPCWebTrailers(data)
+			if err != nil {
+				return status.Errorf(codes.Internal, "invalid trailers: %v", err)
+			}
+			return grpcWebStatus(trailers)
+		}
+		if err := stream.SendMsg(&data); err != nil {
+			return err
+		}
+	}
+}
+
+// grpcWebStatus returns the error corresponding to the grpc-status and grpc-message headers
+func grpcWebStatus(header http.Header) error {
+	statusCode := header.Get("Grpc-Status")
+	if statusCode == "" {
+		return status.Errorf(codes.Internal, "response is missing the gRPC status")
+	}
+	code, err := strconv.Atoi(statusCode)
+	if err != nil {
+		return status.Errorf(codes.Internal, "invalid gRPC status '%s'", statusCode)
+	}
+	if codes.Code(code) == codes.OK {
+		return nil
+	}
+	message, err := url.PathUnescape(header.Get("Grpc-Message"))
+	if err != nil {
+		message = header.Get("Grpc-Message")
+	}
+	return status.Error(codes.Code(code), message)
+}
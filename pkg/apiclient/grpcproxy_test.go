@@ -0,0 +1,40 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+)
+
+func TestGRPCWebProxy(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("argocd", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	server := httptest.NewServer(grpc_util.NewGRPCWebHandler(grpcServer, http.NotFoundHandler()))
+	defer server.Close()
+
+	c := client{ServerAddr: strings.TrimPrefix(server.URL, "http://"), PlainText: true, GRPCWeb: true}
+	conn, err := c.NewConn()
+	assert.Nil(t, err)
+	defer func() { _ = conn.Close() }()
+	healthClient := healthpb.NewHealthClient(conn)
+
+	resp, err := healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "argocd"})
+	assert.Nil(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+
+	_, err = healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
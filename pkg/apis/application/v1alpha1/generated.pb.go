@@ -19,6 +19,7 @@
 		ApplicationSpec
 		ApplicationStatus
 		ApplicationWatchEvent
+		Backoff
 		Cluster
 		ClusterConfig
 		ClusterList
@@ -26,21 +27,32 @@
 		ComponentParameter
 		ConnectionState
 		DeploymentInfo
+		GuardrailState
 		HealthStatus
 		HookStatus
+		ManagedNamespaceMetadata
 		Operation
+		OperationInitiator
 		OperationState
+		OrphanedResourceKey
+		OrphanedResourcesMonitorSettings
 		Repository
 		RepositoryList
 		ResourceDetails
+		ResourceIgnoreDifferences
 		ResourceNode
 		ResourceState
+		RetryStrategy
 		RollbackOperation
 		SyncOperation
+		SyncOperationResource
 		SyncOperationResult
+		SyncPolicy
+		SyncPolicyAutomated
 		SyncStrategy
 		SyncStrategyApply
 		SyncStrategyHook
+		SyncWindow
 		TLSClientConfig
 */
 package v1alpha1
@@ -53,6 +65,8 @@ import k8s_io_apimachinery_pkg_apis_meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v
 
 import k8s_io_apimachinery_pkg_watch "k8s.io/apimachinery/pkg/watch"
 
+import sortkeys "github.com/gogo/protobuf/sortkeys"
+
 import strings "strings"
 import reflect "reflect"
 
@@ -113,97 +127,155 @@ func (m *ApplicationWatchEvent) Reset()                    { *m = ApplicationWat
 func (*ApplicationWatchEvent) ProtoMessage()               {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{10} }
 
+func (m *Backoff) Reset()                    { *m = Backoff{} }
+func (*Backoff) ProtoMessage()               {}
+func (*Backoff) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{11} }
+
 func (m *Cluster) Reset()                    { *m = Cluster{} }
 func (*Cluster) ProtoMessage()               {}
-func (*Cluster) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{11} }
+func (*Cluster) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{12} }
 
 func (m *ClusterConfig) Reset()                    { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage()               {}
-func (*ClusterConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{12} }
+func (*ClusterConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{13} }
 
 func (m *ClusterList) Reset()                    { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage()               {}
-func (*ClusterList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{13} }
+func (*ClusterList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{14} }
 
 func (m *ComparisonResult) Reset()                    { *m = ComparisonResult{} }
 func (*ComparisonResult) ProtoMessage()               {}
-func (*ComparisonResult) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{14} }
+func (*ComparisonResult) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{15} }
 
 func (m *ComponentParameter) Reset()                    { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage()               {}
-func (*ComponentParameter) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{15} }
+func (*ComponentParameter) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{16} }
 
 func (m *ConnectionState) Reset()                    { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage()               {}
-func (*ConnectionState) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{16} }
+func (*ConnectionState) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{17} }
 
 func (m *DeploymentInfo) Reset()                    { *m = DeploymentInfo{} }
 func (*DeploymentInfo) ProtoMessage()               {}
-func (*DeploymentInfo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{17} }
+func (*DeploymentInfo) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{18} }
+
+func (m *GuardrailState) Reset()                    { *m = GuardrailState{} }
+func (*GuardrailState) ProtoMessage()               {}
+func (*GuardrailState) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{19} }
 
 func (m *HealthStatus) Reset()                    { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage()               {}
-func (*HealthStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{18} }
+func (*HealthStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{20} }
 
 func (m *HookStatus) Reset()                    { *m = HookStatus{} }
 func (*HookStatus) ProtoMessage()               {}
-func (*HookStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{19} }
+func (*HookStatus) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{21} }
+
+func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
+func (*ManagedNamespaceMetadata) ProtoMessage() {}
+func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptorGenerated, []int{22}
+}
 
 func (m *Operation) Reset()                    { *m = Operation{} }
 func (*Operation) ProtoMessage()               {}
-func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{20} }
+func (*Operation) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{23} }
+
+func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
+func (*OperationInitiator) ProtoMessage() {}
+func (*OperationInitiator) Descriptor() ([]byte, []int) {
+	return fileDescriptorGenerated, []int{24}
+}
 
 func (m *OperationState) Reset()                    { *m = OperationState{} }
 func (*OperationState) ProtoMessage()               {}
-func (*OperationState) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{21} }
+func (*OperationState) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{25} }
+
+func (m *OrphanedResourceKey) Reset()                    { *m = OrphanedResourceKey{} }
+func (*OrphanedResourceKey) ProtoMessage()               {}
+func (*OrphanedResourceKey) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{26} }
+
+func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
+func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
+func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptorGenerated, []int{27}
+}
 
 func (m *Repository) Reset()                    { *m = Repository{} }
 func (*Repository) ProtoMessage()               {}
-func (*Repository) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{22} }
+func (*Repository) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{28} }
 
 func (m *RepositoryList) Reset()                    { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage()               {}
-func (*RepositoryList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{23} }
+func (*RepositoryList) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{29} }
 
 func (m *ResourceDetails) Reset()                    { *m = ResourceDetails{} }
 func (*ResourceDetails) ProtoMessage()               {}
-func (*ResourceDetails) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{24} }
+func (*ResourceDetails) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{30} }
+
+func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
+func (*ResourceIgnoreDifferences) ProtoMessage() {}
+func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
+	return fileDescriptorGenerated, []int{31}
+}
 
 func (m *ResourceNode) Reset()                    { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage()               {}
-func (*ResourceNode) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{25} }
+func (*ResourceNode) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{32} }
 
 func (m *ResourceState) Reset()                    { *m = ResourceState{} }
 func (*ResourceState) ProtoMessage()               {}
-func (*ResourceState) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{26} }
+func (*ResourceState) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{33} }
+
+func (m *RetryStrategy) Reset()                    { *m = RetryStrategy{} }
+func (*RetryStrategy) ProtoMessage()               {}
+func (*RetryStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{34} }
 
 func (m *RollbackOperation) Reset()                    { *m = RollbackOperation{} }
 func (*RollbackOperation) ProtoMessage()               {}
-func (*RollbackOperation) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{27} }
+func (*RollbackOperation) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{35} }
 
 func (m *SyncOperation) Reset()                    { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage()               {}
-func (*SyncOperation) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{28} }
+func (*SyncOperation) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{36} }
+
+func (m *SyncOperationResource) Reset()                    { *m = SyncOperationResource{} }
+func (*SyncOperationResource) ProtoMessage()               {}
+func (*SyncOperationResource) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{37} }
 
 func (m *SyncOperationResult) Reset()                    { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage()               {}
-func (*SyncOperationResult) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{29} }
+func (*SyncOperationResult) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{38} }
+
+func (m *SyncPolicy) Reset()                    { *m = SyncPolicy{} }
+func (*SyncPolicy) ProtoMessage()               {}
+func (*SyncPolicy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{39} }
+
+func (m *SyncPolicyAutomated) Reset()                    { *m = SyncPolicyAutomated{} }
+func (*SyncPolicyAutomated) ProtoMessage()               {}
+func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{40} }
 
 func (m *SyncStrategy) Reset()                    { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage()               {}
-func (*SyncStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{30} }
+func (*SyncStrategy) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{41} }
 
 func (m *SyncStrategyApply) Reset()                    { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage()               {}
-func (*SyncStrategyApply) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{31} }
+func (*SyncStrategyApply) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{42} }
 
 func (m *SyncStrategyHook) Reset()                    { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage()               {}
-func (*SyncStrategyHook) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{32} }
+func (*SyncStrategyHook) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{43} }
+
+func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
+func (*SyncWindow) ProtoMessage() {}
+func (*SyncWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptorGenerated, []int{44}
+}
 
 func (m *TLSClientConfig) Reset()                    { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage()               {}
-func (*TLSClientConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{33} }
+func (*TLSClientConfig) Descriptor() ([]byte, []int) { return fileDescriptorGenerated, []int{45} }
 
 func init() {
 	proto.RegisterType((*AppProject)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.AppProject")
@@ -217,6 +289,7 @@ func init() {
 	proto.RegisterType((*ApplicationSpec)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationSpec")
 	proto.RegisterType((*ApplicationStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationStatus")
 	proto.RegisterType((*ApplicationWatchEvent)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ApplicationWatchEvent")
+	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Backoff")
 	proto.RegisterType((*Cluster)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Cluster")
 	proto.RegisterType((*ClusterConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterConfig")
 	proto.RegisterType((*ClusterList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ClusterList")
@@ -224,21 +297,32 @@ func init() {
 	proto.RegisterType((*ComponentParameter)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ComponentParameter")
 	proto.RegisterType((*ConnectionState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ConnectionState")
 	proto.RegisterType((*DeploymentInfo)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.DeploymentInfo")
+	proto.RegisterType((*GuardrailState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.GuardrailState")
 	proto.RegisterType((*HealthStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HealthStatus")
 	proto.RegisterType((*HookStatus)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.HookStatus")
+	proto.RegisterType((*ManagedNamespaceMetadata)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ManagedNamespaceMetadata")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Operation")
+	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OperationState")
+	proto.RegisterType((*OrphanedResourceKey)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OrphanedResourceKey")
+	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
 	proto.RegisterType((*Repository)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.Repository")
 	proto.RegisterType((*RepositoryList)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RepositoryList")
 	proto.RegisterType((*ResourceDetails)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceDetails")
+	proto.RegisterType((*ResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceIgnoreDifferences")
 	proto.RegisterType((*ResourceNode)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceNode")
 	proto.RegisterType((*ResourceState)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.ResourceState")
+	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RetryStrategy")
 	proto.RegisterType((*RollbackOperation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.RollbackOperation")
 	proto.RegisterType((*SyncOperation)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperation")
+	proto.RegisterType((*SyncOperationResource)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResource")
 	proto.RegisterType((*SyncOperationResult)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncOperationResult")
+	proto.RegisterType((*SyncPolicy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncPolicy")
+	proto.RegisterType((*SyncPolicyAutomated)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncPolicyAutomated")
 	proto.RegisterType((*SyncStrategy)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategy")
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncStrategyHook")
+	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.SyncWindow")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.pkg.apis.application.v1alpha1.TLSClientConfig")
 }
 func (m *AppProject) Marshal() (dAtA []byte, err error) {
@@ -359,6 +443,73 @@ func (m *AppProjectSpec) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
 	i += copy(dAtA[i:], m.Description)
+	if len(m.SourceTools) > 0 {
+		for _, s := range m.SourceTools {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.OrphanedResources != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.OrphanedResources.Size()))
+		n4, err := m.OrphanedResources.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.SyncWindows) > 0 {
+		for _, msg := range m.SyncWindows {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.SignatureKeys) > 0 {
+		for _, s := range m.SignatureKeys {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.SecretReferences) > 0 {
+		for _, s := range m.SecretReferences {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -380,36 +531,36 @@ func (m *Application) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ObjectMeta.Size()))
-	n4, err := m.ObjectMeta.MarshalTo(dAtA[i:])
+	n5, err := m.ObjectMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Spec.Size()))
-	n5, err := m.Spec.MarshalTo(dAtA[i:])
+	n6, err := m.Spec.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Status.Size()))
-	n6, err := m.Status.MarshalTo(dAtA[i:])
+	n7, err := m.Status.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n7
 	if m.Operation != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Operation.Size()))
-		n7, err := m.Operation.MarshalTo(dAtA[i:])
+		n8, err := m.Operation.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	return i, nil
 }
@@ -437,6 +588,16 @@ func (m *ApplicationCondition) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i += copy(dAtA[i:], m.Message)
+	if m.LastTransitionTime != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.LastTransitionTime.Size()))
+		n46, err := m.LastTransitionTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
 	return i, nil
 }
 
@@ -484,11 +645,11 @@ func (m *ApplicationList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n8, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n9, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -583,23 +744,50 @@ func (m *ApplicationSpec) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
-	n9, err := m.Source.MarshalTo(dAtA[i:])
+	n10, err := m.Source.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Destination.Size()))
-	n10, err := m.Destination.MarshalTo(dAtA[i:])
+	n11, err := m.Destination.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n11
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Project)))
 	i += copy(dAtA[i:], m.Project)
+	if m.SyncPolicy != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncPolicy.Size()))
+		n12, err := m.SyncPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if len(m.IgnoreDifferences) > 0 {
+		for _, msg := range m.IgnoreDifferences {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.RevisionHistoryLimit != nil {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
+	}
 	return i, nil
 }
 
@@ -621,11 +809,11 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ComparisonResult.Size()))
-	n11, err := m.ComparisonResult.MarshalTo(dAtA[i:])
+	n13, err := m.ComparisonResult.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n13
 	if len(m.History) > 0 {
 		for _, msg := range m.History {
 			dAtA[i] = 0x12
@@ -653,20 +841,20 @@ func (m *ApplicationStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-	n12, err := m.Health.MarshalTo(dAtA[i:])
+	n14, err := m.Health.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n14
 	if m.OperationState != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.OperationState.Size()))
-		n13, err := m.OperationState.MarshalTo(dAtA[i:])
+		n15, err := m.OperationState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n15
 	}
 	if len(m.Conditions) > 0 {
 		for _, msg := range m.Conditions {
@@ -705,11 +893,42 @@ func (m *ApplicationWatchEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Application.Size()))
-	n14, err := m.Application.MarshalTo(dAtA[i:])
+	n16, err := m.Application.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n16
+	return i, nil
+}
+
+func (m *Backoff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Backoff) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i += copy(dAtA[i:], m.Duration)
+	if m.Factor != nil {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Factor))
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDuration)))
+	i += copy(dAtA[i:], m.MaxDuration)
 	return i, nil
 }
 
@@ -739,19 +958,19 @@ func (m *Cluster) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Config.Size()))
-	n15, err := m.Config.MarshalTo(dAtA[i:])
+	n17, err := m.Config.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n17
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionState.Size()))
-	n16, err := m.ConnectionState.MarshalTo(dAtA[i:])
+	n18, err := m.ConnectionState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n18
 	return i, nil
 }
 
@@ -785,11 +1004,11 @@ func (m *ClusterConfig) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.TLSClientConfig.Size()))
-	n17, err := m.TLSClientConfig.MarshalTo(dAtA[i:])
+	n19, err := m.TLSClientConfig.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n19
 	return i, nil
 }
 
@@ -811,11 +1030,11 @@ func (m *ClusterList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n18, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n20, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n20
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -849,19 +1068,19 @@ func (m *ComparisonResult) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ComparedAt.Size()))
-	n19, err := m.ComparedAt.MarshalTo(dAtA[i:])
+	n21, err := m.ComparedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n21
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ComparedTo.Size()))
-	n20, err := m.ComparedTo.MarshalTo(dAtA[i:])
+	n22, err := m.ComparedTo.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n22
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
@@ -878,6 +1097,10 @@ func (m *ComparisonResult) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
+	i += copy(dAtA[i:], m.Revision)
 	return i, nil
 }
 
@@ -938,11 +1161,11 @@ func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.ModifiedAt.Size()))
-		n21, err := m.ModifiedAt.MarshalTo(dAtA[i:])
+		n23, err := m.ModifiedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n23
 	}
 	return i, nil
 }
@@ -993,14 +1216,93 @@ func (m *DeploymentInfo) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.DeployedAt.Size()))
-	n22, err := m.DeployedAt.MarshalTo(dAtA[i:])
+	n24, err := m.DeployedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n24
 	dAtA[i] = 0x28
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ID))
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cause)))
+	i += copy(dAtA[i:], m.Cause)
+	if m.RollbackID != nil {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RollbackID))
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Source.Size()))
+	n48, err := m.Source.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n48
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.InitiatedBy.Size()))
+	n49, err := m.InitiatedBy.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n49
+	return i, nil
+}
+
+func (m *GuardrailState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardrailState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Component)))
+	i += copy(dAtA[i:], m.Component)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Level)))
+	i += copy(dAtA[i:], m.Level)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Value))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Unit)))
+	i += copy(dAtA[i:], m.Unit)
+	if m.ModifiedAt != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ModifiedAt.Size()))
+		n25, err := m.ModifiedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
 	return i, nil
 }
 
@@ -1027,6 +1329,16 @@ func (m *HealthStatus) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StatusDetails)))
 	i += copy(dAtA[i:], m.StatusDetails)
+	if m.ProgressingSince != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ProgressingSince.Size()))
+		n45, err := m.ProgressingSince.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
 	return i, nil
 }
 
@@ -1069,10 +1381,18 @@ func (m *HookStatus) MarshalTo(dAtA []byte) (int, error) {
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i += copy(dAtA[i:], m.Message)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Output)))
+	i += copy(dAtA[i:], m.Output)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
 	return i, nil
 }
 
-func (m *Operation) Marshal() (dAtA []byte, err error) {
+func (m *ManagedNamespaceMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1082,35 +1402,59 @@ func (m *Operation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+func (m *ManagedNamespaceMetadata) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Sync != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Sync.Size()))
-		n23, err := m.Sync.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		sortkeys.Strings(keysForLabels)
+		for _, k := range keysForLabels {
+			dAtA[i] = 0xa
+			i++
+			v := m.Labels[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
-		i += n23
 	}
-	if m.Rollback != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintGenerated(dAtA, i, uint64(m.Rollback.Size()))
-		n24, err := m.Rollback.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		sortkeys.Strings(keysForAnnotations)
+		for _, k := range keysForAnnotations {
+			dAtA[i] = 0x12
+			i++
+			v := m.Annotations[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
-		i += n24
 	}
 	return i, nil
 }
 
-func (m *OperationState) Marshal() (dAtA []byte, err error) {
+func (m *Operation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1120,20 +1464,106 @@ func (m *OperationState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintGenerated(dAtA, i, uint64(m.Operation.Size()))
-	n25, err := m.Operation.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.Sync != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Sync.Size()))
+		n26, err := m.Sync.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
 	}
-	i += n25
-	dAtA[i] = 0x12
+	if m.Rollback != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Rollback.Size()))
+		n27, err := m.Rollback.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.Retry != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Retry.Size()))
+		n28, err := m.Retry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.InitiatedBy.Size()))
+	n47, err := m.InitiatedBy.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n47
+	return i, nil
+}
+
+func (m *OperationInitiator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationInitiator) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i += copy(dAtA[i:], m.Username)
+	dAtA[i] = 0x10
+	i++
+	if m.Automated {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+
+func (m *OperationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Operation.Size()))
+	n29, err := m.Operation.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n29
+	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
 	i += copy(dAtA[i:], m.Phase)
@@ -1145,39 +1575,102 @@ func (m *OperationState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncResult.Size()))
-		n26, err := m.SyncResult.MarshalTo(dAtA[i:])
+		n30, err := m.SyncResult.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n30
 	}
 	if m.RollbackResult != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.RollbackResult.Size()))
-		n27, err := m.RollbackResult.MarshalTo(dAtA[i:])
+		n31, err := m.RollbackResult.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n31
 	}
 	dAtA[i] = 0x32
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.StartedAt.Size()))
-	n28, err := m.StartedAt.MarshalTo(dAtA[i:])
+	n32, err := m.StartedAt.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n32
 	if m.FinishedAt != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.FinishedAt.Size()))
-		n29, err := m.FinishedAt.MarshalTo(dAtA[i:])
+		n33, err := m.FinishedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n33
+	}
+	dAtA[i] = 0x40
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RetryCount))
+	return i, nil
+}
+
+func (m *OrphanedResourceKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourceKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	return i, nil
+}
+
+func (m *OrphanedResourcesMonitorSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrphanedResourcesMonitorSettings) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ignore) > 0 {
+		for _, msg := range m.Ignore {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
@@ -1216,11 +1709,59 @@ func (m *Repository) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ConnectionState.Size()))
-	n30, err := m.ConnectionState.MarshalTo(dAtA[i:])
+	n34, err := m.ConnectionState.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n30
+	i += n34
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		sortkeys.Strings(keysForLabels)
+		for _, k := range keysForLabels {
+			dAtA[i] = 0x32
+			i++
+			v := m.Labels[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		sortkeys.Strings(keysForAnnotations)
+		for _, k := range keysForAnnotations {
+			dAtA[i] = 0x3a
+			i++
+			v := m.Annotations[string(k)]
+			mapSize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			i = encodeVarintGenerated(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DefaultRevision)))
+	i += copy(dAtA[i:], m.DefaultRevision)
 	return i, nil
 }
 
@@ -1242,11 +1783,11 @@ func (m *RepositoryList) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ListMeta.Size()))
-	n31, err := m.ListMeta.MarshalTo(dAtA[i:])
+	n35, err := m.ListMeta.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n35
 	if len(m.Items) > 0 {
 		for _, msg := range m.Items {
 			dAtA[i] = 0x12
@@ -1300,6 +1841,55 @@ func (m *ResourceDetails) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ResourceIgnoreDifferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceIgnoreDifferences) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	if len(m.JSONPointers) > 0 {
+		for _, s := range m.JSONPointers {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
 func (m *ResourceNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1376,11 +1966,42 @@ func (m *ResourceState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Health.Size()))
-	n32, err := m.Health.MarshalTo(dAtA[i:])
+	n36, err := m.Health.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n36
+	return i, nil
+}
+
+func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	if m.Backoff != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Backoff.Size()))
+		n37, err := m.Backoff.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
 	return i, nil
 }
 
@@ -1460,12 +2081,57 @@ func (m *SyncOperation) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategy.Size()))
-		n33, err := m.SyncStrategy.MarshalTo(dAtA[i:])
+		n38, err := m.SyncStrategy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n38
+	}
+	if len(m.Resources) > 0 {
+		for _, msg := range m.Resources {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintGenerated(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SelfHealAttemptsCount))
+	return i, nil
+}
+
+func (m *SyncOperationResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncOperationResource) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Group)))
+	i += copy(dAtA[i:], m.Group)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i += copy(dAtA[i:], m.Name)
 	return i, nil
 }
 
@@ -1515,6 +2181,103 @@ func (m *SyncOperationResult) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SyncPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Automated != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Automated.Size()))
+		n39, err := m.Automated.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if m.Retry != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.Retry.Size()))
+		n40, err := m.Retry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if m.ManagedNamespaceMetadata != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintGenerated(dAtA, i, uint64(m.ManagedNamespaceMetadata.Size()))
+		n41, err := m.ManagedNamespaceMetadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if len(m.SyncOptions) > 0 {
+		for _, s := range m.SyncOptions {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *SyncPolicyAutomated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncPolicyAutomated) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	if m.Prune {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	dAtA[i] = 0x10
+	i++
+	if m.SelfHeal {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+
 func (m *SyncStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1534,21 +2297,21 @@ func (m *SyncStrategy) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Apply.Size()))
-		n34, err := m.Apply.MarshalTo(dAtA[i:])
+		n42, err := m.Apply.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n42
 	}
 	if m.Hook != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintGenerated(dAtA, i, uint64(m.Hook.Size()))
-		n35, err := m.Hook.MarshalTo(dAtA[i:])
+		n43, err := m.Hook.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n43
 	}
 	return i, nil
 }
@@ -1597,11 +2360,98 @@ func (m *SyncStrategyHook) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGenerated(dAtA, i, uint64(m.SyncStrategyApply.Size()))
-	n36, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
+	n44, err := m.SyncStrategyApply.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n36
+	i += n44
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i += copy(dAtA[i:], m.Namespace)
+	return i, nil
+}
+
+func (m *SyncWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindow) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i += copy(dAtA[i:], m.Kind)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i += copy(dAtA[i:], m.Schedule)
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i += copy(dAtA[i:], m.Duration)
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x38
+	i++
+	if m.ManualSync {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
 	return i, nil
 }
 
@@ -1703,6 +2553,34 @@ func (m *AppProjectSpec) Size() (n int) {
 	}
 	l = len(m.Description)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.SourceTools) > 0 {
+		for _, s := range m.SourceTools {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.OrphanedResources != nil {
+		l = m.OrphanedResources.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.SyncWindows) > 0 {
+		for _, e := range m.SyncWindows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.SignatureKeys) > 0 {
+		for _, s := range m.SignatureKeys {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.SecretReferences) > 0 {
+		for _, s := range m.SecretReferences {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1729,6 +2607,10 @@ func (m *ApplicationCondition) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1791,6 +2673,19 @@ func (m *ApplicationSpec) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Project)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.SyncPolicy != nil {
+		l = m.SyncPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.IgnoreDifferences) > 0 {
+		for _, e := range m.IgnoreDifferences {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RevisionHistoryLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
+	}
 	return n
 }
 
@@ -1836,6 +2731,19 @@ func (m *ApplicationWatchEvent) Size() (n int) {
 	return n
 }
 
+func (m *Backoff) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Factor != nil {
+		n += 1 + sovGenerated(uint64(*m.Factor))
+	}
+	l = len(m.MaxDuration)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Cluster) Size() (n int) {
 	var l int
 	_ = l
@@ -1893,6 +2801,8 @@ func (m *ComparisonResult) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.Revision)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -1942,6 +2852,37 @@ func (m *DeploymentInfo) Size() (n int) {
 	l = m.DeployedAt.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 1 + sovGenerated(uint64(m.ID))
+	l = len(m.Cause)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.RollbackID != nil {
+		n += 1 + sovGenerated(uint64(*m.RollbackID))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GuardrailState) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Component)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Level)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Value))
+	n += 1 + sovGenerated(uint64(m.Limit))
+	l = len(m.Unit)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ModifiedAt != nil {
+		l = m.ModifiedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1952,6 +2893,10 @@ func (m *HealthStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.StatusDetails)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.ProgressingSince != nil {
+		l = m.ProgressingSince.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1970,6 +2915,32 @@ func (m *HookStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Output)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ManagedNamespaceMetadata) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -1984,6 +2955,21 @@ func (m *Operation) Size() (n int) {
 		l = m.Rollback.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.InitiatedBy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OperationInitiator) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -2010,32 +2996,27 @@ func (m *OperationState) Size() (n int) {
 		l = m.FinishedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.RetryCount))
 	return n
 }
 
-func (m *Repository) Size() (n int) {
+func (m *OrphanedResourceKey) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Repo)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Username)
+	l = len(m.Group)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Password)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SSHPrivateKey)
+	l = len(m.Kind)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.ConnectionState.Size()
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *RepositoryList) Size() (n int) {
+func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	if len(m.Ignore) > 0 {
+		for _, e := range m.Ignore {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -2043,12 +3024,60 @@ func (m *RepositoryList) Size() (n int) {
 	return n
 }
 
-func (m *ResourceDetails) Size() (n int) {
+func (m *Repository) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Repo)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Password)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SSHPrivateKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ConnectionState.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.DefaultRevision)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RepositoryList) Size() (n int) {
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResourceDetails) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
@@ -2059,6 +3088,26 @@ func (m *ResourceDetails) Size() (n int) {
 	return n
 }
 
+func (m *ResourceIgnoreDifferences) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.JSONPointers) > 0 {
+		for _, s := range m.JSONPointers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ResourceNode) Size() (n int) {
 	var l int
 	_ = l
@@ -2093,6 +3142,17 @@ func (m *ResourceState) Size() (n int) {
 	return n
 }
 
+func (m *RetryStrategy) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Limit))
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *RollbackOperation) Size() (n int) {
 	var l int
 	_ = l
@@ -2113,6 +3173,25 @@ func (m *SyncOperation) Size() (n int) {
 		l = m.SyncStrategy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.SelfHealAttemptsCount))
+	return n
+}
+
+func (m *SyncOperationResource) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Group)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -2136,6 +3215,38 @@ func (m *SyncOperationResult) Size() (n int) {
 	return n
 }
 
+func (m *SyncPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.Automated != nil {
+		l = m.Automated.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ManagedNamespaceMetadata != nil {
+		l = m.ManagedNamespaceMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.SyncOptions) > 0 {
+		for _, s := range m.SyncOptions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncPolicyAutomated) Size() (n int) {
+	var l int
+	_ = l
+	n += 2
+	n += 2
+	return n
+}
+
 func (m *SyncStrategy) Size() (n int) {
 	var l int
 	_ = l
@@ -2162,6 +3273,39 @@ func (m *SyncStrategyHook) Size() (n int) {
 	_ = l
 	l = m.SyncStrategyApply.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncWindow) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
 	return n
 }
 
@@ -2229,6 +3373,11 @@ func (this *AppProjectSpec) String() string {
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Destinations), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`SourceTools:` + fmt.Sprintf("%v", this.SourceTools) + `,`,
+		`OrphanedResources:` + strings.Replace(fmt.Sprintf("%v", this.OrphanedResources), "OrphanedResourcesMonitorSettings", "OrphanedResourcesMonitorSettings", 1) + `,`,
+		`SyncWindows:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SyncWindows), "SyncWindow", "SyncWindow", 1), `&`, ``, 1) + `,`,
+		`SignatureKeys:` + fmt.Sprintf("%v", this.SignatureKeys) + `,`,
+		`SecretReferences:` + fmt.Sprintf("%v", this.SecretReferences) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2253,6 +3402,7 @@ func (this *ApplicationCondition) String() string {
 	s := strings.Join([]string{`&ApplicationCondition{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "k8s_io_apimachinery_pkg_apis_meta_v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2302,6 +3452,9 @@ func (this *ApplicationSpec) String() string {
 		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
 		`Project:` + fmt.Sprintf("%v", this.Project) + `,`,
+		`SyncPolicy:` + strings.Replace(fmt.Sprintf("%v", this.SyncPolicy), "SyncPolicy", "SyncPolicy", 1) + `,`,
+		`IgnoreDifferences:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.IgnoreDifferences), "ResourceIgnoreDifferences", "ResourceIgnoreDifferences", 1), `&`, ``, 1) + `,`,
+		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2332,6 +3485,18 @@ func (this *ApplicationWatchEvent) String() string {
 	}, "")
 	return s
 }
+func (this *Backoff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Backoff{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Factor:` + valueToStringGenerated(this.Factor) + `,`,
+		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Cluster) String() string {
 	if this == nil {
 		return "nil"
@@ -2378,6 +3543,7 @@ func (this *ComparisonResult) String() string {
 		`ComparedTo:` + strings.Replace(strings.Replace(this.ComparedTo.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceState", "ResourceState", 1), `&`, ``, 1) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2416,6 +3582,27 @@ func (this *DeploymentInfo) String() string {
 		`ComponentParameterOverrides:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ComponentParameterOverrides), "ComponentParameter", "ComponentParameter", 1), `&`, ``, 1) + `,`,
 		`DeployedAt:` + strings.Replace(strings.Replace(this.DeployedAt.String(), "Time", "k8s_io_apimachinery_pkg_apis_meta_v1.Time", 1), `&`, ``, 1) + `,`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`RollbackID:` + valueToStringGenerated(this.RollbackID) + `,`,
+		`Source:` + strings.Replace(strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GuardrailState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GuardrailState{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Component:` + fmt.Sprintf("%v", this.Component) + `,`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Unit:` + fmt.Sprintf("%v", this.Unit) + `,`,
+		`ModifiedAt:` + strings.Replace(fmt.Sprintf("%v", this.ModifiedAt), "Time", "k8s_io_apimachinery_pkg_apis_meta_v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2427,6 +3614,7 @@ func (this *HealthStatus) String() string {
 	s := strings.Join([]string{`&HealthStatus{`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`StatusDetails:` + fmt.Sprintf("%v", this.StatusDetails) + `,`,
+		`ProgressingSince:` + strings.Replace(fmt.Sprintf("%v", this.ProgressingSince), "Time", "k8s_io_apimachinery_pkg_apis_meta_v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2442,6 +3630,39 @@ func (this *HookStatus) String() string {
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Output:` + fmt.Sprintf("%v", this.Output) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ManagedNamespaceMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&ManagedNamespaceMetadata{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2453,6 +3674,19 @@ func (this *Operation) String() string {
 	s := strings.Join([]string{`&Operation{`,
 		`Sync:` + strings.Replace(fmt.Sprintf("%v", this.Sync), "SyncOperation", "SyncOperation", 1) + `,`,
 		`Rollback:` + strings.Replace(fmt.Sprintf("%v", this.Rollback), "RollbackOperation", "RollbackOperation", 1) + `,`,
+		`Retry:` + strings.Replace(fmt.Sprintf("%v", this.Retry), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`InitiatedBy:` + strings.Replace(strings.Replace(this.InitiatedBy.String(), "OperationInitiator", "OperationInitiator", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OperationInitiator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OperationInitiator{`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Automated:` + fmt.Sprintf("%v", this.Automated) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2469,6 +3703,29 @@ func (this *OperationState) String() string {
 		`RollbackResult:` + strings.Replace(fmt.Sprintf("%v", this.RollbackResult), "SyncOperationResult", "SyncOperationResult", 1) + `,`,
 		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Time", "k8s_io_apimachinery_pkg_apis_meta_v1.Time", 1), `&`, ``, 1) + `,`,
 		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "k8s_io_apimachinery_pkg_apis_meta_v1.Time", 1) + `,`,
+		`RetryCount:` + fmt.Sprintf("%v", this.RetryCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OrphanedResourceKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResourceKey{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OrphanedResourcesMonitorSettings) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OrphanedResourcesMonitorSettings{`,
+		`Ignore:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Ignore), "OrphanedResourceKey", "OrphanedResourceKey", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2477,12 +3734,35 @@ func (this *Repository) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
 	s := strings.Join([]string{`&Repository{`,
 		`Repo:` + fmt.Sprintf("%v", this.Repo) + `,`,
 		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
 		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
 		`SSHPrivateKey:` + fmt.Sprintf("%v", this.SSHPrivateKey) + `,`,
 		`ConnectionState:` + strings.Replace(strings.Replace(this.ConnectionState.String(), "ConnectionState", "ConnectionState", 1), `&`, ``, 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`DefaultRevision:` + fmt.Sprintf("%v", this.DefaultRevision) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2512,6 +3792,20 @@ func (this *ResourceDetails) String() string {
 	}, "")
 	return s
 }
+func (this *ResourceIgnoreDifferences) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResourceIgnoreDifferences{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`JSONPointers:` + fmt.Sprintf("%v", this.JSONPointers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceNode) String() string {
 	if this == nil {
 		return "nil"
@@ -2537,6 +3831,17 @@ func (this *ResourceState) String() string {
 	}, "")
 	return s
 }
+func (this *RetryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryStrategy{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Backoff:` + strings.Replace(fmt.Sprintf("%v", this.Backoff), "Backoff", "Backoff", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RollbackOperation) String() string {
 	if this == nil {
 		return "nil"
@@ -2558,6 +3863,20 @@ func (this *SyncOperation) String() string {
 		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
 		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
 		`SyncStrategy:` + strings.Replace(fmt.Sprintf("%v", this.SyncStrategy), "SyncStrategy", "SyncStrategy", 1) + `,`,
+		`Resources:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Resources), "SyncOperationResource", "SyncOperationResource", 1), `&`, ``, 1) + `,`,
+		`SelfHealAttemptsCount:` + fmt.Sprintf("%v", this.SelfHealAttemptsCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncOperationResource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncOperationResource{`,
+		`Group:` + fmt.Sprintf("%v", this.Group) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2574,6 +3893,30 @@ func (this *SyncOperationResult) String() string {
 	}, "")
 	return s
 }
+func (this *SyncPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPolicy{`,
+		`Automated:` + strings.Replace(fmt.Sprintf("%v", this.Automated), "SyncPolicyAutomated", "SyncPolicyAutomated", 1) + `,`,
+		`Retry:` + strings.Replace(fmt.Sprintf("%v", this.Retry), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`ManagedNamespaceMetadata:` + strings.Replace(fmt.Sprintf("%v", this.ManagedNamespaceMetadata), "ManagedNamespaceMetadata", "ManagedNamespaceMetadata", 1) + `,`,
+		`SyncOptions:` + fmt.Sprintf("%v", this.SyncOptions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncPolicyAutomated) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncPolicyAutomated{`,
+		`Prune:` + fmt.Sprintf("%v", this.Prune) + `,`,
+		`SelfHeal:` + fmt.Sprintf("%v", this.SelfHeal) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SyncStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -2601,6 +3944,23 @@ func (this *SyncStrategyHook) String() string {
 	}
 	s := strings.Join([]string{`&SyncStrategyHook{`,
 		`SyncStrategyApply:` + strings.Replace(strings.Replace(this.SyncStrategyApply.String(), "SyncStrategyApply", "SyncStrategyApply", 1), `&`, ``, 1) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindow{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Applications:` + fmt.Sprintf("%v", this.Applications) + `,`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
+		`ManualSync:` + fmt.Sprintf("%v", this.ManualSync) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2966,16 +4326,167 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTools", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceTools = append(m.SourceTools, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrphanedResources == nil {
+				m.OrphanedResources = &OrphanedResourcesMonitorSettings{}
+			}
+			if err := m.OrphanedResources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncWindows = append(m.SyncWindows, SyncWindow{})
+			if err := m.SyncWindows[len(m.SyncWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureKeys = append(m.SignatureKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretReferences", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretReferences = append(m.SecretReferences, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -3247,6 +4758,39 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 			}
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &k8s_io_apimachinery_pkg_apis_meta_v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -3831,6 +5375,90 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &SyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
+			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevisionHistoryLimit = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4197,7 +5825,7 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *Backoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4220,15 +5848,15 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4253,13 +5881,13 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4269,26 +5897,17 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Factor = &v
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4298,19 +5917,156 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4792,6 +6548,35 @@ func (m *ComparisonResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5260,59 +7045,9 @@ func (m *DeploymentInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5337,13 +7072,33 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Cause = DeploymentCause(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackID", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RollbackID = &v
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5353,29 +7108,60 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatusDetails = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -5389,7 +7175,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HookStatus) Unmarshal(dAtA []byte) error {
+func (m *GuardrailState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5412,10 +7198,10 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HookStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: GuardrailState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HookStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GuardrailState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5449,7 +7235,7 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5474,11 +7260,11 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Component = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5503,11 +7289,11 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.Level = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5532,11 +7318,49 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = HookType(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5561,13 +7385,13 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = OperationPhase(dAtA[iNdEx:postIndex])
+			m.Unit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5577,20 +7401,24 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &k8s_io_apimachinery_pkg_apis_meta_v1.Time{}
+			}
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5613,7 +7441,7 @@ func (m *HookStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Operation) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5636,17 +7464,17 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5656,28 +7484,53 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Sync == nil {
-				m.Sync = &SyncOperation{}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDetails", wireType)
 			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.StatusDetails = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressingSince", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5701,10 +7554,10 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Rollback == nil {
-				m.Rollback = &RollbackOperation{}
+			if m.ProgressingSince == nil {
+				m.ProgressingSince = &k8s_io_apimachinery_pkg_apis_meta_v1.Time{}
 			}
-			if err := m.Rollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProgressingSince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5729,7 +7582,7 @@ func (m *Operation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationState) Unmarshal(dAtA []byte) error {
+func (m *HookStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5752,17 +7605,17 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+			return fmt.Errorf("proto: HookStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HookStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5772,25 +7625,24 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5815,11 +7667,11 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5844,13 +7696,13 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5860,30 +7712,26 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncResult == nil {
-				m.SyncResult = &SyncOperationResult{}
-			}
-			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = HookType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollbackResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5893,30 +7741,26 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RollbackResult == nil {
-				m.RollbackResult = &SyncOperationResult{}
-			}
-			if err := m.RollbackResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5926,27 +7770,26 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5956,27 +7799,52 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &k8s_io_apimachinery_pkg_apis_meta_v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Output = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -5996,7 +7864,7 @@ func (m *OperationState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Repository) Unmarshal(dAtA []byte) error {
+func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6019,75 +7887,17 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6097,53 +7907,113 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6167,9 +8037,97 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6192,7 +8150,7 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepositoryList) Unmarshal(dAtA []byte) error {
+func (m *Operation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6215,15 +8173,15 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Operation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Operation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6247,13 +8205,16 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Sync == nil {
+				m.Sync = &SyncOperation{}
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6277,8 +8238,73 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Repository{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Rollback == nil {
+				m.Rollback = &RollbackOperation{}
+			}
+			if err := m.Rollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitiatedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6303,7 +8329,7 @@ func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
+func (m *OperationInitiator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6326,15 +8352,15 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperationInitiator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperationInitiator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6359,13 +8385,83 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Automated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6375,24 +8471,25 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.Operation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6417,9 +8514,9 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Phase = OperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
@@ -6448,11 +8545,11 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncResult", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6462,76 +8559,63 @@ func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ResourceSyncStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if m.SyncResult == nil {
+				m.SyncResult = &SyncOperationResult{}
+			}
+			if err := m.SyncResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackResult", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceNode) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.RollbackResult == nil {
+				m.RollbackResult = &SyncOperationResult{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.RollbackResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6541,24 +8625,25 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6582,11 +8667,32 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, ResourceNode{})
-			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &k8s_io_apimachinery_pkg_apis_meta_v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+			}
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6608,7 +8714,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceState) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourceKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6631,15 +8737,15 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceState: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourceKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourceKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6664,11 +8770,11 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetState = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6693,11 +8799,11 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiveState = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6722,74 +8828,13 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ComparisonStatus(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildLiveResources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChildLiveResources = append(m.ChildLiveResources, ResourceNode{})
-			if err := m.ChildLiveResources[len(m.ChildLiveResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if skippy < 0 {
 				return ErrInvalidLengthGenerated
@@ -6806,7 +8851,7 @@ func (m *ResourceState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RollbackOperation) Unmarshal(dAtA []byte) error {
+func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6829,17 +8874,17 @@ func (m *RollbackOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RollbackOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RollbackOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrphanedResourcesMonitorSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6849,51 +8894,23 @@ func (m *RollbackOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Prune = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DryRun = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6915,7 +8932,7 @@ func (m *RollbackOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+func (m *Repository) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6938,15 +8955,15 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Repository: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Repository: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6971,13 +8988,13 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6987,17 +9004,26 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Prune = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7007,17 +9033,26 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7027,78 +9062,24 @@ func (m *SyncOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncStrategy == nil {
-				m.SyncStrategy = &SyncStrategy{}
-			}
-			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SSHPrivateKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7122,16 +9103,15 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &ResourceDetails{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7141,35 +9121,124 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
 				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
@@ -7182,10 +9251,126 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hooks = append(m.Hooks, &HookStatus{})
-			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRevision", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7208,7 +9393,7 @@ func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
+func (m *RepositoryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7231,15 +9416,15 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7263,16 +9448,13 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apply == nil {
-				m.Apply = &SyncStrategyApply{}
-			}
-			if err := m.Apply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7296,10 +9478,8 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hook == nil {
-				m.Hook = &SyncStrategyHook{}
-			}
-			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Repository{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7324,7 +9504,7 @@ func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
+func (m *ResourceDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7347,17 +9527,17 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7367,12 +9547,137 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ResourceSyncStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -7394,7 +9699,7 @@ func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
+func (m *ResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7417,17 +9722,17 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceIgnoreDifferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7437,7 +9742,231 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPointers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPointers = append(m.JSONPointers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7449,7 +9978,8 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Children = append(m.Children, ResourceNode{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7474,6 +10004,1702 @@ func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResourceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ComparisonStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildLiveResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChildLiveResources = append(m.ChildLiveResources, ResourceNode{})
+			if err := m.ChildLiveResources[len(m.ChildLiveResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &Backoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prune = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prune = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncStrategy == nil {
+				m.SyncStrategy = &SyncStrategy{}
+			}
+			if err := m.SyncStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHealAttemptsCount", wireType)
+			}
+			m.SelfHealAttemptsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfHealAttemptsCount |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperationResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperationResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperationResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOperationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOperationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOperationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &ResourceDetails{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, &HookStatus{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Automated == nil {
+				m.Automated = &SyncPolicyAutomated{}
+			}
+			if err := m.Automated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &RetryStrategy{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedNamespaceMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManagedNamespaceMetadata == nil {
+				m.ManagedNamespaceMetadata = &ManagedNamespaceMetadata{}
+			}
+			if err := m.ManagedNamespaceMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncOptions = append(m.SyncOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncPolicyAutomated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncPolicyAutomated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncPolicyAutomated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prune = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfHeal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SelfHeal = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Apply == nil {
+				m.Apply = &SyncStrategyApply{}
+			}
+			if err := m.Apply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hook == nil {
+				m.Hook = &SyncStrategyHook{}
+			}
+			if err := m.Hook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStrategyApply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStrategyApply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStrategyApply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Force = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncStrategyHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncStrategyHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncStrategyHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStrategyApply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncStrategyApply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ManualSync = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TLSClientConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7776,158 +12002,231 @@ func init() {
 }
 
 var fileDescriptorGenerated = []byte{
-	// 2446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4b, 0x8c, 0x1c, 0x47,
-	0x19, 0x76, 0xcf, 0x6b, 0x67, 0xfe, 0xd9, 0x87, 0x5d, 0x79, 0x30, 0x38, 0xd2, 0xee, 0xaa, 0xc3,
-	0xc3, 0xa0, 0x64, 0x06, 0x1b, 0x02, 0xe6, 0x21, 0x24, 0xcf, 0xae, 0x1d, 0x6f, 0xd6, 0x8f, 0xa5,
-	0x66, 0x13, 0xa4, 0x10, 0x05, 0xda, 0x3d, 0xb5, 0x33, 0xed, 0x99, 0xe9, 0xee, 0x74, 0xd5, 0x8c,
-	0x35, 0x12, 0x41, 0x46, 0x08, 0x89, 0xa7, 0x04, 0x42, 0x88, 0x2b, 0x07, 0x4e, 0x08, 0x09, 0x09,
-	0x71, 0x42, 0xe2, 0x00, 0x07, 0xe4, 0x63, 0x0e, 0x20, 0xa2, 0x80, 0x56, 0x78, 0x73, 0x89, 0xc4,
-	0x81, 0x7b, 0x4e, 0xa8, 0x1e, 0xdd, 0x55, 0xdd, 0xb3, 0xcb, 0xae, 0x3d, 0x6d, 0x03, 0xb7, 0xee,
-	0xff, 0xff, 0xfb, 0xff, 0xfe, 0xfa, 0xeb, 0xaf, 0xff, 0x51, 0x0d, 0x5b, 0x3d, 0x8f, 0xf5, 0xc7,
-	0xb7, 0x9a, 0x6e, 0x30, 0x6a, 0x39, 0x51, 0x2f, 0x08, 0xa3, 0xe0, 0xb6, 0x78, 0x78, 0xde, 0xed,
-	0xb6, 0xc2, 0x41, 0xaf, 0xe5, 0x84, 0x1e, 0x6d, 0x39, 0x61, 0x38, 0xf4, 0x5c, 0x87, 0x79, 0x81,
-	0xdf, 0x9a, 0x9c, 0x77, 0x86, 0x61, 0xdf, 0x39, 0xdf, 0xea, 0x11, 0x9f, 0x44, 0x0e, 0x23, 0xdd,
-	0x66, 0x18, 0x05, 0x2c, 0x40, 0x9f, 0xd5, 0xaa, 0x9a, 0xb1, 0x2a, 0xf1, 0xf0, 0x55, 0xb7, 0xdb,
-	0x0c, 0x07, 0xbd, 0x26, 0x57, 0xd5, 0x34, 0x54, 0x35, 0x63, 0x55, 0x67, 0x9f, 0x37, 0xac, 0xe8,
-	0x05, 0xbd, 0xa0, 0x25, 0x34, 0xde, 0x1a, 0xef, 0x89, 0x37, 0xf1, 0x22, 0x9e, 0x24, 0xd2, 0xd9,
-	0x4f, 0x0d, 0x2e, 0xd2, 0xa6, 0x17, 0x70, 0xdb, 0x46, 0x8e, 0xdb, 0xf7, 0x7c, 0x12, 0x4d, 0xb5,
-	0xb1, 0x23, 0xc2, 0x9c, 0xd6, 0x64, 0xc6, 0xbe, 0xb3, 0xad, 0xa3, 0xbe, 0x8a, 0xc6, 0x3e, 0xf3,
-	0x46, 0x64, 0xe6, 0x83, 0x4f, 0x1f, 0xf7, 0x01, 0x75, 0xfb, 0x64, 0xe4, 0xcc, 0x7c, 0xf7, 0xc9,
-	0xa3, 0xbe, 0x1b, 0x33, 0x6f, 0xd8, 0xf2, 0x7c, 0x46, 0x59, 0x94, 0xfd, 0xc8, 0xfe, 0x9b, 0x05,
-	0x70, 0x29, 0x0c, 0x77, 0xa2, 0xe0, 0x36, 0x71, 0x19, 0xfa, 0x1a, 0x54, 0xf9, 0x3a, 0xba, 0x0e,
-	0x73, 0x1a, 0xd6, 0xba, 0x75, 0xae, 0x7e, 0xe1, 0x13, 0x4d, 0xa9, 0xb6, 0x69, 0xaa, 0xd5, 0x7e,
-	0xe5, 0xd2, 0xcd, 0xc9, 0xf9, 0xe6, 0xcd, 0x5b, 0xfc, 0xfb, 0xeb, 0x84, 0x39, 0x6d, 0x74, 0x6f,
-	0x7f, 0xed, 0xd4, 0xc1, 0xfe, 0x1a, 0x68, 0x1a, 0x4e, 0xb4, 0xa2, 0x01, 0x94, 0x68, 0x48, 0xdc,
-	0x46, 0x41, 0x68, 0xdf, 0x6a, 0x3e, 0xf4, 0xee, 0x35, 0xb5, 0xd9, 0x9d, 0x90, 0xb8, 0xed, 0x45,
-	0x05, 0x5b, 0xe2, 0x6f, 0x58, 0x80, 0xd8, 0xef, 0x58, 0xb0, 0xac, 0xc5, 0xae, 0x79, 0x94, 0xa1,
-	0xd7, 0x66, 0x56, 0xd8, 0x3c, 0xd9, 0x0a, 0xf9, 0xd7, 0x62, 0x7d, 0xa7, 0x15, 0x50, 0x35, 0xa6,
-	0x18, 0xab, 0xbb, 0x0d, 0x65, 0x8f, 0x91, 0x11, 0x6d, 0x14, 0xd6, 0x8b, 0xe7, 0xea, 0x17, 0x2e,
-	0xe7, 0xb2, 0xbc, 0xf6, 0x92, 0x42, 0x2c, 0x6f, 0x71, 0xdd, 0x58, 0x42, 0xd8, 0x77, 0x0b, 0xe6,
-	0xe2, 0xf8, 0xaa, 0xd1, 0xc7, 0x60, 0x81, 0x06, 0xe3, 0xc8, 0x25, 0xb4, 0x61, 0xad, 0x17, 0xcf,
-	0xd5, 0xda, 0x2b, 0x07, 0xfb, 0x6b, 0xf5, 0x8e, 0x20, 0x61, 0x12, 0x06, 0x14, 0xc7, 0x7c, 0xf4,
-	0x7d, 0x0b, 0x16, 0xbb, 0x84, 0x32, 0xcf, 0x17, 0xb8, 0xb1, 0xc5, 0x5f, 0x9a, 0xcf, 0xe2, 0x98,
-	0xb8, 0xa9, 0x35, 0xb7, 0x9f, 0x54, 0xd6, 0x2f, 0x1a, 0x44, 0x8a, 0x53, 0xe0, 0xe8, 0x05, 0xa8,
-	0x77, 0x09, 0x75, 0x23, 0x2f, 0xe4, 0xef, 0x8d, 0xe2, 0xba, 0x75, 0xae, 0xd6, 0x7e, 0x42, 0x7d,
-	0x58, 0xdf, 0xd4, 0x2c, 0x6c, 0xca, 0xd9, 0x7f, 0x2a, 0x42, 0xdd, 0x40, 0x7d, 0x0c, 0xe1, 0x3b,
-	0x4c, 0x85, 0xef, 0x4b, 0xf9, 0x78, 0xeb, 0xa8, 0xf8, 0x45, 0x0c, 0x2a, 0x94, 0x39, 0x6c, 0x4c,
-	0x85, 0x47, 0xea, 0x17, 0xae, 0xe5, 0x84, 0x27, 0x74, 0xb6, 0x97, 0x15, 0x62, 0x45, 0xbe, 0x63,
-	0x85, 0x85, 0xde, 0x80, 0x5a, 0x10, 0xf2, 0x2c, 0xc1, 0xb7, 0xa2, 0x24, 0x80, 0x37, 0xe7, 0x00,
-	0xbe, 0x19, 0xeb, 0x6a, 0x2f, 0x1d, 0xec, 0xaf, 0xd5, 0x92, 0x57, 0xac, 0x51, 0x6c, 0x17, 0x9e,
-	0x34, 0xec, 0xdb, 0x08, 0xfc, 0xae, 0x27, 0x36, 0x74, 0x1d, 0x4a, 0x6c, 0x1a, 0x12, 0xb1, 0x99,
-	0x35, 0xed, 0xa2, 0xdd, 0x69, 0x48, 0xb0, 0xe0, 0xf0, 0x90, 0x1f, 0x11, 0x4a, 0x9d, 0x1e, 0x11,
-	0x7b, 0x52, 0x6b, 0xaf, 0x28, 0xa1, 0x85, 0xeb, 0x92, 0x8c, 0x63, 0xbe, 0xfd, 0x06, 0x3c, 0x7d,
-	0x78, 0x88, 0xa2, 0x8f, 0x40, 0x85, 0x92, 0x68, 0x42, 0x22, 0x05, 0xa4, 0x3d, 0x23, 0xa8, 0x58,
-	0x71, 0x51, 0x0b, 0x6a, 0xbe, 0x33, 0x22, 0x34, 0x74, 0xdc, 0x18, 0xee, 0x8c, 0x12, 0xad, 0xdd,
-	0x88, 0x19, 0x58, 0xcb, 0xd8, 0x7f, 0xb7, 0x60, 0xc5, 0xc0, 0x7c, 0x0c, 0x19, 0x68, 0x90, 0xce,
-	0x40, 0x57, 0xf2, 0x89, 0x98, 0x23, 0x52, 0xd0, 0x1f, 0x8a, 0x70, 0xc6, 0x8c, 0x2b, 0x91, 0x5b,
-	0xf8, 0x96, 0x44, 0x24, 0x0c, 0x5e, 0xc6, 0xd7, 0x94, 0x3b, 0x93, 0x2d, 0xc1, 0x92, 0x8c, 0x63,
-	0x3e, 0xdf, 0xdf, 0xd0, 0x61, 0x7d, 0xe5, 0xcb, 0x64, 0x7f, 0x77, 0x1c, 0xd6, 0xc7, 0x82, 0xc3,
-	0x33, 0x03, 0xf1, 0x27, 0x5e, 0x14, 0xf8, 0x23, 0xe2, 0xb3, 0x6c, 0x66, 0xb8, 0xac, 0x59, 0xd8,
-	0x94, 0x43, 0x5f, 0x84, 0x65, 0xe6, 0x44, 0x3d, 0xc2, 0x30, 0x99, 0x78, 0x34, 0x0e, 0xe4, 0x5a,
-	0xfb, 0x69, 0xf5, 0xe5, 0xf2, 0x6e, 0x8a, 0x8b, 0x33, 0xd2, 0xe8, 0xb7, 0x16, 0x3c, 0xe3, 0x06,
-	0xa3, 0x30, 0xf0, 0x89, 0xcf, 0x76, 0x9c, 0xc8, 0x19, 0x11, 0x46, 0xa2, 0x9b, 0x13, 0x12, 0x45,
-	0x5e, 0x97, 0xd0, 0x46, 0x59, 0x78, 0xf7, 0xfa, 0x1c, 0xde, 0xdd, 0x98, 0xd1, 0xde, 0x7e, 0x56,
-	0x19, 0xf7, 0xcc, 0xc6, 0xd1, 0xc8, 0xf8, 0x3f, 0x99, 0x85, 0xce, 0x43, 0x7d, 0xe2, 0x0c, 0xc7,
-	0x84, 0x5e, 0xf1, 0x86, 0x84, 0x36, 0x2a, 0xba, 0x08, 0xbc, 0xa2, 0xc9, 0xd8, 0x94, 0xb1, 0x7f,
-	0x5f, 0x48, 0x85, 0x68, 0x27, 0xce, 0x3b, 0x62, 0x2f, 0x55, 0x80, 0xe6, 0x95, 0x77, 0x84, 0x4e,
-	0xe3, 0x74, 0xc9, 0xc2, 0xa4, 0xb0, 0xd0, 0x77, 0x2c, 0x51, 0x05, 0xe2, 0x53, 0xa9, 0x72, 0xec,
-	0x23, 0xa8, 0x48, 0x66, 0x61, 0x89, 0x89, 0xd8, 0x84, 0xe6, 0x21, 0x1c, 0xca, 0xba, 0xaa, 0x22,
-	0x2e, 0x09, 0x61, 0x55, 0x6e, 0x71, 0xcc, 0xb7, 0x7f, 0x5e, 0x49, 0x9f, 0x01, 0x99, 0x43, 0x7f,
-	0x6c, 0xc1, 0x69, 0xbe, 0x51, 0x4e, 0xe4, 0xd1, 0xc0, 0xc7, 0x84, 0x8e, 0x87, 0x4c, 0x39, 0x73,
-	0x7b, 0xce, 0xa0, 0x31, 0x55, 0xb6, 0x1b, 0xca, 0xae, 0xd3, 0x59, 0x0e, 0x9e, 0x81, 0x47, 0x0c,
-	0x16, 0xfa, 0x1e, 0x65, 0x41, 0x34, 0x55, 0xc9, 0x61, 0x9e, 0xee, 0x6b, 0x93, 0x84, 0xc3, 0x60,
-	0xca, 0xcf, 0xda, 0x96, 0xbf, 0x17, 0x68, 0xff, 0x5c, 0x95, 0x08, 0x38, 0x86, 0x42, 0xdf, 0xb4,
-	0x00, 0xc2, 0x38, 0x52, 0x79, 0x21, 0x7b, 0x04, 0x07, 0x27, 0xa9, 0xd9, 0x09, 0x89, 0x62, 0x03,
-	0x14, 0x05, 0x50, 0xe9, 0x13, 0x67, 0xc8, 0xfa, 0xaa, 0x9c, 0xbd, 0x38, 0x07, 0xfc, 0x55, 0xa1,
-	0x28, 0x5b, 0x42, 0x25, 0x15, 0x2b, 0x18, 0xf4, 0x6d, 0x0b, 0x96, 0x93, 0xea, 0xc6, 0x65, 0x49,
-	0xa3, 0x3c, 0x77, 0xc3, 0x7b, 0x33, 0xa5, 0xb0, 0x8d, 0x78, 0x1a, 0x4b, 0xd3, 0x70, 0x06, 0x14,
-	0x7d, 0xcb, 0x02, 0x70, 0xe3, 0x6a, 0x2a, 0xf3, 0x41, 0xfd, 0xc2, 0xcd, 0x7c, 0x4e, 0x54, 0x52,
-	0xa5, 0xb5, 0xfb, 0x13, 0x12, 0xc5, 0x06, 0xac, 0xfd, 0xae, 0x05, 0x4f, 0x19, 0x1f, 0x7e, 0xd9,
-	0x61, 0x6e, 0xff, 0xf2, 0x84, 0xa7, 0xe9, 0xed, 0x54, 0x7d, 0xff, 0x8c, 0x59, 0xdf, 0xdf, 0xdf,
-	0x5f, 0xfb, 0xe8, 0x51, 0x13, 0xcd, 0x1d, 0xae, 0xa1, 0x29, 0x54, 0x18, 0xad, 0xc0, 0x9b, 0x50,
-	0x37, 0x6c, 0x56, 0xe9, 0x23, 0xaf, 0x02, 0x98, 0xe4, 0x0c, 0x83, 0x88, 0x4d, 0x3c, 0xfb, 0x2f,
-	0x05, 0x58, 0xd8, 0x18, 0x8e, 0x29, 0x23, 0xd1, 0x89, 0x1b, 0x8a, 0x75, 0x28, 0xf1, 0x66, 0x21,
-	0x5b, 0xff, 0x78, 0x2f, 0x81, 0x05, 0x07, 0x85, 0x50, 0x71, 0x03, 0x7f, 0xcf, 0xeb, 0xa9, 0x16,
-	0xf0, 0xea, 0x3c, 0x27, 0x47, 0x5a, 0xb7, 0x21, 0xf4, 0x69, 0x9b, 0xe4, 0x3b, 0x56, 0x38, 0xe8,
-	0x87, 0x16, 0xac, 0xb8, 0x81, 0xef, 0x13, 0x57, 0x07, 0x6f, 0x69, 0xee, 0x76, 0x77, 0x23, 0xad,
-	0xb1, 0xfd, 0x01, 0x85, 0xbe, 0x92, 0x61, 0xe0, 0x2c, 0xb6, 0xfd, 0x9b, 0x02, 0x2c, 0xa5, 0x2c,
-	0x47, 0xcf, 0x41, 0x75, 0x4c, 0x49, 0x24, 0x3c, 0x27, 0xfd, 0x9b, 0x74, 0x44, 0x2f, 0x2b, 0x3a,
-	0x4e, 0x24, 0xb8, 0x74, 0xe8, 0x50, 0x7a, 0x27, 0x88, 0xba, 0xca, 0xcf, 0x89, 0xf4, 0x8e, 0xa2,
-	0xe3, 0x44, 0x82, 0xf7, 0x1b, 0xb7, 0x88, 0x13, 0x91, 0x68, 0x37, 0x18, 0x90, 0x99, 0x49, 0xa4,
-	0xad, 0x59, 0xd8, 0x94, 0x13, 0x4e, 0x63, 0x43, 0xba, 0x31, 0xf4, 0x88, 0xcf, 0xa4, 0x99, 0x39,
-	0x38, 0x6d, 0xf7, 0x5a, 0xc7, 0xd4, 0xa8, 0x9d, 0x96, 0x61, 0xe0, 0x2c, 0xb6, 0xfd, 0x67, 0x0b,
-	0xea, 0xca, 0x69, 0x8f, 0xa1, 0xe9, 0xec, 0xa5, 0x9b, 0xce, 0xf6, 0xfc, 0x31, 0x7a, 0x44, 0xc3,
-	0xf9, 0xab, 0x22, 0xcc, 0x54, 0x3a, 0xf4, 0x3a, 0xcf, 0x71, 0x9c, 0x46, 0xba, 0x97, 0xe2, 0x22,
-	0xfb, 0xf1, 0x93, 0xad, 0x6e, 0xd7, 0x1b, 0x11, 0x33, 0x7d, 0xc5, 0x5a, 0xb0, 0xa1, 0x11, 0xdd,
-	0xb5, 0x34, 0xc0, 0x6e, 0xa0, 0xf2, 0x4a, 0xbe, 0x2d, 0xd1, 0x8c, 0x09, 0xbb, 0x01, 0x36, 0x30,
-	0xd1, 0xe7, 0x92, 0x41, 0xb0, 0x2c, 0x02, 0xd2, 0x4e, 0x8f, 0x6e, 0xef, 0xa7, 0x1a, 0x80, 0xcc,
-	0x38, 0x37, 0x85, 0x5a, 0x44, 0xe2, 0x6b, 0x01, 0x59, 0x01, 0xe6, 0x49, 0x22, 0x58, 0xe9, 0x92,
-	0xc7, 0x38, 0x19, 0x7f, 0x62, 0x32, 0xc5, 0x1a, 0xcd, 0xfe, 0x81, 0x05, 0x68, 0xb6, 0x5c, 0xf3,
-	0x31, 0x2a, 0x69, 0x62, 0xd5, 0x01, 0x4e, 0xf4, 0x24, 0xe2, 0x58, 0xcb, 0x9c, 0x20, 0x4d, 0x3e,
-	0x0b, 0x65, 0xd1, 0xd4, 0xaa, 0x03, 0x9b, 0x44, 0x8f, 0x68, 0x7b, 0xb1, 0xe4, 0xd9, 0x7f, 0xb4,
-	0x20, 0x9b, 0x6e, 0x44, 0xa6, 0x96, 0x9e, 0xcd, 0x66, 0xea, 0xb4, 0x17, 0x4f, 0x3e, 0x67, 0xa2,
-	0xd7, 0xa0, 0xee, 0x30, 0x46, 0x46, 0x21, 0x13, 0x01, 0x59, 0x7c, 0xe0, 0x80, 0x5c, 0xe6, 0x91,
-	0x70, 0x3d, 0xe8, 0x7a, 0x7b, 0x9e, 0x08, 0x46, 0x53, 0x9d, 0xfd, 0x5e, 0x11, 0x96, 0xd3, 0xcd,
-	0x17, 0x1a, 0x43, 0x45, 0x34, 0x3b, 0xf2, 0xd6, 0x27, 0xf7, 0xee, 0x2a, 0x71, 0x89, 0x20, 0x51,
-	0xac, 0xc0, 0x78, 0x62, 0x8d, 0xe2, 0xe9, 0x2a, 0x93, 0x58, 0x93, 0xb9, 0x2a, 0x91, 0x38, 0x76,
-	0xa2, 0x2a, 0xfe, 0x6f, 0x4e, 0x54, 0xaf, 0x03, 0x74, 0x85, 0xb7, 0xc5, 0x5e, 0x96, 0x1e, 0x3e,
-	0xb9, 0x6c, 0x26, 0x5a, 0xb0, 0xa1, 0x11, 0x9d, 0x85, 0x82, 0xd7, 0x15, 0xa7, 0xba, 0xd8, 0x06,
-	0x25, 0x5b, 0xd8, 0xda, 0xc4, 0x05, 0xaf, 0x6b, 0x53, 0x58, 0x34, 0xbb, 0xcd, 0x13, 0xc7, 0xea,
-	0xe7, 0x61, 0x49, 0x3e, 0x6d, 0x12, 0xe6, 0x78, 0x43, 0xaa, 0x76, 0xe7, 0x29, 0x25, 0xbe, 0xd4,
-	0x31, 0x99, 0x38, 0x2d, 0x6b, 0xff, 0xac, 0x00, 0x70, 0x35, 0x08, 0x06, 0x0a, 0x33, 0x3e, 0x7a,
-	0xd6, 0x91, 0x47, 0x6f, 0x1d, 0x4a, 0x03, 0xcf, 0xef, 0x66, 0x0f, 0xe7, 0xb6, 0xe7, 0x77, 0xb1,
-	0xe0, 0xa0, 0x0b, 0x00, 0x4e, 0xe8, 0xbd, 0x42, 0x22, 0xaa, 0x2f, 0xf7, 0x12, 0xbf, 0x5c, 0xda,
-	0xd9, 0x52, 0x1c, 0x6c, 0x48, 0xa1, 0xe7, 0x54, 0x67, 0x28, 0xc7, 0xf6, 0x46, 0xa6, 0x33, 0xac,
-	0x72, 0x0b, 0x8d, 0xd6, 0xef, 0x62, 0x26, 0x3f, 0xae, 0xcf, 0xe4, 0x47, 0xdd, 0x29, 0xef, 0xf4,
-	0x1d, 0x4a, 0x0e, 0x3b, 0xd7, 0x95, 0x63, 0xee, 0x8f, 0xfe, 0x69, 0x81, 0xbe, 0xbd, 0x42, 0x7b,
-	0x50, 0xa2, 0x53, 0xdf, 0x55, 0xf5, 0x66, 0x9e, 0x8c, 0xda, 0x99, 0xfa, 0xae, 0xbe, 0x24, 0xab,
-	0x8a, 0x3b, 0xc0, 0xa9, 0xef, 0x62, 0xa1, 0x1f, 0x4d, 0xa0, 0x1a, 0x05, 0xc3, 0xe1, 0x2d, 0xc7,
-	0x1d, 0xe4, 0x50, 0x7a, 0xb0, 0x52, 0xa5, 0xf1, 0x16, 0xc5, 0x79, 0x55, 0x64, 0x9c, 0x60, 0xd9,
-	0xbf, 0x2e, 0x43, 0x66, 0xba, 0x40, 0x63, 0xf3, 0x62, 0xd0, 0xca, 0xf1, 0x62, 0x30, 0xc9, 0xfe,
-	0x87, 0x5d, 0x0e, 0xa2, 0x17, 0xa0, 0x1c, 0xf2, 0x3d, 0x53, 0x11, 0xb6, 0x16, 0xe7, 0x76, 0xb1,
-	0x91, 0x87, 0x6c, 0xad, 0x94, 0x36, 0x77, 0xb6, 0x78, 0x4c, 0xc6, 0xfe, 0x06, 0x00, 0xf7, 0xb5,
-	0x1a, 0xd3, 0xe5, 0x21, 0xbf, 0x91, 0xd7, 0x8e, 0xaa, 0x49, 0x5d, 0x24, 0xf5, 0x4e, 0x82, 0x82,
-	0x0d, 0x44, 0xf4, 0x3d, 0x0b, 0x96, 0x63, 0xc7, 0x2b, 0x23, 0xca, 0x8f, 0xc4, 0x08, 0x31, 0x33,
-	0xe2, 0x14, 0x12, 0xce, 0x20, 0xa3, 0xaf, 0x40, 0x8d, 0x32, 0x27, 0x92, 0xc5, 0xab, 0xf2, 0xc0,
-	0x09, 0x2f, 0xd9, 0xcb, 0x4e, 0xac, 0x04, 0x6b, 0x7d, 0xe8, 0x55, 0x80, 0x3d, 0xcf, 0xf7, 0x68,
-	0x5f, 0x68, 0x5f, 0x78, 0xb8, 0xd2, 0x78, 0x25, 0xd1, 0x80, 0x0d, 0x6d, 0xf6, 0x5f, 0x0b, 0x00,
-	0xe2, 0x2f, 0x87, 0x27, 0x2e, 0x1e, 0xd6, 0xa1, 0x14, 0x91, 0x30, 0xc8, 0x66, 0x2e, 0x2e, 0x81,
-	0x05, 0x27, 0x35, 0x47, 0x14, 0x1e, 0x68, 0x8e, 0x28, 0x1e, 0x3b, 0x47, 0xf0, 0x1c, 0x4c, 0xfb,
-	0x3b, 0x91, 0x37, 0x71, 0x18, 0xd9, 0x26, 0x53, 0x95, 0xc8, 0x74, 0x0e, 0xee, 0x5c, 0xd5, 0x4c,
-	0x9c, 0x96, 0x3d, 0x74, 0x04, 0x2b, 0xff, 0x17, 0x47, 0xb0, 0x77, 0x2c, 0x58, 0xd6, 0x9e, 0xfd,
-	0xff, 0xfa, 0x8f, 0xa6, 0xed, 0x3e, 0x62, 0xa6, 0xf8, 0x97, 0x05, 0x2b, 0x71, 0xf7, 0xaa, 0x8a,
-	0x60, 0x2e, 0x55, 0x2f, 0xf5, 0xb3, 0xa0, 0x78, 0xfc, 0xcf, 0x02, 0x33, 0x61, 0x95, 0x8e, 0x49,
-	0x58, 0x5f, 0xc8, 0xd4, 0xbb, 0x0f, 0xcd, 0xd4, 0x3b, 0x94, 0xf4, 0xe9, 0x53, 0xdf, 0x4d, 0xf7,
-	0x07, 0xf6, 0x2f, 0x2d, 0x58, 0x8c, 0xd9, 0x37, 0x82, 0xae, 0xe8, 0x9e, 0xa9, 0x08, 0x32, 0x2b,
-	0xdd, 0x3d, 0xcb, 0x70, 0x90, 0x3c, 0x34, 0x86, 0xaa, 0xdb, 0xf7, 0x86, 0xdd, 0x88, 0xf8, 0x6a,
-	0x5b, 0x5e, 0xcc, 0x61, 0x8c, 0xe0, 0xf8, 0x3a, 0x14, 0x36, 0x14, 0x00, 0x4e, 0xa0, 0xec, 0xdf,
-	0x15, 0x61, 0x29, 0x35, 0x73, 0xf0, 0x11, 0x5d, 0xde, 0xd6, 0x77, 0x0c, 0x9b, 0x93, 0x11, 0x7d,
-	0x57, 0xb3, 0xb0, 0x29, 0xc7, 0xf7, 0x63, 0xe8, 0x4d, 0xa4, 0x8e, 0xec, 0xcf, 0x9b, 0x6b, 0x31,
-	0x03, 0x6b, 0x19, 0x63, 0xe8, 0x2a, 0x3e, 0xf0, 0xd0, 0xf5, 0x13, 0x0b, 0x90, 0x58, 0x02, 0xd7,
-	0x9c, 0xcc, 0x46, 0x8d, 0x52, 0xbe, 0x7e, 0x3b, 0xab, 0x2c, 0x42, 0x1b, 0x33, 0x50, 0xf8, 0x10,
-	0x78, 0xe3, 0x1e, 0xb4, 0xfc, 0x58, 0xee, 0x41, 0xed, 0xaf, 0xc3, 0x99, 0x99, 0x8e, 0x43, 0xb5,
-	0xbc, 0xd6, 0x61, 0x2d, 0x2f, 0x8f, 0xc4, 0x30, 0x1a, 0xfb, 0x72, 0x83, 0xaa, 0x3a, 0x12, 0x77,
-	0x38, 0x11, 0x4b, 0x1e, 0xef, 0x83, 0xbb, 0xd1, 0x14, 0x8f, 0x65, 0x2f, 0x59, 0xd5, 0xe8, 0x9b,
-	0x82, 0x8a, 0x15, 0xd7, 0xfe, 0x6e, 0x01, 0x96, 0x52, 0x55, 0x30, 0x35, 0xb2, 0x58, 0xc7, 0x8e,
-	0x2c, 0x79, 0x1a, 0x83, 0xde, 0x84, 0x45, 0x2a, 0x8e, 0x62, 0xe4, 0x30, 0xd2, 0x9b, 0xe6, 0x70,
-	0x13, 0xdd, 0x31, 0xd4, 0xb5, 0x4f, 0x1f, 0xec, 0xaf, 0x2d, 0x9a, 0x14, 0x9c, 0x82, 0xb3, 0x7f,
-	0x51, 0x80, 0x27, 0x0e, 0xe9, 0x08, 0xd0, 0x1d, 0xf3, 0x76, 0x40, 0x8e, 0x8f, 0x2f, 0xe5, 0x10,
-	0x9e, 0x2a, 0x91, 0xca, 0x5f, 0xbe, 0x87, 0xdd, 0x0d, 0x3c, 0xe0, 0xf4, 0xb8, 0x07, 0xe5, 0x7e,
-	0x10, 0x0c, 0xe2, 0x31, 0x71, 0x9e, 0x82, 0xa0, 0x87, 0x9b, 0x76, 0x8d, 0xef, 0x26, 0x7f, 0xa7,
-	0x58, 0xaa, 0xb7, 0xdf, 0xb3, 0x20, 0xe5, 0x45, 0x34, 0x82, 0x32, 0xd7, 0x32, 0xcd, 0xe1, 0x4f,
-	0x98, 0xa9, 0xf7, 0x12, 0xd7, 0x29, 0xf1, 0xc5, 0x23, 0x96, 0x28, 0xc8, 0x83, 0x12, 0x37, 0x44,
-	0x75, 0xfa, 0xdb, 0x39, 0xa1, 0xf1, 0x25, 0xca, 0xc1, 0x82, 0x3f, 0x61, 0x01, 0x61, 0x5f, 0x84,
-	0x33, 0x33, 0x16, 0xf1, 0x90, 0xdf, 0x0b, 0xe2, 0x1f, 0x7f, 0x46, 0xc8, 0x5f, 0xe1, 0x44, 0x2c,
-	0x79, 0xbc, 0x7e, 0x9c, 0xce, 0xaa, 0x47, 0x3f, 0xb5, 0xe0, 0x0c, 0xcd, 0xea, 0x7b, 0x24, 0x5e,
-	0xfb, 0xa0, 0x32, 0x6a, 0xd6, 0x7c, 0x3c, 0x6b, 0x01, 0xdf, 0xd1, 0xec, 0x75, 0x29, 0x8f, 0x3d,
-	0xcf, 0xa7, 0xc4, 0x1d, 0x47, 0xf1, 0x42, 0x93, 0xd8, 0xdb, 0x52, 0x74, 0x9c, 0x48, 0xf0, 0xf1,
-	0x55, 0x5e, 0xd7, 0xdf, 0xd0, 0x8d, 0x62, 0x32, 0xbe, 0x76, 0x12, 0x0e, 0x36, 0xa4, 0xd0, 0x39,
-	0xa8, 0xba, 0x24, 0x62, 0x9b, 0xbc, 0x3d, 0xe2, 0x79, 0x61, 0x51, 0xce, 0x59, 0x1b, 0x8a, 0x86,
-	0x13, 0x2e, 0xfa, 0x30, 0x2c, 0x0c, 0xc8, 0x54, 0x08, 0x96, 0x84, 0x60, 0x9d, 0x57, 0xfc, 0x6d,
-	0x49, 0xc2, 0x31, 0x0f, 0xd9, 0x50, 0x71, 0x1d, 0x21, 0x55, 0x16, 0x52, 0x20, 0x6e, 0xee, 0x2f,
-	0x09, 0x21, 0xc5, 0x69, 0x37, 0xef, 0xdd, 0x5f, 0x3d, 0xf5, 0xd6, 0xfd, 0xd5, 0x53, 0x6f, 0xdf,
-	0x5f, 0x3d, 0x75, 0xf7, 0x60, 0xd5, 0xba, 0x77, 0xb0, 0x6a, 0xbd, 0x75, 0xb0, 0x6a, 0xbd, 0x7d,
-	0xb0, 0x6a, 0xfd, 0xe3, 0x60, 0xd5, 0xfa, 0xd1, 0xbb, 0xab, 0xa7, 0x5e, 0xad, 0xc6, 0xae, 0xfd,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x73, 0x59, 0x1b, 0x86, 0x27, 0x00, 0x00,
+	// 3605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x1b, 0x4b, 0x6c, 0x24, 0x47,
+	0x35, 0x3d, 0x3f, 0xdb, 0xe5, 0xcf, 0xda, 0x95, 0xdd, 0xe0, 0x38, 0x90, 0x5d, 0x75, 0xf8, 0x2c,
+	0x88, 0x8c, 0x49, 0xc8, 0x67, 0x13, 0x50, 0x84, 0xc7, 0xde, 0x5d, 0x7b, 0x6d, 0xef, 0x9a, 0x1a,
+	0x27, 0x91, 0x92, 0x88, 0xd0, 0x9e, 0xe9, 0x99, 0xe9, 0xb8, 0xa7, 0x7b, 0xd2, 0xdd, 0xe3, 0x65,
+	0x44, 0x12, 0x05, 0x01, 0x02, 0x01, 0x91, 0xf8, 0x88, 0x0b, 0x08, 0x11, 0x21, 0x4e, 0x48, 0x5c,
+	0x10, 0x27, 0x24, 0x0e, 0x70, 0x40, 0xb9, 0x00, 0x39, 0x80, 0x12, 0x05, 0x88, 0x48, 0x72, 0x41,
+	0xe2, 0x00, 0xe7, 0x70, 0xe1, 0xd5, 0xa7, 0xab, 0xaa, 0x7b, 0x66, 0x76, 0xec, 0x9d, 0xb6, 0x03,
+	0x07, 0xaf, 0xa6, 0xdf, 0x7b, 0xf5, 0xde, 0xab, 0x57, 0xaf, 0x5e, 0xbd, 0xf7, 0xaa, 0x16, 0x6d,
+	0x34, 0x9d, 0xa8, 0xd5, 0xdd, 0x2b, 0xd7, 0xfc, 0xf6, 0xb2, 0x15, 0x34, 0xfd, 0x4e, 0xe0, 0x3f,
+	0xc3, 0x7e, 0xdc, 0x5d, 0xab, 0x2f, 0x77, 0xf6, 0x9b, 0xcb, 0x56, 0xc7, 0x09, 0xe1, 0x9f, 0x8e,
+	0xeb, 0xd4, 0xac, 0xc8, 0xf1, 0xbd, 0xe5, 0x83, 0x7b, 0x2c, 0xb7, 0xd3, 0xb2, 0xee, 0x59, 0x6e,
+	0xda, 0x9e, 0x1d, 0x58, 0x91, 0x5d, 0x2f, 0xc3, 0xa0, 0xc8, 0xc7, 0x0f, 0x29, 0x56, 0xe5, 0x98,
+	0x15, 0xfb, 0xf1, 0x74, 0x0d, 0x48, 0xf6, 0x9b, 0x65, 0xca, 0xaa, 0xac, 0xb1, 0x2a, 0xc7, 0xac,
+	0x96, 0xee, 0xd6, 0xb4, 0x68, 0xfa, 0x4d, 0x7f, 0x99, 0x71, 0xdc, 0xeb, 0x36, 0xd8, 0x17, 0xfb,
+	0x60, 0xbf, 0xb8, 0xa4, 0xa5, 0xfb, 0xf6, 0x2f, 0x84, 0x65, 0xc7, 0xa7, 0xba, 0xb5, 0xad, 0x5a,
+	0xcb, 0x01, 0x3d, 0x7a, 0x4a, 0xd9, 0xb6, 0x1d, 0x59, 0xa0, 0x65, 0x5a, 0xbf, 0xa5, 0xe5, 0x61,
+	0xa3, 0x82, 0xae, 0x17, 0x39, 0x6d, 0xbb, 0x6f, 0xc0, 0x03, 0xa3, 0x06, 0x84, 0xb5, 0x96, 0xdd,
+	0xb6, 0xfa, 0xc6, 0x7d, 0x72, 0xd8, 0xb8, 0x6e, 0xe4, 0xb8, 0xcb, 0x8e, 0x17, 0x85, 0x51, 0x90,
+	0x1e, 0x64, 0xfe, 0xc5, 0x40, 0x68, 0xa5, 0xd3, 0xd9, 0x01, 0xa3, 0xd9, 0xb5, 0x08, 0x7f, 0x1e,
+	0x4d, 0xd2, 0x79, 0xd4, 0xad, 0xc8, 0x5a, 0x34, 0xce, 0x19, 0xe7, 0xa7, 0xef, 0xfd, 0x44, 0x99,
+	0xb3, 0x2d, 0xeb, 0x6c, 0x95, 0x5d, 0x29, 0x35, 0x18, 0xb4, 0x7c, 0x6d, 0x8f, 0x8e, 0xdf, 0x86,
+	0xaf, 0x0a, 0x7e, 0xe5, 0xcd, 0xb3, 0xb7, 0xbc, 0xfd, 0xe6, 0x59, 0xa4, 0x60, 0x44, 0x72, 0xc5,
+	0xfb, 0xa8, 0x10, 0x76, 0xec, 0xda, 0x62, 0x8e, 0x71, 0xdf, 0x28, 0xdf, 0xf4, 0xea, 0x95, 0x95,
+	0xda, 0x55, 0x60, 0x58, 0x99, 0x11, 0x62, 0x0b, 0xf4, 0x8b, 0x30, 0x21, 0xe6, 0x1b, 0x06, 0x9a,
+	0x53, 0x64, 0x5b, 0x4e, 0x18, 0xe1, 0xa7, 0xfa, 0x66, 0x58, 0x3e, 0xdc, 0x0c, 0xe9, 0x68, 0x36,
+	0xbf, 0x79, 0x21, 0x68, 0x32, 0x86, 0x68, 0xb3, 0x7b, 0x06, 0x15, 0x9d, 0xc8, 0x6e, 0x87, 0x30,
+	0xbd, 0x3c, 0xb0, 0xbe, 0x98, 0xc9, 0xf4, 0x2a, 0xb3, 0x42, 0x62, 0x71, 0x83, 0xf2, 0x26, 0x5c,
+	0x84, 0xf9, 0xc7, 0xa2, 0x3e, 0x39, 0x3a, 0x6b, 0xfc, 0x51, 0x34, 0x11, 0xfa, 0xdd, 0xa0, 0x66,
+	0x87, 0x30, 0xb7, 0xfc, 0xf9, 0xa9, 0xca, 0x29, 0x18, 0x35, 0x5d, 0x65, 0x20, 0x62, 0x77, 0xfc,
+	0x90, 0xc4, 0x78, 0xfc, 0x4d, 0x03, 0xcd, 0xd4, 0xed, 0x30, 0x72, 0x3c, 0x26, 0x37, 0xd6, 0xf8,
+	0xb3, 0xe3, 0x69, 0x1c, 0x03, 0xd7, 0x14, 0xe7, 0xca, 0x69, 0xa1, 0xfd, 0x8c, 0x06, 0x0c, 0x49,
+	0x42, 0x38, 0xbe, 0x1f, 0x4d, 0xc3, 0x77, 0x2d, 0x70, 0x3a, 0xf4, 0x7b, 0x31, 0x0f, 0x0b, 0x33,
+	0x55, 0xb9, 0x55, 0x0c, 0x9c, 0x5e, 0x53, 0x28, 0xa2, 0xd3, 0xe1, 0x7b, 0xd0, 0x34, 0x9f, 0xcf,
+	0xae, 0xef, 0xbb, 0xe1, 0x62, 0x21, 0x3d, 0x67, 0x06, 0x26, 0x3a, 0x0d, 0x7e, 0xd9, 0x40, 0x0b,
+	0x7e, 0x00, 0xfa, 0x7a, 0x76, 0x9d, 0xd8, 0xb1, 0xb5, 0x8a, 0xcc, 0x13, 0x9e, 0x1c, 0x63, 0xf2,
+	0xd7, 0xd2, 0x3c, 0xb7, 0x7d, 0xcf, 0x89, 0xfc, 0xa0, 0x6a, 0x47, 0x30, 0xcd, 0x66, 0x58, 0x39,
+	0x03, 0x6a, 0x2d, 0xf4, 0x51, 0x91, 0x7e, 0x65, 0xf0, 0x73, 0x30, 0xab, 0x9e, 0x57, 0x7b, 0xdc,
+	0xf1, 0xea, 0xfe, 0xf5, 0x70, 0xb1, 0x34, 0xb6, 0x2b, 0x55, 0x25, 0x37, 0x65, 0x53, 0x05, 0xa3,
+	0x06, 0x52, 0x1f, 0xf8, 0x41, 0x34, 0x1b, 0x3a, 0x4d, 0x58, 0x98, 0x6e, 0x60, 0x6f, 0xda, 0xbd,
+	0x70, 0x71, 0x82, 0x59, 0x75, 0x01, 0x06, 0xcd, 0x56, 0x75, 0x04, 0x49, 0xd2, 0xe1, 0xcf, 0xa0,
+	0xf9, 0xd0, 0xae, 0x05, 0x76, 0x44, 0xec, 0x86, 0x1d, 0xd8, 0x1e, 0xb5, 0xeb, 0x24, 0x1b, 0x7b,
+	0x1a, 0xc6, 0xce, 0x57, 0x53, 0x38, 0xd2, 0x47, 0x6d, 0xfe, 0x2e, 0x8f, 0xa6, 0x35, 0x27, 0x3a,
+	0x81, 0x68, 0xe4, 0x26, 0xa2, 0xd1, 0x95, 0x6c, 0x9c, 0x7f, 0x58, 0x38, 0xc2, 0x11, 0x2a, 0x85,
+	0x11, 0x18, 0x2c, 0x64, 0x0e, 0x3e, 0x7d, 0xef, 0x56, 0x46, 0xf2, 0x18, 0xcf, 0xca, 0x9c, 0x90,
+	0x58, 0xe2, 0xdf, 0x44, 0xc8, 0xc2, 0xcf, 0xa2, 0x29, 0xbf, 0x43, 0x83, 0x3e, 0xdd, 0x59, 0x05,
+	0x26, 0x78, 0x6d, 0x1c, 0x47, 0x8f, 0x79, 0x55, 0x66, 0x41, 0xd8, 0x94, 0xfc, 0x24, 0x4a, 0x8a,
+	0xf9, 0x9a, 0x81, 0x4e, 0x6b, 0x0a, 0xae, 0xfa, 0x5e, 0xdd, 0x61, 0x2b, 0x7a, 0x0e, 0x15, 0xa2,
+	0x5e, 0xc7, 0x66, 0xab, 0x39, 0xa5, 0x6c, 0xb4, 0x0b, 0x30, 0xc2, 0x30, 0x34, 0x84, 0xb5, 0xed,
+	0x30, 0xb4, 0x9a, 0x36, 0x5b, 0x14, 0xd8, 0xce, 0x82, 0x68, 0x62, 0x9b, 0x83, 0x49, 0x8c, 0xc7,
+	0x01, 0xc2, 0xae, 0x15, 0x46, 0xbb, 0x81, 0xe5, 0x85, 0x8c, 0xfd, 0x2e, 0x9c, 0x8e, 0xc2, 0xb4,
+	0x1f, 0x3b, 0x9c, 0xa3, 0xd0, 0x11, 0x95, 0xdb, 0x80, 0x3b, 0xde, 0xea, 0xe3, 0x44, 0x06, 0x70,
+	0x37, 0x9f, 0x45, 0xb7, 0x0d, 0x0e, 0x73, 0xf8, 0xc3, 0xb0, 0xb8, 0x76, 0x70, 0x60, 0x07, 0x62,
+	0x72, 0x6a, 0x39, 0x18, 0x94, 0x08, 0x2c, 0x5e, 0x46, 0x53, 0x9e, 0x05, 0x53, 0xe8, 0x58, 0xb5,
+	0x78, 0x8a, 0x0b, 0x82, 0x74, 0xea, 0x6a, 0x8c, 0x20, 0x8a, 0xc6, 0xfc, 0xab, 0x81, 0x4e, 0x69,
+	0x32, 0x4f, 0xe0, 0x14, 0xdb, 0x4f, 0x9e, 0x62, 0x97, 0xb2, 0x71, 0xd3, 0x21, 0xc7, 0xd8, 0x6f,
+	0xf2, 0x68, 0x41, 0x77, 0x66, 0x16, 0x04, 0xa9, 0x1b, 0x04, 0x70, 0x60, 0x3d, 0x4a, 0xb6, 0x84,
+	0x39, 0xa5, 0x1b, 0x10, 0x0e, 0x26, 0x31, 0x9e, 0xfa, 0x54, 0xc7, 0x8a, 0x5a, 0xc2, 0x96, 0xd2,
+	0xa7, 0x76, 0x00, 0x46, 0x18, 0x86, 0x9e, 0x2e, 0xb6, 0x77, 0xe0, 0x04, 0xbe, 0xd7, 0xb6, 0xbd,
+	0x28, 0x7d, 0xba, 0x5c, 0x54, 0x28, 0xa2, 0xd3, 0xe1, 0x47, 0xd0, 0x5c, 0x04, 0xb3, 0xa4, 0x21,
+	0xea, 0xc0, 0x09, 0xe3, 0xdd, 0x33, 0x55, 0xb9, 0x4d, 0x8c, 0x9c, 0xdb, 0x4d, 0x60, 0x49, 0x8a,
+	0x1a, 0xff, 0xd2, 0x40, 0x77, 0x80, 0xc9, 0x3a, 0xbe, 0x07, 0xdc, 0x76, 0xac, 0x00, 0x56, 0x34,
+	0xb2, 0x83, 0x6b, 0xe0, 0x04, 0x81, 0x53, 0x67, 0x87, 0x0e, 0xb5, 0xee, 0xf6, 0x18, 0xd6, 0x5d,
+	0xed, 0xe3, 0x5e, 0xb9, 0x4b, 0x28, 0x77, 0xc7, 0xea, 0x70, 0xc9, 0xe4, 0x46, 0x6a, 0xd1, 0x43,
+	0xf5, 0xc0, 0x72, 0xbb, 0x76, 0x78, 0xc9, 0x71, 0x6d, 0x7e, 0xfc, 0x88, 0x43, 0xf5, 0x31, 0x05,
+	0x26, 0x3a, 0x8d, 0xf9, 0x93, 0x62, 0xc2, 0x45, 0xab, 0x71, 0xb0, 0x63, 0x6b, 0x29, 0x1c, 0x34,
+	0xab, 0x60, 0xc7, 0x78, 0x6a, 0xbb, 0x8b, 0x27, 0x37, 0x42, 0x16, 0xfe, 0xba, 0xc1, 0x32, 0x89,
+	0x78, 0x57, 0x8a, 0xc0, 0x7e, 0x0c, 0x59, 0x8d, 0x9e, 0x9c, 0xc4, 0x40, 0xa2, 0x8b, 0xa6, 0x2e,
+	0xdc, 0xe1, 0xb9, 0x99, 0xf0, 0x38, 0xe9, 0xc2, 0x22, 0x65, 0x23, 0x31, 0x1e, 0x77, 0x11, 0xa2,
+	0x47, 0xf0, 0x8e, 0x0f, 0x92, 0x7a, 0x22, 0x46, 0x8f, 0x7b, 0xe0, 0x73, 0x66, 0x95, 0x39, 0x7a,
+	0xf6, 0xa9, 0x6f, 0xa2, 0x09, 0xc2, 0x3f, 0x82, 0x5c, 0x08, 0x8e, 0x70, 0x3f, 0xb0, 0xd7, 0x9c,
+	0x86, 0x3c, 0xb3, 0xb9, 0x5b, 0xee, 0x8e, 0x21, 0x3e, 0x4e, 0x65, 0x36, 0xd2, 0xbc, 0x2b, 0xb7,
+	0x0b, 0x13, 0x2c, 0xf4, 0xa1, 0x48, 0xbf, 0x26, 0x78, 0x0b, 0x9d, 0x0e, 0xc4, 0x66, 0x5a, 0x87,
+	0x28, 0xe5, 0x07, 0xbd, 0x2d, 0xa7, 0xed, 0x44, 0xe0, 0x92, 0xc6, 0xf9, 0x7c, 0x65, 0x11, 0xf8,
+	0x9c, 0x26, 0x03, 0xf0, 0x64, 0xe0, 0x28, 0xf3, 0xe5, 0x52, 0x32, 0xd0, 0xf0, 0xd3, 0xf1, 0x3b,
+	0x06, 0x9a, 0xa7, 0xbb, 0xc1, 0x0a, 0x9c, 0x10, 0x56, 0xd0, 0x0e, 0xbb, 0x6e, 0x24, 0x3c, 0x76,
+	0x73, 0xcc, 0x9d, 0xa9, 0xb3, 0xac, 0x2c, 0x8a, 0x99, 0xcf, 0xa7, 0x31, 0xa4, 0x4f, 0x3c, 0x6c,
+	0x9d, 0x89, 0x16, 0xd7, 0x5c, 0x44, 0xe0, 0x71, 0xca, 0xa4, 0x35, 0xbb, 0xe3, 0xfa, 0x3d, 0x1a,
+	0xd0, 0x36, 0xbc, 0x86, 0xaf, 0x9c, 0x50, 0xd8, 0x86, 0xc4, 0xa2, 0xf0, 0x97, 0xa0, 0x14, 0xec,
+	0xc4, 0xe1, 0x80, 0xa6, 0x28, 0xc7, 0x10, 0x9d, 0x64, 0x36, 0x26, 0x41, 0x21, 0xd1, 0x84, 0x62,
+	0x1f, 0x95, 0x5a, 0xb6, 0xe5, 0x42, 0x34, 0xe7, 0x9b, 0xe0, 0xf2, 0x18, 0xe2, 0xd7, 0x19, 0xa3,
+	0x74, 0x72, 0xc4, 0xa1, 0x44, 0x88, 0xc1, 0x5f, 0x85, 0x0a, 0x51, 0xe6, 0x2d, 0x94, 0xd6, 0x16,
+	0xb5, 0xc0, 0x46, 0x16, 0x29, 0x12, 0x63, 0x58, 0xc1, 0xf4, 0xac, 0x48, 0xc2, 0x48, 0x4a, 0x28,
+	0xfe, 0x32, 0x18, 0xbf, 0x16, 0xa7, 0x49, 0x71, 0xce, 0x7f, 0x2d, 0x9b, 0xb0, 0x25, 0xd3, 0x2f,
+	0x65, 0x7e, 0x09, 0x02, 0xf3, 0x2b, 0xb1, 0xe6, 0x3b, 0x06, 0x3a, 0xa3, 0x0d, 0x7c, 0xdc, 0x8a,
+	0x6a, 0xad, 0x8b, 0x07, 0xf4, 0x2c, 0xdc, 0x4c, 0x24, 0x6e, 0x0f, 0xea, 0x89, 0xdb, 0xbb, 0x6f,
+	0x9e, 0xfd, 0xc8, 0xb0, 0xd6, 0xc3, 0x75, 0xca, 0xa1, 0xcc, 0x58, 0x68, 0x39, 0xde, 0xf3, 0x68,
+	0x5a, 0xd3, 0x59, 0xc4, 0xe8, 0xac, 0xb2, 0x0c, 0x19, 0x98, 0x35, 0x20, 0xd1, 0xe5, 0x99, 0xdf,
+	0x35, 0xd0, 0x44, 0xc5, 0xaa, 0xed, 0xfb, 0x8d, 0x06, 0xfe, 0x38, 0x9a, 0xac, 0x77, 0x45, 0x6e,
+	0xcc, 0xe7, 0x26, 0x13, 0xa3, 0x35, 0x01, 0x27, 0x92, 0x02, 0x9b, 0xa8, 0xd4, 0xb0, 0x6a, 0xb0,
+	0x5b, 0x98, 0xce, 0xf9, 0x0a, 0xa2, 0x1e, 0x75, 0x89, 0x41, 0x88, 0xc0, 0xd0, 0x64, 0xa3, 0x6d,
+	0x7d, 0x21, 0x1e, 0x9c, 0x4e, 0x36, 0xb6, 0x15, 0x8a, 0xe8, 0x74, 0xe6, 0x9f, 0x73, 0x68, 0x62,
+	0xd5, 0xed, 0x86, 0xb0, 0x0d, 0x0e, 0x9d, 0x4a, 0x42, 0xe6, 0x43, 0xd3, 0xc4, 0x74, 0xe6, 0x43,
+	0xb3, 0x48, 0xc2, 0x30, 0xb8, 0x83, 0x4a, 0xb0, 0xbc, 0x0d, 0xa7, 0x29, 0xd2, 0xe2, 0xf5, 0x71,
+	0xb6, 0x33, 0xd7, 0x6e, 0x95, 0xf1, 0x53, 0x3a, 0xf1, 0x6f, 0x22, 0xe4, 0xe0, 0x97, 0x20, 0x5b,
+	0x85, 0x9f, 0x1e, 0x1c, 0x6b, 0x72, 0x47, 0x15, 0xc6, 0xae, 0xae, 0x56, 0x93, 0x1c, 0x2b, 0xef,
+	0x13, 0xd2, 0x4f, 0xa5, 0x10, 0x24, 0x2d, 0xdb, 0xfc, 0x45, 0x0e, 0xcd, 0x26, 0x34, 0xa7, 0x4b,
+	0xde, 0x05, 0x03, 0x32, 0xcb, 0xa5, 0x96, 0xfc, 0x51, 0x01, 0x27, 0x92, 0x82, 0x52, 0x77, 0xac,
+	0x30, 0xbc, 0xee, 0x07, 0x75, 0x61, 0x67, 0x49, 0xbd, 0x23, 0xe0, 0x44, 0x52, 0xd0, 0xc5, 0xdf,
+	0xb3, 0xad, 0xc0, 0x0e, 0x76, 0xfd, 0x7d, 0xbb, 0x6f, 0xf1, 0x2b, 0x0a, 0x45, 0x74, 0x3a, 0x66,
+	0xb4, 0xc8, 0x0d, 0x57, 0x5d, 0x07, 0x36, 0x0a, 0x57, 0x33, 0x03, 0xa3, 0xed, 0x6e, 0x55, 0x75,
+	0x8e, 0xca, 0x68, 0x29, 0x04, 0x49, 0xcb, 0x36, 0xff, 0x04, 0x59, 0x94, 0x30, 0xda, 0x09, 0x94,
+	0x1b, 0xcd, 0x64, 0xb9, 0x51, 0x19, 0xdf, 0x47, 0x87, 0x94, 0x1a, 0x6f, 0xe4, 0x51, 0xdf, 0xf1,
+	0x8b, 0x3f, 0x47, 0x03, 0x2f, 0x85, 0xd9, 0xf5, 0x95, 0xf8, 0xe4, 0x3f, 0x4a, 0xf5, 0xa8, 0xc5,
+	0xd4, 0x98, 0x0b, 0xd1, 0x38, 0xe2, 0x17, 0x0d, 0x25, 0x60, 0xd7, 0x17, 0xc1, 0x2e, 0xdb, 0x64,
+	0xb8, 0x4f, 0x85, 0x5d, 0x9f, 0x68, 0x32, 0xf1, 0xc3, 0xb2, 0xef, 0x50, 0x64, 0x0e, 0x69, 0x26,
+	0x3b, 0x05, 0xef, 0x26, 0xb2, 0x92, 0x54, 0xf7, 0xa0, 0x87, 0xa6, 0x02, 0xd9, 0x26, 0xe3, 0xc7,
+	0xd2, 0x7a, 0x06, 0xa9, 0x21, 0xdf, 0xc6, 0xb2, 0xf0, 0x55, 0xfd, 0x30, 0x25, 0x8d, 0x6e, 0xbd,
+	0x38, 0x91, 0x5b, 0x9c, 0x48, 0x6e, 0x3d, 0x59, 0x73, 0x49, 0x0a, 0xf3, 0x5b, 0x06, 0xc2, 0xfd,
+	0x19, 0x07, 0x2d, 0xb7, 0x65, 0xb1, 0x23, 0xb6, 0xbb, 0x94, 0x2a, 0xc9, 0x89, 0xa2, 0x39, 0x44,
+	0x50, 0xbd, 0x0b, 0x15, 0x59, 0xf1, 0x23, 0xb6, 0xb7, 0xf4, 0x35, 0x56, 0x1e, 0x11, 0x8e, 0x33,
+	0x7f, 0x0b, 0x5b, 0x3a, 0x15, 0x9c, 0x58, 0x5c, 0xe7, 0xeb, 0x90, 0x8e, 0xeb, 0x49, 0x9b, 0x1f,
+	0xa1, 0x07, 0xf2, 0x14, 0x1c, 0xa5, 0x11, 0x38, 0x77, 0x27, 0x62, 0xee, 0x7b, 0xf4, 0xe6, 0x07,
+	0xab, 0x0f, 0xb6, 0xfd, 0xba, 0xd3, 0x70, 0x98, 0xeb, 0xea, 0xec, 0xcc, 0xdf, 0x97, 0xd0, 0x5c,
+	0x32, 0x7f, 0x84, 0x52, 0xa5, 0xc4, 0xf2, 0x35, 0xde, 0x61, 0xce, 0x3c, 0x41, 0x94, 0x26, 0x61,
+	0x20, 0x30, 0x09, 0x17, 0x96, 0xf0, 0x85, 0xdc, 0x28, 0x5f, 0x18, 0x59, 0x79, 0xe7, 0xff, 0x37,
+	0x2b, 0x6f, 0x08, 0x45, 0x75, 0x66, 0x6d, 0xb6, 0x96, 0x85, 0x9b, 0x0f, 0x45, 0x6b, 0x92, 0x0b,
+	0xd1, 0x38, 0xe2, 0x25, 0x94, 0x73, 0xea, 0x2c, 0x06, 0x40, 0xea, 0x22, 0x68, 0x73, 0x1b, 0x6b,
+	0x04, 0xa0, 0xf8, 0x01, 0x54, 0xac, 0x59, 0x70, 0xea, 0xb1, 0xe2, 0x6a, 0xaa, 0x72, 0x2e, 0x76,
+	0xea, 0x55, 0x0a, 0x84, 0x08, 0x71, 0x4a, 0xf9, 0x01, 0x03, 0x11, 0x4e, 0x8e, 0xcb, 0x08, 0x05,
+	0xbe, 0xeb, 0xee, 0x41, 0x3e, 0xb5, 0xb1, 0xc6, 0xb6, 0x69, 0x9e, 0xfb, 0x14, 0x91, 0x50, 0xa2,
+	0x51, 0x68, 0x6d, 0x81, 0xc9, 0x13, 0x6c, 0x0b, 0x7c, 0x05, 0x0e, 0x34, 0xc7, 0x83, 0x2c, 0x97,
+	0x5e, 0x7d, 0x55, 0x7a, 0x8b, 0x53, 0x4c, 0xf6, 0x76, 0x16, 0x39, 0xfe, 0x06, 0x67, 0xeb, 0x07,
+	0xea, 0x9c, 0xdf, 0x50, 0x92, 0x88, 0x2e, 0xd6, 0xfc, 0x4f, 0x0e, 0xcd, 0x5d, 0xee, 0x5a, 0x41,
+	0x3d, 0xb0, 0x1c, 0x97, 0xc7, 0x84, 0x38, 0xdc, 0x18, 0x43, 0xc3, 0x4d, 0x22, 0x82, 0xe5, 0x0e,
+	0x11, 0xc1, 0x20, 0x3e, 0xb9, 0xf6, 0x81, 0xed, 0xa6, 0xe3, 0xd3, 0x16, 0x05, 0x12, 0x8e, 0xd3,
+	0x63, 0x4c, 0x61, 0x44, 0x8c, 0x91, 0xf1, 0x8e, 0x7b, 0xce, 0xc0, 0x78, 0xc7, 0x84, 0x6a, 0xc5,
+	0xb9, 0x12, 0xca, 0x2a, 0x72, 0x8e, 0xa3, 0x93, 0xed, 0x82, 0x39, 0x44, 0x34, 0x97, 0x93, 0x7d,
+	0x14, 0x60, 0x84, 0x61, 0xf0, 0x13, 0x08, 0xb5, 0x65, 0x30, 0x12, 0x2e, 0x32, 0x4e, 0x38, 0xd3,
+	0xb8, 0x99, 0x6f, 0x19, 0x68, 0x46, 0x2f, 0x0a, 0x0f, 0x1d, 0x8f, 0x3f, 0x85, 0x66, 0xf9, 0xaf,
+	0x35, 0x10, 0xe5, 0xb8, 0xa1, 0x58, 0x85, 0x33, 0x82, 0x7c, 0xb6, 0xaa, 0x23, 0x49, 0x92, 0x16,
+	0xbb, 0x68, 0x1e, 0xdc, 0xaa, 0x09, 0xc7, 0x5a, 0xe8, 0x78, 0xcd, 0xaa, 0xe3, 0xd5, 0x6e, 0xa6,
+	0x47, 0xcd, 0xae, 0x50, 0x76, 0x52, 0x7c, 0x48, 0x1f, 0x67, 0xf3, 0xdf, 0x39, 0x84, 0xd6, 0x7d,
+	0x7f, 0x5f, 0xcc, 0x70, 0xb4, 0x77, 0x01, 0xc5, 0xbe, 0xe3, 0xd5, 0xd3, 0xc7, 0xdd, 0x26, 0xc0,
+	0x08, 0xc3, 0xe0, 0x7b, 0x11, 0x02, 0x7d, 0x1e, 0x83, 0xf2, 0x5c, 0xd5, 0x33, 0x32, 0xd2, 0xac,
+	0xec, 0x6c, 0x08, 0x0c, 0xd1, 0xa8, 0x20, 0x5c, 0xf3, 0x72, 0x91, 0xbb, 0xd6, 0x62, 0xaa, 0x5c,
+	0x9c, 0xa4, 0x1a, 0x6a, 0xf5, 0xe0, 0x85, 0x54, 0x7e, 0x72, 0xae, 0x2f, 0x3f, 0x51, 0xe5, 0xf3,
+	0x4e, 0xcb, 0x0a, 0xed, 0x41, 0x27, 0x65, 0x69, 0x84, 0x17, 0xc3, 0x62, 0xfb, 0xdd, 0xa8, 0xd3,
+	0x8d, 0xbd, 0x4f, 0x2e, 0xf6, 0x35, 0x06, 0x25, 0x02, 0x9b, 0xec, 0xcf, 0x4f, 0x1e, 0xa2, 0x3f,
+	0xff, 0xeb, 0x3c, 0x5a, 0xdc, 0xb6, 0x3c, 0x90, 0x51, 0x97, 0xf8, 0xed, 0x38, 0xb7, 0xfd, 0x9a,
+	0x81, 0x4a, 0xae, 0xb5, 0x67, 0xbb, 0xf1, 0x79, 0xf9, 0xf4, 0x18, 0x31, 0x67, 0x98, 0x94, 0xf2,
+	0x16, 0x93, 0x70, 0xd1, 0x8b, 0x82, 0x9e, 0x9a, 0x17, 0x07, 0x12, 0x21, 0x1e, 0xff, 0x10, 0x42,
+	0xa0, 0xe5, 0x79, 0x7e, 0x94, 0xb8, 0xef, 0xad, 0x1f, 0x87, 0x3a, 0x2b, 0x4a, 0x0c, 0xd7, 0x49,
+	0xd5, 0xe4, 0x0a, 0x43, 0x74, 0x6d, 0x96, 0x1e, 0x42, 0xd3, 0xda, 0x24, 0xf0, 0x3c, 0xca, 0xef,
+	0xdb, 0x3d, 0xee, 0xb6, 0x84, 0xfe, 0xc4, 0xa7, 0xe3, 0x20, 0xc4, 0x1c, 0x55, 0x44, 0x9d, 0x87,
+	0x73, 0x17, 0x8c, 0xa5, 0x47, 0xd0, 0x7c, 0x5a, 0xe0, 0x51, 0xc6, 0x9b, 0x7f, 0xcb, 0x23, 0x75,
+	0x8b, 0x85, 0x1b, 0xa8, 0x40, 0x3b, 0xa4, 0xa2, 0x10, 0x58, 0x1f, 0xb3, 0x09, 0xab, 0x2e, 0xcb,
+	0x26, 0xd9, 0x5d, 0x20, 0x80, 0x08, 0xe3, 0x8f, 0x0f, 0x20, 0xa1, 0x11, 0xa7, 0x62, 0x06, 0x35,
+	0x41, 0x7c, 0xd8, 0x2a, 0x79, 0x33, 0x2c, 0x35, 0x12, 0x60, 0x22, 0x65, 0x61, 0x07, 0x15, 0x03,
+	0x1b, 0x4c, 0x94, 0x41, 0x43, 0x80, 0x50, 0x3e, 0xd5, 0x88, 0x3e, 0x27, 0x69, 0xf6, 0x2a, 0x53,
+	0x34, 0xda, 0x33, 0x10, 0xe1, 0x12, 0xfa, 0x0e, 0xdd, 0xc2, 0x7b, 0x73, 0xe8, 0x86, 0x08, 0xf7,
+	0x8f, 0x3b, 0x62, 0x17, 0x00, 0x82, 0x82, 0xd5, 0x8d, 0xfc, 0x36, 0x65, 0xc9, 0x96, 0x6b, 0x52,
+	0x05, 0x85, 0x95, 0x18, 0x41, 0x14, 0x8d, 0xf9, 0x4e, 0x11, 0xa5, 0x5a, 0x7e, 0x90, 0x39, 0x6b,
+	0xf7, 0xb0, 0x46, 0x86, 0xf7, 0xb0, 0x52, 0x93, 0x41, 0x77, 0xb1, 0xf8, 0x7e, 0x54, 0xec, 0xd0,
+	0x98, 0x29, 0x22, 0xfc, 0xd9, 0xf8, 0x60, 0x66, 0x81, 0x74, 0x40, 0x68, 0xe5, 0xd4, 0x7a, 0x64,
+	0xcd, 0x8f, 0x88, 0xac, 0x2f, 0xf0, 0xdb, 0x0b, 0xd1, 0x3b, 0xe7, 0xab, 0x7c, 0x35, 0xab, 0x8d,
+	0x23, 0xda, 0xe7, 0xf2, 0x1a, 0x43, 0x34, 0xcd, 0x35, 0x89, 0xf8, 0x1b, 0x06, 0x9a, 0x8b, 0xfd,
+	0x5b, 0x28, 0x51, 0x3c, 0x16, 0x25, 0x58, 0x23, 0x97, 0x24, 0x24, 0x91, 0x94, 0x64, 0xfc, 0x24,
+	0x9a, 0x82, 0xb3, 0x29, 0xe0, 0xe5, 0x58, 0xe9, 0xc8, 0xe7, 0xbc, 0x5c, 0xcb, 0x6a, 0xcc, 0x84,
+	0x28, 0x7e, 0x34, 0x3b, 0x6a, 0x80, 0x6b, 0x87, 0x2d, 0xc6, 0x7d, 0xe2, 0xe6, 0xb2, 0xa3, 0x4b,
+	0x92, 0x03, 0xd1, 0xb8, 0xd1, 0x63, 0x9e, 0x6d, 0xdb, 0x55, 0xbf, 0xeb, 0xf1, 0xcc, 0x2b, 0xaf,
+	0x8e, 0x79, 0x22, 0x31, 0x44, 0xa3, 0x32, 0x5f, 0x40, 0xb7, 0xa6, 0x5f, 0xb4, 0x6c, 0x42, 0xac,
+	0x85, 0x5c, 0xb0, 0x19, 0xf8, 0xdd, 0x8e, 0xd8, 0x58, 0x32, 0x17, 0xbc, 0x4c, 0x81, 0x84, 0xe3,
+	0x0e, 0x91, 0x78, 0xc4, 0xc9, 0x4b, 0x7e, 0x58, 0xf2, 0x62, 0xfe, 0xc0, 0x40, 0xe7, 0x46, 0x3d,
+	0xbc, 0x81, 0x48, 0x5b, 0xe2, 0x57, 0x4b, 0xe2, 0x04, 0xbe, 0x9a, 0xe1, 0x2b, 0x1f, 0x98, 0xad,
+	0x3a, 0x70, 0xf9, 0x9d, 0x16, 0x11, 0xd2, 0xe8, 0x7d, 0x13, 0x62, 0x8f, 0xae, 0x1c, 0x76, 0xbd,
+	0x02, 0xb3, 0xa1, 0x37, 0xd6, 0xe9, 0x54, 0x8c, 0x52, 0x10, 0x86, 0x49, 0x84, 0xa4, 0xdc, 0x91,
+	0x1a, 0x93, 0xf9, 0x91, 0x8d, 0x49, 0x9a, 0xc2, 0x86, 0xad, 0x9d, 0xc0, 0x39, 0x80, 0x50, 0x04,
+	0x5a, 0x8b, 0xcc, 0x4c, 0xa5, 0xb0, 0xd5, 0x75, 0x85, 0x24, 0x49, 0xda, 0x81, 0x3d, 0xdd, 0xe2,
+	0x7b, 0xd7, 0xd3, 0xc5, 0x3d, 0x99, 0x53, 0x95, 0xc6, 0x7e, 0xb4, 0xa6, 0x56, 0xe8, 0x50, 0x59,
+	0xd4, 0x4b, 0xa9, 0x2c, 0x6a, 0x82, 0x29, 0xf0, 0x58, 0x36, 0x0a, 0x1c, 0x3d, 0x6f, 0xc2, 0x2b,
+	0xe8, 0x54, 0xdd, 0x6e, 0x58, 0x34, 0x12, 0xc5, 0xed, 0x11, 0x9e, 0xb3, 0x4a, 0x6b, 0xae, 0x25,
+	0xd1, 0x24, 0x4d, 0xff, 0x5e, 0xa6, 0x5e, 0xf4, 0x7d, 0xa6, 0x9a, 0xff, 0xff, 0xd7, 0xfb, 0x4c,
+	0xa5, 0xf7, 0x90, 0x6e, 0xf3, 0xbf, 0x60, 0xd7, 0xc4, 0x71, 0x22, 0x2e, 0x06, 0xb3, 0xa8, 0xc7,
+	0x12, 0x05, 0x4a, 0x7e, 0x74, 0x81, 0x72, 0x94, 0x52, 0xff, 0xd3, 0xa9, 0x4a, 0xec, 0x83, 0x7d,
+	0x95, 0x18, 0x96, 0x1d, 0x5c, 0x38, 0x20, 0x93, 0x75, 0xb2, 0xf9, 0x4f, 0x03, 0xdd, 0x3e, 0xf4,
+	0xee, 0xff, 0xc4, 0x4e, 0x85, 0xa4, 0x81, 0x0a, 0x87, 0x30, 0xd0, 0x7d, 0x68, 0xe6, 0x99, 0x10,
+	0xf2, 0x1f, 0xdf, 0xf1, 0xd8, 0xd5, 0x77, 0x91, 0x3d, 0x79, 0x99, 0xa7, 0x6f, 0x56, 0xaf, 0x54,
+	0xaf, 0x5d, 0x8d, 0xe1, 0x24, 0x41, 0x65, 0xfe, 0xcc, 0x40, 0x33, 0xf1, 0x6c, 0xaf, 0xfa, 0x75,
+	0xd6, 0x02, 0x09, 0x59, 0x6c, 0x4c, 0x4d, 0x90, 0x47, 0x31, 0x8e, 0x83, 0x2c, 0x70, 0x12, 0x5c,
+	0xd8, 0xad, 0x83, 0x51, 0x84, 0x13, 0x5e, 0xce, 0xa0, 0x9d, 0x4e, 0xe5, 0x2b, 0xc7, 0x5f, 0x15,
+	0x02, 0x88, 0x14, 0x65, 0xfe, 0x2a, 0x8f, 0x66, 0x13, 0xbd, 0x77, 0x7a, 0x55, 0xc5, 0xdf, 0x2b,
+	0x55, 0x35, 0x9d, 0x65, 0xc0, 0xd9, 0x55, 0x28, 0xa2, 0xd3, 0x51, 0xe3, 0xba, 0xce, 0x01, 0xe7,
+	0x91, 0xee, 0x46, 0x6d, 0xc5, 0x08, 0xa2, 0x68, 0xb4, 0xcb, 0x87, 0xfc, 0x91, 0x2f, 0x1f, 0xbe,
+	0x67, 0x20, 0xcc, 0xa6, 0x40, 0x39, 0xab, 0xd7, 0xba, 0x85, 0x6c, 0xed, 0xb6, 0x24, 0x34, 0xc2,
+	0xab, 0x7d, 0xa2, 0xc8, 0x00, 0xf1, 0xda, 0x23, 0x85, 0xe2, 0x89, 0x3c, 0x52, 0x30, 0x7f, 0x6c,
+	0xd0, 0xc5, 0xd3, 0x8a, 0x2d, 0xd5, 0x6d, 0x33, 0x6e, 0xd0, 0x6d, 0x73, 0xd0, 0xc4, 0x1e, 0xbf,
+	0xe6, 0x16, 0x15, 0xe6, 0x38, 0x37, 0x6b, 0xe2, 0xc2, 0xbc, 0x32, 0x4d, 0xe3, 0x86, 0xf8, 0x20,
+	0x31, 0x7f, 0xf3, 0x39, 0xb4, 0xd0, 0x57, 0x82, 0x8a, 0x76, 0xb3, 0x31, 0xb0, 0xdd, 0x0c, 0x13,
+	0xe8, 0x04, 0x5d, 0xcf, 0x16, 0xc5, 0x94, 0x9c, 0xc0, 0x0e, 0x05, 0x12, 0x8e, 0xa3, 0x2d, 0x9b,
+	0x3a, 0x94, 0x93, 0x5d, 0xde, 0x75, 0x9a, 0x54, 0xf6, 0x59, 0x63, 0x50, 0x22, 0xb0, 0xe6, 0xdb,
+	0xe0, 0xdc, 0x89, 0x7c, 0x3d, 0x71, 0x5d, 0x60, 0x8c, 0xbc, 0x2e, 0xc8, 0x52, 0x19, 0xfc, 0x3c,
+	0x9a, 0x09, 0x59, 0x68, 0xe4, 0x4b, 0x95, 0xc1, 0x43, 0x96, 0xaa, 0xc6, 0x8e, 0x47, 0x25, 0x1d,
+	0x42, 0x12, 0xe2, 0xe8, 0x2b, 0x1e, 0xed, 0xc2, 0x8e, 0xbf, 0xe5, 0xda, 0xc9, 0xb0, 0x0e, 0xe2,
+	0x7d, 0xf6, 0x1b, 0x5f, 0xdc, 0x55, 0xd1, 0x99, 0xd0, 0x76, 0x1b, 0xd4, 0x8b, 0x57, 0xf8, 0x6d,
+	0x52, 0xc8, 0xab, 0x0a, 0xde, 0x1b, 0xfe, 0x80, 0x18, 0x7c, 0xa6, 0x3a, 0x88, 0x88, 0x0c, 0x1e,
+	0x6b, 0xbe, 0x68, 0xa0, 0x33, 0x03, 0x95, 0x39, 0xb9, 0x72, 0xe3, 0xa7, 0x39, 0x74, 0xeb, 0x80,
+	0xba, 0x10, 0x5f, 0xd7, 0x4d, 0xce, 0x8b, 0x8c, 0x2b, 0x19, 0x04, 0x27, 0x91, 0x34, 0xf0, 0x77,
+	0xd6, 0x23, 0x6f, 0x48, 0x47, 0xdf, 0x8a, 0x35, 0x50, 0xb1, 0xe5, 0xfb, 0xfb, 0xf1, 0xf5, 0xd7,
+	0x38, 0xc9, 0x8f, 0x6a, 0x31, 0xf3, 0xbe, 0x0f, 0xfd, 0x86, 0xc4, 0x87, 0xb1, 0x37, 0x5f, 0xcb,
+	0x23, 0xed, 0xc5, 0x21, 0xfe, 0xa2, 0xde, 0x3b, 0x31, 0x32, 0x29, 0xcc, 0x39, 0x67, 0xd9, 0x78,
+	0xe1, 0x16, 0x1a, 0xd4, 0x87, 0x51, 0xed, 0xae, 0xdc, 0xb1, 0xb7, 0xbb, 0x7e, 0x6e, 0xa0, 0xc5,
+	0xf6, 0x90, 0x96, 0xa8, 0xe8, 0xb6, 0x55, 0x8f, 0xa1, 0xdb, 0x5a, 0x79, 0x3f, 0x68, 0x32, 0xb4,
+	0x01, 0x4d, 0x86, 0xaa, 0xc4, 0xfe, 0xf3, 0x0c, 0x73, 0x66, 0x5e, 0xc9, 0xe8, 0xff, 0x79, 0x46,
+	0x81, 0x89, 0x4e, 0x63, 0xb6, 0xb8, 0xff, 0xa7, 0xcc, 0xaf, 0xe2, 0xa7, 0x71, 0x83, 0xf8, 0x09,
+	0xbe, 0x1a, 0x6f, 0x6c, 0x11, 0x67, 0xa5, 0xaf, 0xc6, 0x71, 0x80, 0x48, 0x0a, 0xf3, 0x1f, 0x90,
+	0x5c, 0xe9, 0x51, 0x0e, 0xb7, 0x51, 0x91, 0x9a, 0xa5, 0x97, 0xc1, 0x6b, 0x62, 0x9d, 0x2f, 0xbd,
+	0x42, 0x14, 0x8b, 0xc9, 0x7e, 0x12, 0x2e, 0x05, 0xfc, 0xa6, 0x40, 0x9d, 0x59, 0xb8, 0xcd, 0x66,
+	0x46, 0xd2, 0xe8, 0x36, 0xe1, 0x9d, 0x60, 0xfa, 0x8b, 0x30, 0x11, 0xe6, 0x05, 0xb4, 0xd0, 0xa7,
+	0x11, 0x35, 0x69, 0xc3, 0x8f, 0x1f, 0x4f, 0x6b, 0x26, 0xbd, 0x44, 0x81, 0x84, 0xe3, 0xe8, 0x7f,
+	0xde, 0x9b, 0x4f, 0xb3, 0xc7, 0xdf, 0x37, 0xd0, 0x42, 0x98, 0xe6, 0x77, 0x2c, 0x56, 0x93, 0x8f,
+	0x79, 0xfb, 0x50, 0xa4, 0x5f, 0x83, 0xa3, 0xff, 0xbf, 0x87, 0x3f, 0xe4, 0x78, 0x18, 0xe1, 0xff,
+	0x31, 0x49, 0x06, 0x70, 0x63, 0x68, 0x00, 0xa7, 0x1e, 0x56, 0x6b, 0xd9, 0xf5, 0xae, 0xdb, 0xd7,
+	0x3f, 0xa9, 0x0a, 0x38, 0x91, 0x14, 0x89, 0x97, 0x7f, 0xf9, 0x91, 0x2f, 0xff, 0xa0, 0x44, 0xd0,
+	0xac, 0x12, 0xef, 0x16, 0x76, 0x18, 0x6b, 0xb7, 0xd0, 0x50, 0x22, 0xe8, 0x54, 0xf4, 0x72, 0x5c,
+	0xce, 0x27, 0x2e, 0x2b, 0x58, 0x0f, 0x4e, 0x4e, 0x38, 0x24, 0x1a, 0x05, 0x3e, 0x0f, 0xc5, 0x01,
+	0x7f, 0xc1, 0x14, 0xbf, 0xbb, 0x67, 0x6d, 0x7c, 0xf1, 0xaa, 0x29, 0x24, 0x12, 0x4b, 0xbb, 0x75,
+	0xb0, 0xb1, 0xbb, 0x96, 0x4b, 0x2d, 0xc4, 0x3a, 0x81, 0x93, 0xaa, 0x5b, 0xb7, 0x2d, 0x31, 0x44,
+	0xa3, 0xa2, 0x7b, 0x2a, 0xfd, 0xf4, 0x8b, 0x5a, 0xc1, 0xf1, 0x42, 0xbb, 0xd6, 0x0d, 0x62, 0x57,
+	0x93, 0x56, 0xd8, 0x10, 0x70, 0x22, 0x29, 0xa8, 0x54, 0xfe, 0xf4, 0xf0, 0xaa, 0xea, 0x51, 0x49,
+	0xa9, 0x55, 0x89, 0x21, 0x1a, 0x15, 0x9b, 0x93, 0x1d, 0x44, 0x6b, 0x71, 0x14, 0x9c, 0x11, 0x73,
+	0x12, 0x30, 0x22, 0xb1, 0xf8, 0x43, 0x68, 0x62, 0xdf, 0xee, 0x31, 0xc2, 0x02, 0x23, 0x64, 0xb9,
+	0xe6, 0x26, 0x07, 0x91, 0x18, 0x47, 0x1f, 0x61, 0xd6, 0x2c, 0x46, 0x55, 0x64, 0x54, 0xec, 0x11,
+	0xe6, 0xea, 0x0a, 0x23, 0x12, 0x98, 0x4a, 0xf9, 0x95, 0xb7, 0xee, 0xbc, 0xe5, 0x55, 0xf8, 0x7b,
+	0x1d, 0xfe, 0x5e, 0x7c, 0xfb, 0x4e, 0xe3, 0x15, 0xf8, 0x7b, 0x15, 0xfe, 0x5e, 0x87, 0xbf, 0xbf,
+	0xc3, 0xdf, 0xb7, 0xdf, 0xb9, 0xf3, 0x96, 0x27, 0x26, 0x63, 0xe7, 0xfe, 0x2f, 0x5c, 0x61, 0x1c,
+	0x3a, 0x90, 0x3c, 0x00, 0x00,
 }
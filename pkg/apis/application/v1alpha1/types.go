@@ -2,9 +2,13 @@ package v1alpha1
 
 import (
 	"encoding/json"
+	"fmt"
+	"path"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/robfig/cron"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/watch"
@@ -24,6 +28,36 @@ type SyncOperation struct {
 	DryRun bool `json:"dryRun,omitempty" protobuf:"bytes,3,opt,name=dryRun"`
 	// SyncStrategy describes how to perform the sync
 	SyncStrategy *SyncStrategy `json:"syncStrategy,omitempty" protobuf:"bytes,4,opt,name=syncStrategy"`
+	// Resources describes which resources to sync. All resources are synced if empty.
+	Resources []SyncOperationResource `json:"resources,omitempty" protobuf:"bytes,5,opt,name=resources"`
+	// SelfHealAttemptsCount is the number of consecutive self-heals of the application which led to the
+	// sync, and determines the backoff of the next self-heal
+	SelfHealAttemptsCount int64 `json:"selfHealAttemptsCount,omitempty" protobuf:"bytes,6,opt,name=selfHealAttemptsCount"`
+}
+
+// SyncOperationResource identifies a resource to sync in a selective sync
+type SyncOperationResource struct {
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind  string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	Name  string `json:"name" protobuf:"bytes,3,opt,name=name"`
+}
+
+// HasIdentity returns whether the object is the resource
+func (r SyncOperationResource) HasIdentity(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().Group == r.Group && obj.GetKind() == r.Kind && obj.GetName() == r.Name
+}
+
+// IsResourceIncluded returns whether the object is synced by the operation
+func (o *SyncOperation) IsResourceIncluded(obj *unstructured.Unstructured) bool {
+	if len(o.Resources) == 0 {
+		return true
+	}
+	for _, r := range o.Resources {
+		if r.HasIdentity(obj) {
+			return true
+		}
+	}
+	return false
 }
 
 type RollbackOperation struct {
@@ -36,6 +70,85 @@ type RollbackOperation struct {
 type Operation struct {
 	Sync     *SyncOperation     `json:"sync,omitempty" protobuf:"bytes,1,opt,name=sync"`
 	Rollback *RollbackOperation `json:"rollback,omitempty" protobuf:"bytes,2,opt,name=rollback"`
+	// Retry controls the retries of the operation when it fails
+	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
+	// InitiatedBy is who initiated the operation
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,4,opt,name=initiatedBy"`
+}
+
+// OperationInitiator is who initiated an operation: a user, or the controller for automated syncs
+type OperationInitiator struct {
+	// Username is the name of the user who initiated the operation
+	Username string `json:"username,omitempty" protobuf:"bytes,1,opt,name=username"`
+	// Automated is true if the operation was initiated by the automated sync policy
+	Automated bool `json:"automated,omitempty" protobuf:"bytes,2,opt,name=automated"`
+}
+
+const (
+	// DefaultSyncRetryDuration is the default delay before the first retry of a failed operation
+	DefaultSyncRetryDuration = 5 * time.Second
+	// DefaultSyncRetryMaxDuration is the default maximum delay between retries of a failed operation
+	DefaultSyncRetryMaxDuration = 3 * time.Minute
+	// DefaultSyncRetryFactor is the default factor by which the delay grows after each retry
+	DefaultSyncRetryFactor = int64(2)
+)
+
+// RetryStrategy controls the retries of a failed operation
+type RetryStrategy struct {
+	// Limit is the maximum number of retries of a failed operation
+	Limit int64 `json:"limit,omitempty" protobuf:"bytes,1,opt,name=limit"`
+	// Backoff controls the delays between retries
+	Backoff *Backoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+}
+
+// Backoff is the exponential backoff of the retries of a failed operation
+type Backoff struct {
+	// Duration is the delay before the first retry, e.g. 5s (default: 5s)
+	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+	// Factor multiplies the delay after each retry (default: 2)
+	Factor *int64 `json:"factor,omitempty" protobuf:"bytes,2,opt,name=factor"`
+	// MaxDuration is the maximum delay between retries, e.g. 3m (default: 3m)
+	MaxDuration string `json:"maxDuration,omitempty" protobuf:"bytes,3,opt,name=maxDuration"`
+}
+
+// Validate returns an error if the retry strategy is invalid
+func (r *RetryStrategy) Validate() error {
+	_, err := r.NextRetryAt(time.Time{}, 0)
+	if err == nil && r.Limit < 0 {
+		err = fmt.Errorf("retry limit must not be negative")
+	}
+	return err
+}
+
+// NextRetryAt returns the time of the next retry of a failed operation, after retryCount retries
+// already failed. The last failure happened at lastAttempt.
+func (r *RetryStrategy) NextRetryAt(lastAttempt time.Time, retryCount int64) (time.Time, error) {
+	duration, maxDuration, factor := DefaultSyncRetryDuration, DefaultSyncRetryMaxDuration, DefaultSyncRetryFactor
+	if b := r.Backoff; b != nil {
+		var err error
+		if b.Duration != "" {
+			if duration, err = time.ParseDuration(b.Duration); err != nil {
+				return time.Time{}, fmt.Errorf("invalid retry backoff duration '%s': %v", b.Duration, err)
+			}
+		}
+		if b.MaxDuration != "" {
+			if maxDuration, err = time.ParseDuration(b.MaxDuration); err != nil {
+				return time.Time{}, fmt.Errorf("invalid retry backoff max duration '%s': %v", b.MaxDuration, err)
+			}
+		}
+		if b.Factor != nil {
+			if factor = *b.Factor; factor < 1 {
+				return time.Time{}, fmt.Errorf("retry backoff factor must be at least 1")
+			}
+		}
+	}
+	for i := int64(0); i < retryCount && duration < maxDuration; i++ {
+		duration *= time.Duration(factor)
+	}
+	if duration > maxDuration {
+		duration = maxDuration
+	}
+	return lastAttempt.Add(duration), nil
 }
 
 type OperationPhase string
@@ -74,8 +187,11 @@ type OperationState struct {
 	RollbackResult *SyncOperationResult `json:"rollbackResult,omitempty" protobuf:"bytes,5,opt,name=rollbackResult"`
 	// StartedAt contains time of operation start
 	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,6,opt,name=startedAt"`
-	// FinishedAt contains time of operation completion
+	// FinishedAt contains time of operation completion. While a failed operation is retried, it
+	// contains the time of the last failure.
 	FinishedAt *metav1.Time `json:"finishedAt" protobuf:"bytes,7,opt,name=finishedAt"`
+	// RetryCount is the number of retries of the operation after it failed
+	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
 }
 
 // SyncStrategy indicates the
@@ -99,6 +215,9 @@ type SyncStrategyApply struct {
 type SyncStrategyHook struct {
 	// Embed SyncStrategyApply type to inherit any `apply` options
 	SyncStrategyApply `protobuf:"bytes,1,opt,name=syncStrategyApply"`
+	// Namespace is the namespace in which hook resources are created, instead of the application
+	// destination namespace. Hooks created in this namespace are deleted once they complete.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
 }
 
 type HookType string
@@ -108,11 +227,10 @@ const (
 	HookTypeSync     HookType = "Sync"
 	HookTypePostSync HookType = "PostSync"
 	HookTypeSkip     HookType = "Skip"
-
-	// NOTE: we may consider adding SyncFail hook. With a SyncFail hook, finalizer-like logic could
-	// be implemented by specifying both PostSync,SyncFail in the hook annotation:
+	// HookTypeSyncFail hooks run when a hook sync fails. Finalizer-like logic can be implemented by
+	// specifying both PostSync,SyncFail in the hook annotation:
 	// (e.g.: argocd.argoproj.io/hook: PostSync,SyncFail)
-	//HookTypeSyncFail     HookType = "SyncFail"
+	HookTypeSyncFail HookType = "SyncFail"
 )
 
 type HookDeletePolicy string
@@ -120,6 +238,9 @@ type HookDeletePolicy string
 const (
 	HookDeletePolicyHookSucceeded HookDeletePolicy = "HookSucceeded"
 	HookDeletePolicyHookFailed    HookDeletePolicy = "HookFailed"
+	// HookDeletePolicyBeforeHookCreation deletes the hook resource left by a previous sync before
+	// the hook is created again
+	HookDeletePolicyBeforeHookCreation HookDeletePolicy = "BeforeHookCreation"
 )
 
 // HookStatus contains status about a hook invocation
@@ -130,12 +251,16 @@ type HookStatus struct {
 	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
 	// APIVersion is the resource API version
 	APIVersion string `json:"apiVersion" protobuf:"bytes,3,opt,name=apiVersion"`
-	// Type is the type of hook (e.g. PreSync, Sync, PostSync, Skip)
+	// Type is the type of hook (e.g. PreSync, Sync, PostSync, SyncFail, Skip)
 	Type HookType `json:"type" protobuf:"bytes,4,opt,name=type"`
 	// Status a simple, high-level summary of where the resource is in its lifecycle
 	Status OperationPhase `json:"status" protobuf:"bytes,5,opt,name=status"`
 	// A human readable message indicating details about why the resource is in this condition.
 	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+	// Output is a bounded copy of the logs and exit codes of the hook pods, captured when the hook completed
+	Output string `json:"output,omitempty" protobuf:"bytes,7,opt,name=output"`
+	// Namespace is the namespace in which the hook resource was created
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,8,opt,name=namespace"`
 }
 
 // SyncOperationResult represent result of sync operation
@@ -176,8 +301,33 @@ type DeploymentInfo struct {
 	ComponentParameterOverrides []ComponentParameter `json:"componentParameterOverrides,omitempty" protobuf:"bytes,3,opt,name=componentParameterOverrides"`
 	DeployedAt                  metav1.Time          `json:"deployedAt" protobuf:"bytes,4,opt,name=deployedAt"`
 	ID                          int64                `json:"id" protobuf:"bytes,5,opt,name=id"`
+	// Cause is what caused the deployment: Sync (default) or Rollback
+	Cause DeploymentCause `json:"cause,omitempty" protobuf:"bytes,6,opt,name=cause,casttype=DeploymentCause"`
+	// RollbackID is the ID of the deployment which was redeployed by a rollback
+	RollbackID *int64 `json:"rollbackID,omitempty" protobuf:"bytes,7,opt,name=rollbackID"`
+	// Source is a snapshot of the source of the application at the time of the deployment
+	Source ApplicationSource `json:"source,omitempty" protobuf:"bytes,8,opt,name=source"`
+	// InitiatedBy is who initiated the operation which deployed the application
+	InitiatedBy OperationInitiator `json:"initiatedBy,omitempty" protobuf:"bytes,9,opt,name=initiatedBy"`
 }
 
+// DeploymentCause is what caused a deployment of an application
+type DeploymentCause string
+
+const (
+	DeploymentCauseSync     DeploymentCause = "Sync"
+	DeploymentCauseRollback DeploymentCause = "Rollback"
+)
+
+// RefreshType is the type of a refresh of an application. A normal refresh compares the live state
+// with the manifests cached by the repo server, a hard refresh regenerates the manifests.
+type RefreshType string
+
+const (
+	RefreshTypeNormal RefreshType = "normal"
+	RefreshTypeHard   RefreshType = "hard"
+)
+
 // Application is a definition of Application resource.
 // +genclient
 // +genclient:noStatus
@@ -218,6 +368,88 @@ type ApplicationSpec struct {
 	Destination ApplicationDestination `json:"destination" protobuf:"bytes,2,name=destination"`
 	// Project is a application project name. Empty name means that application belongs to 'default' project.
 	Project string `json:"project" protobuf:"bytes,3,name=project"`
+	// SyncPolicy controls when a sync will be performed
+	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty" protobuf:"bytes,4,opt,name=syncPolicy"`
+	// IgnoreDifferences are the fields of resources which are ignored when comparing the live and target states
+	IgnoreDifferences []ResourceIgnoreDifferences `json:"ignoreDifferences,omitempty" protobuf:"bytes,5,opt,name=ignoreDifferences"`
+	// RevisionHistoryLimit is the number of deployments kept in the history of the application
+	// (default: 5)
+	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty" protobuf:"bytes,6,opt,name=revisionHistoryLimit"`
+}
+
+// DefaultRevisionHistoryLimit is the default number of deployments kept in the history of an application
+const DefaultRevisionHistoryLimit = int64(5)
+
+// GetRevisionHistoryLimit returns the number of deployments kept in the history of the application
+func (spec *ApplicationSpec) GetRevisionHistoryLimit() int64 {
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit >= 0 {
+		return *spec.RevisionHistoryLimit
+	}
+	return DefaultRevisionHistoryLimit
+}
+
+// ResourceIgnoreDifferences contains the fields of resources which are ignored during the comparison,
+// e.g. fields mutated by admission webhooks or horizontal pod autoscalers. Empty name and namespace
+// match all resources of the group and kind.
+type ResourceIgnoreDifferences struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind      string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	Name      string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	// JSONPointers are RFC 6901 JSON pointers to the ignored fields, e.g. /spec/replicas
+	JSONPointers []string `json:"jsonPointers" protobuf:"bytes,5,rep,name=jsonPointers"`
+}
+
+// Matches returns whether the differences of the object are ignored
+func (r ResourceIgnoreDifferences) Matches(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().Group == r.Group && obj.GetKind() == r.Kind &&
+		(r.Name == "" || obj.GetName() == r.Name) && (r.Namespace == "" || obj.GetNamespace() == r.Namespace)
+}
+
+// SyncPolicy controls when a sync will be performed in response to updates in git
+type SyncPolicy struct {
+	// Automated will keep an application synced to the target revision
+	Automated *SyncPolicyAutomated `json:"automated,omitempty" protobuf:"bytes,1,opt,name=automated"`
+	// Retry controls the retries of automated syncs which failed
+	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,2,opt,name=retry"`
+	// ManagedNamespaceMetadata is the metadata of the destination namespace, which is created if
+	// missing and updated with the metadata whenever the application is synced
+	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,3,opt,name=managedNamespaceMetadata"`
+	// SyncOptions are the sync options which apply to the application as a whole, e.g. CreateNamespace=true
+	SyncOptions []string `json:"syncOptions,omitempty" protobuf:"bytes,4,rep,name=syncOptions"`
+}
+
+// SyncOptionCreateNamespace is the sync option of an application which creates its destination
+// namespace if missing, before any resource is applied
+const SyncOptionCreateNamespace = "CreateNamespace=true"
+
+// HasSyncOption returns whether or not the sync policy has the given sync option
+func (p *SyncPolicy) HasSyncOption(option string) bool {
+	if p == nil {
+		return false
+	}
+	for _, opt := range p.SyncOptions {
+		if strings.TrimSpace(opt) == option {
+			return true
+		}
+	}
+	return false
+}
+
+// ManagedNamespaceMetadata holds the labels and annotations which the controller sets on the
+// destination namespace of an application
+type ManagedNamespaceMetadata struct {
+	Labels      map[string]string `json:"labels,omitempty" protobuf:"bytes,1,rep,name=labels"`
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,rep,name=annotations"`
+}
+
+// SyncPolicyAutomated controls the behavior of an automated sync
+type SyncPolicyAutomated struct {
+	// Prune will delete the resources removed from git as part of the automated sync (default: false)
+	Prune bool `json:"prune,omitempty" protobuf:"bytes,1,opt,name=prune"`
+	// SelfHeal will sync the application again to the last synced revision when the live state drifts
+	// from it, e.g. after a resource is edited in the cluster (default: false)
+	SelfHeal bool `json:"selfHeal,omitempty" protobuf:"bytes,2,opt,name=selfHeal"`
 }
 
 // ComponentParameter contains information about component parameter value
@@ -285,10 +517,22 @@ const (
 	ApplicationConditionInvalidSpecError = "InvalidSpecError"
 	// ApplicationComparisonError indicates controller failed to compare application state
 	ApplicationConditionComparisonError = "ComparisonError"
+	// ApplicationConditionManifestGenerationError indicates that the repo server failed to generate the manifests of the application
+	ApplicationConditionManifestGenerationError = "ManifestGenerationError"
 	// ApplicationConditionUnknownError indicates an unknown controller error
 	ApplicationConditionUnknownError = "UnknownError"
 	// ApplicationConditionSharedResourceWarning indicates that controller detected resources which belongs to more than one application
 	ApplicationConditionSharedResourceWarning = "SharedResourceWarning"
+	// ApplicationConditionSelfManagementWarning indicates that the application manages ArgoCD's own components
+	ApplicationConditionSelfManagementWarning = "SelfManagementWarning"
+	// ApplicationConditionSyncError indicates that the automated sync of the application failed
+	ApplicationConditionSyncError = "SyncError"
+	// ApplicationConditionOrphanedResourceWarning indicates that the destination namespace of the application contains orphaned resources
+	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionExcludedResourceWarning indicates that the application has resources of kinds excluded from the controller
+	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
+	// ApplicationConditionObjectSizeWarning indicates that the application object approaches the app-object-size guardrail
+	ApplicationConditionObjectSizeWarning = "ObjectSizeWarning"
 )
 
 // ApplicationCondition contains details about current application condition
@@ -297,6 +541,8 @@ type ApplicationCondition struct {
 	Type ApplicationConditionType `json:"type" protobuf:"bytes,1,opt,name=type"`
 	// Message contains human-readable message indicating details about condition
 	Message string `json:"message" protobuf:"bytes,2,opt,name=message"`
+	// LastTransitionTime is the time the condition was first observed with its current message
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
 }
 
 // ComparisonResult is a comparison result of application spec and deployed application.
@@ -305,11 +551,15 @@ type ComparisonResult struct {
 	ComparedTo ApplicationSource `json:"comparedTo" protobuf:"bytes,2,opt,name=comparedTo"`
 	Status     ComparisonStatus  `json:"status" protobuf:"bytes,5,opt,name=status,casttype=ComparisonStatus"`
 	Resources  []ResourceState   `json:"resources" protobuf:"bytes,6,opt,name=resources"`
+	// Revision is the commit SHA the target revision resolved to at the time of comparison
+	Revision string `json:"revision,omitempty" protobuf:"bytes,7,opt,name=revision"`
 }
 
 type HealthStatus struct {
 	Status        HealthStatusCode `json:"status,omitempty" protobuf:"bytes,1,opt,name=status"`
 	StatusDetails string           `json:"statusDetails,omitempty" protobuf:"bytes,2,opt,name=statusDetails"`
+	// ProgressingSince is the time since which the resource has been continuously progressing
+	ProgressingSince *metav1.Time `json:"progressingSince,omitempty" protobuf:"bytes,3,opt,name=progressingSince"`
 }
 
 type HealthStatusCode = string
@@ -353,6 +603,36 @@ type ConnectionState struct {
 	ModifiedAt *metav1.Time     `json:"attemptedAt" protobuf:"bytes,3,opt,name=attemptedAt"`
 }
 
+// GuardrailLevel represents how close the measured value of a guardrail is to its limit
+type GuardrailLevel = string
+
+const (
+	GuardrailLevelOK       = "OK"
+	GuardrailLevelWarning  = "Warning"
+	GuardrailLevelCritical = "Critical"
+)
+
+// GuardrailState contains the last measurement of a resource usage guardrail, e.g. the memory used
+// by the manifest cache of the repo server
+type GuardrailState struct {
+	// Name is the name of the guardrail
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Component is the ArgoCD component which measured the value
+	Component string `json:"component" protobuf:"bytes,2,opt,name=component"`
+	// Level indicates how close the value is to the limit
+	Level GuardrailLevel `json:"level" protobuf:"bytes,3,opt,name=level"`
+	// Message is a human readable description of the state
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// Value is the measured value
+	Value int64 `json:"value" protobuf:"varint,5,opt,name=value"`
+	// Limit is the value at which the guardrail is exceeded
+	Limit int64 `json:"limit" protobuf:"varint,6,opt,name=limit"`
+	// Unit is the unit of the value and limit, e.g. bytes
+	Unit string `json:"unit,omitempty" protobuf:"bytes,7,opt,name=unit"`
+	// ModifiedAt is the time the value was measured
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty" protobuf:"bytes,8,opt,name=modifiedAt"`
+}
+
 // Cluster is the definition of a cluster resource
 type Cluster struct {
 	// Server is the API server URL of the Kubernetes cluster
@@ -417,6 +697,13 @@ type Repository struct {
 	Password        string          `json:"password,omitempty" protobuf:"bytes,3,opt,name=password"`
 	SSHPrivateKey   string          `json:"sshPrivateKey,omitempty" protobuf:"bytes,4,opt,name=sshPrivateKey"`
 	ConnectionState ConnectionState `json:"connectionState,omitempty" protobuf:"bytes,5,opt,name=connectionState"`
+	// Labels are used to group repositories (e.g. team=payments) and can be matched by label selectors
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,6,rep,name=labels"`
+	// Annotations hold arbitrary non-identifying metadata about the repository
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,7,rep,name=annotations"`
+	// DefaultRevision is the revision used by applications which do not specify a target revision.
+	// Defaults to HEAD, i.e. the default branch of the remote repository.
+	DefaultRevision string `json:"defaultRevision,omitempty" protobuf:"bytes,8,opt,name=defaultRevision"`
 }
 
 // RepositoryList is a collection of Repositories.
@@ -453,6 +740,138 @@ type AppProjectSpec struct {
 
 	// Description contains optional project description
 	Description string `json:"description,omitempty" protobuf:"bytes,3,opt,name=description"`
+
+	// SourceTools contains list of config management tools (e.g. helm, ksonnet) which can be used to
+	// generate the manifests of applications. All tools are permitted if empty.
+	SourceTools []string `json:"sourceTools,omitempty" protobuf:"bytes,4,rep,name=sourceTools"`
+
+	// OrphanedResources enables the detection of orphaned resources in the destination namespaces of
+	// the applications of the project. Detection is disabled if nil.
+	OrphanedResources *OrphanedResourcesMonitorSettings `json:"orphanedResources,omitempty" protobuf:"bytes,5,opt,name=orphanedResources"`
+
+	// SyncWindows are the time windows during which the syncs of the applications of the project are
+	// allowed or denied
+	SyncWindows []SyncWindow `json:"syncWindows,omitempty" protobuf:"bytes,6,rep,name=syncWindows"`
+
+	// SignatureKeys contains the fingerprints of the GPG keys which may sign the revisions synced by the applications
+	// of the project. Revisions are not required to be signed if empty.
+	SignatureKeys []string `json:"signatureKeys,omitempty" protobuf:"bytes,7,rep,name=signatureKeys"`
+
+	// SecretReferences contains the glob patterns of the secret references, in the form backend:path (e.g.
+	// vault:secret/data/guestbook/*), which the controller resolves in the manifests of the applications of
+	// the project. No reference is resolved if empty.
+	SecretReferences []string `json:"secretReferences,omitempty" protobuf:"bytes,8,rep,name=secretReferences"`
+}
+
+const (
+	// SyncWindowKindAllow designates the windows outside of which syncs are denied
+	SyncWindowKindAllow = "allow"
+	// SyncWindowKindDeny designates the windows during which syncs are denied
+	SyncWindowKindDeny = "deny"
+)
+
+// SyncWindow is a recurring time window during which the syncs of the matching applications are
+// allowed or denied. Applications are matched by name, destination namespace or destination cluster,
+// and the values may contain glob patterns.
+type SyncWindow struct {
+	// Kind is either allow or deny
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Schedule is the cron expression of the start of the window
+	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	// Duration is the duration of the window, e.g. 1h30m
+	Duration string `json:"duration" protobuf:"bytes,3,opt,name=duration"`
+	// Applications are the names of the matched applications
+	Applications []string `json:"applications,omitempty" protobuf:"bytes,4,rep,name=applications"`
+	// Namespaces are the destination namespaces of the matched applications
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,5,rep,name=namespaces"`
+	// Clusters are the destination cluster URLs of the matched applications
+	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,6,rep,name=clusters"`
+	// ManualSync permits the manual syncs of the applications, regardless of the window
+	ManualSync bool `json:"manualSync,omitempty" protobuf:"bytes,7,opt,name=manualSync"`
+}
+
+// Validate returns an error if the kind, schedule or duration of the window is invalid
+func (w *SyncWindow) Validate() error {
+	if w.Kind != SyncWindowKindAllow && w.Kind != SyncWindowKindDeny {
+		return fmt.Errorf("kind '%s' must be either %s or %s", w.Kind, SyncWindowKindAllow, SyncWindowKindDeny)
+	}
+	if _, err := cron.ParseStandard(w.Schedule); err != nil {
+		return fmt.Errorf("invalid schedule '%s': %v", w.Schedule, err)
+	}
+	if duration, err := time.ParseDuration(w.Duration); err != nil || duration <= 0 {
+		return fmt.Errorf("duration '%s' must be a positive duration", w.Duration)
+	}
+	if len(w.Applications) == 0 && len(w.Namespaces) == 0 && len(w.Clusters) == 0 {
+		return fmt.Errorf("window must match applications, namespaces or clusters")
+	}
+	return nil
+}
+
+// Active returns whether the window is open at the given time, i.e. whether its schedule fired less
+// than its duration ago
+func (w *SyncWindow) Active(now time.Time) (bool, error) {
+	schedule, err := cron.ParseStandard(w.Schedule)
+	if err != nil {
+		return false, err
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return false, err
+	}
+	return !schedule.Next(now.Add(-duration)).After(now), nil
+}
+
+// Matches returns whether the window applies to the application
+func (w *SyncWindow) Matches(app *Application) bool {
+	return matchesAnyPattern(w.Applications, app.Name) ||
+		matchesAnyPattern(w.Namespaces, app.Spec.Destination.Namespace) ||
+		matchesAnyPattern(w.Clusters, app.Spec.Destination.Server)
+}
+
+func matchesAnyPattern(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, value); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// OrphanedResourcesMonitorSettings controls the detection of orphaned resources, which are resources
+// in the destination namespace of an application that are not managed by any application
+type OrphanedResourcesMonitorSettings struct {
+	// Ignore are the resources which are never reported as orphaned
+	Ignore []OrphanedResourceKey `json:"ignore,omitempty" protobuf:"bytes,1,opt,name=ignore"`
+}
+
+// OrphanedResourceKey matches the resources which are not reported as orphaned. An empty kind matches
+// all kinds of the group, and an empty name matches all names. Names may contain glob patterns.
+type OrphanedResourceKey struct {
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind  string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	Name  string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+}
+
+// Matches returns whether the object is matched by the key
+func (k OrphanedResourceKey) Matches(obj *unstructured.Unstructured) bool {
+	if obj.GroupVersionKind().Group != k.Group || (k.Kind != "" && obj.GetKind() != k.Kind) {
+		return false
+	}
+	if k.Name == "" {
+		return true
+	}
+	matched, err := path.Match(k.Name, obj.GetName())
+	return err == nil && matched
+}
+
+// IsIgnored returns whether the object is never reported as orphaned
+func (s *OrphanedResourcesMonitorSettings) IsIgnored(obj *unstructured.Unstructured) bool {
+	for _, key := range s.Ignore {
+		if key.Matches(obj) {
+			return true
+		}
+	}
+	return false
 }
 
 func GetDefaultProject(namespace string) AppProject {
@@ -503,6 +922,47 @@ func (status *ApplicationStatus) GetErrorConditions() []ApplicationCondition {
 	return result
 }
 
+// SetConditions replaces the conditions of the evaluated types with the given conditions, and keeps
+// the conditions of other types. Duplicate conditions are merged, and conditions which were already
+// present with the same message keep their last transition time.
+func (status *ApplicationStatus) SetConditions(conditions []ApplicationCondition, evaluatedTypes map[ApplicationConditionType]bool) {
+	appConditions := make([]ApplicationCondition, 0)
+	for i := range status.Conditions {
+		if !evaluatedTypes[status.Conditions[i].Type] {
+			appConditions = append(appConditions, status.Conditions[i])
+		}
+	}
+	now := metav1.Now()
+	for i := range conditions {
+		condition := conditions[i]
+		if hasCondition(appConditions, condition) {
+			continue
+		}
+		if condition.LastTransitionTime == nil {
+			condition.LastTransitionTime = &now
+			for j := range status.Conditions {
+				existing := status.Conditions[j]
+				if existing.Type == condition.Type && existing.Message == condition.Message && existing.LastTransitionTime != nil {
+					condition.LastTransitionTime = existing.LastTransitionTime
+					break
+				}
+			}
+		}
+		appConditions = append(appConditions, condition)
+	}
+	status.Conditions = appConditions
+}
+
+// hasCondition returns whether or not the conditions contain a condition of the same type and message
+func hasCondition(conditions []ApplicationCondition, condition ApplicationCondition) bool {
+	for i := range conditions {
+		if conditions[i].Type == condition.Type && conditions[i].Message == condition.Message {
+			return true
+		}
+	}
+	return false
+}
+
 // IsError returns true if condition is error condition
 func (condition *ApplicationCondition) IsError() bool {
 	return strings.HasSuffix(condition.Type, "Error")
@@ -541,6 +1001,101 @@ func (proj AppProject) IsSourcePermitted(src ApplicationSource) bool {
 	return false
 }
 
+// IsSourceToolPermitted returns whether or not applications of the project may use the config management tool
+func (proj AppProject) IsSourceToolPermitted(tool string) bool {
+	if proj.IsDefault() || len(proj.Spec.SourceTools) == 0 {
+		return true
+	}
+	for _, permitted := range proj.Spec.SourceTools {
+		if permitted == tool {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSignatureKeyPermitted returns whether or not the revisions signed by the GPG key may be synced by the
+// applications of the project. Keys are identified by their full fingerprint, compared case-insensitively,
+// since short and long key IDs can be forged.
+func (proj AppProject) IsSignatureKeyPermitted(fingerprint string) bool {
+	if !IsGPGKeyFingerprint(fingerprint) {
+		return false
+	}
+	for _, permitted := range proj.Spec.SignatureKeys {
+		if strings.EqualFold(permitted, fingerprint) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsGPGKeyFingerprint returns whether or not the string is the full fingerprint of a GPG key, made of 40
+// hexadecimal characters
+func IsGPGKeyFingerprint(fingerprint string) bool {
+	if len(fingerprint) != 40 {
+		return false
+	}
+	for _, c := range fingerprint {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSecretReferencePermitted returns whether or not the controller may resolve the secret reference, in
+// the form backend:path, in the manifests of the applications of the project
+func (proj AppProject) IsSecretReferencePermitted(ref string) bool {
+	return matchesAnyPattern(proj.Spec.SecretReferences, ref)
+}
+
+// IsSyncPermitted returns whether the sync windows of the project permit to sync the application at the
+// given time. Syncs are denied during the active deny windows matching the application, as well as
+// outside of the allow windows matching it. Manual syncs are still permitted if every window denying
+// them has manual sync enabled.
+func (proj AppProject) IsSyncPermitted(app *Application, manual bool, now time.Time) (bool, error) {
+	allowWindows, activeAllow := false, false
+	var denying []SyncWindow
+	var inactiveAllowWindows []SyncWindow
+	for _, w := range proj.Spec.SyncWindows {
+		if !w.Matches(app) {
+			continue
+		}
+		active, err := w.Active(now)
+		if err != nil {
+			return false, fmt.Errorf("invalid sync window of project %s: %v", proj.Name, err)
+		}
+		switch w.Kind {
+		case SyncWindowKindAllow:
+			allowWindows = true
+			if active {
+				activeAllow = true
+			} else {
+				inactiveAllowWindows = append(inactiveAllowWindows, w)
+			}
+		case SyncWindowKindDeny:
+			if active {
+				denying = append(denying, w)
+			}
+		}
+	}
+	if allowWindows && !activeAllow {
+		denying = append(denying, inactiveAllowWindows...)
+	}
+	if len(denying) == 0 {
+		return true, nil
+	}
+	if !manual {
+		return false, nil
+	}
+	for _, w := range denying {
+		if !w.ManualSync {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 	if proj.IsDefault() {
 		return true
@@ -0,0 +1,166 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newSyncWindowTestApp() *Application {
+	return &Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-prod"},
+		Spec: ApplicationSpec{
+			Project:     "prod",
+			Destination: ApplicationDestination{Server: "https://prod.example.com", Namespace: "guestbook"},
+		},
+	}
+}
+
+func TestSyncWindowActive(t *testing.T) {
+	// every day from 9:00 to 17:00
+	w := SyncWindow{Kind: SyncWindowKindDeny, Schedule: "0 9 * * *", Duration: "8h"}
+	active, err := w.Active(time.Date(2019, 1, 2, 10, 0, 0, 0, time.Local))
+	assert.NoError(t, err)
+	assert.True(t, active)
+	active, err = w.Active(time.Date(2019, 1, 2, 18, 0, 0, 0, time.Local))
+	assert.NoError(t, err)
+	assert.False(t, active)
+
+	w.Schedule = "not a schedule"
+	_, err = w.Active(time.Now())
+	assert.Error(t, err)
+}
+
+func TestSyncWindowMatches(t *testing.T) {
+	app := newSyncWindowTestApp()
+	assert.True(t, (&SyncWindow{Applications: []string{"guestbook-*"}}).Matches(app))
+	assert.True(t, (&SyncWindow{Namespaces: []string{"guestbook"}}).Matches(app))
+	assert.True(t, (&SyncWindow{Clusters: []string{"https://prod.example.com"}}).Matches(app))
+	assert.False(t, (&SyncWindow{Applications: []string{"other"}, Clusters: []string{"https://*.staging.com"}}).Matches(app))
+	assert.False(t, (&SyncWindow{}).Matches(app))
+}
+
+func TestSyncWindowValidate(t *testing.T) {
+	w := SyncWindow{Kind: SyncWindowKindAllow, Schedule: "0 22 * * *", Duration: "1h", Applications: []string{"*"}}
+	assert.NoError(t, w.Validate())
+
+	invalid := w
+	invalid.Kind = "maybe"
+	assert.Error(t, invalid.Validate())
+	invalid = w
+	invalid.Duration = "-1h"
+	assert.Error(t, invalid.Validate())
+	invalid = w
+	invalid.Applications = nil
+	assert.Error(t, invalid.Validate())
+}
+
+func TestIsSyncPermitted(t *testing.T) {
+	app := newSyncWindowTestApp()
+	businessHours := time.Date(2019, 1, 2, 10, 0, 0, 0, time.Local)
+	night := time.Date(2019, 1, 2, 22, 30, 0, 0, time.Local)
+	proj := AppProject{ObjectMeta: metav1.ObjectMeta{Name: "prod"}}
+
+	// no windows
+	permitted, err := proj.IsSyncPermitted(app, false, businessHours)
+	assert.NoError(t, err)
+	assert.True(t, permitted)
+
+	// deny window during business hours
+	proj.Spec.SyncWindows = []SyncWindow{{Kind: SyncWindowKindDeny, Schedule: "0 9 * * *", Duration: "8h", Namespaces: []string{"guestbook"}}}
+	permitted, _ = proj.IsSyncPermitted(app, false, businessHours)
+	assert.False(t, permitted)
+	permitted, _ = proj.IsSyncPermitted(app, true, businessHours)
+	assert.False(t, permitted)
+	permitted, _ = proj.IsSyncPermitted(app, false, night)
+	assert.True(t, permitted)
+
+	// manual syncs are permitted if the window permits them
+	proj.Spec.SyncWindows[0].ManualSync = true
+	permitted, _ = proj.IsSyncPermitted(app, true, businessHours)
+	assert.True(t, permitted)
+
+	// allow window at night
+	proj.Spec.SyncWindows = []SyncWindow{{Kind: SyncWindowKindAllow, Schedule: "0 22 * * *", Duration: "1h", Applications: []string{"guestbook-*"}}}
+	permitted, _ = proj.IsSyncPermitted(app, false, night)
+	assert.True(t, permitted)
+	permitted, _ = proj.IsSyncPermitted(app, false, businessHours)
+	assert.False(t, permitted)
+
+	// deny windows take precedence over allow windows
+	proj.Spec.SyncWindows = append(proj.Spec.SyncWindows, SyncWindow{Kind: SyncWindowKindDeny, Schedule: "0 0 * * *", Duration: "24h", Clusters: []string{"*"}})
+	permitted, _ = proj.IsSyncPermitted(app, false, night)
+	assert.False(t, permitted)
+
+	// windows matching other applications are ignored
+	app.Spec.Destination.Server = "https://staging.example.com"
+	proj.Spec.SyncWindows[1].Clusters = []string{"https://prod.example.com"}
+	permitted, _ = proj.IsSyncPermitted(app, false, night)
+	assert.True(t, permitted)
+}
+
+func TestSyncPolicyHasSyncOption(t *testing.T) {
+	var policy *SyncPolicy
+	assert.False(t, policy.HasSyncOption(SyncOptionCreateNamespace))
+	policy = &SyncPolicy{SyncOptions: []string{"Validate=false", " CreateNamespace=true"}}
+	assert.True(t, policy.HasSyncOption(SyncOptionCreateNamespace))
+	assert.False(t, policy.HasSyncOption("Prune=false"))
+}
+
+func TestIsSignatureKeyPermitted(t *testing.T) {
+	proj := AppProject{Spec: AppProjectSpec{SignatureKeys: []string{"4aee18f83afdeb23d5d9a1c34d9e8e13a7e3c96a", "", "0123456789ABCDEF"}}}
+	assert.True(t, proj.IsSignatureKeyPermitted("4AEE18F83AFDEB23D5D9A1C34D9E8E13A7E3C96A"))
+	// key IDs and other suffixes or prefixes of fingerprints are never permitted
+	assert.False(t, proj.IsSignatureKeyPermitted("4D9E8E13A7E3C96A"))
+	assert.False(t, proj.IsSignatureKeyPermitted("0123456789abcdef"))
+	assert.False(t, proj.IsSignatureKeyPermitted("FFFFFFFF0123456789ABCDEF0123456789ABCDEF"))
+	assert.False(t, proj.IsSignatureKeyPermitted("FFFF4AEE18F83AFDEB23D5D9A1C34D9E8E13A7E3C96A"))
+	assert.False(t, proj.IsSignatureKeyPermitted(""))
+}
+
+func TestIsGPGKeyFingerprint(t *testing.T) {
+	assert.True(t, IsGPGKeyFingerprint("4AEE18F83AFDEB23D5D9A1C34D9E8E13A7E3C96A"))
+	assert.True(t, IsGPGKeyFingerprint("4aee18f83afdeb23d5d9a1c34d9e8e13a7e3c96a"))
+	assert.False(t, IsGPGKeyFingerprint("4D9E8E13A7E3C96A"))
+	assert.False(t, IsGPGKeyFingerprint("4AEE18F83AFDEB23D5D9A1C34D9E8E13A7E3C96Z"))
+	assert.False(t, IsGPGKeyFingerprint(" 4AEE18F83AFDEB23D5D9A1C34D9E8E13A7E3C96"))
+}
+
+func TestIsSecretReferencePermitted(t *testing.T) {
+	proj := AppProject{Spec: AppProjectSpec{SecretReferences: []string{"vault:secret/data/guestbook/*", "vault:kv/db"}}}
+	assert.True(t, proj.IsSecretReferencePermitted("vault:secret/data/guestbook/db"))
+	assert.True(t, proj.IsSecretReferencePermitted("vault:kv/db"))
+	assert.False(t, proj.IsSecretReferencePermitted("vault:secret/data/guestbook/db/admin"))
+	assert.False(t, proj.IsSecretReferencePermitted("other:kv/db"))
+	assert.False(t, AppProject{}.IsSecretReferencePermitted("vault:kv/db"))
+}
+
+func TestSetConditions(t *testing.T) {
+	since := metav1.NewTime(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC))
+	status := ApplicationStatus{Conditions: []ApplicationCondition{
+		{Type: ApplicationConditionDeletionError, Message: "failed to delete", LastTransitionTime: &since},
+		{Type: ApplicationConditionComparisonError, Message: "failed to compare", LastTransitionTime: &since},
+		{Type: ApplicationConditionSharedResourceWarning, Message: "shared"},
+	}}
+	status.SetConditions([]ApplicationCondition{
+		{Type: ApplicationConditionComparisonError, Message: "failed to compare"},
+		{Type: ApplicationConditionComparisonError, Message: "failed to compare"},
+		{Type: ApplicationConditionManifestGenerationError, Message: "failed to generate"},
+	}, map[ApplicationConditionType]bool{
+		ApplicationConditionComparisonError:         true,
+		ApplicationConditionManifestGenerationError: true,
+		ApplicationConditionSharedResourceWarning:   true,
+	})
+
+	assert.Len(t, status.Conditions, 3)
+	// conditions of other types are kept
+	assert.Equal(t, ApplicationConditionDeletionError, status.Conditions[0].Type)
+	// unchanged conditions keep their last transition time, and duplicates are merged
+	assert.Equal(t, ApplicationConditionComparisonError, status.Conditions[1].Type)
+	assert.Equal(t, since, *status.Conditions[1].LastTransitionTime)
+	// new conditions are observed now
+	assert.Equal(t, ApplicationConditionManifestGenerationError, status.Conditions[2].Type)
+	assert.True(t, status.Conditions[2].LastTransitionTime.After(since.Time))
+}
@@ -3,7 +3,9 @@ package reposerver
 import (
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/healthz"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
 
@@ -29,3 +31,32 @@ func (c *clientSet) NewRepositoryClient() (util.Closer, repository.RepositorySer
 func NewRepositoryServerClientset(address string) Clientset {
 	return &clientSet{address: address}
 }
+
+// NewToolVersionsGetter returns a function which queries the repository server for the versions of
+// the tools it renders manifests with
+func NewToolVersionsGetter(clientset Clientset) func(ctx context.Context) (*repository.ToolVersionsResponse, error) {
+	return func(ctx context.Context) (*repository.ToolVersionsResponse, error) {
+		conn, repoClient, err := clientset.NewRepositoryClient()
+		if err != nil {
+			return nil, err
+		}
+		defer util.Close(conn)
+		return repoClient.GetToolVersions(ctx, &repository.ToolVersionsRequest{})
+	}
+}
+
+// NewHealthCheck returns a health check that the repository server serves requests
+func NewHealthCheck(clientset Clientset) healthz.Check {
+	return healthz.Check{
+		Name: "repo-server",
+		Check: func() error {
+			conn, repoClient, err := clientset.NewRepositoryClient()
+			if err != nil {
+				return err
+			}
+			defer util.Close(conn)
+			_, err = repoClient.GetGuardrails(context.Background(), &repository.GuardrailsRequest{})
+			return err
+		},
+	}
+}
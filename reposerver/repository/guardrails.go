@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/guardrail"
+)
+
+const (
+	// guardrailComponent is the component name of the repo server guardrails
+	guardrailComponent = "repo-server"
+	// guardrailDiskUsage is the guardrail on the used space of the file system repositories are cloned to
+	guardrailDiskUsage = "disk-usage"
+	// guardrailCacheMemory is the guardrail on the memory used by the in-memory manifest cache
+	guardrailCacheMemory = "cache-memory"
+
+	// DefaultMaxCacheMemory is the default size in bytes of the in-memory manifest cache at which the
+	// cache-memory guardrail is exceeded
+	DefaultMaxCacheMemory = 1024 * 1024 * 1024
+)
+
+// NewWatchdog returns the watchdog measuring the guardrails of the repo server. The disk-usage limit
+// is the size of the file system repositories are cloned to. The cache-memory guardrail measures the
+// size of the items of the manifest cache, and is disabled if maxCacheMemory is zero, or if the cache
+// is not held in memory, e.g. because it is stored in redis.
+func NewWatchdog(repoCache cache.Cache, maxCacheMemory int64) *guardrail.Watchdog {
+	var measureCache func() (int64, error)
+	if sizer, ok := repoCache.(cache.Sizer); ok {
+		measureCache = func() (int64, error) {
+			return sizer.Size(), nil
+		}
+	} else {
+		maxCacheMemory = 0
+	}
+	var diskSize int64
+	fs, err := statRepoFS()
+	if err != nil {
+		log.Warnf("Failed to determine size of %s, disabling guardrail %s: %v", os.TempDir(), guardrailDiskUsage, err)
+	} else {
+		diskSize = int64(fs.Blocks) * int64(fs.Bsize)
+	}
+	return guardrail.NewWatchdog(guardrailComponent,
+		guardrail.Check{
+			Name:    guardrailDiskUsage,
+			Limit:   diskSize,
+			Unit:    guardrail.UnitBytes,
+			Measure: diskUsage,
+		},
+		guardrail.Check{
+			Name:    guardrailCacheMemory,
+			Limit:   maxCacheMemory,
+			Unit:    guardrail.UnitBytes,
+			Measure: measureCache,
+		},
+	)
+}
+
+// statRepoFS returns the stats of the file system repositories are cloned to
+func statRepoFS() (*syscall.Statfs_t, error) {
+	var fs syscall.Statfs_t
+	if err := syscall.Statfs(os.TempDir(), &fs); err != nil {
+		return nil, err
+	}
+	return &fs, nil
+}
+
+// diskUsage returns the used space of the file system repositories are cloned to
+func diskUsage() (int64, error) {
+	fs, err := statRepoFS()
+	if err != nil {
+		return 0, err
+	}
+	return (int64(fs.Blocks) - int64(fs.Bavail)) * int64(fs.Bsize), nil
+}
+
+// GetGuardrails returns the last measured guardrail states of the repo server
+func (s *Service) GetGuardrails(ctx context.Context, q *GuardrailsRequest) (*GuardrailsResponse, error) {
+	res := GuardrailsResponse{}
+	if s.watchdog != nil {
+		res.Items = s.watchdog.States()
+	}
+	return &res, nil
+}
@@ -71,6 +71,96 @@ func (_m *RepositoryServiceClient) GetFile(ctx context.Context, in *repository.G
 	return r0, r1
 }
 
+// GetFiles provides a mock function with given fields: ctx, in, opts
+func (_m *RepositoryServiceClient) GetFiles(ctx context.Context, in *repository.GetFilesRequest, opts ...grpc.CallOption) (*repository.GetFilesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *repository.GetFilesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.GetFilesRequest, ...grpc.CallOption) *repository.GetFilesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.GetFilesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.GetFilesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetGuardrails provides a mock function with given fields: ctx, in, opts
+func (_m *RepositoryServiceClient) GetGuardrails(ctx context.Context, in *repository.GuardrailsRequest, opts ...grpc.CallOption) (*repository.GuardrailsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *repository.GuardrailsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.GuardrailsRequest, ...grpc.CallOption) *repository.GuardrailsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.GuardrailsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.GuardrailsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetToolVersions provides a mock function with given fields: ctx, in, opts
+func (_m *RepositoryServiceClient) GetToolVersions(ctx context.Context, in *repository.ToolVersionsRequest, opts ...grpc.CallOption) (*repository.ToolVersionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *repository.ToolVersionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.ToolVersionsRequest, ...grpc.CallOption) *repository.ToolVersionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.ToolVersionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.ToolVersionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListDir provides a mock function with given fields: ctx, in, opts
 func (_m *RepositoryServiceClient) ListDir(ctx context.Context, in *repository.ListDirRequest, opts ...grpc.CallOption) (*repository.FileList, error) {
 	_va := make([]interface{}, len(opts))
@@ -100,3 +190,33 @@ func (_m *RepositoryServiceClient) ListDir(ctx context.Context, in *repository.L
 
 	return r0, r1
 }
+
+// ResolveRevision provides a mock function with given fields: ctx, in, opts
+func (_m *RepositoryServiceClient) ResolveRevision(ctx context.Context, in *repository.ResolveRevisionRequest, opts ...grpc.CallOption) (*repository.ResolveRevisionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *repository.ResolveRevisionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.ResolveRevisionRequest, ...grpc.CallOption) *repository.ResolveRevisionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.ResolveRevisionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *repository.ResolveRevisionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
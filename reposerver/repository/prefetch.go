@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/util/cache"
+)
+
+const (
+	// DefaultPrefetchTimeout is the default duration after which the pre-fetch of repositories at
+	// startup is abandoned
+	DefaultPrefetchTimeout = 5 * time.Minute
+	// repoUsageCacheKey is the key of the generation counts of repositories stored in the cache. When
+	// the cache is shared, the counts are shared between replicas.
+	repoUsageCacheKey = "repo-usage"
+	// maxConcurrentPrefetches is the maximum number of repositories cloned concurrently at startup
+	maxConcurrentPrefetches = 4
+)
+
+// repoUsage counts the manifest generations which required a clone of a repository, by repository URL
+type repoUsage map[string]int64
+
+// recordRepoUsage increments the generation count of the repository. Counts are a heuristic to
+// select the repositories worth pre-fetching, so concurrent updates of a shared cache may be lost.
+func (s *Service) recordRepoUsage(repoURL string) {
+	s.usageLock.Lock()
+	defer s.usageLock.Unlock()
+	usage := repoUsage{}
+	err := s.cache.Get(repoUsageCacheKey, &usage)
+	if err != nil && err != cache.ErrCacheMiss {
+		log.Warnf("repo usage cache error: %v", err)
+		return
+	}
+	usage[repoURL]++
+	err = s.cache.Set(&cache.Item{
+		Key:        repoUsageCacheKey,
+		Object:     &usage,
+		Expiration: DefaultRepoCacheExpiration,
+	})
+	if err != nil {
+		log.Warnf("repo usage cache set error: %v", err)
+	}
+}
+
+// HotRepos returns the URLs of the limit most used repositories, most used first
+func (s *Service) HotRepos(limit int) []string {
+	usage := repoUsage{}
+	err := s.cache.Get(repoUsageCacheKey, &usage)
+	if err != nil {
+		if err != cache.ErrCacheMiss {
+			log.Warnf("repo usage cache error: %v", err)
+		}
+		return nil
+	}
+	repos := make([]string, 0, len(usage))
+	for repoURL := range usage {
+		repos = append(repos, repoURL)
+	}
+	sort.Slice(repos, func(i, j int) bool {
+		if usage[repos[i]] != usage[repos[j]] {
+			return usage[repos[i]] > usage[repos[j]]
+		}
+		return repos[i] < repos[j]
+	})
+	if len(repos) > limit {
+		repos = repos[:limit]
+	}
+	return repos
+}
+
+// Prefetch clones the repositories into the local repository cache, so that the first manifest
+// generations of the repositories only need to fetch the latest commits. Repositories are cloned
+// without credentials; failures are logged and do not prevent the others from being cloned.
+// Prefetch returns once all repositories are cloned, or when ctx is done.
+func (s *Service) Prefetch(ctx context.Context, repos []string) {
+	sem := make(chan struct{}, maxConcurrentPrefetches)
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := range repos {
+		repoURL := repos[i]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+			start := time.Now()
+			if err := s.prefetchRepo(repoURL); err != nil {
+				log.Warnf("Failed to pre-fetch %s: %v", repoURL, err)
+				return
+			}
+			log.Infof("Pre-fetched %s in %v", repoURL, time.Since(start))
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		log.Warnf("Pre-fetch of repositories abandoned: %v", ctx.Err())
+	}
+}
+
+func (s *Service) prefetchRepo(repoURL string) error {
+	appRepoPath := tempRepoPath(repoURL)
+	s.repoLock.Lock(appRepoPath)
+	defer s.repoLock.Unlock(appRepoPath)
+
+	gitClient := s.gitFactory.NewClient(repoURL, appRepoPath, "", "", "")
+	err := gitClient.Init()
+	if err != nil {
+		return err
+	}
+	return gitClient.Fetch()
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/git"
+)
+
+type fakeGitClient struct {
+	git.Client
+	repoURL string
+	fetched *sync.Map
+}
+
+func (c *fakeGitClient) Init() error {
+	return nil
+}
+
+func (c *fakeGitClient) Fetch() error {
+	c.fetched.Store(c.repoURL, true)
+	return nil
+}
+
+type fakeGitFactory struct {
+	fetched sync.Map
+}
+
+func (f *fakeGitFactory) NewClient(repoURL, path, username, password, sshPrivateKey string) git.Client {
+	return &fakeGitClient{repoURL: repoURL, fetched: &f.fetched}
+}
+
+func TestHotRepos(t *testing.T) {
+	s := NewService(nil, cache.NewInMemoryCache(DefaultRepoCacheExpiration), nil, DefaultManifestGenerateTimeout, nil)
+	assert.Empty(t, s.HotRepos(2))
+
+	s.recordRepoUsage("https://github.com/argoproj/argocd-example-apps")
+	s.recordRepoUsage("https://github.com/argoproj/argo-cd")
+	s.recordRepoUsage("https://github.com/argoproj/argo-cd")
+	s.recordRepoUsage("https://github.com/argoproj/argo")
+	assert.Equal(t, []string{"https://github.com/argoproj/argo-cd", "https://github.com/argoproj/argo"}, s.HotRepos(2))
+	assert.Len(t, s.HotRepos(10), 3)
+}
+
+func TestPrefetch(t *testing.T) {
+	factory := &fakeGitFactory{}
+	s := NewService(factory, cache.NewInMemoryCache(DefaultRepoCacheExpiration), nil, DefaultManifestGenerateTimeout, nil)
+	repos := []string{"https://github.com/argoproj/argo-cd", "https://github.com/argoproj/argo"}
+	s.Prefetch(context.Background(), repos)
+	for _, repoURL := range repos {
+		_, ok := factory.fetched.Load(repoURL)
+		assert.True(t, ok, repoURL)
+	}
+}
@@ -9,10 +9,14 @@ import (
 	"path"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ksonnet/ksonnet/pkg/app"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/common"
@@ -20,14 +24,20 @@ import (
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/guardrail"
 	"github.com/argoproj/argo-cd/util/helm"
 	ksutil "github.com/argoproj/argo-cd/util/ksonnet"
 	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/kustomize"
 )
 
 const (
 	// DefaultRepoCacheExpiration is the duration for items to live in the repo cache
 	DefaultRepoCacheExpiration = 24 * time.Hour
+	// DefaultManifestGenerateTimeout is the default duration after which manifest generation is aborted
+	DefaultManifestGenerateTimeout = 90 * time.Second
+	// maxConcurrentFileReads is the maximum number of files read concurrently by a GetFiles request
+	maxConcurrentFileReads = 10
 )
 
 type AppSourceType string
@@ -35,22 +45,33 @@ type AppSourceType string
 const (
 	AppSourceKsonnet   AppSourceType = "ksonnet"
 	AppSourceHelm      AppSourceType = "helm"
+	AppSourceKustomize AppSourceType = "kustomize"
 	AppSourceDirectory AppSourceType = "directory"
 )
 
 // Service implements ManifestService interface
 type Service struct {
-	repoLock   *util.KeyLock
-	gitFactory git.ClientFactory
-	cache      cache.Cache
+	repoLock                *util.KeyLock
+	manifestLock            util.Locker
+	gitFactory              git.ClientFactory
+	cache                   cache.Cache
+	manifestGenerateTimeout time.Duration
+	watchdog                *guardrail.Watchdog
+	usageLock               sync.Mutex
 }
 
-// NewService returns a new instance of the Manifest service
-func NewService(gitFactory git.ClientFactory, cache cache.Cache) *Service {
+// NewService returns a new instance of the Manifest service. manifestLock is shared with the other
+// repo servers sharing the cache, so that the manifests of a revision are generated by one of them,
+// and may be nil if the cache is not shared. The guardrail states reported by the service are
+// measured by watchdog, which may be nil.
+func NewService(gitFactory git.ClientFactory, cache cache.Cache, manifestLock util.Locker, manifestGenerateTimeout time.Duration, watchdog *guardrail.Watchdog) *Service {
 	return &Service{
-		repoLock:   util.NewKeyLock(),
-		gitFactory: gitFactory,
-		cache:      cache,
+		repoLock:                util.NewKeyLock(),
+		manifestLock:            manifestLock,
+		gitFactory:              gitFactory,
+		cache:                   cache,
+		manifestGenerateTimeout: manifestGenerateTimeout,
+		watchdog:                watchdog,
 	}
 }
 
@@ -66,7 +87,8 @@ func (s *Service) ListDir(ctx context.Context, q *ListDirRequest) (*FileList, er
 		return nil, err
 	}
 
-	commitSHA, err := gitClient.LsRemote(q.Revision)
+	revision := getRevision(q.Repo, q.Revision)
+	commitSHA, err := gitClient.LsRemote(revision)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +100,7 @@ func (s *Service) ListDir(ctx context.Context, q *ListDirRequest) (*FileList, er
 		return &res, nil
 	}
 
-	err = checkoutRevision(gitClient, q.Revision)
+	err = checkoutRevision(gitClient, revision)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +134,7 @@ func (s *Service) GetFile(ctx context.Context, q *GetFileRequest) (*GetFileRespo
 	if err != nil {
 		return nil, err
 	}
-	err = checkoutRevision(gitClient, q.Revision)
+	err = checkoutRevision(gitClient, getRevision(q.Repo, q.Revision))
 	if err != nil {
 		return nil, err
 	}
@@ -126,11 +148,80 @@ func (s *Service) GetFile(ctx context.Context, q *GetFileRequest) (*GetFileRespo
 	return &res, nil
 }
 
+// GetFiles returns the contents of multiple files at the specified repo and revision. The files are
+// read concurrently, after checking out the revision once.
+func (s *Service) GetFiles(ctx context.Context, q *GetFilesRequest) (*GetFilesResponse, error) {
+	appRepoPath := tempRepoPath(q.Repo.Repo)
+	s.repoLock.Lock(appRepoPath)
+	defer s.repoLock.Unlock(appRepoPath)
+
+	gitClient := s.gitFactory.NewClient(q.Repo.Repo, appRepoPath, q.Repo.Username, q.Repo.Password, q.Repo.SSHPrivateKey)
+	err := gitClient.Init()
+	if err != nil {
+		return nil, err
+	}
+	err = checkoutRevision(gitClient, getRevision(q.Repo, q.Revision))
+	if err != nil {
+		return nil, err
+	}
+	files, err := readFiles(gitClient.Root(), q.Paths)
+	if err != nil {
+		return nil, err
+	}
+	return &GetFilesResponse{Files: files}, nil
+}
+
+// ResolveRevision resolves a branch, tag or semver constraint to the commit SHA it currently points to
+func (s *Service) ResolveRevision(ctx context.Context, q *ResolveRevisionRequest) (*ResolveRevisionResponse, error) {
+	appRepoPath := tempRepoPath(q.Repo.Repo)
+	s.repoLock.Lock(appRepoPath)
+	defer s.repoLock.Unlock(appRepoPath)
+
+	gitClient := s.gitFactory.NewClient(q.Repo.Repo, appRepoPath, q.Repo.Username, q.Repo.Password, q.Repo.SSHPrivateKey)
+	err := gitClient.Init()
+	if err != nil {
+		return nil, err
+	}
+	commitSHA, err := gitClient.LsRemote(getRevision(q.Repo, q.Revision))
+	if err != nil {
+		return nil, err
+	}
+	return &ResolveRevisionResponse{Revision: commitSHA}, nil
+}
+
+// readFiles reads the files at the specified paths relative to root, using at most
+// maxConcurrentFileReads concurrent readers
+func readFiles(root string, paths []string) (map[string][]byte, error) {
+	var lock sync.Mutex
+	files := make(map[string][]byte, len(paths))
+	sem := make(chan struct{}, maxConcurrentFileReads)
+	var g errgroup.Group
+	for i := range paths {
+		filePath := paths[i]
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			data, err := ioutil.ReadFile(path.Join(root, filePath))
+			if err != nil {
+				return err
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			files[filePath] = data
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
 func (s *Service) GenerateManifest(c context.Context, q *ManifestRequest) (*ManifestResponse, error) {
 	var res ManifestResponse
-	if git.IsCommitSHA(q.Revision) {
+	if git.IsCommitSHA(q.Revision) && !q.NoCache {
 		cacheKey := manifestCacheKey(q.Revision, q)
-		err := s.cache.Get(cacheKey, res)
+		err := s.cache.Get(cacheKey, &res)
 		if err == nil {
 			log.Infof("manifest cache hit: %s", cacheKey)
 			return &res, nil
@@ -145,34 +236,68 @@ func (s *Service) GenerateManifest(c context.Context, q *ManifestRequest) (*Mani
 	if err != nil {
 		return nil, err
 	}
-	commitSHA, err := gitClient.LsRemote(q.Revision)
+	revision := getRevision(q.Repo, q.Revision)
+	commitSHA, err := gitClient.LsRemote(revision)
 	if err != nil {
 		return nil, err
 	}
 	cacheKey := manifestCacheKey(commitSHA, q)
-	err = s.cache.Get(cacheKey, &res)
-	if err == nil {
-		log.Infof("manifest cache hit: %s", cacheKey)
-		return &res, nil
-	}
-	if err != cache.ErrCacheMiss {
-		log.Warnf("manifest cache error %s: %v", cacheKey, err)
+	if q.NoCache {
+		log.Infof("manifest cache bypassed: %s", cacheKey)
 	} else {
-		log.Infof("manifest cache miss: %s", cacheKey)
+		err = s.cache.Get(cacheKey, &res)
+		if err == nil {
+			log.Infof("manifest cache hit: %s", cacheKey)
+			return &res, nil
+		}
+		if err != cache.ErrCacheMiss {
+			log.Warnf("manifest cache error %s: %v", cacheKey, err)
+		} else {
+			log.Infof("manifest cache miss: %s", cacheKey)
+		}
+		if s.manifestLock != nil {
+			// the replicas waiting for the lock are served the manifests generated by its holder
+			err = s.manifestLock.Lock(cacheKey)
+			if err != nil {
+				return nil, status.Errorf(codes.Unavailable, "failed to lock manifest generation: %v", err)
+			}
+			defer s.manifestLock.Unlock(cacheKey)
+			err = s.cache.Get(cacheKey, &res)
+			if err == nil {
+				log.Infof("manifest cache hit: %s", cacheKey)
+				return &res, nil
+			}
+		}
 	}
 
-	err = checkoutRevision(gitClient, q.Revision)
+	s.recordRepoUsage(q.Repo.Repo)
+	err = checkoutRevision(gitClient, revision)
 	if err != nil {
 		return nil, err
 	}
 	appPath := path.Join(appRepoPath, q.Path)
 
-	genRes, err := generateManifests(appPath, q)
+	timeout := s.getManifestGenerateTimeout(q)
+	ctx, cancel := context.WithTimeout(c, timeout)
+	defer cancel()
+	genRes, err := generateManifests(ctx, appPath, q)
 	if err != nil {
+		switch ctx.Err() {
+		case context.DeadlineExceeded:
+			return nil, status.Errorf(codes.DeadlineExceeded, "manifest generation timed out after %v", timeout)
+		case context.Canceled:
+			return nil, status.Errorf(codes.Canceled, "manifest generation cancelled")
+		}
 		return nil, err
 	}
 	res = *genRes
 	res.Revision = commitSHA
+	if q.VerifySignature {
+		res.SignatureKeyFingerprint, err = gitClient.VerifyCommitSignature(commitSHA)
+		if err != nil {
+			return nil, err
+		}
+	}
 	err = s.cache.Set(&cache.Item{
 		Key:        cacheKey,
 		Object:     res,
@@ -184,27 +309,41 @@ func (s *Service) GenerateManifest(c context.Context, q *ManifestRequest) (*Mani
 	return &res, nil
 }
 
-// generateManifests generates manifests from a path
-func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, error) {
+// getManifestGenerateTimeout returns the timeout for generating the manifests of the request,
+// preferring the timeout of the request over the service default
+func (s *Service) getManifestGenerateTimeout(q *ManifestRequest) time.Duration {
+	if q.TimeoutSeconds > 0 {
+		return time.Duration(q.TimeoutSeconds) * time.Second
+	}
+	return s.manifestGenerateTimeout
+}
+
+// generateManifests generates manifests from a path. Tool processes are killed when ctx is done.
+func generateManifests(ctx context.Context, appPath string, q *ManifestRequest) (*ManifestResponse, error) {
 	var targetObjs []*unstructured.Unstructured
 	var params []*v1alpha1.ComponentParameter
 	var env *app.EnvironmentSpec
 	var err error
 
 	appSourceType := identifyAppSourceType(appPath)
+	if !isSourceTypeAllowed(appSourceType, q.AllowedSourceTypes) {
+		return nil, status.Errorf(codes.PermissionDenied, "application source type '%s' is not permitted (permitted: %s)", appSourceType, strings.Join(q.AllowedSourceTypes, ", "))
+	}
 	switch appSourceType {
 	case AppSourceKsonnet:
-		targetObjs, params, env, err = ksShow(appPath, q.Environment, q.ComponentParameterOverrides)
+		targetObjs, params, env, err = ksShow(ctx, appPath, q.Environment, q.ComponentParameterOverrides)
 	case AppSourceHelm:
 		h := helm.NewHelmApp(appPath)
-		targetObjs, err = h.Template(q.AppLabel, q.ValueFiles, q.ComponentParameterOverrides)
+		targetObjs, err = h.Template(ctx, q.AppLabel, q.ValueFiles, q.ComponentParameterOverrides)
 		if err != nil {
 			return nil, err
 		}
-		params, err = h.GetParameters(q.ValueFiles)
+		params, err = h.GetParameters(ctx, q.ValueFiles)
 		if err != nil {
 			return nil, err
 		}
+	case AppSourceKustomize:
+		targetObjs, err = kustomize.Build(ctx, appPath)
 	case AppSourceDirectory:
 		targetObjs, err = findManifests(appPath)
 	}
@@ -215,7 +354,7 @@ func generateManifests(appPath string, q *ManifestRequest) (*ManifestResponse, e
 
 	manifests := make([]string, len(targetObjs))
 	for i, target := range targetObjs {
-		if q.AppLabel != "" {
+		if q.AppLabel != "" && !q.NoAppLabel {
 			err = kube.SetLabel(target, common.LabelApplicationName, q.AppLabel)
 			if err != nil {
 				return nil, err
@@ -244,6 +383,23 @@ func tempRepoPath(repo string) string {
 	return path.Join(os.TempDir(), strings.Replace(repo, "/", "_", -1))
 }
 
+// isSourceTypeAllowed returns whether or not the source type is one of the allowed ones. All source
+// types are allowed if none are specified.
+func isSourceTypeAllowed(appSourceType AppSourceType, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, sourceType := range allowed {
+		if AppSourceType(sourceType) == appSourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// kustomizationFileNames are the file names of kustomizations recognized by kustomize
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
 // identifyAppSourceType examines a directory and determines its application source type
 func identifyAppSourceType(appPath string) AppSourceType {
 	if pathExists(path.Join(appPath, "app.yaml")) {
@@ -252,15 +408,35 @@ func identifyAppSourceType(appPath string) AppSourceType {
 	if pathExists(path.Join(appPath, "Chart.yaml")) {
 		return AppSourceHelm
 	}
+	for _, name := range kustomizationFileNames {
+		if pathExists(path.Join(appPath, name)) {
+			return AppSourceKustomize
+		}
+	}
 	return AppSourceDirectory
 }
 
-// checkoutRevision is a convenience function to initialize a repo, fetch, and checkout a revision
+// getRevision returns the requested revision, or the default revision of the repository if unspecified
+func getRevision(repo *v1alpha1.Repository, revision string) string {
+	if revision == "" {
+		return repo.DefaultRevision
+	}
+	return revision
+}
+
+// checkoutRevision is a convenience function to initialize a repo, fetch, and checkout a revision.
+// Semver constraints are checked out at the commit of the highest matching tag.
 func checkoutRevision(gitClient git.Client, revision string) error {
 	err := gitClient.Fetch()
 	if err != nil {
 		return err
 	}
+	if git.IsSemverConstraint(revision) {
+		revision, err = gitClient.LsRemote(revision)
+		if err != nil {
+			return err
+		}
+	}
 	err = gitClient.Reset()
 	if err != nil {
 		log.Warn(err)
@@ -275,7 +451,8 @@ func checkoutRevision(gitClient git.Client, revision string) error {
 func manifestCacheKey(commitSHA string, q *ManifestRequest) string {
 	pStr, _ := json.Marshal(q.ComponentParameterOverrides)
 	valuesFiles := strings.Join(q.ValueFiles, ",")
-	return fmt.Sprintf("mfst|%s|%s|%s|%s|%s|%s", q.AppLabel, q.Path, q.Environment, commitSHA, string(pStr), valuesFiles)
+	sourceTypes := strings.Join(q.AllowedSourceTypes, ",")
+	return fmt.Sprintf("mfst|%s|%s|%s|%s|%s|%s|%s|%t|%t", q.AppLabel, q.Path, q.Environment, commitSHA, string(pStr), valuesFiles, sourceTypes, q.NoAppLabel, q.VerifySignature)
 }
 
 func listDirCacheKey(commitSHA string, q *ListDirRequest) string {
@@ -283,7 +460,7 @@ func listDirCacheKey(commitSHA string, q *ListDirRequest) string {
 }
 
 // ksShow runs `ks show` in an app directory after setting any component parameter overrides
-func ksShow(appPath, envName string, overrides []*v1alpha1.ComponentParameter) ([]*unstructured.Unstructured, []*v1alpha1.ComponentParameter, *app.EnvironmentSpec, error) {
+func ksShow(ctx context.Context, appPath, envName string, overrides []*v1alpha1.ComponentParameter) ([]*unstructured.Unstructured, []*v1alpha1.ComponentParameter, *app.EnvironmentSpec, error) {
 	ksApp, err := ksutil.NewKsonnetApp(appPath)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("unable to load application from %s: %v", appPath, err)
@@ -294,7 +471,7 @@ func ksShow(appPath, envName string, overrides []*v1alpha1.ComponentParameter) (
 	}
 	if overrides != nil {
 		for _, override := range overrides {
-			err = ksApp.SetComponentParams(envName, override.Component, override.Name, override.Value)
+			err = ksApp.SetComponentParams(ctx, envName, override.Component, override.Name, override.Value)
 			if err != nil {
 				return nil, nil, nil, err
 			}
@@ -305,7 +482,7 @@ func ksShow(appPath, envName string, overrides []*v1alpha1.ComponentParameter) (
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("environment '%s' does not exist in ksonnet app", envName)
 	}
-	targetObjs, err := ksApp.Show(envName)
+	targetObjs, err := ksApp.Show(ctx, envName)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -1,19 +1,152 @@
 package repository
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/cache"
+	"github.com/argoproj/argo-cd/util/git"
 )
 
+const fakeCommitSHA = "a9c1f54e7fa5c2c8a4e1b8a2e0c4b0b8e2f1d3c7"
+
+// resolvingGitClient is a git client which resolves every revision to fakeCommitSHA
+type resolvingGitClient struct {
+	git.Client
+}
+
+func (c *resolvingGitClient) Init() error {
+	return nil
+}
+
+func (c *resolvingGitClient) LsRemote(revision string) (string, error) {
+	return fakeCommitSHA, nil
+}
+
+type resolvingGitFactory struct{}
+
+func (f *resolvingGitFactory) NewClient(repoURL, path, username, password, sshPrivateKey string) git.Client {
+	return &resolvingGitClient{}
+}
+
 func TestGenerateManifestInDir(t *testing.T) {
 	q := ManifestRequest{}
-	res1, err := generateManifests("../../manifests/components", &q)
+	res1, err := generateManifests(context.Background(), "../../manifests/components", &q)
 	assert.Nil(t, err)
 	assert.True(t, len(res1.Manifests) == 16) // update this value if we add/remove manifests
 
 	// this will test concatenated manifests to verify we split YAMLs correctly
-	res2, err := generateManifests("../../manifests", &q)
+	res2, err := generateManifests(context.Background(), "../../manifests", &q)
 	assert.Nil(t, err)
 	assert.True(t, len(res2.Manifests) == len(res1.Manifests))
 }
+
+func TestGenerateManifestSourceTypeNotAllowed(t *testing.T) {
+	_, err := generateManifests(context.Background(), "../../manifests/components", &ManifestRequest{AllowedSourceTypes: []string{"helm"}})
+	assert.NotNil(t, err)
+
+	_, err = generateManifests(context.Background(), "../../manifests/components", &ManifestRequest{AllowedSourceTypes: []string{"helm", "directory"}})
+	assert.Nil(t, err)
+}
+
+func TestIdentifyAppSourceType(t *testing.T) {
+	dir, err := ioutil.TempDir("", "app")
+	assert.Nil(t, err)
+	defer func() { _ = os.RemoveAll(dir) }()
+	assert.Equal(t, AppSourceDirectory, identifyAppSourceType(dir))
+
+	err = ioutil.WriteFile(path.Join(dir, "kustomization.yaml"), []byte("resources:\n- deployment.yaml\n"), 0644)
+	assert.Nil(t, err)
+	assert.Equal(t, AppSourceKustomize, identifyAppSourceType(dir))
+
+	// kustomize apps are only generated if the project permits kustomize
+	_, err = generateManifests(context.Background(), dir, &ManifestRequest{AllowedSourceTypes: []string{"directory"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.True(t, isSourceTypeAllowed(identifyAppSourceType(dir), []string{"kustomize"}))
+
+	assert.Equal(t, AppSourceHelm, identifyAppSourceType("../../util/helm/testdata/minio"))
+}
+
+func TestReadFiles(t *testing.T) {
+	paths := []string{"components/01a_application-crd.yaml", "components/02a_argocd-cm.yaml", "install.yaml"}
+	files, err := readFiles("../../manifests", paths)
+	assert.Nil(t, err)
+	assert.Len(t, files, len(paths))
+	for _, p := range paths {
+		assert.NotEmpty(t, files[p])
+	}
+
+	_, err = readFiles("../../manifests", []string{"install.yaml", "does-not-exist.yaml"})
+	assert.NotNil(t, err)
+}
+
+func TestGetManifestGenerateTimeout(t *testing.T) {
+	s := NewService(nil, nil, nil, DefaultManifestGenerateTimeout, nil)
+	assert.Equal(t, DefaultManifestGenerateTimeout, s.getManifestGenerateTimeout(&ManifestRequest{}))
+	assert.Equal(t, 5*time.Minute, s.getManifestGenerateTimeout(&ManifestRequest{TimeoutSeconds: 300}))
+}
+
+type fakeLocker struct {
+	lock func(key string) error
+}
+
+func (l *fakeLocker) Lock(key string) error {
+	return l.lock(key)
+}
+
+func (l *fakeLocker) Unlock(key string) {
+}
+
+func TestGenerateManifestSharedLock(t *testing.T) {
+	q := ManifestRequest{Repo: &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd"}, Revision: "master", Path: "manifests"}
+
+	repoCache := cache.NewInMemoryCache(DefaultRepoCacheExpiration)
+	// the manifests are generated by another replica while the lock is awaited
+	locker := &fakeLocker{lock: func(key string) error {
+		return repoCache.Set(&cache.Item{Key: key, Object: ManifestResponse{Revision: fakeCommitSHA}})
+	}}
+	s := NewService(&resolvingGitFactory{}, repoCache, locker, DefaultManifestGenerateTimeout, nil)
+	res, err := s.GenerateManifest(context.Background(), &q)
+	assert.Nil(t, err)
+	assert.Equal(t, fakeCommitSHA, res.Revision)
+
+	locker.lock = func(key string) error {
+		return fmt.Errorf("connection refused")
+	}
+	s = NewService(&resolvingGitFactory{}, cache.NewInMemoryCache(DefaultRepoCacheExpiration), locker, DefaultManifestGenerateTimeout, nil)
+	_, err = s.GenerateManifest(context.Background(), &q)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestGetRevision(t *testing.T) {
+	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd", DefaultRevision: "develop"}
+	assert.Equal(t, "develop", getRevision(repo, ""))
+	assert.Equal(t, "v1.0.0", getRevision(repo, "v1.0.0"))
+	assert.Equal(t, "", getRevision(&v1alpha1.Repository{}, ""))
+}
+
+func TestGetGuardrails(t *testing.T) {
+	watchdog := NewWatchdog(cache.NewInMemoryCache(time.Minute), DefaultMaxCacheMemory)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	watchdog.Run(ctx, time.Minute, nil)
+	s := NewService(nil, nil, nil, DefaultManifestGenerateTimeout, watchdog)
+
+	res, err := s.GetGuardrails(context.Background(), &GuardrailsRequest{})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, res.Items)
+	for _, state := range res.Items {
+		assert.Equal(t, guardrailComponent, state.Component)
+		assert.True(t, state.Limit > 0)
+	}
+}
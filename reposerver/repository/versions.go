@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/util/helm"
+	ksutil "github.com/argoproj/argo-cd/util/ksonnet"
+	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/kustomize"
+)
+
+var (
+	toolVersionsOnce sync.Once
+	toolVersions     ToolVersionsResponse
+)
+
+// ToolVersions returns the versions of the tools manifests are rendered with. The tools are bundled
+// with the repo server, so they are only run the first time, which the repo server does at startup.
+func ToolVersions() ToolVersionsResponse {
+	toolVersionsOnce.Do(func() {
+		toolVersions = ToolVersionsResponse{
+			KsonnetVersion:   toolVersion(ksutil.KsonnetVersion),
+			HelmVersion:      toolVersion(helm.Version),
+			KubectlVersion:   toolVersion(kube.KubectlVersion),
+			KustomizeVersion: toolVersion(kustomize.Version),
+		}
+	})
+	return toolVersions
+}
+
+// toolVersion returns the version reported by the tool, or an empty string if it is not installed
+func toolVersion(version func() (string, error)) string {
+	v, err := version()
+	if err != nil {
+		log.Warn(err)
+		return ""
+	}
+	return v
+}
+
+// GetToolVersions returns the versions of the tools the repo server renders manifests with
+func (s *Service) GetToolVersions(ctx context.Context, q *ToolVersionsRequest) (*ToolVersionsResponse, error) {
+	res := ToolVersions()
+	return &res, nil
+}
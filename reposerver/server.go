@@ -1,11 +1,16 @@
 package reposerver
 
 import (
+	"context"
+	"time"
+
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/server/version"
+	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/git"
 	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+	"github.com/argoproj/argo-cd/util/guardrail"
 	"github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	log "github.com/sirupsen/logrus"
@@ -15,17 +20,23 @@ import (
 
 // ArgoCDRepoServer is the repo server implementation
 type ArgoCDRepoServer struct {
-	log        *log.Entry
-	gitFactory git.ClientFactory
-	cache      cache.Cache
+	log                     *log.Entry
+	gitFactory              git.ClientFactory
+	cache                   cache.Cache
+	manifestLock            util.Locker
+	manifestGenerateTimeout time.Duration
+	watchdog                *guardrail.Watchdog
 }
 
 // NewServer returns a new instance of the ArgoCD Repo server
-func NewServer(gitFactory git.ClientFactory, cache cache.Cache) *ArgoCDRepoServer {
+func NewServer(gitFactory git.ClientFactory, cache cache.Cache, manifestLock util.Locker, manifestGenerateTimeout time.Duration, watchdog *guardrail.Watchdog) *ArgoCDRepoServer {
 	return &ArgoCDRepoServer{
-		log:        log.NewEntry(log.New()),
-		gitFactory: gitFactory,
-		cache:      cache,
+		log:                     log.NewEntry(log.New()),
+		gitFactory:              gitFactory,
+		cache:                   cache,
+		manifestLock:            manifestLock,
+		manifestGenerateTimeout: manifestGenerateTimeout,
+		watchdog:                watchdog,
 	}
 }
 
@@ -41,8 +52,10 @@ func (a *ArgoCDRepoServer) CreateGRPC() *grpc.Server {
 			grpc_util.PanicLoggerUnaryServerInterceptor(a.log),
 		)),
 	)
-	version.RegisterVersionServiceServer(server, &version.Server{})
-	manifestService := repository.NewService(a.gitFactory, a.cache)
+	manifestService := repository.NewService(a.gitFactory, a.cache, a.manifestLock, a.manifestGenerateTimeout, a.watchdog)
+	version.RegisterVersionServiceServer(server, version.NewServer(func(ctx context.Context) (*repository.ToolVersionsResponse, error) {
+		return manifestService.GetToolVersions(ctx, &repository.ToolVersionsRequest{})
+	}))
 	repository.RegisterRepositoryServiceServer(server, manifestService)
 
 	// Register reflection service on gRPC server.
@@ -5,34 +5,49 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
+	"github.com/yudai/gojsondiff"
+	"github.com/yudai/gojsondiff/formatter"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
 
-	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/controller"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/actions"
 	"github.com/argoproj/argo-cd/util/argo"
 	argoutil "github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/diff"
 	"github.com/argoproj/argo-cd/util/grpc"
+	"github.com/argoproj/argo-cd/util/health"
+	"github.com/argoproj/argo-cd/util/kube"
 	"github.com/argoproj/argo-cd/util/rbac"
 	"github.com/argoproj/argo-cd/util/session"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 // Server provides a Application service
@@ -46,6 +61,7 @@ type Server struct {
 	enf           *rbac.Enforcer
 	projectLock   *util.KeyLock
 	auditLogger   *argo.AuditLogger
+	settings      *settings.ArgoCDSettings
 }
 
 // NewServer returns a new instance of the Application service
@@ -57,18 +73,23 @@ func NewServer(
 	db db.ArgoDB,
 	enf *rbac.Enforcer,
 	projectLock *util.KeyLock,
+	argoSettings *settings.ArgoCDSettings,
 ) ApplicationServiceServer {
-
+	appComparator := controller.NewAppStateManager(db, appclientset, repoClientset, namespace, &controller.AppStateManagerConfig{
+		ResourceFilter:   argoSettings,
+		ResourceTracking: argo.NewResourceTracking(argoSettings.ResourceTrackingMethod, namespace),
+	})
 	return &Server{
 		ns:            namespace,
 		appclientset:  appclientset,
 		kubeclientset: kubeclientset,
 		db:            db,
 		repoClientset: repoClientset,
-		appComparator: controller.NewAppStateManager(db, appclientset, repoClientset, namespace),
+		appComparator: appComparator,
 		enf:           enf,
 		projectLock:   projectLock,
 		auditLogger:   argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
+		settings:      argoSettings,
 	}
 }
 
@@ -77,35 +98,72 @@ func appRBACName(app appv1.Application) string {
 	return fmt.Sprintf("%s/%s", app.Spec.GetProject(), app.Name)
 }
 
-// List returns list of applications
+// List returns the applications matching the label selector and filters of the query, sorted by
+// name. If a limit is specified in the query, a single page of at most limit applications is listed
+// from the API server and then filtered, so a page can hold fewer applications than the limit even
+// if more are available.
 func (s *Server) List(ctx context.Context, q *ApplicationQuery) (*appv1.ApplicationList, error) {
-	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{})
+	if err := validateSelector(q.Selector); err != nil {
+		return nil, err
+	}
+	if q.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	appList, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).List(metav1.ListOptions{
+		LabelSelector: q.Selector,
+		Limit:         q.Limit,
+		Continue:      q.Continue,
+	})
 	if err != nil {
 		return nil, err
 	}
 	newItems := make([]appv1.Application, 0)
 	for _, a := range appList.Items {
+		if !matchesListFilters(a, q) {
+			continue
+		}
 		if s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(a)) {
 			newItems = append(newItems, a)
 		}
 	}
 	newItems = argoutil.FilterByProjects(newItems, q.Projects)
+	sort.Slice(newItems, func(i, j int) bool {
+		return newItems[i].Name < newItems[j].Name
+	})
 	appList.Items = newItems
 	return appList, nil
 }
 
+// matchesListFilters returns whether or not the application matches the destination cluster, sync
+// status and health status filters of the query
+func matchesListFilters(a appv1.Application, q *ApplicationQuery) bool {
+	if q.Cluster != "" && q.Cluster != a.Spec.Destination.Server {
+		return false
+	}
+	if q.SyncStatus != "" && q.SyncStatus != string(a.Status.ComparisonResult.Status) {
+		return false
+	}
+	if q.HealthStatus != "" && q.HealthStatus != a.Status.Health.Status {
+		return false
+	}
+	return true
+}
+
 // Create creates an application
 func (s *Server) Create(ctx context.Context, q *ApplicationCreateRequest) (*appv1.Application, error) {
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "create", appRBACName(q.Application)) {
 		return nil, grpc.ErrPermissionDenied
 	}
 
+	a := q.Application
+	if q.Validate {
+		return s.validateAppDryRun(ctx, &a)
+	}
+
 	if !q.Application.Spec.BelongsToDefaultProject() {
 		s.projectLock.Lock(q.Application.Spec.Project)
 		defer s.projectLock.Unlock(q.Application.Spec.Project)
 	}
-
-	a := q.Application
 	err := s.validateApp(ctx, &a.Spec)
 	if err != nil {
 		return nil, err
@@ -138,6 +196,156 @@ func (s *Server) Create(ctx context.Context, q *ApplicationCreateRequest) (*appv
 	return out, err
 }
 
+const (
+	bulkApplyActionCreate = "create"
+	bulkApplyActionUpdate = "update"
+	bulkApplyActionNone   = "none"
+)
+
+// BulkApply validates all applications of the request, then creates or updates them. If any of the
+// applications is invalid, none of them are applied. Since Kubernetes has no transactions, the
+// applications already applied are reverted if applying one of the following applications fails.
+func (s *Server) BulkApply(ctx context.Context, q *ApplicationBulkApplyRequest) (*ApplicationBulkApplyResponse, error) {
+	// projects are locked in a consistent order, so concurrent bulk applies cannot deadlock
+	projectSet := make(map[string]bool)
+	for _, a := range q.Applications {
+		if !a.Spec.BelongsToDefaultProject() {
+			projectSet[a.Spec.Project] = true
+		}
+	}
+	projects := make([]string, 0, len(projectSet))
+	for proj := range projectSet {
+		projects = append(projects, proj)
+	}
+	sort.Strings(projects)
+	for _, proj := range projects {
+		s.projectLock.Lock(proj)
+		defer s.projectLock.Unlock(proj)
+	}
+
+	res := ApplicationBulkApplyResponse{Results: make([]ApplicationBulkApplyResult, len(q.Applications))}
+	existingApps := make([]*appv1.Application, len(q.Applications))
+	names := make(map[string]bool)
+	valid := true
+	for i := range q.Applications {
+		a := &q.Applications[i]
+		result := &res.Results[i]
+		result.Name = a.Name
+		if a.Name == "" {
+			result.Error = "application name is required"
+		} else if names[a.Name] {
+			result.Error = "application is specified more than once"
+		} else {
+			names[a.Name] = true
+			action, existing, err := s.validateBulkApplyItem(ctx, a)
+			if err != nil {
+				result.Error = errorMessage(err)
+			}
+			result.Action = action
+			existingApps[i] = existing
+		}
+		if result.Error != "" {
+			valid = false
+		}
+	}
+	if !valid || q.DryRun {
+		return &res, nil
+	}
+
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
+	for i := range q.Applications {
+		a := q.Applications[i]
+		result := &res.Results[i]
+		var out *appv1.Application
+		var err error
+		switch result.Action {
+		case bulkApplyActionCreate:
+			out, err = appIf.Create(&a)
+		case bulkApplyActionUpdate:
+			updated := existingApps[i].DeepCopy()
+			updated.Spec = a.Spec
+			out, err = appIf.Update(updated)
+		default:
+			out = existingApps[i]
+		}
+		if err != nil {
+			result.Error = errorMessage(err)
+			s.revertBulkApply(res.Results[:i], existingApps)
+			return &res, nil
+		}
+		result.Application = out
+	}
+	for _, result := range res.Results {
+		switch result.Action {
+		case bulkApplyActionCreate:
+			s.logEvent(result.Application, ctx, argo.EventReasonResourceCreated, "create")
+		case bulkApplyActionUpdate:
+			s.logEvent(result.Application, ctx, argo.EventReasonResourceUpdated, "update")
+		}
+	}
+	res.Applied = true
+	return &res, nil
+}
+
+// validateBulkApplyItem validates an application of a bulk apply, and returns whether it needs to be
+// created or updated, along with the existing application
+func (s *Server) validateBulkApplyItem(ctx context.Context, a *appv1.Application) (string, *appv1.Application, error) {
+	action := bulkApplyActionCreate
+	existing, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(a.Name, metav1.GetOptions{})
+	if err == nil {
+		action = bulkApplyActionUpdate
+	} else if !apierr.IsNotFound(err) {
+		return "", nil, status.Errorf(codes.Internal, "unable to check existing application details: %v", err)
+	} else {
+		existing = nil
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", action, appRBACName(*a)) {
+		return "", nil, grpc.ErrPermissionDenied
+	}
+	err = s.validateApp(ctx, &a.Spec)
+	if err != nil {
+		return "", nil, err
+	}
+	if existing != nil && reflect.DeepEqual(existing.Spec, a.Spec) {
+		action = bulkApplyActionNone
+	}
+	return action, existing, nil
+}
+
+// revertBulkApply reverts the applications applied by a bulk apply, by deleting the created ones and
+// restoring the previous spec of the updated ones. Failures are reported in the results.
+func (s *Server) revertBulkApply(results []ApplicationBulkApplyResult, existingApps []*appv1.Application) {
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
+	for i := range results {
+		result := &results[i]
+		var err error
+		switch result.Action {
+		case bulkApplyActionCreate:
+			err = appIf.Delete(result.Name, &metav1.DeleteOptions{})
+		case bulkApplyActionUpdate:
+			var current *appv1.Application
+			current, err = appIf.Get(result.Name, metav1.GetOptions{})
+			if err == nil {
+				current.Spec = existingApps[i].Spec
+				_, err = appIf.Update(current)
+			}
+		}
+		if err != nil {
+			log.Warnf("Failed to revert %s of application '%s': %v", result.Action, result.Name, err)
+			result.Error = fmt.Sprintf("unable to revert %s: %v", result.Action, err)
+		}
+		result.Application = nil
+	}
+}
+
+// errorMessage returns the message of a gRPC status error, or the error string of other errors
+func errorMessage(err error) string {
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
+
 // GetManifests returns application manifests
 func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery) (*repository.ManifestResponse, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
@@ -147,7 +355,18 @@ func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery)
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications/manifests", "get", appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
+	format := q.Format
+	if format == "" {
+		format = argoutil.GetManifestFormat(a, s.settings.ManifestFormat)
+	}
+	if format != "" && !argoutil.IsValidManifestFormat(format) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown manifest format '%s', expected one of: yaml, json, jsonl", format)
+	}
 	repo := s.getRepo(ctx, a.Spec.Source.RepoURL)
+	proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
+	if err != nil {
+		return nil, err
+	}
 
 	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
 	if err != nil {
@@ -166,6 +385,7 @@ func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery)
 	if q.Revision != "" {
 		revision = q.Revision
 	}
+	tracking := argoutil.NewResourceTracking(s.settings.ResourceTrackingMethod, s.ns)
 	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &repository.ManifestRequest{
 		Repo:                        repo,
 		Environment:                 a.Spec.Source.Environment,
@@ -174,14 +394,55 @@ func (s *Server) GetManifests(ctx context.Context, q *ApplicationManifestQuery)
 		ComponentParameterOverrides: overrides,
 		AppLabel:                    a.Name,
 		ValueFiles:                  a.Spec.Source.ValuesFiles,
+		TimeoutSeconds:              argoutil.GetManifestGenerateTimeoutSeconds(a),
+		AllowedSourceTypes:          proj.Spec.SourceTools,
+		NoAppLabel:                  !tracking.UsesLabel(),
+		VerifySignature:             len(proj.Spec.SignatureKeys) > 0,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if tracking.UsesAnnotation() {
+		manifestInfo.Manifests, err = setManifestsAppInstance(tracking, a, manifestInfo.Manifests)
+		if err != nil {
+			return nil, err
+		}
+	}
 
+	if format != "" {
+		manifestInfo.Formatted, err = argoutil.FormatManifests(manifestInfo.Manifests, format)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return manifestInfo, nil
 }
 
+// setManifestsAppInstance sets the tracking annotation of the application on the manifests, hooks
+// included, as the controller does before applying them
+func setManifestsAppInstance(tracking argoutil.ResourceTracking, a *appv1.Application, manifests []string) ([]string, error) {
+	res := make([]string, len(manifests))
+	for i, manifest := range manifests {
+		obj, err := appv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			res[i] = manifest
+			continue
+		}
+		if err = tracking.SetAppInstance(obj, a.Name, a.Spec.Destination.Namespace); err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = string(data)
+	}
+	return res, nil
+}
+
 // Get returns an application by name
 func (s *Server) Get(ctx context.Context, q *ApplicationQuery) (*appv1.Application, error) {
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
@@ -192,8 +453,18 @@ func (s *Server) Get(ctx context.Context, q *ApplicationQuery) (*appv1.Applicati
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
-	if q.Refresh {
-		_, err = argoutil.RefreshApp(appIf, *q.Name)
+	refreshType := appv1.RefreshType(q.RefreshType)
+	switch refreshType {
+	case "":
+		if q.Refresh {
+			refreshType = appv1.RefreshTypeNormal
+		}
+	case appv1.RefreshTypeNormal, appv1.RefreshTypeHard:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown refresh type '%s'", q.RefreshType)
+	}
+	if refreshType != "" {
+		_, err = argoutil.RefreshApp(appIf, *q.Name, refreshType)
 		if err != nil {
 			return nil, err
 		}
@@ -205,6 +476,357 @@ func (s *Server) Get(ctx context.Context, q *ApplicationQuery) (*appv1.Applicati
 	return a, nil
 }
 
+// ManagedResources returns the resources managed by an application, filtered by kind, namespace
+// and name. Results are paginated if a limit is specified in the query.
+func (s *Server) ManagedResources(ctx context.Context, q *ManagedResourcesQuery) (*ManagedResourcesResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	offset := 0
+	if q.Continue != "" {
+		offset, err = strconv.Atoi(q.Continue)
+		if err != nil || offset < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid continue token '%s'", q.Continue)
+		}
+	}
+	if q.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	items := make([]appv1.ResourceState, 0)
+	for _, res := range a.Status.ComparisonResult.Resources {
+		obj, err := resourceStateObject(res)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+		if (q.Kind == "" || q.Kind == obj.GetKind()) && (q.Namespace == "" || q.Namespace == obj.GetNamespace()) && (q.ResourceName == "" || q.ResourceName == obj.GetName()) {
+			items = append(items, res)
+		}
+	}
+	res := ManagedResourcesResponse{Total: int64(len(items))}
+	if offset > len(items) {
+		offset = len(items)
+	}
+	items = items[offset:]
+	if q.Limit > 0 && int64(len(items)) > q.Limit {
+		items = items[:q.Limit]
+		res.Continue = strconv.Itoa(offset + len(items))
+	}
+	res.Items = items
+	return &res, nil
+}
+
+// resourceStateObject returns the live object of the resource state, or the target object if the resource is not yet deployed
+func resourceStateObject(res appv1.ResourceState) (*unstructured.Unstructured, error) {
+	obj, err := res.LiveObject()
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		obj, err = res.TargetObject()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
+}
+
+// ResourceTree returns the live resources of an application as a flattened tree, in which each
+// managed resource is followed by the resources it created (e.g. deployment, replica sets, pods)
+func (s *Server) ResourceTree(ctx context.Context, q *ResourceTreeQuery) (*ApplicationTree, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	nodes, err := getResourceTreeNodes(a)
+	if err != nil {
+		return nil, err
+	}
+	return &ApplicationTree{Nodes: nodes}, nil
+}
+
+// GetResource returns the live state of a resource of the resource tree of the application, i.e. of a
+// managed resource or of a resource created by one, with the data of secrets redacted
+func (s *Server) GetResource(ctx context.Context, q *ApplicationResourceRequest) (*ApplicationResourceResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications/resources", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	nodes, err := getResourceTreeNodes(a)
+	if err != nil {
+		return nil, err
+	}
+	var node *ResourceTreeNode
+	for i := range nodes {
+		n := nodes[i]
+		if n.Group == q.Group && n.Kind == q.Kind && n.Namespace == q.Namespace && n.Name == q.ResourceName {
+			node = &n
+			break
+		}
+	}
+	if node == nil {
+		return nil, status.Errorf(codes.NotFound, "%s %s is not a resource of application %s", q.Kind, q.ResourceName, a.Name)
+	}
+	config, _, err := s.getApplicationClusterConfig(a.Name)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(schema.GroupVersion{Group: node.Group, Version: node.Version}.String())
+	obj.SetKind(node.Kind)
+	obj.SetName(node.Name)
+	liveObj, err := kube.GetResource(config, obj, node.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(kube.RedactSecret(liveObj).Object)
+	if err != nil {
+		return nil, err
+	}
+	return &ApplicationResourceResponse{Manifest: string(data)}, nil
+}
+
+// getResourceTreeNodes returns the live resources of the application, each managed resource being
+// followed by the resources it created
+func getResourceTreeNodes(a *appv1.Application) ([]ResourceTreeNode, error) {
+	nodes := make([]ResourceTreeNode, 0)
+	for _, res := range a.Status.ComparisonResult.Resources {
+		obj, err := res.LiveObject()
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+		nodes = append(nodes, newResourceTreeNode(obj, "", string(res.Status), res.Health))
+		for _, child := range res.ChildLiveResources {
+			if nodes, err = appendResourceTreeNodes(nodes, child, string(obj.GetUID())); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return nodes, nil
+}
+
+// appendResourceTreeNodes appends the live resource of the node and its children to the nodes of a tree
+func appendResourceTreeNodes(nodes []ResourceTreeNode, node appv1.ResourceNode, parentUID string) ([]ResourceTreeNode, error) {
+	obj, err := appv1.UnmarshalToUnstructured(node.State)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nodes, nil
+	}
+	resHealth, _ := health.GetAppHealth(obj)
+	nodes = append(nodes, newResourceTreeNode(obj, parentUID, "", *resHealth))
+	for _, child := range node.Children {
+		if nodes, err = appendResourceTreeNodes(nodes, child, string(obj.GetUID())); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func newResourceTreeNode(obj *unstructured.Unstructured, parentUID string, syncStatus string, resHealth appv1.HealthStatus) ResourceTreeNode {
+	gvk := obj.GroupVersionKind()
+	return ResourceTreeNode{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		UID:       string(obj.GetUID()),
+		ParentUID: parentUID,
+		Status:    syncStatus,
+		Health:    resHealth,
+		Images:    kube.GetResourceImages(obj),
+	}
+}
+
+// CompareRevisions renders the application at its current revision and at the proposed revision, and
+// compares both with the live state. The current revision is the most recently deployed revision, or
+// the target revision if the application was never synced.
+func (s *Server) CompareRevisions(ctx context.Context, q *ApplicationCompareRevisionsQuery) (*ApplicationCompareRevisionsResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	if q.Revision == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "revision is required")
+	}
+	currentRevision := a.Spec.Source.TargetRevision
+	if len(a.Status.History) > 0 {
+		currentRevision = a.Status.History[len(a.Status.History)-1].Revision
+	}
+	current, err := s.compareRevision(a, currentRevision)
+	if err != nil {
+		return nil, err
+	}
+	proposed, err := s.compareRevision(a, q.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return &ApplicationCompareRevisionsResponse{Current: *current, Proposed: *proposed}, nil
+}
+
+// compareRevision compares the live state of the application with its manifests at the given revision
+func (s *Server) compareRevision(a *appv1.Application, revision string) (*RevisionComparison, error) {
+	comparison, _, conditions, err := s.appComparator.CompareAppState(a, revision, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	return &RevisionComparison{Revision: revision, ComparisonResult: *comparison, Conditions: conditions}, nil
+}
+
+// Diff returns the diff between the live and target state of the resources of an application. The
+// resources are normalized and diffed exactly like the controller does, so that clients report the
+// same differences the controller acts on.
+func (s *Server) Diff(ctx context.Context, q *ApplicationDiffQuery) (*ApplicationDiffResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	comparison := &a.Status.ComparisonResult
+	if q.Revision != "" {
+		res, err := s.compareRevision(a, q.Revision)
+		if err != nil {
+			return nil, err
+		}
+		comparison = &res.ComparisonResult
+	}
+	targetObjs, err := comparison.TargetObjects()
+	if err != nil {
+		return nil, err
+	}
+	liveObjs, err := comparison.LiveObjects()
+	if err != nil {
+		return nil, err
+	}
+	targetObjs, liveObjs, err = controller.NormalizeDiffObjects(a, targetObjs, liveObjs)
+	if err != nil {
+		return nil, err
+	}
+	diffResults, err := diff.DiffArray(targetObjs, liveObjs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := ApplicationDiffResponse{
+		Items:    make([]ResourceDiff, len(targetObjs)),
+		Revision: comparison.Revision,
+	}
+	for i := range targetObjs {
+		obj := targetObjs[i]
+		if obj == nil {
+			obj = liveObjs[i]
+		}
+		item := &res.Items[i]
+		if obj != nil {
+			item.Group = obj.GroupVersionKind().Group
+			item.Kind = obj.GetKind()
+			item.Namespace = obj.GetNamespace()
+			item.Name = obj.GetName()
+		}
+		if item.TargetState, err = marshalDiffObject(targetObjs[i]); err != nil {
+			return nil, err
+		}
+		if item.LiveState, err = marshalDiffObject(liveObjs[i]); err != nil {
+			return nil, err
+		}
+
+		diffResult := diffResults.Diffs[i]
+		left := targetObjs[i]
+		if left == nil && liveObjs[i] != nil {
+			// like the controller, report live resources which are not target resources as modified
+			left = &unstructured.Unstructured{Object: make(map[string]interface{})}
+			diffResult.Diff = gojsondiff.New().CompareObjects(left.Object, liveObjs[i].Object)
+			diffResult.Modified = true
+		}
+		item.Modified = diffResult.Modified || liveObjs[i] == nil
+		if diffResult.Diff.Modified() {
+			item.Diff, err = diffResult.ASCIIFormat(left, formatter.AsciiFormatterConfig{})
+			if err != nil {
+				return nil, err
+			}
+		}
+		res.Modified = res.Modified || item.Modified
+	}
+	return &res, nil
+}
+
+// marshalDiffObject returns the JSON of a normalized object, or null if the object does not exist
+func marshalDiffObject(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "null", nil
+	}
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// HookOutput returns the status and captured output of a hook of the current or most recent operation
+func (s *Server) HookOutput(ctx context.Context, q *ApplicationHookQuery) (*appv1.HookStatus, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	opState := a.Status.OperationState
+	if opState != nil {
+		for _, res := range []*appv1.SyncOperationResult{opState.SyncResult, opState.RollbackResult} {
+			if res == nil {
+				continue
+			}
+			for _, hook := range res.Hooks {
+				if hook.Name == *q.HookName && (q.Kind == "" || hook.Kind == q.Kind) {
+					return hook, nil
+				}
+			}
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "hook '%s' not found in operation of application '%s'", *q.HookName, a.Name)
+}
+
+// History returns the deployment history of an application, oldest first. The IDs of the
+// deployments can be passed to Rollback to redeploy them.
+func (s *Server) History(ctx context.Context, q *ApplicationHistoryQuery) (*ApplicationHistoryResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	history := a.Status.History
+	if history == nil {
+		history = []appv1.DeploymentInfo{}
+	}
+	return &ApplicationHistoryResponse{Items: history}, nil
+}
+
 // ListResourceEvents returns a list of event resources
 func (s *Server) ListResourceEvents(ctx context.Context, q *ApplicationResourceEventsQuery) (*v1.EventList, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
@@ -252,18 +874,88 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *ApplicationResourceE
 	return kubeClientset.CoreV1().Events(namespace).List(opts)
 }
 
+// ListEvents returns the events of the live resources of an application, queried from the namespaces
+// of the resources in the destination cluster and sorted by the time they were last seen
+func (s *Server) ListEvents(ctx context.Context, q *ApplicationEventsQuery) (*v1.EventList, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications/events", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	if q.Type != "" && q.Type != v1.EventTypeNormal && q.Type != v1.EventTypeWarning {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown event type '%s'", q.Type)
+	}
+	nodes, err := getResourceTreeNodes(a)
+	if err != nil {
+		return nil, err
+	}
+	config, _, err := s.getApplicationClusterConfig(*q.Name)
+	if err != nil {
+		return nil, err
+	}
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	events, err := listResourceTreeEvents(kubeClientset, nodes, q.Type)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.EventList{Items: events}, nil
+}
+
+// listResourceTreeEvents lists the events of the namespaces of the nodes, and returns the ones
+// involving a node. Events of cluster scoped resources are recorded in the default namespace.
+func listResourceTreeEvents(kubeClientset kubernetes.Interface, nodes []ResourceTreeNode, eventType string) ([]v1.Event, error) {
+	uids := make(map[string]bool)
+	namespaces := make(map[string]bool)
+	for _, node := range nodes {
+		uids[node.UID] = true
+		if node.Namespace == "" {
+			namespaces[metav1.NamespaceDefault] = true
+		} else {
+			namespaces[node.Namespace] = true
+		}
+	}
+	var opts metav1.ListOptions
+	if eventType != "" {
+		opts.FieldSelector = fields.OneTermEqualSelector("type", eventType).String()
+	}
+	events := make([]v1.Event, 0)
+	for namespace := range namespaces {
+		eventList, err := kubeClientset.CoreV1().Events(namespace).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range eventList.Items {
+			if uids[string(event.InvolvedObject.UID)] {
+				events = append(events, event)
+			}
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp.Before(&events[j].LastTimestamp)
+	})
+	return events, nil
+}
+
 // Update updates an application
 func (s *Server) Update(ctx context.Context, q *ApplicationUpdateRequest) (*appv1.Application, error) {
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "update", appRBACName(*q.Application)) {
 		return nil, grpc.ErrPermissionDenied
 	}
 
+	a := q.Application
+	if q.Validate {
+		return s.validateAppDryRun(ctx, a)
+	}
+
 	if !q.Application.Spec.BelongsToDefaultProject() {
 		s.projectLock.Lock(q.Application.Spec.Project)
 		defer s.projectLock.Unlock(q.Application.Spec.Project)
 	}
-
-	a := q.Application
 	err := s.validateApp(ctx, &a.Spec)
 	if err != nil {
 		return nil, err
@@ -341,6 +1033,112 @@ func (s *Server) UpdateSpec(ctx context.Context, q *ApplicationUpdateSpecRequest
 	}
 }
 
+// Patch patches the spec of an application with a JSON patch, JSON merge patch or strategic merge patch.
+// The patch is applied to the latest version of the application, and re-applied a few times if the update
+// conflicts with a concurrent update, so clients can change a single field without a read-modify-write race.
+func (s *Server) Patch(ctx context.Context, q *ApplicationPatchRequest) (*appv1.Application, error) {
+	if q.Patch == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "patch must not be empty")
+	}
+	var updated *appv1.Application
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(q.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		updated, err = s.patchApp(ctx, a, q)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(updated, ctx, argo.EventReasonResourceUpdated, "patch")
+	return updated, nil
+}
+
+func (s *Server) patchApp(ctx context.Context, a *appv1.Application, q *ApplicationPatchRequest) (*appv1.Application, error) {
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "update", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	patched, err := applyAppPatch(a, q.Patch, q.PatchType)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to patch application '%s': %v", a.Name, err)
+	}
+	if patched.Spec.GetProject() != a.Spec.GetProject() {
+		// moving the application to another project requires the permission to update it in that project
+		if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "update", appRBACName(*patched)) {
+			return nil, grpc.ErrPermissionDenied
+		}
+	}
+
+	if !patched.Spec.BelongsToDefaultProject() {
+		s.projectLock.Lock(patched.Spec.Project)
+		defer s.projectLock.Unlock(patched.Spec.Project)
+	}
+
+	err = s.validateApp(ctx, &patched.Spec)
+	if err != nil {
+		return nil, err
+	}
+	specReq, err := s.removeInvalidOverrides(a, &ApplicationUpdateSpecRequest{Name: q.Name, Spec: patched.Spec})
+	if err != nil {
+		return nil, err
+	}
+	patched.Spec = specReq.Spec
+	return s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(patched)
+}
+
+// applyAppPatch returns a copy of the application with the patch of the given type, json, merge or
+// strategic, applied. Patches may only change the spec of the application.
+func applyAppPatch(a *appv1.Application, patch string, patchType string) (*appv1.Application, error) {
+	orig, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	var patchedJSON []byte
+	switch patchType {
+	case "json":
+		var jsonPatch jsonpatch.Patch
+		jsonPatch, err = jsonpatch.DecodePatch([]byte(patch))
+		if err == nil {
+			patchedJSON, err = jsonPatch.Apply(orig)
+		}
+	case "", "merge":
+		patchedJSON, err = jsonpatch.MergePatch(orig, []byte(patch))
+	case "strategic":
+		patchedJSON, err = strategicpatch.StrategicMergePatch(orig, []byte(patch), appv1.Application{})
+	default:
+		return nil, fmt.Errorf("unknown patch type '%s', must be one of: json, merge, strategic", patchType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var patched appv1.Application
+	if err := json.Unmarshal(patchedJSON, &patched); err != nil {
+		return nil, err
+	}
+
+	// compare everything but the spec of the original and patched application
+	withoutSpec := func(app appv1.Application) ([]byte, error) {
+		app.Spec = appv1.ApplicationSpec{}
+		return json.Marshal(app)
+	}
+	origRest, err := withoutSpec(*a)
+	if err != nil {
+		return nil, err
+	}
+	patchedRest, err := withoutSpec(patched)
+	if err != nil {
+		return nil, err
+	}
+	if string(origRest) != string(patchedRest) {
+		return nil, fmt.Errorf("only the spec of an application can be patched")
+	}
+	result := a.DeepCopy()
+	result.Spec = patched.Spec
+	return result, nil
+}
+
 // Delete removes an application and all associated resources
 func (s *Server) Delete(ctx context.Context, q *ApplicationDeleteRequest) (*ApplicationResponse, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
@@ -397,8 +1195,13 @@ func (s *Server) Delete(ctx context.Context, q *ApplicationDeleteRequest) (*Appl
 	return &ApplicationResponse{}, nil
 }
 
+// Watch streams the added, modified and deleted applications matching the name, projects and label
+// selector of the query, until the client disconnects
 func (s *Server) Watch(q *ApplicationQuery, ws ApplicationService_WatchServer) error {
-	w, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Watch(metav1.ListOptions{})
+	if err := validateSelector(q.Selector); err != nil {
+		return err
+	}
+	w, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Watch(metav1.ListOptions{LabelSelector: q.Selector})
 	if err != nil {
 		return err
 	}
@@ -406,19 +1209,29 @@ func (s *Server) Watch(q *ApplicationQuery, ws ApplicationService_WatchServer) e
 	done := make(chan bool)
 	go func() {
 		for next := range w.ResultChan() {
-			a := *next.Object.(*appv1.Application)
-			if q.Name == nil || *q.Name == "" || *q.Name == a.Name {
-				if !s.enf.EnforceClaims(claims, "applications", "get", appRBACName(a)) {
-					// do not emit apps user does not have accessing
-					continue
-				}
-				err = ws.Send(&appv1.ApplicationWatchEvent{
-					Type:        next.Type,
-					Application: a,
-				})
-				if err != nil {
-					log.Warnf("Unable to send stream message: %v", err)
-				}
+			app, ok := next.Object.(*appv1.Application)
+			if !ok {
+				// errors of the watch are reported as status objects
+				log.Warnf("Unexpected object in application watch: %v", next.Object)
+				continue
+			}
+			a := *app
+			if q.Name != nil && *q.Name != "" && *q.Name != a.Name {
+				continue
+			}
+			if len(argoutil.FilterByProjects([]appv1.Application{a}, q.Projects)) == 0 {
+				continue
+			}
+			if !s.enf.EnforceClaims(claims, "applications", "get", appRBACName(a)) {
+				// do not emit apps user does not have accessing
+				continue
+			}
+			err = ws.Send(&appv1.ApplicationWatchEvent{
+				Type:        next.Type,
+				Application: a,
+			})
+			if err != nil {
+				log.Warnf("Unable to send stream message: %v", err)
 			}
 		}
 		done <- true
@@ -432,25 +1245,81 @@ func (s *Server) Watch(q *ApplicationQuery, ws ApplicationService_WatchServer) e
 	return nil
 }
 
+// validateSelector returns an invalid argument error if the label selector of a query cannot be parsed
+func validateSelector(selector string) error {
+	if _, err := labels.Parse(selector); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid selector '%s': %v", selector, err)
+	}
+	return nil
+}
+
+// validateApp returns an invalid argument error if the application spec is invalid
 func (s *Server) validateApp(ctx context.Context, spec *appv1.ApplicationSpec) error {
+	_, conditions, err := s.getSpecErrors(ctx, spec)
+	if err != nil {
+		return err
+	}
+	if len(conditions) > 0 {
+		return status.Errorf(codes.InvalidArgument, "application spec is invalid: %s", argo.FormatAppConditions(conditions))
+	}
+	return nil
+}
+
+// getSpecErrors returns the project and the InvalidSpecError conditions of the application spec, or an
+// error if the user may not get the project. The project is nil if it does not exist.
+func (s *Server) getSpecErrors(ctx context.Context, spec *appv1.ApplicationSpec) (*appv1.AppProject, []appv1.ApplicationCondition, error) {
 	proj, err := argo.GetAppProject(spec, s.appclientset, s.ns)
 	if err != nil {
 		if apierr.IsNotFound(err) {
-			return status.Errorf(codes.InvalidArgument, "application referencing project %s which does not exist", spec.Project)
+			return nil, []appv1.ApplicationCondition{{
+				Type:    appv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application referencing project %s which does not exist", spec.Project),
+			}}, nil
 		}
-		return err
+		return nil, nil, err
 	}
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "projects", "get", proj.Name) {
-		return status.Errorf(codes.PermissionDenied, "permission denied for project %s", proj.Name)
+		return nil, nil, status.Errorf(codes.PermissionDenied, "permission denied for project %s", proj.Name)
 	}
 	conditions, err := argo.GetSpecErrors(ctx, spec, proj, s.repoClientset, s.db)
+	return proj, conditions, err
+}
+
+// clusterReachabilityTimeout is the timeout of the requests verifying the destination cluster of an
+// application is reachable
+const clusterReachabilityTimeout = 10 * time.Second
+
+// validateAppDryRun validates an application without persisting it, and returns the application with
+// every validation error as an InvalidSpecError condition, so that clients can report all the errors
+// at once. Unlike the validation of applied applications, it also verifies the destination cluster
+// is reachable. Since nothing is persisted, it does not hold the project lock, so the slow reachability
+// check does not block the creations and updates of the applications of the project.
+func (s *Server) validateAppDryRun(ctx context.Context, a *appv1.Application) (*appv1.Application, error) {
+	out := a.DeepCopy()
+	proj, conditions, err := s.getSpecErrors(ctx, &out.Spec)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if len(conditions) > 0 {
-		return status.Errorf(codes.InvalidArgument, "application spec is invalid: %s", argo.FormatAppConditions(conditions))
+	out.Status.Conditions = append(make([]appv1.ApplicationCondition, 0), conditions...)
+	if proj != nil && out.Spec.Destination.Server != "" && out.Spec.Destination.Namespace != "" {
+		clst, err := s.db.GetCluster(ctx, out.Spec.Destination.Server)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				// the missing cluster is already reported by the spec errors
+				return out, nil
+			}
+			return nil, err
+		}
+		config := clst.RESTConfig()
+		config.Timeout = clusterReachabilityTimeout
+		if err := kube.TestConfig(config); err != nil {
+			out.Status.Conditions = append(out.Status.Conditions, appv1.ApplicationCondition{
+				Type:    appv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("cluster '%s' is not reachable: %v", out.Spec.Destination.Server, err),
+			})
+		}
 	}
-	return nil
+	return out, nil
 }
 
 func (s *Server) getApplicationClusterConfig(applicationName string) (*rest.Config, string, error) {
@@ -466,19 +1335,38 @@ func (s *Server) getApplicationClusterConfig(applicationName string) (*rest.Conf
 	return config, namespace, err
 }
 
-func (s *Server) ensurePodBelongsToApp(applicationName string, podName, namespace string, kubeClientset *kubernetes.Clientset) error {
-	pod, err := kubeClientset.CoreV1().Pods(namespace).Get(podName, metav1.GetOptions{})
+// ensurePodBelongsToApp returns an error unless the pod is part of the resource tree of the application,
+// i.e. it is either managed by the application or was created by one of its resources
+func ensurePodBelongsToApp(a *appv1.Application, podName, namespace string) error {
+	for _, res := range a.Status.ComparisonResult.Resources {
+		node := appv1.ResourceNode{State: res.LiveState, Children: res.ChildLiveResources}
+		found, err := isPodInResourceTree(node, podName, namespace)
+		if err != nil {
+			return err
+		}
+		if found {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "pod %s does not belong to application %s", podName, a.Name)
+}
+
+// isPodInResourceTree returns whether the resource node, or any of its descendants, is the pod
+func isPodInResourceTree(node appv1.ResourceNode, podName, namespace string) (bool, error) {
+	obj, err := appv1.UnmarshalToUnstructured(node.State)
 	if err != nil {
-		return err
+		return false, err
 	}
-	wrongPodError := status.Errorf(codes.InvalidArgument, "pod %s does not belong to application %s", podName, applicationName)
-	if pod.Labels == nil {
-		return wrongPodError
+	if obj != nil && obj.GetKind() == kube.PodKind && obj.GetName() == podName && obj.GetNamespace() == namespace {
+		return true, nil
 	}
-	if value, ok := pod.Labels[common.LabelApplicationName]; !ok || value != applicationName {
-		return wrongPodError
+	for _, child := range node.Children {
+		found, err := isPodInResourceTree(child, podName, namespace)
+		if err != nil || found {
+			return found, err
+		}
 	}
-	return nil
+	return false, nil
 }
 
 func (s *Server) DeletePod(ctx context.Context, q *ApplicationDeletePodRequest) (*ApplicationResponse, error) {
@@ -499,7 +1387,7 @@ func (s *Server) DeletePod(ctx context.Context, q *ApplicationDeletePodRequest)
 	if err != nil {
 		return nil, err
 	}
-	err = s.ensurePodBelongsToApp(*q.Name, *q.PodName, namespace, kubeClientset)
+	err = ensurePodBelongsToApp(a, *q.PodName, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -510,6 +1398,79 @@ func (s *Server) DeletePod(ctx context.Context, q *ApplicationDeletePodRequest)
 	return &ApplicationResponse{}, nil
 }
 
+// getManagedLiveResource reads the live state of a resource managed by the application from the
+// cluster, and returns it along with the config of the cluster
+func (s *Server) getManagedLiveResource(a *appv1.Application, group, kind, namespace, name string) (*unstructured.Unstructured, *rest.Config, error) {
+	for _, res := range a.Status.ComparisonResult.Resources {
+		obj, err := res.LiveObject()
+		if err != nil {
+			return nil, nil, err
+		}
+		if obj == nil || obj.GroupVersionKind().Group != group || obj.GetKind() != kind || obj.GetNamespace() != namespace || obj.GetName() != name {
+			continue
+		}
+		config, _, err := s.getApplicationClusterConfig(a.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		liveObj, err := kube.GetResource(config, obj, namespace)
+		if err != nil {
+			return nil, nil, err
+		}
+		return liveObj, config, nil
+	}
+	return nil, nil, status.Errorf(codes.NotFound, "%s %s is not a live resource of application %s", kind, name, a.Name)
+}
+
+// ListResourceActions returns the built-in and custom actions which can be run on a resource of the application
+func (s *Server) ListResourceActions(ctx context.Context, q *ResourceActionsListQuery) (*ResourceActionsListResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "get", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	obj, _, err := s.getManagedLiveResource(a, q.Group, q.Kind, q.Namespace, q.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+	res := &ResourceActionsListResponse{Actions: make([]ResourceAction, 0)}
+	for _, action := range actions.GetActions(obj, s.settings.ResourceActions) {
+		res.Actions = append(res.Actions, ResourceAction{Name: action.Name, Params: action.Params})
+	}
+	return res, nil
+}
+
+// RunResourceAction runs an action on a resource of the application, and updates the resource in the cluster
+func (s *Server) RunResourceAction(ctx context.Context, q *ResourceActionRunRequest) (*ApplicationResponse, error) {
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "action", appRBACName(*a)) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	obj, config, err := s.getManagedLiveResource(a, q.Group, q.Kind, q.Namespace, q.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string)
+	for _, param := range q.Parameters {
+		params[param.Name] = param.Value
+	}
+	newObj, err := actions.RunAction(obj, q.Action, params, s.settings.ResourceActions)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	_, err = kube.UpdateResource(config, newObj, q.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	s.logEvent(a, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("%s on %s %s", q.Action, q.Kind, q.ResourceName))
+	return &ApplicationResponse{}, nil
+}
+
 func (s *Server) PodLogs(q *ApplicationPodLogsQuery, ws ApplicationService_PodLogsServer) error {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*q.Name, metav1.GetOptions{})
 	if err != nil {
@@ -526,7 +1487,7 @@ func (s *Server) PodLogs(q *ApplicationPodLogsQuery, ws ApplicationService_PodLo
 	if err != nil {
 		return err
 	}
-	err = s.ensurePodBelongsToApp(*q.Name, *q.PodName, namespace, kubeClientset)
+	err = ensurePodBelongsToApp(a, *q.PodName, namespace)
 	if err != nil {
 		return err
 	}
@@ -610,19 +1571,91 @@ func (s *Server) Sync(ctx context.Context, syncReq *ApplicationSyncRequest) (*ap
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "sync", appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
+	if syncReq.Strategy != nil && syncReq.Strategy.Hook != nil && syncReq.Strategy.Hook.Namespace != "" {
+		proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
+		if err != nil {
+			return nil, err
+		}
+		hookDest := appv1.ApplicationDestination{Server: a.Spec.Destination.Server, Namespace: syncReq.Strategy.Hook.Namespace}
+		if !proj.IsDestinationPermitted(hookDest) {
+			return nil, status.Errorf(codes.InvalidArgument, "hook namespace %s is not permitted in project %s", hookDest.Namespace, proj.Name)
+		}
+	}
+	if syncReq.Retry != nil {
+		if err := syncReq.Retry.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sync retry strategy: %v", err)
+		}
+	}
+	// dry runs preview the sync without changing the cluster, so sync windows do not apply to them
+	if !syncReq.DryRun {
+		if err := s.checkSyncWindows(a); err != nil {
+			return nil, err
+		}
+	}
 	return s.setAppOperation(ctx, *syncReq.Name, "sync", func(app *appv1.Application) (*appv1.Operation, error) {
 		syncOp := appv1.SyncOperation{
 			Revision:     syncReq.Revision,
 			Prune:        syncReq.Prune,
 			DryRun:       syncReq.DryRun,
 			SyncStrategy: syncReq.Strategy,
+			Resources:    syncReq.Resources,
 		}
 		return &appv1.Operation{
-			Sync: &syncOp,
+			Sync:        &syncOp,
+			Retry:       syncReq.Retry,
+			InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 		}, nil
 	})
 }
 
+// defaultBatchSyncConcurrency is the number of applications synced concurrently by a batch sync, unless
+// specified in the request
+const defaultBatchSyncConcurrency = 10
+
+// BatchSync syncs all applications matching the label selector and projects of the request, at most
+// concurrency applications at a time. The applications which cannot be synced, e.g. because the
+// user is not permitted to sync them, are reported in the results and do not fail the other syncs.
+func (s *Server) BatchSync(ctx context.Context, q *ApplicationBatchSyncRequest) (*ApplicationBatchSyncResponse, error) {
+	if q.Selector == "" && len(q.Projects) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "a selector or a project is required")
+	}
+	if q.Concurrency < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "concurrency must not be negative")
+	}
+	concurrency := int(q.Concurrency)
+	if concurrency == 0 {
+		concurrency = defaultBatchSyncConcurrency
+	}
+	appList, err := s.List(ctx, &ApplicationQuery{Selector: q.Selector, Projects: q.Projects})
+	if err != nil {
+		return nil, err
+	}
+
+	res := ApplicationBatchSyncResponse{Results: make([]ApplicationBatchSyncResult, len(appList.Items))}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range appList.Items {
+		result := &res.Results[i]
+		result.Name = appList.Items[i].Name
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			app, err := s.Sync(ctx, &ApplicationSyncRequest{Name: &result.Name, Prune: q.Prune, DryRun: q.DryRun})
+			if err != nil {
+				result.Error = errorMessage(err)
+			} else {
+				result.Application = app
+			}
+		}()
+	}
+	wg.Wait()
+	return &res, nil
+}
+
 func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackRequest) (*appv1.Application, error) {
 	a, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*rollbackReq.Name, metav1.GetOptions{})
 	if err != nil {
@@ -631,6 +1664,14 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackR
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "applications", "rollback", appRBACName(*a)) {
 		return nil, grpc.ErrPermissionDenied
 	}
+	if !hasDeployment(a, rollbackReq.ID) {
+		return nil, status.Errorf(codes.InvalidArgument, "application %s does not have deployment with id %v", a.Name, rollbackReq.ID)
+	}
+	if !rollbackReq.DryRun {
+		if err := s.checkSyncWindows(a); err != nil {
+			return nil, err
+		}
+	}
 	return s.setAppOperation(ctx, *rollbackReq.Name, "rollback", func(app *appv1.Application) (*appv1.Operation, error) {
 		return &appv1.Operation{
 			Rollback: &appv1.RollbackOperation{
@@ -638,10 +1679,38 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *ApplicationRollbackR
 				Prune:  rollbackReq.Prune,
 				DryRun: rollbackReq.DryRun,
 			},
+			InitiatedBy: appv1.OperationInitiator{Username: session.Username(ctx)},
 		}, nil
 	})
 }
 
+// checkSyncWindows returns an error if the sync windows of the project of the application currently
+// deny to sync it manually
+func (s *Server) checkSyncWindows(a *appv1.Application) error {
+	proj, err := argo.GetAppProject(&a.Spec, s.appclientset, s.ns)
+	if err != nil {
+		return err
+	}
+	permitted, err := proj.IsSyncPermitted(a, true, time.Now())
+	if err != nil {
+		return err
+	}
+	if !permitted {
+		return status.Errorf(codes.FailedPrecondition, "sync of application %s is denied by the sync windows of project %s", a.Name, proj.Name)
+	}
+	return nil
+}
+
+// hasDeployment returns whether the history of the application contains the deployment with the given ID
+func hasDeployment(app *appv1.Application, id int64) bool {
+	for _, info := range app.Status.History {
+		if info.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Server) setAppOperation(ctx context.Context, appName string, operationName string, operationCreator func(app *appv1.Application) (*appv1.Operation, error)) (*appv1.Application, error) {
 	for {
 		a, err := s.Get(ctx, &ApplicationQuery{Name: &appName})
@@ -679,24 +1748,28 @@ func (s *Server) TerminateOperation(ctx context.Context, termOpReq *OperationTer
 	}
 
 	for i := 0; i < 10; i++ {
+		// operations awaiting a retry are still terminated, so that they are not retried
 		if a.Operation == nil || a.Status.OperationState == nil {
 			return nil, status.Errorf(codes.InvalidArgument, "Unable to terminate operation. No operation is in progress")
 		}
+		if a.Status.OperationState.Phase == appv1.OperationTerminating {
+			return &OperationTerminateResponse{}, nil
+		}
 		a.Status.OperationState.Phase = appv1.OperationTerminating
+		a.Status.OperationState.Message = "operation is terminating"
 		_, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(a)
 		if err == nil {
+			s.logEvent(a, ctx, argo.EventReasonResourceUpdated, "terminateop")
 			return &OperationTerminateResponse{}, nil
 		}
 		if !apierr.IsConflict(err) {
 			return nil, err
 		}
-		log.Warnf("Failed to set operation for app '%s' due to update conflict. Retrying again...", termOpReq.Name)
+		log.Warnf("Failed to set operation for app '%s' due to update conflict. Retrying again...", *termOpReq.Name)
 		time.Sleep(100 * time.Millisecond)
 		a, err = s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Get(*termOpReq.Name, metav1.GetOptions{})
 		if err != nil {
 			return nil, err
-		} else {
-			s.logEvent(a, ctx, argo.EventReasonResourceUpdated, "terminateop")
 		}
 	}
 	return nil, status.Errorf(codes.Internal, "Failed to terminate app. Too many conflicts")
@@ -1,12 +1,25 @@
 package application
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
+	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/errors"
@@ -17,8 +30,10 @@ import (
 	mockreposerver "github.com/argoproj/argo-cd/reposerver/repository/mocks"
 	"github.com/argoproj/argo-cd/test"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 const (
@@ -73,7 +88,7 @@ func fakeListDirResponse() *repository.FileList {
 }
 
 // return an ApplicationServiceServer which returns fake data
-func newTestAppServer() ApplicationServiceServer {
+func newTestAppServer(objects ...runtime.Object) ApplicationServiceServer {
 	kubeclientset := fake.NewSimpleClientset()
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
 	enforcer.SetBuiltinPolicy(test.BuiltinPolicy)
@@ -96,11 +111,12 @@ func newTestAppServer() ApplicationServiceServer {
 	return NewServer(
 		testNamespace,
 		kubeclientset,
-		apps.NewSimpleClientset(),
+		apps.NewSimpleClientset(objects...),
 		mockRepoClient,
 		db,
 		enforcer,
 		util.NewKeyLock(),
+		&settings.ArgoCDSettings{},
 	)
 }
 
@@ -126,3 +142,653 @@ func TestCreateApp(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, app.Spec.Project, "default")
 }
+
+func fakeResourceState(kind string, namespace string, name string) appsv1.ResourceState {
+	return appsv1.ResourceState{
+		TargetState: fmt.Sprintf(`{"apiVersion":"v1","kind":"%s","metadata":{"namespace":"%s","name":"%s"}}`, kind, namespace, name),
+		LiveState:   "null",
+	}
+}
+
+func TestManagedResources(t *testing.T) {
+	app := appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: testNamespace},
+		Status: appsv1.ApplicationStatus{
+			ComparisonResult: appsv1.ComparisonResult{
+				Resources: []appsv1.ResourceState{
+					fakeResourceState("Service", "default", "guestbook-ui"),
+					fakeResourceState("Deployment", "default", "guestbook-ui"),
+					fakeResourceState("ConfigMap", "default", "config-1"),
+					fakeResourceState("ConfigMap", "kube-system", "config-2"),
+					fakeResourceState("ConfigMap", "default", "config-3"),
+				},
+			},
+		},
+	}
+	appServer := newTestAppServer(&app)
+	appName := "test-app"
+
+	res, err := appServer.ManagedResources(context.Background(), &ManagedResourcesQuery{Name: &appName})
+	assert.Nil(t, err)
+	assert.Len(t, res.Items, 5)
+	assert.Equal(t, "", res.Continue)
+
+	res, err = appServer.ManagedResources(context.Background(), &ManagedResourcesQuery{Name: &appName, Kind: "ConfigMap", Namespace: "default"})
+	assert.Nil(t, err)
+	assert.Len(t, res.Items, 2)
+	assert.Equal(t, int64(2), res.Total)
+
+	res, err = appServer.ManagedResources(context.Background(), &ManagedResourcesQuery{Name: &appName, ResourceName: "guestbook-ui"})
+	assert.Nil(t, err)
+	assert.Len(t, res.Items, 2)
+
+	// paginate through all resources, two at a time
+	var items []appsv1.ResourceState
+	q := ManagedResourcesQuery{Name: &appName, Limit: 2}
+	for {
+		res, err = appServer.ManagedResources(context.Background(), &q)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), res.Total)
+		items = append(items, res.Items...)
+		if res.Continue == "" {
+			break
+		}
+		q.Continue = res.Continue
+	}
+	assert.Equal(t, app.Status.ComparisonResult.Resources, items)
+
+	_, err = appServer.ManagedResources(context.Background(), &ManagedResourcesQuery{Name: &appName, Continue: "abc"})
+	assert.NotNil(t, err)
+}
+
+func TestResourceTree(t *testing.T) {
+	app := appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: testNamespace},
+		Status: appsv1.ApplicationStatus{
+			ComparisonResult: appsv1.ComparisonResult{
+				Resources: []appsv1.ResourceState{{
+					LiveState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"default","uid":"1"}}`,
+					Status:    appsv1.ComparisonStatusSynced,
+					Health:    appsv1.HealthStatus{Status: appsv1.HealthStatusHealthy},
+					ChildLiveResources: []appsv1.ResourceNode{{
+						State: `{"apiVersion":"apps/v1","kind":"ReplicaSet","metadata":{"name":"guestbook-5d8f","namespace":"default","uid":"2"}}`,
+						Children: []appsv1.ResourceNode{{
+							State: `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"guestbook-5d8f-x7k2p","namespace":"default","uid":"3"},"spec":{"containers":[{"name":"guestbook","image":"gcr.io/heptio-images/ks-guestbook-demo:0.2"}]}}`,
+						}},
+					}},
+				}, {
+					TargetState: `{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook","namespace":"default"}}`,
+					Status:      appsv1.ComparisonStatusOutOfSync,
+				}},
+			},
+		},
+	}
+	appServer := newTestAppServer(&app)
+	appName := "test-app"
+
+	tree, err := appServer.ResourceTree(context.Background(), &ResourceTreeQuery{Name: &appName})
+	assert.Nil(t, err)
+	assert.Len(t, tree.Nodes, 3)
+	assert.Equal(t, "Deployment", tree.Nodes[0].Kind)
+	assert.Equal(t, "apps", tree.Nodes[0].Group)
+	assert.Equal(t, "", tree.Nodes[0].ParentUID)
+	assert.Equal(t, string(appsv1.ComparisonStatusSynced), tree.Nodes[0].Status)
+	assert.Equal(t, appsv1.HealthStatusHealthy, tree.Nodes[0].Health.Status)
+	assert.Equal(t, "ReplicaSet", tree.Nodes[1].Kind)
+	assert.Equal(t, "1", tree.Nodes[1].ParentUID)
+	assert.Equal(t, "Pod", tree.Nodes[2].Kind)
+	assert.Equal(t, "2", tree.Nodes[2].ParentUID)
+	assert.Equal(t, []string{"gcr.io/heptio-images/ks-guestbook-demo:0.2"}, tree.Nodes[2].Images)
+}
+
+func TestListResourceTreeEvents(t *testing.T) {
+	newEvent := func(namespace, name, uid string, lastTimestamp time.Time) *v1.Event {
+		return &v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: namespace},
+			InvolvedObject: v1.ObjectReference{UID: types.UID(uid)},
+			LastTimestamp:  metav1.NewTime(lastTimestamp),
+		}
+	}
+	now := time.Now()
+	kubeClientset := fake.NewSimpleClientset(
+		newEvent("default", "pulled", "3", now),
+		newEvent("default", "scheduled", "3", now.Add(-time.Minute)),
+		newEvent("default", "other-app", "4", now),
+		newEvent("kube-system", "pv-bound", "5", now),
+	)
+	nodes := []ResourceTreeNode{
+		{Kind: "Deployment", Namespace: "default", UID: "1"},
+		{Kind: "Pod", Namespace: "default", UID: "3", ParentUID: "1"},
+		{Kind: "PersistentVolume", UID: "5"},
+	}
+
+	events, err := listResourceTreeEvents(kubeClientset, nodes, "")
+	assert.Nil(t, err)
+	assert.Len(t, events, 2)
+	assert.Equal(t, "scheduled", events[0].Name)
+	assert.Equal(t, "pulled", events[1].Name)
+}
+
+func TestHookOutput(t *testing.T) {
+	app := appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: testNamespace},
+		Status: appsv1.ApplicationStatus{
+			OperationState: &appsv1.OperationState{
+				SyncResult: &appsv1.SyncOperationResult{
+					Hooks: []*appsv1.HookStatus{{
+						Name:   "db-migrate",
+						Kind:   "Job",
+						Type:   appsv1.HookTypePreSync,
+						Status: appsv1.OperationFailed,
+						Output: "==> pod/db-migrate-abcde container/migrate (exit code: 1) <==\nmigration failed\n",
+					}},
+				},
+			},
+		},
+	}
+	appServer := newTestAppServer(&app)
+	appName := "test-app"
+	hookName := "db-migrate"
+
+	hook, err := appServer.HookOutput(context.Background(), &ApplicationHookQuery{Name: &appName, HookName: &hookName})
+	assert.Nil(t, err)
+	assert.Contains(t, hook.Output, "migration failed")
+
+	_, err = appServer.HookOutput(context.Background(), &ApplicationHookQuery{Name: &appName, HookName: &hookName, Kind: "Workflow"})
+	assert.NotNil(t, err)
+}
+
+func TestHistory(t *testing.T) {
+	app := newTestApp("test-app")
+	app.Status.History = []appsv1.DeploymentInfo{
+		{ID: 0, Revision: "a1b2c3", InitiatedBy: appsv1.OperationInitiator{Username: "admin"}},
+		{ID: 1, Revision: "d4e5f6", InitiatedBy: appsv1.OperationInitiator{Automated: true}},
+	}
+	undeployed := newTestApp("undeployed")
+	appServer := newTestAppServer(&app, &undeployed)
+
+	appName := "test-app"
+	history, err := appServer.History(context.Background(), &ApplicationHistoryQuery{Name: &appName})
+	assert.Nil(t, err)
+	assert.Equal(t, app.Status.History, history.Items)
+
+	undeployedName := "undeployed"
+	history, err = appServer.History(context.Background(), &ApplicationHistoryQuery{Name: &undeployedName})
+	assert.Nil(t, err)
+	assert.Len(t, history.Items, 0)
+}
+
+func TestRollback(t *testing.T) {
+	app := newTestApp("test-app")
+	app.Status.History = []appsv1.DeploymentInfo{{ID: 0, Revision: "a1b2c3"}, {ID: 1, Revision: "d4e5f6"}}
+	appServer := newTestAppServer(&app)
+	appName := "test-app"
+	ctx := context.WithValue(context.Background(), "claims", jwt.MapClaims{"iss": "argocd", "sub": "admin"})
+
+	_, err := appServer.Rollback(ctx, &ApplicationRollbackRequest{Name: &appName, ID: 5})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+	updated, err := appServer.Rollback(ctx, &ApplicationRollbackRequest{Name: &appName, ID: 0, Prune: true})
+	assert.Nil(t, err)
+	assert.Equal(t, &appsv1.RollbackOperation{ID: 0, Prune: true}, updated.Operation.Rollback)
+	assert.Equal(t, appsv1.OperationInitiator{Username: "admin"}, updated.Operation.InitiatedBy)
+}
+
+func TestSyncHookNamespace(t *testing.T) {
+	proj := appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: testNamespace},
+		Spec: appsv1.AppProjectSpec{
+			Destinations: []appsv1.ApplicationDestination{{Server: "https://cluster-api.com", Namespace: "default"}},
+		},
+	}
+	app := appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: testNamespace},
+		Spec: appsv1.ApplicationSpec{
+			Project:     "restricted",
+			Destination: appsv1.ApplicationDestination{Server: "https://cluster-api.com", Namespace: "default"},
+		},
+	}
+	appServer := newTestAppServer(&proj, &app)
+	appName := "test-app"
+
+	_, err := appServer.Sync(context.Background(), &ApplicationSyncRequest{
+		Name:     &appName,
+		Strategy: &appsv1.SyncStrategy{Hook: &appsv1.SyncStrategyHook{Namespace: "kube-system"}},
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+}
+
+type fakeAppComparator struct{}
+
+func (c *fakeAppComparator) CompareAppState(app *appsv1.Application, revision string, overrides []appsv1.ComponentParameter, noCache bool) (
+	*appsv1.ComparisonResult, *repository.ManifestResponse, []appsv1.ApplicationCondition, error) {
+	status := appsv1.ComparisonStatusSynced
+	if revision != "abc123" {
+		status = appsv1.ComparisonStatusOutOfSync
+	}
+	return &appsv1.ComparisonResult{Status: status, Revision: revision}, &repository.ManifestResponse{Revision: revision}, nil, nil
+}
+
+func (c *fakeAppComparator) SyncAppState(app *appsv1.Application, state *appsv1.OperationState) {}
+
+func TestCompareRevisions(t *testing.T) {
+	app := appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: testNamespace},
+		Spec:       appsv1.ApplicationSpec{Source: appsv1.ApplicationSource{TargetRevision: "HEAD"}},
+		Status: appsv1.ApplicationStatus{
+			History: []appsv1.DeploymentInfo{{ID: 1, Revision: "0a1b2c"}, {ID: 2, Revision: "abc123"}},
+		},
+	}
+	appServer := newTestAppServer(&app)
+	appServer.(*Server).appComparator = &fakeAppComparator{}
+	appName := "test-app"
+
+	res, err := appServer.CompareRevisions(context.Background(), &ApplicationCompareRevisionsQuery{Name: &appName, Revision: "def456"})
+	assert.Nil(t, err)
+	assert.Equal(t, "abc123", res.Current.Revision)
+	assert.Equal(t, appsv1.ComparisonStatusSynced, res.Current.ComparisonResult.Status)
+	assert.Equal(t, "def456", res.Proposed.Revision)
+	assert.Equal(t, appsv1.ComparisonStatusOutOfSync, res.Proposed.ComparisonResult.Status)
+
+	_, err = appServer.CompareRevisions(context.Background(), &ApplicationCompareRevisionsQuery{Name: &appName})
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+}
+
+func TestEnsurePodBelongsToApp(t *testing.T) {
+	app := appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: testNamespace},
+		Status: appsv1.ApplicationStatus{
+			ComparisonResult: appsv1.ComparisonResult{
+				Resources: []appsv1.ResourceState{{
+					LiveState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook","namespace":"default"}}`,
+					ChildLiveResources: []appsv1.ResourceNode{{
+						State: `{"apiVersion":"apps/v1","kind":"ReplicaSet","metadata":{"name":"guestbook-5d8f","namespace":"default"}}`,
+						Children: []appsv1.ResourceNode{{
+							State: `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"guestbook-5d8f-x7k2p","namespace":"default"}}`,
+						}},
+					}},
+				}},
+			},
+		},
+	}
+
+	assert.Nil(t, ensurePodBelongsToApp(&app, "guestbook-5d8f-x7k2p", "default"))
+
+	err := ensurePodBelongsToApp(&app, "guestbook-5d8f-x7k2p", "other")
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+	err = ensurePodBelongsToApp(&app, "guestbook", "default")
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+}
+
+func TestGetManifestsInvalidFormat(t *testing.T) {
+	app := appsv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: testNamespace}}
+	appServer := newTestAppServer(&app)
+	appName := "test-app"
+
+	_, err := appServer.GetManifests(context.Background(), &ApplicationManifestQuery{Name: &appName, Format: "xml"})
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+}
+
+func TestSetManifestsAppInstance(t *testing.T) {
+	app := newTestApp("guestbook")
+	tracking := argo.NewResourceTracking(argo.TrackingMethodAnnotation, testNamespace)
+	manifests := []string{
+		`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui"}}`,
+		`{"apiVersion":"batch/v1","kind":"Job","metadata":{"name":"migrate","annotations":{"argocd.argoproj.io/hook":"PreSync"}}}`,
+	}
+
+	res, err := setManifestsAppInstance(tracking, &app, manifests)
+	assert.Nil(t, err)
+	assert.Len(t, res, 2)
+	obj, err := appsv1.UnmarshalToUnstructured(res[0])
+	assert.Nil(t, err)
+	assert.Equal(t, "default/guestbook:/Service:default/guestbook-ui", obj.GetAnnotations()[common.AnnotationKeyAppInstance])
+	hook, err := appsv1.UnmarshalToUnstructured(res[1])
+	assert.Nil(t, err)
+	assert.Equal(t, "default/guestbook:batch/Job:default/migrate", hook.GetAnnotations()[common.AnnotationKeyAppInstance])
+	assert.Equal(t, "PreSync", hook.GetAnnotations()["argocd.argoproj.io/hook"])
+}
+
+func newTestApp(name string) appsv1.Application {
+	return appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: appsv1.ApplicationSpec{
+			Project: "default",
+			Source: appsv1.ApplicationSource{
+				RepoURL:        fakeRepoURL,
+				Path:           "some/path",
+				Environment:    "default",
+				TargetRevision: "HEAD",
+			},
+			Destination: appsv1.ApplicationDestination{
+				Server:    "https://cluster-api.com",
+				Namespace: "default",
+			},
+		},
+	}
+}
+
+func TestBulkApply(t *testing.T) {
+	existing := newTestApp("existing")
+	updated := newTestApp("updated")
+	appServer := newTestAppServer(existing.DeepCopy(), updated.DeepCopy())
+	updated.Spec.Source.TargetRevision = "v2.0.0"
+	newAppName := "new"
+	updatedAppName := "updated"
+
+	// dry runs validate the applications without applying them
+	req := ApplicationBulkApplyRequest{Applications: []appsv1.Application{newTestApp("new"), existing, updated}, DryRun: true}
+	res, err := appServer.BulkApply(context.Background(), &req)
+	assert.Nil(t, err)
+	assert.False(t, res.Applied)
+	_, err = appServer.Get(context.Background(), &ApplicationQuery{Name: &newAppName})
+	assert.NotNil(t, err)
+
+	req.DryRun = false
+	res, err = appServer.BulkApply(context.Background(), &req)
+	assert.Nil(t, err)
+	assert.True(t, res.Applied)
+	assert.Len(t, res.Results, 3)
+	assert.Equal(t, "create", res.Results[0].Action)
+	assert.Equal(t, "none", res.Results[1].Action)
+	assert.Equal(t, "update", res.Results[2].Action)
+	for _, result := range res.Results {
+		assert.Empty(t, result.Error)
+		assert.NotNil(t, result.Application)
+	}
+	app, err := appServer.Get(context.Background(), &ApplicationQuery{Name: &updatedAppName})
+	assert.Nil(t, err)
+	assert.Equal(t, "v2.0.0", app.Spec.Source.TargetRevision)
+}
+
+func TestBulkApplyInvalid(t *testing.T) {
+	appServer := newTestAppServer()
+	invalid := newTestApp("invalid")
+	invalid.Spec.Project = "does-not-exist"
+	req := ApplicationBulkApplyRequest{Applications: []appsv1.Application{newTestApp("valid"), invalid, newTestApp("valid")}}
+	res, err := appServer.BulkApply(context.Background(), &req)
+	assert.Nil(t, err)
+	assert.False(t, res.Applied)
+	assert.Empty(t, res.Results[0].Error)
+	assert.Contains(t, res.Results[1].Error, "does-not-exist")
+	assert.Equal(t, "application is specified more than once", res.Results[2].Error)
+
+	// none of the applications are created if any is invalid
+	validAppName := "valid"
+	_, err = appServer.Get(context.Background(), &ApplicationQuery{Name: &validAppName})
+	assert.NotNil(t, err)
+}
+
+func TestCreateAndUpdateValidate(t *testing.T) {
+	existing := newTestApp("existing")
+	appServer := newTestAppServer(existing.DeepCopy())
+
+	// the destination namespace is omitted, so the unreachable fake cluster is not verified
+	valid := newTestApp("new")
+	valid.Spec.Destination.Namespace = ""
+	app, err := appServer.Create(context.Background(), &ApplicationCreateRequest{Application: valid, Validate: true})
+	assert.Nil(t, err)
+	assert.Len(t, app.Status.Conditions, 0)
+	newAppName := "new"
+	_, err = appServer.Get(context.Background(), &ApplicationQuery{Name: &newAppName})
+	assert.NotNil(t, err)
+
+	invalid := newTestApp("new")
+	invalid.Spec.Project = "unknown"
+	app, err = appServer.Create(context.Background(), &ApplicationCreateRequest{Application: invalid, Validate: true})
+	assert.Nil(t, err)
+	assert.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, appsv1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
+
+	updated := existing.DeepCopy()
+	updated.Spec.Destination.Namespace = ""
+	updated.Spec.IgnoreDifferences = []appsv1.ResourceIgnoreDifferences{{Kind: "Deployment", JSONPointers: []string{"spec/replicas"}}}
+	app, err = appServer.Update(context.Background(), &ApplicationUpdateRequest{Application: updated, Validate: true})
+	assert.Nil(t, err)
+	assert.Len(t, app.Status.Conditions, 1)
+	existingAppName := "existing"
+	app, err = appServer.Get(context.Background(), &ApplicationQuery{Name: &existingAppName})
+	assert.Nil(t, err)
+	assert.Len(t, app.Spec.IgnoreDifferences, 0)
+}
+
+func TestTerminateOperation(t *testing.T) {
+	app := newTestApp("test-app")
+	app.Operation = &appsv1.Operation{Sync: &appsv1.SyncOperation{}}
+	app.Status.OperationState = &appsv1.OperationState{
+		Operation: *app.Operation,
+		Phase:     appsv1.OperationRunning,
+		Message:   "waiting for completion of hook batch/Job/migrate",
+	}
+	idle := newTestApp("idle-app")
+	appServer := newTestAppServer(&app, &idle)
+
+	appName := "test-app"
+	_, err := appServer.TerminateOperation(context.Background(), &OperationTerminateRequest{Name: &appName})
+	assert.Nil(t, err)
+	updated, err := appServer.Get(context.Background(), &ApplicationQuery{Name: &appName})
+	assert.Nil(t, err)
+	assert.Equal(t, appsv1.OperationTerminating, updated.Status.OperationState.Phase)
+
+	// terminating an operation twice is harmless
+	_, err = appServer.TerminateOperation(context.Background(), &OperationTerminateRequest{Name: &appName})
+	assert.Nil(t, err)
+
+	idleName := "idle-app"
+	_, err = appServer.TerminateOperation(context.Background(), &OperationTerminateRequest{Name: &idleName})
+	assert.NotNil(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+}
+
+func TestResourceActionsOfUnmanagedResource(t *testing.T) {
+	app := appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: testNamespace},
+		Status: appsv1.ApplicationStatus{
+			ComparisonResult: appsv1.ComparisonResult{
+				Resources: []appsv1.ResourceState{fakeResourceState("Deployment", "default", "guestbook-ui")},
+			},
+		},
+	}
+	appServer := newTestAppServer(&app)
+	appName := "test-app"
+
+	_, err := appServer.ListResourceActions(context.Background(), &ResourceActionsListQuery{
+		Name: &appName, Group: "apps", Kind: "Deployment", Namespace: "default", ResourceName: "other",
+	})
+	assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+	_, err = appServer.RunResourceAction(context.Background(), &ResourceActionRunRequest{
+		Name: &appName, Group: "apps", Kind: "Deployment", Namespace: "default", ResourceName: "other", Action: "restart",
+	})
+	assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+}
+
+func TestListWithSelector(t *testing.T) {
+	guestbook := newTestApp("guestbook")
+	guestbook.Labels = map[string]string{"team": "frontend"}
+	redis := newTestApp("redis")
+	redis.Labels = map[string]string{"team": "backend"}
+	appServer := newTestAppServer(&guestbook, &redis)
+
+	apps, err := appServer.List(context.Background(), &ApplicationQuery{Selector: "team=frontend"})
+	assert.Nil(t, err)
+	assert.Len(t, apps.Items, 1)
+	assert.Equal(t, "guestbook", apps.Items[0].Name)
+
+	_, err = appServer.List(context.Background(), &ApplicationQuery{Selector: "team in frontend"})
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+}
+
+func TestListWithFilters(t *testing.T) {
+	guestbook := newTestApp("guestbook")
+	guestbook.Status.ComparisonResult.Status = appsv1.ComparisonStatusOutOfSync
+	guestbook.Status.Health.Status = appsv1.HealthStatusDegraded
+	redis := newTestApp("redis")
+	redis.Spec.Destination.Server = "https://other-cluster.com"
+	redis.Status.ComparisonResult.Status = appsv1.ComparisonStatusSynced
+	appServer := newTestAppServer(&guestbook, &redis)
+
+	apps, err := appServer.List(context.Background(), &ApplicationQuery{Cluster: "https://other-cluster.com"})
+	assert.Nil(t, err)
+	assert.Len(t, apps.Items, 1)
+	assert.Equal(t, "redis", apps.Items[0].Name)
+
+	apps, err = appServer.List(context.Background(), &ApplicationQuery{SyncStatus: string(appsv1.ComparisonStatusOutOfSync)})
+	assert.Nil(t, err)
+	assert.Len(t, apps.Items, 1)
+	assert.Equal(t, "guestbook", apps.Items[0].Name)
+
+	apps, err = appServer.List(context.Background(), &ApplicationQuery{HealthStatus: appsv1.HealthStatusHealthy})
+	assert.Nil(t, err)
+	assert.Len(t, apps.Items, 0)
+}
+
+func TestListPaginated(t *testing.T) {
+	appServer := newTestAppServer()
+	// the fake clientset ignores the limit, so it serves the pages an API server would return
+	pages := []appsv1.ApplicationList{
+		{ListMeta: metav1.ListMeta{Continue: "page-2"}, Items: []appsv1.Application{newTestApp("app-a"), newTestApp("app-b")}},
+		{ListMeta: metav1.ListMeta{Continue: "page-3"}, Items: []appsv1.Application{newTestApp("app-c"), newTestApp("app-d")}},
+		{Items: []appsv1.Application{newTestApp("app-e")}},
+	}
+	pages[1].Items[0].Spec.Destination.Server = "https://other-cluster.com"
+	pages[1].Items[1].Spec.Destination.Server = "https://other-cluster.com"
+	listed := 0
+	appServer.(*Server).appclientset.(*apps.Clientset).PrependReactor("list", "applications", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		page := pages[listed]
+		listed++
+		return true, &page, nil
+	})
+
+	var names []string
+	var continues []string
+	q := ApplicationQuery{Limit: 2, Cluster: "https://cluster-api.com"}
+	for {
+		apps, err := appServer.List(context.Background(), &q)
+		assert.Nil(t, err)
+		for _, a := range apps.Items {
+			names = append(names, a.Name)
+		}
+		if apps.Continue == "" {
+			break
+		}
+		continues = append(continues, apps.Continue)
+		q.Continue = apps.Continue
+	}
+	assert.Equal(t, []string{"app-a", "app-b", "app-e"}, names)
+	assert.Equal(t, []string{"page-2", "page-3"}, continues)
+	assert.Equal(t, 3, listed)
+}
+
+func TestPatch(t *testing.T) {
+	app := newTestApp("test-app")
+	appServer := newTestAppServer(&app)
+	appName := "test-app"
+
+	patched, err := appServer.Patch(context.Background(), &ApplicationPatchRequest{
+		Name:      &appName,
+		Patch:     `[{"op": "replace", "path": "/spec/source/targetRevision", "value": "v1.0.0"}]`,
+		PatchType: "json",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "v1.0.0", patched.Spec.Source.TargetRevision)
+
+	patched, err = appServer.Patch(context.Background(), &ApplicationPatchRequest{
+		Name:  &appName,
+		Patch: `{"spec": {"source": {"targetRevision": "v2.0.0"}}}`,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "v2.0.0", patched.Spec.Source.TargetRevision)
+	assert.Equal(t, "some/path", patched.Spec.Source.Path)
+
+	// only the spec can be patched
+	_, err = appServer.Patch(context.Background(), &ApplicationPatchRequest{
+		Name:  &appName,
+		Patch: `{"metadata": {"labels": {"foo": "bar"}}}`,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+	_, err = appServer.Patch(context.Background(), &ApplicationPatchRequest{Name: &appName, Patch: `{}`, PatchType: "unknown"})
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+	// the patch is not retried forever while updates conflict
+	updates := 0
+	appServer.(*Server).appclientset.(*apps.Clientset).PrependReactor("update", "applications", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		updates++
+		return true, nil, apierr.NewConflict(schema.GroupResource{Resource: "applications"}, appName, fmt.Errorf("conflict"))
+	})
+	_, err = appServer.Patch(context.Background(), &ApplicationPatchRequest{Name: &appName, Patch: `{"spec": {"source": {"targetRevision": "v3.0.0"}}}`})
+	assert.True(t, apierr.IsConflict(err))
+	assert.Equal(t, retry.DefaultRetry.Steps, updates)
+}
+
+func TestBatchSync(t *testing.T) {
+	var objects []runtime.Object
+	for _, name := range []string{"app-b", "app-a", "other"} {
+		app := newTestApp(name)
+		if name != "other" {
+			app.Labels = map[string]string{"app.kubernetes.io/part-of": "guestbook"}
+		}
+		objects = append(objects, &app)
+	}
+	appServer := newTestAppServer(objects...)
+
+	res, err := appServer.BatchSync(context.Background(), &ApplicationBatchSyncRequest{Selector: "app.kubernetes.io/part-of=guestbook", Prune: true, Concurrency: 1})
+	assert.Nil(t, err)
+	assert.Len(t, res.Results, 2)
+	for i, name := range []string{"app-a", "app-b"} {
+		assert.Equal(t, name, res.Results[i].Name)
+		assert.Empty(t, res.Results[i].Error)
+		assert.True(t, res.Results[i].Application.Operation.Sync.Prune)
+	}
+
+	// the applications are already being synced
+	res, err = appServer.BatchSync(context.Background(), &ApplicationBatchSyncRequest{Selector: "app.kubernetes.io/part-of=guestbook"})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, res.Results[0].Error)
+
+	_, err = appServer.BatchSync(context.Background(), &ApplicationBatchSyncRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+}
+
+func TestDiff(t *testing.T) {
+	app := newTestApp("test-app")
+	app.Status.ComparisonResult = appsv1.ComparisonResult{
+		Revision: "a1b2c3",
+		Resources: []appsv1.ResourceState{{
+			TargetState: `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "changed", "namespace": "default"}, "data": {"foo": "bar"}}`,
+			LiveState:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "changed", "namespace": "default"}, "data": {"foo": "baz"}}`,
+		}, {
+			TargetState: `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "ignored", "namespace": "default"}, "data": {"foo": "bar"}}`,
+			LiveState:   `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "ignored", "namespace": "default"}, "data": {"foo": "baz"}}`,
+		}, {
+			TargetState: "null",
+			LiveState:   `{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "extra", "namespace": "default"}}`,
+		}},
+	}
+	app.Spec.IgnoreDifferences = []appsv1.ResourceIgnoreDifferences{{Kind: "ConfigMap", Name: "ignored", JSONPointers: []string{"/data"}}}
+	appServer := newTestAppServer(&app)
+	appName := "test-app"
+
+	res, err := appServer.Diff(context.Background(), &ApplicationDiffQuery{Name: &appName})
+	assert.Nil(t, err)
+	assert.True(t, res.Modified)
+	assert.Equal(t, "a1b2c3", res.Revision)
+	assert.Len(t, res.Items, 3)
+
+	assert.Equal(t, "changed", res.Items[0].Name)
+	assert.True(t, res.Items[0].Modified)
+	assert.Contains(t, res.Items[0].Diff, "baz")
+
+	assert.Equal(t, "ignored", res.Items[1].Name)
+	assert.False(t, res.Items[1].Modified)
+	assert.Empty(t, res.Items[1].Diff)
+
+	assert.Equal(t, "apps", res.Items[2].Group)
+	assert.Equal(t, "Deployment", res.Items[2].Kind)
+	assert.True(t, res.Items[2].Modified)
+	assert.Equal(t, "null", res.Items[2].TargetState)
+}
@@ -3,6 +3,8 @@ package application
 import (
 	"io"
 	"net/http"
+	"sync"
+	"time"
 
 	"encoding/json"
 
@@ -40,17 +42,80 @@ func (m *SSEMarshaler) ContentType() string {
 	return "text/event-stream"
 }
 
+// DefaultStreamKeepaliveInterval is the default interval of the keepalive messages of idle streams
+const DefaultStreamKeepaliveInterval = 30 * time.Second
+
 var (
-	sseMarshaler SSEMarshaler
+	sseMarshaler         SSEMarshaler
+	sseKeepaliveInterval = DefaultStreamKeepaliveInterval
 )
 
+// SetSSEKeepaliveInterval sets the interval after which a keepalive comment is sent on idle server-sent
+// event streams, so idle-timeout load balancers do not close them. Zero disables keepalives.
+func SetSSEKeepaliveInterval(interval time.Duration) {
+	sseKeepaliveInterval = interval
+}
+
+// sseKeepaliveWriter serializes the writes of the forwarded stream and of the keepalive comments
+type sseKeepaliveWriter struct {
+	http.ResponseWriter
+	lock      sync.Mutex
+	lastWrite time.Time
+}
+
+func (w *sseKeepaliveWriter) Write(data []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.lastWrite = time.Now()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *sseKeepaliveWriter) Flush() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// keepalive writes an SSE comment, which clients ignore, whenever nothing was written during the
+// interval, until done is closed
+func (w *sseKeepaliveWriter) keepalive(interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			w.lock.Lock()
+			if time.Since(w.lastWrite) >= interval {
+				w.lastWrite = time.Now()
+				_, _ = w.ResponseWriter.Write([]byte(": keepalive\n\n"))
+				if f, ok := w.ResponseWriter.(http.Flusher); ok {
+					f.Flush()
+				}
+			}
+			w.lock.Unlock()
+		}
+	}
+}
+
 func init() {
 	sseOverrider := func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, req *http.Request, recv func() (proto.Message, error), opts ...func(context.Context, http.ResponseWriter, proto.Message) error) {
 		if req.Header.Get("Accept") == "text/event-stream" {
 			w.Header().Set("Content-Type", "text/event-stream")
 			w.Header().Set("Transfer-Encoding", "chunked")
 			w.Header().Set("X-Content-Type-Options", "nosniff")
-			runtime.ForwardResponseStream(ctx, mux, &sseMarshaler, w, req, recv, opts...)
+			if sseKeepaliveInterval <= 0 {
+				runtime.ForwardResponseStream(ctx, mux, &sseMarshaler, w, req, recv, opts...)
+				return
+			}
+			kw := &sseKeepaliveWriter{ResponseWriter: w, lastWrite: time.Now()}
+			done := make(chan struct{})
+			defer close(done)
+			go kw.keepalive(sseKeepaliveInterval, done)
+			runtime.ForwardResponseStream(ctx, mux, &sseMarshaler, kw, req, recv, opts...)
 		} else {
 			runtime.ForwardResponseStream(ctx, mux, marshaler, w, req, recv, opts...)
 		}
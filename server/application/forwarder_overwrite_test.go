@@ -0,0 +1,31 @@
+package application
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSEKeepalive(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	w := &sseKeepaliveWriter{ResponseWriter: recorder, lastWrite: time.Now()}
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		w.keepalive(20*time.Millisecond, done)
+		close(stopped)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	close(done)
+	<-stopped
+	_, err := w.Write([]byte("data: {} \n\n"))
+	assert.Nil(t, err)
+
+	body := recorder.Body.String()
+	assert.True(t, strings.HasPrefix(body, ": keepalive\n\n"))
+	assert.True(t, strings.HasSuffix(body, "data: {} \n\n"))
+	assert.True(t, recorder.Flushed)
+}
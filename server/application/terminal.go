@@ -0,0 +1,331 @@
+package application
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/argoproj/argo-cd/common"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/rbac"
+	"github.com/argoproj/argo-cd/util/session"
+)
+
+const (
+	// DefaultTerminalIdleTimeout is the default duration after which terminal sessions without input are closed
+	DefaultTerminalIdleTimeout = 15 * time.Minute
+	// defaultTerminalShell is the shell started in the pod unless the client requests another allowed shell
+	defaultTerminalShell = "sh"
+)
+
+// terminalShells are the shells which clients may start in pods
+var terminalShells = []string{"sh", "bash"}
+
+var terminalUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// TerminalHandler serves interactive shells in the pods of applications over websockets. Starting
+// a shell requires the create action on applications/exec, and the start and end of every session
+// is recorded as an audit event of the application.
+type TerminalHandler struct {
+	server      *Server
+	sessionMgr  *session.SessionManager
+	disableAuth bool
+	idleTimeout time.Duration
+}
+
+// NewTerminalHandler returns a new terminal handler. Sessions without input for the idle timeout
+// are closed, unless the timeout is zero.
+func NewTerminalHandler(
+	namespace string,
+	kubeclientset kubernetes.Interface,
+	appclientset appclientset.Interface,
+	db db.ArgoDB,
+	enf *rbac.Enforcer,
+	sessionMgr *session.SessionManager,
+	disableAuth bool,
+	idleTimeout time.Duration,
+) *TerminalHandler {
+	return &TerminalHandler{
+		server: &Server{
+			ns:            namespace,
+			kubeclientset: kubeclientset,
+			appclientset:  appclientset,
+			db:            db,
+			enf:           enf,
+			auditLogger:   argo.NewAuditLogger(namespace, kubeclientset, "argocd-server"),
+		},
+		sessionMgr:  sessionMgr,
+		disableAuth: disableAuth,
+		idleTimeout: idleTimeout,
+	}
+}
+
+// ServeHTTP starts a shell in the container of a pod of an application, selected by the appName,
+// pod, container and shell query parameters, and streams it over a websocket
+func (h *TerminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	appName := query.Get("appName")
+	podName := query.Get("pod")
+	container := query.Get("container")
+	shell := query.Get("shell")
+	if appName == "" || podName == "" {
+		http.Error(w, "appName and pod are required", http.StatusBadRequest)
+		return
+	}
+	if shell == "" {
+		shell = defaultTerminalShell
+	}
+	if !isTerminalShell(shell) {
+		http.Error(w, fmt.Sprintf("shell '%s' is not allowed, must be one of: %s", shell, strings.Join(terminalShells, ", ")), http.StatusBadRequest)
+		return
+	}
+
+	ctx, err := h.authenticate(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	a, err := h.server.appclientset.ArgoprojV1alpha1().Applications(h.server.ns).Get(appName, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+		} else {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	if !h.server.enf.EnforceClaims(ctx.Value("claims"), "applications/exec", "create", appRBACName(*a)) {
+		http.Error(w, "permission denied", http.StatusForbidden)
+		return
+	}
+	config, namespace, err := h.server.getApplicationClusterConfig(appName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := ensurePodBelongsToApp(a, podName, namespace); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	conn, err := terminalUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has already replied to the client
+		log.Warnf("Failed to upgrade terminal connection of application '%s': %v", appName, err)
+		return
+	}
+	terminal := newTerminalSession(conn, h.idleTimeout)
+	defer terminal.Close()
+
+	action := fmt.Sprintf("exec %s in %s/%s", shell, podName, container)
+	log.Infof("User %s started terminal session in application '%s' (%s)", session.Username(ctx), appName, action)
+	h.server.logEvent(a, ctx, argo.EventReasonTerminalSessionStarted, action)
+	err = startTerminalProcess(kubeClientset, config, namespace, podName, container, shell, terminal)
+	if err != nil && !terminal.isClosed() {
+		log.Warnf("Terminal session in application '%s' failed: %v", appName, err)
+		_, _ = terminal.Write([]byte(fmt.Sprintf("\r\n%v\r\n", err)))
+	}
+	log.Infof("User %s ended terminal session in application '%s' (%s)", session.Username(ctx), appName, action)
+	h.server.logEvent(a, ctx, argo.EventReasonTerminalSessionEnded, action)
+}
+
+// authenticate verifies the token of the request, read from the auth cookie or the bearer token of
+// the authorization header, and returns a context holding its claims
+func (h *TerminalHandler) authenticate(r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	if h.disableAuth {
+		return ctx, nil
+	}
+	var tokenString string
+	if cookie, err := r.Cookie(common.AuthCookieName); err == nil {
+		tokenString = cookie.Value
+	} else if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		tokenString = strings.TrimPrefix(auth, "Bearer ")
+	}
+	if tokenString == "" {
+		return nil, fmt.Errorf("no session information")
+	}
+	claims, err := h.sessionMgr.VerifyToken(tokenString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session: %v", err)
+	}
+	return context.WithValue(ctx, "claims", claims), nil
+}
+
+// isTerminalShell returns whether or not clients may start the shell
+func isTerminalShell(shell string) bool {
+	for _, s := range terminalShells {
+		if s == shell {
+			return true
+		}
+	}
+	return false
+}
+
+// startTerminalProcess execs the shell in the container of the pod, attaching the terminal session
+// to its TTY, and returns once the shell exits or the session is closed
+func startTerminalProcess(kubeClientset kubernetes.Interface, config *rest.Config, namespace, podName, container, shell string, terminal *terminalSession) error {
+	req := kubeClientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(podName).
+		Namespace(namespace).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   []string{shell},
+			Stdin:     true,
+			Stdout:    true,
+			Stderr:    true,
+			TTY:       true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+	return exec.Stream(remotecommand.StreamOptions{
+		Stdin:             terminal,
+		Stdout:            terminal,
+		Stderr:            terminal,
+		Tty:               true,
+		TerminalSizeQueue: terminal,
+	})
+}
+
+// terminalMessage is a message of the terminal websocket protocol. Clients send stdin messages with
+// their input and resize messages with the size of their terminal, the server sends stdout messages.
+type terminalMessage struct {
+	Operation string `json:"operation"`
+	Data      string `json:"data,omitempty"`
+	Rows      uint16 `json:"rows,omitempty"`
+	Cols      uint16 `json:"cols,omitempty"`
+}
+
+// terminalConn is the websocket connection of a terminal session
+type terminalConn interface {
+	ReadJSON(v interface{}) error
+	WriteJSON(v interface{}) error
+	Close() error
+}
+
+// terminalSession adapts a websocket connection to the streams and terminal size queue of an exec
+type terminalSession struct {
+	conn        terminalConn
+	sizeChan    chan remotecommand.TerminalSize
+	doneChan    chan struct{}
+	writeLock   sync.Mutex
+	closeOnce   sync.Once
+	idleTimeout time.Duration
+	idleTimer   *time.Timer
+	// pendingStdin holds the input of the last stdin message which did not fit in the buffer of Read
+	pendingStdin []byte
+}
+
+func newTerminalSession(conn terminalConn, idleTimeout time.Duration) *terminalSession {
+	t := &terminalSession{
+		conn:        conn,
+		sizeChan:    make(chan remotecommand.TerminalSize),
+		doneChan:    make(chan struct{}),
+		idleTimeout: idleTimeout,
+	}
+	if idleTimeout > 0 {
+		t.idleTimer = time.AfterFunc(idleTimeout, func() {
+			_, _ = t.Write([]byte(fmt.Sprintf("\r\nSession closed after %v without input\r\n", idleTimeout)))
+			t.Close()
+		})
+	}
+	return t
+}
+
+// Read reads the input of the client, and queues the terminal sizes it receives. The input of a message
+// which does not fit in p is returned by the next calls, before another message is read.
+func (t *terminalSession) Read(p []byte) (int, error) {
+	if len(t.pendingStdin) > 0 {
+		n := copy(p, t.pendingStdin)
+		t.pendingStdin = t.pendingStdin[n:]
+		return n, nil
+	}
+	var msg terminalMessage
+	if err := t.conn.ReadJSON(&msg); err != nil {
+		return 0, err
+	}
+	if t.idleTimer != nil {
+		t.idleTimer.Reset(t.idleTimeout)
+	}
+	switch msg.Operation {
+	case "stdin":
+		n := copy(p, msg.Data)
+		t.pendingStdin = []byte(msg.Data[n:])
+		return n, nil
+	case "resize":
+		select {
+		case t.sizeChan <- remotecommand.TerminalSize{Width: msg.Cols, Height: msg.Rows}:
+		case <-t.doneChan:
+		}
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("unknown terminal message operation '%s'", msg.Operation)
+	}
+}
+
+// Write sends the output of the shell to the client
+func (t *terminalSession) Write(p []byte) (int, error) {
+	t.writeLock.Lock()
+	defer t.writeLock.Unlock()
+	if err := t.conn.WriteJSON(terminalMessage{Operation: "stdout", Data: string(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Next returns the next terminal size of the client, or nil once the session is closed
+func (t *terminalSession) Next() *remotecommand.TerminalSize {
+	select {
+	case size := <-t.sizeChan:
+		return &size
+	case <-t.doneChan:
+		return nil
+	}
+}
+
+// Close closes the session and its connection
+func (t *terminalSession) Close() {
+	t.closeOnce.Do(func() {
+		if t.idleTimer != nil {
+			t.idleTimer.Stop()
+		}
+		close(t.doneChan)
+		_ = t.conn.Close()
+	})
+}
+
+func (t *terminalSession) isClosed() bool {
+	select {
+	case <-t.doneChan:
+		return true
+	default:
+		return false
+	}
+}
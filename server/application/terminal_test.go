@@ -0,0 +1,120 @@
+package application
+
+import (
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeTerminalConn replays client messages and records the messages sent to the client
+type fakeTerminalConn struct {
+	received []terminalMessage
+	sent     []terminalMessage
+	closed   bool
+}
+
+func (c *fakeTerminalConn) ReadJSON(v interface{}) error {
+	if len(c.received) == 0 {
+		return io.EOF
+	}
+	data, err := json.Marshal(c.received[0])
+	if err != nil {
+		return err
+	}
+	c.received = c.received[1:]
+	return json.Unmarshal(data, v)
+}
+
+func (c *fakeTerminalConn) WriteJSON(v interface{}) error {
+	c.sent = append(c.sent, v.(terminalMessage))
+	return nil
+}
+
+func (c *fakeTerminalConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestTerminalSession(t *testing.T) {
+	conn := &fakeTerminalConn{received: []terminalMessage{
+		{Operation: "resize", Rows: 24, Cols: 80},
+		{Operation: "stdin", Data: "ls\n"},
+		{Operation: "unknown"},
+	}}
+	terminal := newTerminalSession(conn, 0)
+
+	sizes := make(chan int)
+	go func() {
+		size := terminal.Next()
+		sizes <- int(size.Width) * int(size.Height)
+	}()
+	buf := make([]byte, 32)
+	n, err := terminal.Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, 80*24, <-sizes)
+
+	n, err = terminal.Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "ls\n", string(buf[:n]))
+
+	_, err = terminal.Read(buf)
+	assert.NotNil(t, err)
+
+	n, err = terminal.Write([]byte("file.txt\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, 9, n)
+	assert.Equal(t, []terminalMessage{{Operation: "stdout", Data: "file.txt\n"}}, conn.sent)
+
+	terminal.Close()
+	assert.True(t, conn.closed)
+	assert.Nil(t, terminal.Next())
+}
+
+func TestTerminalSessionReadLongInput(t *testing.T) {
+	conn := &fakeTerminalConn{received: []terminalMessage{
+		{Operation: "stdin", Data: "echo hello\n"},
+		{Operation: "stdin", Data: "ls\n"},
+	}}
+	terminal := newTerminalSession(conn, 0)
+
+	var input []byte
+	buf := make([]byte, 4)
+	for {
+		n, err := terminal.Read(buf)
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		input = append(input, buf[:n]...)
+	}
+	assert.Equal(t, "echo hello\nls\n", string(input))
+}
+
+func TestTerminalSessionIdleTimeout(t *testing.T) {
+	conn := &fakeTerminalConn{}
+	terminal := newTerminalSession(conn, 10*time.Millisecond)
+	assert.Nil(t, terminal.Next())
+	assert.True(t, terminal.isClosed())
+	assert.True(t, conn.closed)
+}
+
+func TestTerminalAuthenticate(t *testing.T) {
+	h := &TerminalHandler{}
+	_, err := h.authenticate(httptest.NewRequest("GET", "/terminal?appName=guestbook&pod=guestbook-x7k2p", nil))
+	assert.NotNil(t, err)
+
+	h.disableAuth = true
+	_, err = h.authenticate(httptest.NewRequest("GET", "/terminal?appName=guestbook&pod=guestbook-x7k2p", nil))
+	assert.Nil(t, err)
+}
+
+func TestIsTerminalShell(t *testing.T) {
+	assert.True(t, isTerminalShell("sh"))
+	assert.True(t, isTerminalShell("bash"))
+	assert.False(t, isTerminalShell("rm -rf /"))
+}
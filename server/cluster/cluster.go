@@ -3,10 +3,14 @@ package cluster
 import (
 	"reflect"
 
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 
+	"github.com/argoproj/argo-cd/common"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/grpc"
@@ -107,6 +111,56 @@ func (s *Server) Delete(ctx context.Context, q *ClusterQuery) (*ClusterResponse,
 	return &ClusterResponse{}, err
 }
 
+// RotateAuth rotates the bearer token of the service account used to manage a cluster. A new token
+// is created for the service account and tested before it replaces the credentials of the cluster,
+// then the previous token is revoked.
+func (s *Server) RotateAuth(ctx context.Context, q *ClusterQuery) (*ClusterResponse, error) {
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "clusters", "update", q.Server) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	logCtx := log.WithField("cluster", q.Server)
+	logCtx.Info("Rotating auth")
+	clust, err := s.db.GetCluster(ctx, q.Server)
+	if err != nil {
+		return nil, err
+	}
+	restCfg := clust.RESTConfig()
+	if restCfg.BearerToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cluster '%s' does not use bearer token authentication", q.Server)
+	}
+	claims, err := common.ParseServiceAccountToken(restCfg.BearerToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cluster '%s' does not use a service account token: %v", q.Server, err)
+	}
+	kubeclientset, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		return nil, err
+	}
+	newSecret, err := common.GenerateNewClusterManagerSecret(kubeclientset, claims)
+	if err != nil {
+		return nil, err
+	}
+	// the cluster is authenticated by the token only, the client certificate is not kept
+	clust.Config.BearerToken = string(newSecret.Data["token"])
+	clust.Config.TLSClientConfig.CertData = nil
+	clust.Config.TLSClientConfig.KeyData = nil
+	err = kube.TestConfig(clust.RESTConfig())
+	if err == nil {
+		_, err = s.db.UpdateCluster(ctx, clust)
+	}
+	if err != nil {
+		// the previous token is still valid and stored, only the new one is discarded
+		_ = kubeclientset.CoreV1().Secrets(claims.Namespace).Delete(newSecret.Name, &metav1.DeleteOptions{})
+		return nil, err
+	}
+	err = common.RotateServiceAccountSecrets(kubeclientset, claims, newSecret)
+	if err != nil {
+		return nil, err
+	}
+	logCtx.Infof("Rotated auth (old: %s, new: %s)", claims.SecretName, newSecret.Name)
+	return &ClusterResponse{}, nil
+}
+
 func redact(clust *appv1.Cluster) *appv1.Cluster {
 	if clust == nil {
 		return nil
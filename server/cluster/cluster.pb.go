@@ -136,6 +136,8 @@ type ClusterServiceClient interface {
 	Update(ctx context.Context, in *ClusterUpdateRequest, opts ...grpc.CallOption) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Cluster, error)
 	// Delete deletes a cluster
 	Delete(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error)
+	// RotateAuth rotates the bearer token used for a cluster
+	RotateAuth(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error)
 }
 
 type clusterServiceClient struct {
@@ -191,6 +193,15 @@ func (c *clusterServiceClient) Delete(ctx context.Context, in *ClusterQuery, opt
 	return out, nil
 }
 
+func (c *clusterServiceClient) RotateAuth(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error) {
+	out := new(ClusterResponse)
+	err := grpc.Invoke(ctx, "/cluster.ClusterService/RotateAuth", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ClusterService service
 
 type ClusterServiceServer interface {
@@ -204,6 +215,8 @@ type ClusterServiceServer interface {
 	Update(context.Context, *ClusterUpdateRequest) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Cluster, error)
 	// Delete deletes a cluster
 	Delete(context.Context, *ClusterQuery) (*ClusterResponse, error)
+	// RotateAuth rotates the bearer token used for a cluster
+	RotateAuth(context.Context, *ClusterQuery) (*ClusterResponse, error)
 }
 
 func RegisterClusterServiceServer(s *grpc.Server, srv ClusterServiceServer) {
@@ -300,6 +313,24 @@ func _ClusterService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_RotateAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).RotateAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.ClusterService/RotateAuth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).RotateAuth(ctx, req.(*ClusterQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClusterService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cluster.ClusterService",
 	HandlerType: (*ClusterServiceServer)(nil),
@@ -324,6 +355,10 @@ var _ClusterService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ClusterService_Delete_Handler,
 		},
+		{
+			MethodName: "RotateAuth",
+			Handler:    _ClusterService_RotateAuth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/cluster/cluster.proto",
@@ -921,35 +956,36 @@ var (
 func init() { proto.RegisterFile("server/cluster/cluster.proto", fileDescriptorCluster) }
 
 var fileDescriptorCluster = []byte{
-	// 472 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x8b, 0x13, 0x31,
-	0x14, 0xc7, 0xc9, 0xaa, 0xa3, 0x46, 0xf1, 0x47, 0x58, 0xa5, 0x8e, 0x6b, 0xd9, 0xcd, 0x41, 0x96,
-	0x45, 0x13, 0x5a, 0x2f, 0x8b, 0xc7, 0x5d, 0x51, 0x04, 0x2f, 0x56, 0xbc, 0xc8, 0x82, 0x64, 0xa7,
-	0x8f, 0xec, 0xd8, 0x71, 0x12, 0x93, 0xcc, 0x80, 0x88, 0x08, 0x7a, 0x15, 0x2f, 0xfe, 0x01, 0x5e,
-	0xfd, 0x53, 0x3c, 0x0a, 0xfe, 0x03, 0x52, 0xfc, 0x43, 0x64, 0x32, 0x49, 0xbb, 0x6d, 0xa9, 0x17,
-	0xcb, 0x9e, 0x9a, 0xbc, 0xa4, 0xef, 0x7d, 0xf2, 0x7d, 0xdf, 0x79, 0x78, 0xc3, 0x82, 0xa9, 0xc1,
-	0xf0, 0xac, 0xa8, 0xac, 0x9b, 0xfe, 0x32, 0x6d, 0x94, 0x53, 0xe4, 0x6c, 0xd8, 0xa6, 0xeb, 0x52,
-	0x49, 0xe5, 0x63, 0xbc, 0x59, 0xb5, 0xc7, 0xe9, 0x86, 0x54, 0x4a, 0x16, 0xc0, 0x85, 0xce, 0xb9,
-	0x28, 0x4b, 0xe5, 0x84, 0xcb, 0x55, 0x69, 0xc3, 0x29, 0x1d, 0xed, 0x5a, 0x96, 0x2b, 0x7f, 0x9a,
-	0x29, 0x03, 0xbc, 0xee, 0x71, 0x09, 0x25, 0x18, 0xe1, 0x60, 0x18, 0xee, 0x3c, 0x96, 0xb9, 0x3b,
-	0xaa, 0x0e, 0x59, 0xa6, 0x5e, 0x73, 0x61, 0x7c, 0x89, 0x57, 0x7e, 0x71, 0x37, 0x1b, 0x72, 0x3d,
-	0x92, 0xcd, 0x9f, 0x2d, 0x17, 0x5a, 0x17, 0x79, 0xe6, 0x93, 0xf3, 0xba, 0x27, 0x0a, 0x7d, 0x24,
-	0x16, 0x52, 0xd1, 0xdb, 0xf8, 0xe2, 0x7e, 0x4b, 0xfb, 0xb4, 0x02, 0xf3, 0x96, 0x5c, 0xc7, 0x49,
-	0xfb, 0xb6, 0x0e, 0xda, 0x44, 0xdb, 0xe7, 0x07, 0x61, 0x47, 0xaf, 0xe2, 0xcb, 0xe1, 0xde, 0x00,
-	0xac, 0x56, 0xa5, 0x05, 0xfa, 0x19, 0xe1, 0xf5, 0x10, 0xdb, 0x37, 0x20, 0x1c, 0x0c, 0xe0, 0x4d,
-	0x05, 0xd6, 0x91, 0x03, 0x1c, 0x15, 0xf0, 0x49, 0x2e, 0xf4, 0xf7, 0xd8, 0x14, 0x98, 0x45, 0x60,
-	0xbf, 0x78, 0x99, 0x0d, 0x99, 0x1e, 0x49, 0xd6, 0x00, 0xb3, 0x63, 0xc0, 0x2c, 0x02, 0xb3, 0x58,
-	0x35, 0xa6, 0x6c, 0x08, 0x2b, 0x6d, 0xc1, 0xb8, 0xce, 0xda, 0x26, 0xda, 0x3e, 0x37, 0x08, 0x3b,
-	0xea, 0x26, 0x34, 0xcf, 0xf5, 0xf0, 0xa4, 0x68, 0xfa, 0xdf, 0xcf, 0xe0, 0x4b, 0x21, 0xf8, 0x0c,
-	0x4c, 0x9d, 0x67, 0x40, 0x3e, 0xe0, 0xd3, 0x4f, 0x72, 0xeb, 0xc8, 0x35, 0x16, 0x6d, 0x71, 0x5c,
-	0xe1, 0xf4, 0xe1, 0xff, 0x97, 0x6f, 0xd2, 0xd3, 0xce, 0xc7, 0x5f, 0x7f, 0xbe, 0xae, 0x11, 0x72,
-	0xc5, 0x5b, 0xa5, 0xee, 0x45, 0x13, 0x5a, 0xf2, 0x05, 0xe1, 0xa4, 0xed, 0x08, 0xb9, 0x35, 0xcf,
-	0x30, 0xd3, 0xa9, 0x74, 0x05, 0x52, 0xd0, 0x2d, 0xcf, 0x71, 0x93, 0x2e, 0x70, 0xdc, 0x9f, 0xb4,
-	0xec, 0x13, 0xc2, 0xa7, 0x1e, 0xc1, 0x52, 0x45, 0x56, 0x48, 0x41, 0x6e, 0xcc, 0x53, 0xf0, 0x77,
-	0xad, 0x83, 0xdf, 0x93, 0x6f, 0x08, 0x27, 0xad, 0x35, 0x16, 0x65, 0x99, 0xb1, 0xcc, 0x4a, 0x80,
-	0xfa, 0x1e, 0xe8, 0x4e, 0xba, 0xb5, 0x08, 0x14, 0x6b, 0x07, 0xb0, 0xa9, 0x4e, 0x07, 0x38, 0x79,
-	0x00, 0x05, 0x38, 0x58, 0xa6, 0x54, 0x67, 0x3e, 0x3c, 0xf9, 0x18, 0xc3, 0xfb, 0x77, 0x96, 0xbf,
-	0x7f, 0x6f, 0xf7, 0xc7, 0xb8, 0x8b, 0x7e, 0x8e, 0xbb, 0xe8, 0xf7, 0xb8, 0x8b, 0x5e, 0xec, 0xfc,
-	0x6b, 0x86, 0xcc, 0x8e, 0xb7, 0xc3, 0xc4, 0xcf, 0x8a, 0x7b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x2c, 0xae, 0x46, 0x1e, 0xf7, 0x04, 0x00, 0x00,
+	// 490 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbd, 0x94, 0x4f, 0x4b, 0xdc, 0x40,
+	0x18, 0xc6, 0x89, 0x96, 0xa8, 0x63, 0xf1, 0xcf, 0x60, 0xcb, 0x9a, 0xaa, 0xe8, 0x80, 0x5a, 0xc4,
+	0x9d, 0xa1, 0xeb, 0x65, 0xe9, 0xad, 0x5a, 0x14, 0xc1, 0x8b, 0x11, 0x2f, 0x22, 0x48, 0xcc, 0xbe,
+	0x64, 0xd3, 0x8d, 0x3b, 0x71, 0x32, 0x59, 0x28, 0x45, 0x04, 0x7b, 0x2d, 0xbd, 0x14, 0x7a, 0xed,
+	0xd7, 0xe9, 0xb1, 0xd0, 0x2f, 0x50, 0x8a, 0x1f, 0xc4, 0xc9, 0x64, 0xb2, 0xeb, 0xee, 0xb2, 0x82,
+	0xb8, 0x78, 0x48, 0x32, 0x7f, 0x92, 0xe7, 0xf9, 0xcd, 0x33, 0x6f, 0x06, 0x2d, 0x24, 0x20, 0x5a,
+	0x20, 0x98, 0x1f, 0xa5, 0x89, 0xec, 0x3c, 0x69, 0x2c, 0xb8, 0xe4, 0x78, 0xcc, 0x74, 0x9d, 0xb9,
+	0x80, 0x07, 0x5c, 0x8f, 0xb1, 0xac, 0x95, 0x4f, 0x3b, 0x0b, 0x01, 0xe7, 0x41, 0x04, 0xcc, 0x8b,
+	0x43, 0xe6, 0x35, 0x9b, 0x5c, 0x7a, 0x32, 0xe4, 0xcd, 0xc4, 0xcc, 0x92, 0x46, 0x35, 0xa1, 0x21,
+	0xd7, 0xb3, 0x3e, 0x17, 0xc0, 0x5a, 0xef, 0x58, 0x00, 0x4d, 0x10, 0x9e, 0x84, 0x9a, 0x79, 0x67,
+	0x3f, 0x08, 0x65, 0x3d, 0x3d, 0xa7, 0x3e, 0xbf, 0x60, 0x9e, 0xd0, 0x16, 0x9f, 0x74, 0xa3, 0xec,
+	0xd7, 0x58, 0xdc, 0x08, 0xb2, 0x8f, 0x13, 0x75, 0x8b, 0xa3, 0xd0, 0xd7, 0xe2, 0x4a, 0xc4, 0x8b,
+	0xe2, 0xba, 0xd7, 0x27, 0x45, 0xd6, 0xd0, 0xcb, 0x9d, 0x9c, 0xf6, 0x30, 0x05, 0xf1, 0x19, 0xbf,
+	0x46, 0x76, 0xbe, 0xb6, 0x92, 0xb5, 0x6c, 0xbd, 0x9d, 0x70, 0x4d, 0x8f, 0xcc, 0xa2, 0x69, 0xf3,
+	0x9e, 0x0b, 0x49, 0xac, 0x70, 0x81, 0x7c, 0xb3, 0xd0, 0x9c, 0x19, 0xdb, 0x11, 0xa0, 0x34, 0x5d,
+	0xb8, 0x4c, 0x21, 0x91, 0xf8, 0x14, 0x15, 0x09, 0x68, 0x91, 0xc9, 0xca, 0x36, 0xed, 0x00, 0xd3,
+	0x02, 0x58, 0x37, 0xce, 0x7c, 0x05, 0xd2, 0x08, 0x68, 0x06, 0x4c, 0xef, 0x01, 0xd3, 0x02, 0x98,
+	0x16, 0xae, 0x85, 0x64, 0x46, 0x98, 0xc6, 0x8a, 0x4a, 0x96, 0x46, 0x94, 0xf8, 0xb8, 0x6b, 0x7a,
+	0x44, 0xb6, 0x69, 0x8e, 0xe3, 0xda, 0x73, 0xd1, 0x54, 0x7e, 0xda, 0x68, 0xca, 0x0c, 0x1e, 0xa9,
+	0xa4, 0x42, 0x1f, 0xf0, 0x35, 0x7a, 0x71, 0x10, 0x2a, 0xe3, 0x57, 0xb4, 0x28, 0x8b, 0xfb, 0x09,
+	0x3b, 0xbb, 0x4f, 0xb7, 0xcf, 0xe4, 0x49, 0xe9, 0xe6, 0xef, 0xed, 0x8f, 0x11, 0x8c, 0x67, 0x74,
+	0xa9, 0xa8, 0x2a, 0x31, 0x6e, 0x09, 0xfe, 0x6e, 0x21, 0x3b, 0xdf, 0x11, 0xbc, 0xd8, 0xcb, 0xd0,
+	0xb5, 0x53, 0xce, 0x10, 0xa2, 0x20, 0x2b, 0x9a, 0xe3, 0x0d, 0xe9, 0xe3, 0x78, 0xdf, 0xde, 0xb2,
+	0xaf, 0x16, 0x1a, 0xdd, 0x83, 0x81, 0x89, 0x0c, 0x91, 0x02, 0xcf, 0xf7, 0x52, 0xb0, 0x2f, 0x79,
+	0x05, 0x5f, 0xe1, 0x5f, 0x2a, 0x96, 0xbc, 0x34, 0xfa, 0x63, 0xe9, 0x2a, 0x99, 0xa1, 0x00, 0x55,
+	0x34, 0xd0, 0xa6, 0xb3, 0xd2, 0x0f, 0x54, 0x78, 0x1b, 0xb0, 0x4e, 0x4e, 0xa7, 0xc8, 0xfe, 0x08,
+	0x11, 0x28, 0xc0, 0x01, 0x49, 0x95, 0x7a, 0x87, 0xdb, 0x3f, 0xa3, 0x59, 0xff, 0xc6, 0x03, 0xeb,
+	0x8f, 0x10, 0x72, 0xb3, 0xc3, 0x06, 0x3e, 0xa4, 0xb2, 0xfe, 0x78, 0x87, 0xb2, 0x76, 0x58, 0x27,
+	0xab, 0x03, 0x1d, 0x98, 0xd0, 0xf2, 0x65, 0x4f, 0xe9, 0x6f, 0x57, 0x7f, 0xff, 0x5f, 0xb2, 0xfe,
+	0xa8, 0xeb, 0x9f, 0xba, 0x4e, 0x36, 0x1e, 0x3a, 0xb1, 0xba, 0x0f, 0xd3, 0x73, 0x5b, 0x9f, 0x4c,
+	0x5b, 0x77, 0xf2, 0xe8, 0x1f, 0x75, 0x65, 0x05, 0x00, 0x00,
 }
@@ -0,0 +1,84 @@
+package guardrail
+
+import (
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"k8s.io/client-go/kubernetes"
+
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/reposerver"
+	"github.com/argoproj/argo-cd/reposerver/repository"
+	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/grpc"
+	"github.com/argoproj/argo-cd/util/guardrail"
+	"github.com/argoproj/argo-cd/util/rbac"
+)
+
+// Server provides a Guardrail service
+type Server struct {
+	ns            string
+	kubeclientset kubernetes.Interface
+	repoClientset reposerver.Clientset
+	enf           *rbac.Enforcer
+}
+
+// NewServer returns a new instance of the Guardrail service
+func NewServer(namespace string, kubeclientset kubernetes.Interface, repoClientset reposerver.Clientset, enf *rbac.Enforcer) *Server {
+	return &Server{
+		ns:            namespace,
+		kubeclientset: kubeclientset,
+		repoClientset: repoClientset,
+		enf:           enf,
+	}
+}
+
+// List returns the last measured guardrail states of the controller and the repo server
+func (s *Server) List(ctx context.Context, q *GuardrailQuery) (*GuardrailList, error) {
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "guardrails", "get", "*") {
+		return nil, grpc.ErrPermissionDenied
+	}
+	states, err := s.states(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GuardrailList{Items: states}, nil
+}
+
+// states collects the guardrail states published by the controller, and those of the repo server.
+// The repo server is skipped if it is unavailable, so that the states of the other components can
+// still be reported.
+func (s *Server) states(ctx context.Context) ([]appsv1.GuardrailState, error) {
+	states, err := guardrail.Load(s.kubeclientset, s.ns)
+	if err != nil {
+		return nil, err
+	}
+	conn, repoClient, err := s.repoClientset.NewRepositoryClient()
+	if err != nil {
+		log.Warnf("Failed to connect to repo server: %v", err)
+		return states, nil
+	}
+	defer util.Close(conn)
+	res, err := repoClient.GetGuardrails(ctx, &repository.GuardrailsRequest{})
+	if err != nil {
+		log.Warnf("Failed to get guardrail states of repo server: %v", err)
+		return states, nil
+	}
+	states = append(states, res.Items...)
+	guardrail.SortStates(states)
+	return states, nil
+}
+
+// ServeMetrics serves the guardrail states as Prometheus metrics
+func (s *Server) ServeMetrics(w http.ResponseWriter, r *http.Request) {
+	states, err := s.states(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	if err := guardrail.WriteMetrics(w, states); err != nil {
+		log.Warnf("Failed to write guardrail metrics: %v", err)
+	}
+}
@@ -0,0 +1,496 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: server/guardrail/guardrail.proto
+
+/*
+	Package guardrail is a generated protocol buffer package.
+
+	Guardrail Service
+
+	Guardrail Service API summarizes the resource usage guardrails of the ArgoCD components
+
+	It is generated from these files:
+		server/guardrail/guardrail.proto
+
+	It has these top-level messages:
+		GuardrailQuery
+		GuardrailList
+*/
+package guardrail
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+import github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// GuardrailQuery is a query for the guardrail states
+type GuardrailQuery struct {
+}
+
+func (m *GuardrailQuery) Reset()                    { *m = GuardrailQuery{} }
+func (m *GuardrailQuery) String() string            { return proto.CompactTextString(m) }
+func (*GuardrailQuery) ProtoMessage()               {}
+func (*GuardrailQuery) Descriptor() ([]byte, []int) { return fileDescriptorGuardrail, []int{0} }
+
+// GuardrailList is the list of guardrail states of all components
+type GuardrailList struct {
+	Items []github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.GuardrailState `protobuf:"bytes,1,rep,name=items" json:"items"`
+}
+
+func (m *GuardrailList) Reset()                    { *m = GuardrailList{} }
+func (m *GuardrailList) String() string            { return proto.CompactTextString(m) }
+func (*GuardrailList) ProtoMessage()               {}
+func (*GuardrailList) Descriptor() ([]byte, []int) { return fileDescriptorGuardrail, []int{1} }
+
+func (m *GuardrailList) GetItems() []github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.GuardrailState {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GuardrailQuery)(nil), "guardrail.GuardrailQuery")
+	proto.RegisterType((*GuardrailList)(nil), "guardrail.GuardrailList")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for GuardrailService service
+
+type GuardrailServiceClient interface {
+	// List returns the last measured guardrail states of all components
+	List(ctx context.Context, in *GuardrailQuery, opts ...grpc.CallOption) (*GuardrailList, error)
+}
+
+type guardrailServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGuardrailServiceClient(cc *grpc.ClientConn) GuardrailServiceClient {
+	return &guardrailServiceClient{cc}
+}
+
+func (c *guardrailServiceClient) List(ctx context.Context, in *GuardrailQuery, opts ...grpc.CallOption) (*GuardrailList, error) {
+	out := new(GuardrailList)
+	err := grpc.Invoke(ctx, "/guardrail.GuardrailService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for GuardrailService service
+
+type GuardrailServiceServer interface {
+	// List returns the last measured guardrail states of all components
+	List(context.Context, *GuardrailQuery) (*GuardrailList, error)
+}
+
+func RegisterGuardrailServiceServer(s *grpc.Server, srv GuardrailServiceServer) {
+	s.RegisterService(&_GuardrailService_serviceDesc, srv)
+}
+
+func _GuardrailService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardrailQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuardrailServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/guardrail.GuardrailService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuardrailServiceServer).List(ctx, req.(*GuardrailQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GuardrailService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "guardrail.GuardrailService",
+	HandlerType: (*GuardrailServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _GuardrailService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/guardrail/guardrail.proto",
+}
+
+func (m *GuardrailQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardrailQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GuardrailList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardrailList) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintGuardrail(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintGuardrail(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GuardrailQuery) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GuardrailList) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGuardrail(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGuardrail(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozGuardrail(x uint64) (n int) {
+	return sovGuardrail(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GuardrailQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardrail
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardrailQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardrailQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardrail(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardrail
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GuardrailList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardrail
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardrailList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardrailList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardrail
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGuardrail
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.GuardrailState{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardrail(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGuardrail
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGuardrail(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGuardrail
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuardrail
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuardrail
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthGuardrail
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowGuardrail
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipGuardrail(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthGuardrail = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGuardrail   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("server/guardrail/guardrail.proto", fileDescriptorGuardrail) }
+
+var fileDescriptorGuardrail = []byte{
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0x3f, 0x4b, 0xfb, 0x40,
+	0x18, 0xfe, 0xe5, 0x67, 0x15, 0x3c, 0x51, 0x4a, 0xe8, 0x50, 0x83, 0xd4, 0xd2, 0xc9, 0x41, 0xef,
+	0x48, 0xdd, 0x1c, 0xbb, 0x48, 0xc1, 0x45, 0x1d, 0x04, 0x17, 0x79, 0x9b, 0xbc, 0x5c, 0xcf, 0xa4,
+	0xb9, 0xe3, 0xee, 0x12, 0x70, 0xf5, 0x2b, 0xf8, 0xa5, 0x3a, 0x0a, 0xee, 0x22, 0xc1, 0x0f, 0x22,
+	0xb9, 0x98, 0x44, 0x45, 0xdc, 0x1e, 0xde, 0xe7, 0x9e, 0x3f, 0x3c, 0x47, 0xc6, 0x06, 0x75, 0x81,
+	0x9a, 0xf1, 0x1c, 0x74, 0xac, 0x41, 0xa4, 0x1d, 0xa2, 0x4a, 0x4b, 0x2b, 0xfd, 0xed, 0xf6, 0x10,
+	0x0c, 0xb8, 0xe4, 0xd2, 0x5d, 0x59, 0x85, 0xea, 0x07, 0xc1, 0x01, 0x97, 0x92, 0xa7, 0xc8, 0x40,
+	0x09, 0x06, 0x59, 0x26, 0x2d, 0x58, 0x21, 0x33, 0xf3, 0xc9, 0xce, 0xb9, 0xb0, 0xcb, 0x7c, 0x41,
+	0x23, 0xb9, 0x62, 0xa0, 0x9d, 0xfc, 0xde, 0x81, 0x93, 0x28, 0x66, 0x2a, 0xe1, 0x95, 0xcc, 0x30,
+	0x50, 0x2a, 0x15, 0x91, 0x13, 0xb2, 0x22, 0x84, 0x54, 0x2d, 0x21, 0x64, 0x1c, 0x33, 0xd4, 0x60,
+	0x31, 0xae, 0xad, 0x26, 0x7d, 0xb2, 0x77, 0xde, 0x74, 0xb9, 0xcc, 0x51, 0x3f, 0x4c, 0x0a, 0xb2,
+	0xdb, 0x5e, 0x2e, 0x84, 0xb1, 0x3e, 0x92, 0x4d, 0x61, 0x71, 0x65, 0x86, 0xde, 0x78, 0xe3, 0x68,
+	0x67, 0x3a, 0xa7, 0x5d, 0x3a, 0x6d, 0xd2, 0x1d, 0xb8, 0x8b, 0x62, 0xaa, 0x12, 0x4e, 0xab, 0x74,
+	0xfa, 0x25, 0x9d, 0x36, 0xe9, 0xb4, 0x35, 0xbe, 0xb6, 0x60, 0x71, 0xd6, 0x5b, 0xbf, 0x1e, 0xfe,
+	0xbb, 0xaa, 0xdd, 0xa7, 0x09, 0xe9, 0x77, 0x34, 0xea, 0x42, 0x44, 0xe8, 0xdf, 0x90, 0x9e, 0xab,
+	0xb0, 0x4f, 0xbb, 0x05, 0xbf, 0xd7, 0x0d, 0x86, 0xbf, 0x51, 0x95, 0x68, 0x12, 0x3c, 0xbe, 0xbc,
+	0x3f, 0xfd, 0x1f, 0xf8, 0xbe, 0x5b, 0xb1, 0x08, 0xbb, 0x5f, 0x30, 0xb3, 0xb3, 0x75, 0x39, 0xf2,
+	0x9e, 0xcb, 0x91, 0xf7, 0x56, 0x8e, 0xbc, 0xdb, 0xe3, 0xbf, 0xf6, 0xfc, 0xf9, 0x99, 0x8b, 0x2d,
+	0xb7, 0xdc, 0xe9, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa3, 0xce, 0x76, 0xfb, 0xe7, 0x01, 0x00,
+	0x00,
+}
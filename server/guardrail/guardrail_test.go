@@ -0,0 +1,62 @@
+package guardrail
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/context"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	mockrepo "github.com/argoproj/argo-cd/reposerver/mocks"
+	"github.com/argoproj/argo-cd/reposerver/repository"
+	mockreposerver "github.com/argoproj/argo-cd/reposerver/repository/mocks"
+	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/guardrail"
+	"github.com/argoproj/argo-cd/util/rbac"
+)
+
+const testNamespace = "default"
+
+type fakeCloser struct{}
+
+func (f fakeCloser) Close() error {
+	return nil
+}
+
+func newTestServer(defaultRole string) *Server {
+	kubeclientset := fake.NewSimpleClientset()
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enforcer.SetBuiltinPolicy(test.BuiltinPolicy)
+	enforcer.SetDefaultRole(defaultRole)
+
+	err := guardrail.Publish(kubeclientset, testNamespace, "application-controller", []appsv1.GuardrailState{
+		{Name: "app-object-size", Component: "application-controller", Level: appsv1.GuardrailLevelOK, Value: 1, Limit: 10},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	mockRepoServiceClient := mockreposerver.RepositoryServiceClient{}
+	mockRepoServiceClient.On("GetGuardrails", mock.Anything, mock.Anything).Return(&repository.GuardrailsResponse{
+		Items: []appsv1.GuardrailState{{Name: "disk-usage", Component: "repo-server", Level: appsv1.GuardrailLevelWarning, Value: 9, Limit: 10}},
+	}, nil)
+	mockRepoClient := &mockrepo.Clientset{}
+	mockRepoClient.On("NewRepositoryClient").Return(&fakeCloser{}, &mockRepoServiceClient, nil)
+
+	return NewServer(testNamespace, kubeclientset, mockRepoClient, enforcer)
+}
+
+func TestList(t *testing.T) {
+	res, err := newTestServer("role:admin").List(context.Background(), &GuardrailQuery{})
+	assert.Nil(t, err)
+	assert.Len(t, res.Items, 2)
+	assert.Equal(t, "application-controller", res.Items[0].Component)
+	assert.Equal(t, "repo-server", res.Items[1].Component)
+	assert.Equal(t, appsv1.GuardrailLevelWarning, res.Items[1].Level)
+
+	_, err = newTestServer("role:readonly").List(context.Background(), &GuardrailQuery{})
+	assert.NotNil(t, err)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util"
 	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/git"
@@ -81,44 +82,22 @@ func (s *Server) Get(ctx context.Context, q *ProjectQuery) (*v1alpha1.AppProject
 	return s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(q.Name, metav1.GetOptions{})
 }
 
-func getRemovedDestination(oldProj, newProj *v1alpha1.AppProject) map[string]v1alpha1.ApplicationDestination {
-	oldDest := make(map[string]v1alpha1.ApplicationDestination)
-	newDest := make(map[string]v1alpha1.ApplicationDestination)
-	for i := range oldProj.Spec.Destinations {
-		dest := oldProj.Spec.Destinations[i]
-		oldDest[fmt.Sprintf("%s/%s", dest.Server, dest.Namespace)] = dest
-	}
-	for i := range newProj.Spec.Destinations {
-		dest := newProj.Spec.Destinations[i]
-		newDest[fmt.Sprintf("%s/%s", dest.Server, dest.Namespace)] = dest
-	}
-
-	removed := make(map[string]v1alpha1.ApplicationDestination, 0)
-	for key, dest := range oldDest {
-		if _, ok := newDest[key]; !ok {
-			removed[key] = dest
+// getRevokedApps returns the destinations and sources of the applications of the project which are
+// permitted by the old project but no longer permitted by the new one. Applications which already
+// violated the restrictions of the old project do not prevent updating the project.
+func getRevokedApps(oldProj, newProj *v1alpha1.AppProject, apps []v1alpha1.Application) ([]string, []string) {
+	revokedDst := make([]string, 0)
+	revokedSrc := make([]string, 0)
+	for _, a := range apps {
+		dest := a.Spec.Destination
+		if oldProj.IsDestinationPermitted(dest) && !newProj.IsDestinationPermitted(dest) {
+			revokedDst = append(revokedDst, fmt.Sprintf("server: %s, namespace: %s (application %s)", dest.Server, dest.Namespace, a.Name))
 		}
-	}
-	return removed
-}
-
-func getRemovedSources(oldProj, newProj *v1alpha1.AppProject) map[string]bool {
-	oldSrc := make(map[string]bool)
-	newSrc := make(map[string]bool)
-	for _, src := range oldProj.Spec.SourceRepos {
-		oldSrc[src] = true
-	}
-	for _, src := range newProj.Spec.SourceRepos {
-		newSrc[src] = true
-	}
-
-	removed := make(map[string]bool)
-	for src := range oldSrc {
-		if _, ok := newSrc[src]; !ok {
-			removed[src] = true
+		if oldProj.IsSourcePermitted(a.Spec.Source) && !newProj.IsSourcePermitted(a.Spec.Source) {
+			revokedSrc = append(revokedSrc, fmt.Sprintf("%s (application %s)", a.Spec.Source.RepoURL, a.Name))
 		}
 	}
-	return removed
+	return revokedDst, revokedSrc
 }
 
 func validateProject(p *v1alpha1.AppProject) error {
@@ -141,6 +120,23 @@ func validateProject(p *v1alpha1.AppProject) error {
 			return status.Errorf(codes.InvalidArgument, "source repository %s should not be listed more than once.", src)
 		}
 	}
+	for _, tool := range p.Spec.SourceTools {
+		switch repository.AppSourceType(tool) {
+		case repository.AppSourceKsonnet, repository.AppSourceHelm, repository.AppSourceKustomize, repository.AppSourceDirectory:
+		default:
+			return status.Errorf(codes.InvalidArgument, "source tool %s is not supported.", tool)
+		}
+	}
+	for i := range p.Spec.SyncWindows {
+		if err := p.Spec.SyncWindows[i].Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "sync window %d is invalid: %v", i, err)
+		}
+	}
+	for _, key := range p.Spec.SignatureKeys {
+		if !v1alpha1.IsGPGKeyFingerprint(key) {
+			return status.Errorf(codes.InvalidArgument, "signature key %s is not the fingerprint of a GPG key (40 hexadecimal characters).", key)
+		}
+	}
 	return nil
 }
 
@@ -169,31 +165,14 @@ func (s *Server) Update(ctx context.Context, q *ProjectUpdateRequest) (*v1alpha1
 		return nil, err
 	}
 
-	removedDst := getRemovedDestination(oldProj, q.Project)
-	removedSrc := getRemovedSources(oldProj, q.Project)
-
-	removedDstUsed := make([]v1alpha1.ApplicationDestination, 0)
-	removedSrcUsed := make([]string, 0)
-
-	for _, a := range argo.FilterByProjects(appsList.Items, []string{q.Project.Name}) {
-		if dest, ok := removedDst[fmt.Sprintf("%s/%s", a.Spec.Destination.Server, a.Spec.Destination.Namespace)]; ok {
-			removedDstUsed = append(removedDstUsed, dest)
-		}
-		if _, ok := removedSrc[a.Spec.Source.RepoURL]; ok {
-			removedSrcUsed = append(removedSrcUsed, a.Spec.Source.RepoURL)
-		}
-	}
-	if len(removedDstUsed) > 0 {
-		formattedRemovedUsedList := make([]string, len(removedDstUsed))
-		for i := 0; i < len(removedDstUsed); i++ {
-			formattedRemovedUsedList[i] = fmt.Sprintf("server: %s, namespace: %s", removedDstUsed[i].Server, removedDstUsed[i].Namespace)
-		}
+	revokedDst, revokedSrc := getRevokedApps(oldProj, q.Project, argo.FilterByProjects(appsList.Items, []string{q.Project.Name}))
+	if len(revokedDst) > 0 {
 		return nil, status.Errorf(
-			codes.InvalidArgument, "following destinations are used by one or more application and cannot be removed: %s", strings.Join(formattedRemovedUsedList, ";"))
+			codes.InvalidArgument, "following destinations are used by one or more application and cannot be removed: %s", strings.Join(revokedDst, ";"))
 	}
-	if len(removedSrcUsed) > 0 {
+	if len(revokedSrc) > 0 {
 		return nil, status.Errorf(
-			codes.InvalidArgument, "following source repos are used by one or more application and cannot be removed: %s", strings.Join(removedSrcUsed, ";"))
+			codes.InvalidArgument, "following source repos are used by one or more application and cannot be removed: %s", strings.Join(revokedSrc, ";"))
 	}
 
 	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(q.Project)
@@ -235,3 +214,64 @@ func (s *Server) Delete(ctx context.Context, q *ProjectQuery) (*EmptyResponse, e
 func (s *Server) logEvent(p *v1alpha1.AppProject, ctx context.Context, reason string, action string) {
 	s.auditLogger.LogAppProjEvent(p, argo.EventInfo{Reason: reason, Action: action, Username: session.Username(ctx)}, v1.EventTypeNormal)
 }
+
+// SimulatePolicy evaluates requests against the RBAC policy and project rules and reports whether
+// they are allowed. If a candidate policy is supplied, it is evaluated instead of the configured one.
+func (s *Server) SimulatePolicy(ctx context.Context, q *PolicySimulationRequest) (*PolicySimulationResponse, error) {
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "policies", "simulate", "*") {
+		return nil, grpc.ErrPermissionDenied
+	}
+	enf := s.enf
+	if q.Policy != "" {
+		var err error
+		enf, err = s.enf.WithUserPolicy(q.Policy, q.DefaultRole)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid policy: %v", err)
+		}
+	}
+	projects := make(map[string]*v1alpha1.AppProject)
+	res := PolicySimulationResponse{Results: make([]*PolicyResult, len(q.Requests))}
+	for i, req := range q.Requests {
+		result := PolicyResult{Request: req}
+		if !enf.EnforceSubject(req.Subject, req.Groups, req.Resource, req.Action, req.Object) {
+			result.Message = fmt.Sprintf("%s is not permitted to %s %s '%s'", req.Subject, req.Action, req.Resource, req.Object)
+		} else if req.Resource == "applications" && (req.RepoURL != "" || req.Destination != nil) {
+			result.Message = s.getProjectRulesViolation(req, projects)
+		}
+		result.Allowed = result.Message == ""
+		if result.Allowed {
+			res.Allowed++
+		} else {
+			res.Denied++
+		}
+		res.Results[i] = &result
+	}
+	return &res, nil
+}
+
+// getProjectRulesViolation returns why the application source or destination of the request is not
+// permitted by its project, or an empty string if they are. projects caches the projects by name.
+func (s *Server) getProjectRulesViolation(req *PolicyRequest, projects map[string]*v1alpha1.AppProject) string {
+	projName := strings.SplitN(req.Object, "/", 2)[0]
+	proj, ok := projects[projName]
+	if !ok {
+		if projName == common.DefaultAppProjectName {
+			defaultProj := v1alpha1.GetDefaultProject(s.ns)
+			proj = &defaultProj
+		} else {
+			var err error
+			proj, err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(projName, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Sprintf("unable to get project '%s': %v", projName, err)
+			}
+		}
+		projects[projName] = proj
+	}
+	if req.RepoURL != "" && !proj.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: req.RepoURL}) {
+		return fmt.Sprintf("application repo %s is not permitted in project '%s'", req.RepoURL, projName)
+	}
+	if req.Destination != nil && !proj.IsDestinationPermitted(*req.Destination) {
+		return fmt.Sprintf("application destination {%s %s} is not permitted in project '%s'", req.Destination.Server, req.Destination.Namespace, projName)
+	}
+	return ""
+}
@@ -16,6 +16,10 @@
 		ProjectQuery
 		ProjectUpdateRequest
 		EmptyResponse
+		PolicyRequest
+		PolicySimulationRequest
+		PolicyResult
+		PolicySimulationResponse
 */
 package project
 
@@ -102,11 +106,186 @@ func (m *EmptyResponse) String() string            { return proto.CompactTextStr
 func (*EmptyResponse) ProtoMessage()               {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) { return fileDescriptorProject, []int{3} }
 
+// PolicyRequest is a recorded or synthetic API request to evaluate against the RBAC policy and project rules
+type PolicyRequest struct {
+	// Subject is the user (e.g. admin) or role (e.g. role:readonly) performing the request
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Groups are the groups of the subject, as found in its claims
+	Groups   []string `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty"`
+	Resource string   `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+	Action   string   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// Object is the object of the request, formatted as <project>/<name> for applications
+	Object string `protobuf:"bytes,5,opt,name=object,proto3" json:"object,omitempty"`
+	// RepoURL is the optional application source repository, validated against the project rules
+	RepoURL string `protobuf:"bytes,6,opt,name=repoURL,proto3" json:"repoURL,omitempty"`
+	// Destination is the optional application destination, validated against the project rules
+	Destination *github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.ApplicationDestination `protobuf:"bytes,7,opt,name=destination" json:"destination,omitempty"`
+}
+
+func (m *PolicyRequest) Reset()                    { *m = PolicyRequest{} }
+func (m *PolicyRequest) String() string            { return proto.CompactTextString(m) }
+func (*PolicyRequest) ProtoMessage()               {}
+func (*PolicyRequest) Descriptor() ([]byte, []int) { return fileDescriptorProject, []int{4} }
+
+func (m *PolicyRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *PolicyRequest) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *PolicyRequest) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *PolicyRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PolicyRequest) GetObject() string {
+	if m != nil {
+		return m.Object
+	}
+	return ""
+}
+
+func (m *PolicyRequest) GetRepoURL() string {
+	if m != nil {
+		return m.RepoURL
+	}
+	return ""
+}
+
+func (m *PolicyRequest) GetDestination() *github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.ApplicationDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+// PolicySimulationRequest is a request to evaluate API requests without performing them
+type PolicySimulationRequest struct {
+	Requests []*PolicyRequest `protobuf:"bytes,1,rep,name=requests" json:"requests,omitempty"`
+	// Policy is an optional candidate policy CSV, which replaces the configured policy during the simulation
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// DefaultRole is the default role used along with the candidate policy
+	DefaultRole string `protobuf:"bytes,3,opt,name=defaultRole,proto3" json:"defaultRole,omitempty"`
+}
+
+func (m *PolicySimulationRequest) Reset()                    { *m = PolicySimulationRequest{} }
+func (m *PolicySimulationRequest) String() string            { return proto.CompactTextString(m) }
+func (*PolicySimulationRequest) ProtoMessage()               {}
+func (*PolicySimulationRequest) Descriptor() ([]byte, []int) { return fileDescriptorProject, []int{5} }
+
+func (m *PolicySimulationRequest) GetRequests() []*PolicyRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *PolicySimulationRequest) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *PolicySimulationRequest) GetDefaultRole() string {
+	if m != nil {
+		return m.DefaultRole
+	}
+	return ""
+}
+
+// PolicyResult is the outcome of a simulated request
+type PolicyResult struct {
+	Request *PolicyRequest `protobuf:"bytes,1,opt,name=request" json:"request,omitempty"`
+	Allowed bool           `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// Message explains why the request is denied
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *PolicyResult) Reset()                    { *m = PolicyResult{} }
+func (m *PolicyResult) String() string            { return proto.CompactTextString(m) }
+func (*PolicyResult) ProtoMessage()               {}
+func (*PolicyResult) Descriptor() ([]byte, []int) { return fileDescriptorProject, []int{6} }
+
+func (m *PolicyResult) GetRequest() *PolicyRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *PolicyResult) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *PolicyResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type PolicySimulationResponse struct {
+	Results []*PolicyResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	Allowed int64           `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Denied  int64           `protobuf:"varint,3,opt,name=denied,proto3" json:"denied,omitempty"`
+}
+
+func (m *PolicySimulationResponse) Reset()                    { *m = PolicySimulationResponse{} }
+func (m *PolicySimulationResponse) String() string            { return proto.CompactTextString(m) }
+func (*PolicySimulationResponse) ProtoMessage()               {}
+func (*PolicySimulationResponse) Descriptor() ([]byte, []int) { return fileDescriptorProject, []int{7} }
+
+func (m *PolicySimulationResponse) GetResults() []*PolicyResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *PolicySimulationResponse) GetAllowed() int64 {
+	if m != nil {
+		return m.Allowed
+	}
+	return 0
+}
+
+func (m *PolicySimulationResponse) GetDenied() int64 {
+	if m != nil {
+		return m.Denied
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ProjectCreateRequest)(nil), "project.ProjectCreateRequest")
 	proto.RegisterType((*ProjectQuery)(nil), "project.ProjectQuery")
 	proto.RegisterType((*ProjectUpdateRequest)(nil), "project.ProjectUpdateRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
+	proto.RegisterType((*PolicyRequest)(nil), "project.PolicyRequest")
+	proto.RegisterType((*PolicySimulationRequest)(nil), "project.PolicySimulationRequest")
+	proto.RegisterType((*PolicyResult)(nil), "project.PolicyResult")
+	proto.RegisterType((*PolicySimulationResponse)(nil), "project.PolicySimulationResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -130,6 +309,8 @@ type ProjectServiceClient interface {
 	Update(ctx context.Context, in *ProjectUpdateRequest, opts ...grpc.CallOption) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.AppProject, error)
 	// Delete deletes a project
 	Delete(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SimulatePolicy evaluates requests against the RBAC policy and project rules and reports whether they are allowed
+	SimulatePolicy(ctx context.Context, in *PolicySimulationRequest, opts ...grpc.CallOption) (*PolicySimulationResponse, error)
 }
 
 type projectServiceClient struct {
@@ -185,6 +366,15 @@ func (c *projectServiceClient) Delete(ctx context.Context, in *ProjectQuery, opt
 	return out, nil
 }
 
+func (c *projectServiceClient) SimulatePolicy(ctx context.Context, in *PolicySimulationRequest, opts ...grpc.CallOption) (*PolicySimulationResponse, error) {
+	out := new(PolicySimulationResponse)
+	err := grpc.Invoke(ctx, "/project.ProjectService/SimulatePolicy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ProjectService service
 
 type ProjectServiceServer interface {
@@ -198,6 +388,8 @@ type ProjectServiceServer interface {
 	Update(context.Context, *ProjectUpdateRequest) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.AppProject, error)
 	// Delete deletes a project
 	Delete(context.Context, *ProjectQuery) (*EmptyResponse, error)
+	// SimulatePolicy evaluates requests against the RBAC policy and project rules and reports whether they are allowed
+	SimulatePolicy(context.Context, *PolicySimulationRequest) (*PolicySimulationResponse, error)
 }
 
 func RegisterProjectServiceServer(s *grpc.Server, srv ProjectServiceServer) {
@@ -294,6 +486,24 @@ func _ProjectService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_SimulatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicySimulationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).SimulatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/SimulatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).SimulatePolicy(ctx, req.(*PolicySimulationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ProjectService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "project.ProjectService",
 	HandlerType: (*ProjectServiceServer)(nil),
@@ -318,6 +528,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ProjectService_Delete_Handler,
 		},
+		{
+			MethodName: "SimulatePolicy",
+			Handler:    _ProjectService_SimulatePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/project/project.proto",
@@ -421,6 +635,205 @@ func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *PolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subject) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Subject)))
+		i += copy(dAtA[i:], m.Subject)
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Resource) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Resource)))
+		i += copy(dAtA[i:], m.Resource)
+	}
+	if len(m.Action) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
+	}
+	if len(m.Object) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Object)))
+		i += copy(dAtA[i:], m.Object)
+	}
+	if len(m.RepoURL) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.RepoURL)))
+		i += copy(dAtA[i:], m.RepoURL)
+	}
+	if m.Destination != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.Destination.Size()))
+		n3, err := m.Destination.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *PolicySimulationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicySimulationRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, msg := range m.Requests {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProject(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Policy) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Policy)))
+		i += copy(dAtA[i:], m.Policy)
+	}
+	if len(m.DefaultRole) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.DefaultRole)))
+		i += copy(dAtA[i:], m.DefaultRole)
+	}
+	return i, nil
+}
+
+func (m *PolicyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Request != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.Request.Size()))
+		n4, err := m.Request.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Allowed {
+		dAtA[i] = 0x10
+		i++
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	return i, nil
+}
+
+func (m *PolicySimulationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicySimulationResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintProject(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Allowed != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.Allowed))
+	}
+	if m.Denied != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintProject(dAtA, i, uint64(m.Denied))
+	}
+	return i, nil
+}
+
 func encodeVarintProject(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -466,26 +879,117 @@ func (m *EmptyResponse) Size() (n int) {
 	return n
 }
 
-func sovProject(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *PolicyRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
 		}
 	}
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Object)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.RepoURL)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.Destination != nil {
+		l = m.Destination.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
 	return n
 }
-func sozProject(x uint64) (n int) {
-	return sovProject(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ProjectCreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *PolicySimulationRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.DefaultRole)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicyResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	return n
+}
+
+func (m *PolicySimulationResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.Allowed != 0 {
+		n += 1 + sovProject(uint64(m.Allowed))
+	}
+	if m.Denied != 0 {
+		n += 1 + sovProject(uint64(m.Denied))
+	}
+	return n
+}
+
+func sovProject(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozProject(x uint64) (n int) {
+	return sovProject(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ProjectCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowProject
 			}
@@ -774,6 +1278,653 @@ func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Object = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Destination == nil {
+				m.Destination = &github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.ApplicationDestination{}
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicySimulationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicySimulationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicySimulationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &PolicyRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRole", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultRole = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &PolicyRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicySimulationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicySimulationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicySimulationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &PolicyResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			m.Allowed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Allowed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denied", wireType)
+			}
+			m.Denied = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denied |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProject(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -882,36 +2033,55 @@ var (
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptorProject) }
 
 var fileDescriptorProject = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0xc7, 0x65, 0x28, 0x41, 0x98, 0x4f, 0x59, 0x2d, 0x94, 0xa5, 0x04, 0xb4, 0xa7, 0x2a, 0x52,
-	0x6d, 0xa5, 0xe5, 0x50, 0x71, 0xe3, 0xa3, 0x42, 0x95, 0x38, 0x40, 0x10, 0x12, 0xe2, 0x52, 0xb9,
-	0xde, 0x91, 0xe3, 0x26, 0xbb, 0x36, 0xb6, 0xb3, 0x28, 0x42, 0x5c, 0x2a, 0x6e, 0x1c, 0x79, 0x04,
-	0x6e, 0x3c, 0x09, 0x47, 0x24, 0x5e, 0x00, 0x45, 0x3c, 0x08, 0x5a, 0x67, 0x4d, 0x9a, 0xa6, 0xe1,
-	0xb4, 0xe2, 0x94, 0xc9, 0xf8, 0xe3, 0xff, 0x9b, 0xf1, 0x7f, 0x07, 0x6f, 0x38, 0xb0, 0x25, 0x58,
-	0x66, 0xac, 0x3e, 0x02, 0xe1, 0xe3, 0x2f, 0x35, 0x56, 0x7b, 0x4d, 0x2e, 0xd6, 0x7f, 0x93, 0x55,
-	0xa9, 0xa5, 0x0e, 0x39, 0x56, 0x45, 0xd3, 0xe5, 0x64, 0x43, 0x6a, 0x2d, 0x87, 0xc0, 0xb8, 0x51,
-	0x8c, 0x17, 0x85, 0xf6, 0xdc, 0x2b, 0x5d, 0xb8, 0x7a, 0x35, 0x1d, 0xec, 0x3a, 0xaa, 0x74, 0x58,
-	0x15, 0xda, 0x02, 0x2b, 0xbb, 0x4c, 0x42, 0x01, 0x96, 0x7b, 0xc8, 0xea, 0x3d, 0x0f, 0x66, 0x7b,
-	0x72, 0x2e, 0xfa, 0xaa, 0x00, 0x3b, 0x66, 0x66, 0x20, 0xab, 0x84, 0x63, 0x39, 0x78, 0x7e, 0xd6,
-	0xa9, 0x7d, 0xa9, 0x7c, 0x7f, 0x74, 0x48, 0x85, 0xce, 0x19, 0xb7, 0x01, 0xec, 0x28, 0x04, 0x5b,
-	0x22, 0x9b, 0x9d, 0xe6, 0xc6, 0x0c, 0x95, 0x08, 0x48, 0xac, 0xec, 0xf2, 0xa1, 0xe9, 0xf3, 0x85,
-	0xab, 0xd2, 0xf7, 0x78, 0xf5, 0xc5, 0xb4, 0xc6, 0x27, 0x16, 0xb8, 0x87, 0x1e, 0xbc, 0x1b, 0x81,
-	0xf3, 0xe4, 0x00, 0xc7, 0xda, 0xd7, 0xd1, 0x7d, 0xb4, 0x79, 0x79, 0x7b, 0x8f, 0xce, 0x44, 0x69,
-	0x14, 0x0d, 0xc1, 0x81, 0xc8, 0xa8, 0x19, 0x48, 0x5a, 0x89, 0xd2, 0x13, 0xa2, 0x34, 0x8a, 0xd2,
-	0x47, 0xc6, 0xd4, 0x22, 0xbd, 0x78, 0x6b, 0x9a, 0xe2, 0x2b, 0x75, 0xee, 0xe5, 0x08, 0xec, 0x98,
-	0x10, 0xbc, 0x52, 0xf0, 0x1c, 0x82, 0xda, 0xa5, 0x5e, 0x88, 0x4f, 0xc0, 0xbd, 0x36, 0xd9, 0xff,
-	0x84, 0xbb, 0x8e, 0xaf, 0xee, 0xe5, 0xc6, 0x8f, 0x7b, 0xe0, 0x8c, 0x2e, 0x1c, 0x6c, 0x7f, 0xbb,
-	0x80, 0xaf, 0xd5, 0xbb, 0x5e, 0x81, 0x2d, 0x95, 0x00, 0xf2, 0x19, 0xe1, 0xd6, 0xb4, 0x67, 0xe4,
-	0x2e, 0x8d, 0xb6, 0x39, 0xab, 0x97, 0x49, 0x33, 0x74, 0xe9, 0x9d, 0xe3, 0x9f, 0xbf, 0xbf, 0x9c,
-	0x5b, 0x4b, 0x6f, 0x04, 0x47, 0x95, 0xdd, 0xe8, 0x55, 0xf7, 0x10, 0x75, 0xc8, 0x31, 0xc2, 0x2b,
-	0xcf, 0x95, 0xf3, 0x64, 0xed, 0x34, 0x4b, 0x68, 0x6f, 0xb2, 0xdf, 0x08, 0x43, 0xa5, 0x90, 0xae,
-	0x07, 0x0e, 0x42, 0x16, 0x38, 0xc8, 0x27, 0x84, 0xcf, 0x3f, 0x83, 0xa5, 0x0c, 0x0d, 0xf5, 0xe1,
-	0x5e, 0xd0, 0xbf, 0x4d, 0x6e, 0x9d, 0xd6, 0x67, 0x1f, 0x2a, 0xd7, 0x7c, 0x24, 0x5f, 0x11, 0x6e,
-	0x4d, 0x0d, 0xb3, 0xf8, 0x32, 0x73, 0x46, 0x6a, 0x8a, 0x68, 0x27, 0x10, 0x6d, 0x25, 0x9b, 0x8b,
-	0x44, 0x51, 0xbe, 0xfa, 0x94, 0x33, 0xee, 0x39, 0x0d, 0x88, 0xd5, 0x8b, 0xbd, 0xc1, 0xad, 0xa7,
-	0x30, 0x04, 0x0f, 0xcb, 0xda, 0x75, 0xf3, 0x6f, 0x7a, 0xce, 0x8b, 0xb1, 0xfe, 0xce, 0xb2, 0xfa,
-	0x1f, 0xef, 0x7e, 0x9f, 0xb4, 0xd1, 0x8f, 0x49, 0x1b, 0xfd, 0x9a, 0xb4, 0xd1, 0xdb, 0xce, 0xbf,
-	0x86, 0xc5, 0xfc, 0xf4, 0x3b, 0x6c, 0x85, 0xa1, 0xb0, 0xf3, 0x27, 0x00, 0x00, 0xff, 0xff, 0x59,
-	0x47, 0x12, 0x67, 0x16, 0x05, 0x00, 0x00,
+	// 785 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcb, 0x6e, 0xf3, 0x44,
+	0x14, 0x96, 0x93, 0xe2, 0xfc, 0x9d, 0xfc, 0x7f, 0x41, 0xa3, 0x5e, 0x4c, 0x28, 0x6d, 0xb0, 0x54,
+	0x29, 0x0a, 0xaa, 0x4d, 0x53, 0x16, 0x55, 0x77, 0x94, 0x56, 0xa8, 0x52, 0x17, 0xad, 0xab, 0x4a,
+	0x88, 0x4d, 0x35, 0xb5, 0x0f, 0xae, 0x5b, 0xc7, 0x33, 0x78, 0xc6, 0xa9, 0x22, 0xd4, 0x4d, 0x85,
+	0xc4, 0x82, 0x25, 0x8f, 0xc0, 0x8a, 0x15, 0xaf, 0xc1, 0x12, 0x89, 0x3d, 0x42, 0x11, 0x0f, 0x82,
+	0x66, 0x3c, 0xd3, 0x5c, 0xdc, 0x74, 0x43, 0xc4, 0x2a, 0x73, 0x2e, 0x33, 0xdf, 0x77, 0x3e, 0xcf,
+	0x39, 0x13, 0xb4, 0xc9, 0x21, 0x1f, 0x40, 0xee, 0xb3, 0x9c, 0xde, 0x41, 0x28, 0xcc, 0xaf, 0xc7,
+	0x72, 0x2a, 0x28, 0x6e, 0x68, 0xb3, 0xb5, 0x1a, 0xd3, 0x98, 0x2a, 0x9f, 0x2f, 0x57, 0x65, 0xb8,
+	0xb5, 0x19, 0x53, 0x1a, 0xa7, 0xe0, 0x13, 0x96, 0xf8, 0x24, 0xcb, 0xa8, 0x20, 0x22, 0xa1, 0x19,
+	0xd7, 0x51, 0xf7, 0xfe, 0x80, 0x7b, 0x09, 0x55, 0xd1, 0x90, 0xe6, 0xe0, 0x0f, 0xf6, 0xfc, 0x18,
+	0x32, 0xc8, 0x89, 0x80, 0x48, 0xe7, 0x7c, 0x3e, 0xce, 0xe9, 0x93, 0xf0, 0x36, 0xc9, 0x20, 0x1f,
+	0xfa, 0xec, 0x3e, 0x96, 0x0e, 0xee, 0xf7, 0x41, 0x90, 0x97, 0x76, 0x9d, 0xc6, 0x89, 0xb8, 0x2d,
+	0x6e, 0xbc, 0x90, 0xf6, 0x7d, 0x92, 0x2b, 0x62, 0x77, 0x6a, 0xb1, 0x1b, 0x46, 0xe3, 0xdd, 0x84,
+	0xb1, 0x34, 0x09, 0x15, 0x25, 0x7f, 0xb0, 0x47, 0x52, 0x76, 0x4b, 0x2a, 0x47, 0xb9, 0x0f, 0x68,
+	0xf5, 0xbc, 0xac, 0xf1, 0xcb, 0x1c, 0x88, 0x80, 0x00, 0xbe, 0x2b, 0x80, 0x0b, 0x7c, 0x8d, 0x4c,
+	0xed, 0x8e, 0xd5, 0xb6, 0x3a, 0xcd, 0xde, 0x89, 0x37, 0x06, 0xf5, 0x0c, 0xa8, 0x5a, 0x5c, 0x87,
+	0x91, 0xc7, 0xee, 0x63, 0x4f, 0x82, 0x7a, 0x13, 0xa0, 0x9e, 0x01, 0xf5, 0xbe, 0x60, 0x4c, 0x83,
+	0x04, 0xe6, 0x54, 0xd7, 0x45, 0x6f, 0xb5, 0xef, 0xa2, 0x80, 0x7c, 0x88, 0x31, 0x5a, 0xca, 0x48,
+	0x1f, 0x14, 0xda, 0x72, 0xa0, 0xd6, 0x13, 0xe4, 0xae, 0x58, 0xf4, 0x7f, 0x92, 0x7b, 0x1f, 0xbd,
+	0x3b, 0xe9, 0x33, 0x31, 0x0c, 0x80, 0x33, 0x9a, 0x71, 0x70, 0x7f, 0xad, 0xa1, 0x77, 0xe7, 0x34,
+	0x4d, 0xc2, 0xa1, 0xe1, 0xe0, 0xa0, 0x06, 0x2f, 0x6e, 0x9e, 0x39, 0x2c, 0x07, 0xc6, 0xc4, 0xeb,
+	0xc8, 0x8e, 0x73, 0x5a, 0x30, 0xee, 0xd4, 0xda, 0xf5, 0xce, 0x72, 0xa0, 0x2d, 0xdc, 0x42, 0x6f,
+	0x72, 0xe0, 0xb4, 0xc8, 0x43, 0x70, 0xea, 0x6a, 0xcb, 0xb3, 0x2d, 0xf7, 0x90, 0x50, 0xf2, 0x72,
+	0x96, 0x54, 0x44, 0x5b, 0xd2, 0x4f, 0x4b, 0x90, 0xf7, 0x4a, 0x7f, 0x69, 0xe1, 0x1d, 0xd4, 0xc8,
+	0x81, 0xd1, 0xab, 0xe0, 0xcc, 0xb1, 0x65, 0xe0, 0xa8, 0x39, 0xfa, 0x6b, 0xbb, 0x11, 0x94, 0xae,
+	0xc0, 0xc4, 0x30, 0x47, 0xcd, 0x08, 0xb8, 0x48, 0x32, 0x55, 0xb3, 0xd3, 0x50, 0x62, 0x5d, 0xfc,
+	0x37, 0xb1, 0x8c, 0xf3, 0x78, 0x7c, 0x70, 0x30, 0x89, 0xe2, 0xfe, 0x68, 0xa1, 0x8d, 0x52, 0xab,
+	0xcb, 0xa4, 0x5f, 0xa4, 0x65, 0x86, 0x56, 0xad, 0x27, 0x35, 0x50, 0x4b, 0xee, 0x58, 0xed, 0x7a,
+	0xa7, 0xd9, 0x5b, 0xf7, 0x4c, 0xcb, 0x4d, 0xe9, 0x1b, 0x3c, 0xe7, 0x49, 0x0d, 0x98, 0x0a, 0x39,
+	0xb5, 0x52, 0x83, 0xd2, 0xc2, 0x6d, 0x59, 0xdc, 0xb7, 0xa4, 0x48, 0x45, 0x40, 0x53, 0x23, 0xe9,
+	0xa4, 0xcb, 0x15, 0xe8, 0xad, 0x39, 0x94, 0x17, 0xa9, 0xc0, 0x9f, 0x49, 0xd5, 0xd4, 0xa9, 0xfa,
+	0xde, 0xcc, 0x03, 0x37, 0x69, 0xf2, 0x2b, 0x93, 0x34, 0xa5, 0x0f, 0x10, 0x29, 0xf0, 0x37, 0x81,
+	0x31, 0x65, 0xa4, 0x0f, 0x9c, 0x93, 0xd8, 0x20, 0x1b, 0xd3, 0x7d, 0x44, 0x4e, 0xb5, 0xfc, 0xf2,
+	0x1e, 0x61, 0x5f, 0x32, 0x90, 0x5c, 0x4c, 0xf9, 0x6b, 0x15, 0x06, 0x32, 0x1a, 0x98, 0xac, 0x59,
+	0x02, 0xf5, 0x31, 0x81, 0x75, 0x64, 0x47, 0x90, 0x25, 0x10, 0x29, 0xfc, 0x7a, 0xa0, 0xad, 0xde,
+	0x6f, 0x36, 0x5a, 0xd1, 0x17, 0xfa, 0x12, 0xf2, 0x41, 0x12, 0x02, 0xfe, 0xc9, 0x42, 0x76, 0xd9,
+	0xde, 0xf8, 0xe3, 0x31, 0xde, 0x0b, 0x6d, 0xdf, 0x5a, 0x4c, 0x23, 0xb9, 0x1f, 0x3d, 0xfd, 0xf9,
+	0xcf, 0xcf, 0xb5, 0x35, 0xf7, 0x03, 0x35, 0xfc, 0x06, 0x7b, 0x66, 0xac, 0xf2, 0x43, 0xab, 0x8b,
+	0x9f, 0x2c, 0xb4, 0x74, 0x96, 0x70, 0x81, 0xd7, 0x66, 0xb9, 0xa8, 0x49, 0xd0, 0x3a, 0x5d, 0x08,
+	0x07, 0x89, 0xe0, 0x3a, 0x8a, 0x07, 0xc6, 0x15, 0x1e, 0xf8, 0x07, 0x0b, 0xd5, 0xbf, 0x82, 0xb9,
+	0x1c, 0x16, 0xa4, 0xc3, 0xb6, 0xc2, 0xff, 0x10, 0x6f, 0xcc, 0xe2, 0xfb, 0xdf, 0xcb, 0x01, 0xf7,
+	0x88, 0x7f, 0xb1, 0x90, 0x5d, 0xce, 0xb6, 0xea, 0x97, 0x99, 0x9a, 0x79, 0x8b, 0x62, 0xb4, 0xaf,
+	0x18, 0xed, 0xb6, 0x3a, 0x55, 0x46, 0x06, 0x5e, 0xbe, 0x3a, 0x11, 0x11, 0xc4, 0x53, 0x14, 0xe5,
+	0x17, 0xfb, 0x1a, 0xd9, 0xc7, 0x90, 0x82, 0x80, 0x79, 0x72, 0x8d, 0xfb, 0x68, 0x7a, 0x6c, 0xea,
+	0xfa, 0xbb, 0x73, 0xeb, 0x7f, 0xb2, 0xd0, 0x8a, 0x6e, 0x13, 0x38, 0xd7, 0x6d, 0x3d, 0xd3, 0x11,
+	0x95, 0x21, 0xd2, 0xfa, 0xe4, 0x95, 0x0c, 0x0d, 0xfc, 0xa9, 0x02, 0xde, 0x71, 0xdb, 0x15, 0xe0,
+	0x72, 0x78, 0xf8, 0x5c, 0x83, 0x1e, 0x5a, 0xdd, 0xa3, 0x83, 0xdf, 0x47, 0x5b, 0xd6, 0x1f, 0xa3,
+	0x2d, 0xeb, 0xef, 0xd1, 0x96, 0xf5, 0x4d, 0xf7, 0xb5, 0xc7, 0x75, 0xfa, 0xdf, 0xc2, 0x8d, 0xad,
+	0x1e, 0xd1, 0xfd, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x15, 0x45, 0x71, 0xb3, 0x46, 0x08, 0x00,
+	0x00,
 }
@@ -99,6 +99,40 @@ func TestProjectServer(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
 	})
 
+	t.Run("TestRemoveSourceUsedByAppWithEquivalentURL", func(t *testing.T) {
+		existingApp := v1alpha1.Application{
+			ObjectMeta: v1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       v1alpha1.ApplicationSpec{Project: "test", Source: v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argo-cd"}},
+		}
+
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, util.NewKeyLock())
+
+		updatedProj := existingProj.DeepCopy()
+		updatedProj.Spec.SourceRepos = []string{}
+
+		_, err := projectServer.Update(context.Background(), &ProjectUpdateRequest{Project: updatedProj})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+		assert.Contains(t, err.Error(), "application test")
+	})
+
+	t.Run("TestRemoveDestinationNotPermittedBefore", func(t *testing.T) {
+		existingApp := v1alpha1.Application{
+			ObjectMeta: v1.ObjectMeta{Name: "test", Namespace: "default"},
+			Spec:       v1alpha1.ApplicationSpec{Project: "test", Destination: v1alpha1.ApplicationDestination{Namespace: "ns3", Server: "https://server1"}},
+		}
+
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, util.NewKeyLock())
+
+		updatedProj := existingProj.DeepCopy()
+		updatedProj.Spec.Destinations = updatedProj.Spec.Destinations[1:]
+
+		_, err := projectServer.Update(context.Background(), &ProjectUpdateRequest{Project: updatedProj})
+
+		assert.Nil(t, err)
+	})
+
 	t.Run("TestDeleteProjectSuccessful", func(t *testing.T) {
 		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj), enforcer, util.NewKeyLock())
 
@@ -120,4 +154,53 @@ func TestProjectServer(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
 	})
+
+	t.Run("TestUpdateProjectUnsupportedSourceTool", func(t *testing.T) {
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj), enforcer, util.NewKeyLock())
+
+		updatedProj := existingProj.DeepCopy()
+		updatedProj.Spec.SourceTools = []string{"helm", "jsonnet"}
+		_, err := projectServer.Update(context.Background(), &ProjectUpdateRequest{Project: updatedProj})
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+		updatedProj.Spec.SourceTools = []string{"helm"}
+		_, err = projectServer.Update(context.Background(), &ProjectUpdateRequest{Project: updatedProj})
+		assert.Nil(t, err)
+	})
+
+	t.Run("TestUpdateProjectSignatureKeyNotFingerprint", func(t *testing.T) {
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj), enforcer, util.NewKeyLock())
+
+		updatedProj := existingProj.DeepCopy()
+		updatedProj.Spec.SignatureKeys = []string{"4D9E8E13A7E3C96A"}
+		_, err := projectServer.Update(context.Background(), &ProjectUpdateRequest{Project: updatedProj})
+		assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+
+		updatedProj.Spec.SignatureKeys = []string{"4AEE18F83AFDEB23D5D9A1C34D9E8E13A7E3C96A"}
+		_, err = projectServer.Update(context.Background(), &ProjectUpdateRequest{Project: updatedProj})
+		assert.Nil(t, err)
+	})
+
+	t.Run("TestSimulatePolicy", func(t *testing.T) {
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj), enforcer, util.NewKeyLock())
+
+		res, err := projectServer.SimulatePolicy(context.Background(), &PolicySimulationRequest{
+			Policy: "p, alice, applications, *, test/*",
+			Requests: []*PolicyRequest{
+				{Subject: "alice", Resource: "applications", Action: "sync", Object: "test/guestbook"},
+				{Subject: "alice", Resource: "applications", Action: "sync", Object: "other/guestbook"},
+				{Subject: "alice", Resource: "applications", Action: "create", Object: "test/guestbook", Destination: &v1alpha1.ApplicationDestination{Namespace: "ns3", Server: "https://server3"}},
+				{Subject: "alice", Resource: "applications", Action: "create", Object: "test/guestbook", RepoURL: "https://github.com/argoproj/argo-cd.git"},
+			},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res.Allowed)
+		assert.Equal(t, int64(2), res.Denied)
+		assert.True(t, res.Results[0].Allowed)
+		assert.False(t, res.Results[1].Allowed)
+		assert.False(t, res.Results[2].Allowed)
+		assert.Contains(t, res.Results[2].Message, "destination")
+		assert.True(t, res.Results[3].Allowed)
+	})
 }
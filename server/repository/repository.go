@@ -1,17 +1,25 @@
 package repository
 
 import (
+	"path"
 	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/reposerver/repository"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/grpc"
@@ -20,6 +28,8 @@ import (
 
 // Server provides a Repository service
 type Server struct {
+	ns            string
+	appclientset  appclientset.Interface
 	db            db.ArgoDB
 	repoClientset reposerver.Clientset
 	enf           *rbac.Enforcer
@@ -27,11 +37,15 @@ type Server struct {
 
 // NewServer returns a new instance of the Repository service
 func NewServer(
+	namespace string,
+	appclientset appclientset.Interface,
 	repoClientset reposerver.Clientset,
 	db db.ArgoDB,
 	enf *rbac.Enforcer,
 ) *Server {
 	return &Server{
+		ns:            namespace,
+		appclientset:  appclientset,
 		db:            db,
 		repoClientset: repoClientset,
 		enf:           enf,
@@ -40,10 +54,17 @@ func NewServer(
 
 // List returns list of repositories
 func (s *Server) List(ctx context.Context, q *RepoQuery) (*appsv1.RepositoryList, error) {
+	selector, err := labels.Parse(q.Selector)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector '%s': %v", q.Selector, err)
+	}
 	repoList, err := s.db.ListRepositories(ctx)
 	if repoList != nil {
 		newItems := make([]appsv1.Repository, 0)
 		for _, repo := range repoList.Items {
+			if !selector.Matches(labels.Set(repo.Labels)) {
+				continue
+			}
 			if s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "get", repo.Repo) {
 				newItems = append(newItems, *redact(&repo))
 			}
@@ -53,7 +74,7 @@ func (s *Server) List(ctx context.Context, q *RepoQuery) (*appsv1.RepositoryList
 	return repoList, err
 }
 
-// ListKsonnetApps returns list of Ksonnet apps in the repo
+// ListApps returns list of apps in the repo
 func (s *Server) ListApps(ctx context.Context, q *RepoAppsQuery) (*RepoAppsResponse, error) {
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "repositories/apps", "get", q.Repo) {
 		return nil, grpc.ErrPermissionDenied
@@ -72,7 +93,7 @@ func (s *Server) ListApps(ctx context.Context, q *RepoAppsQuery) (*RepoAppsRespo
 
 	revision := q.Revision
 	if revision == "" {
-		revision = "HEAD"
+		revision = repo.DefaultRevision
 	}
 
 	ksonnetApps, err := s.listKsonnetApps(ctx, repo, revision, repoClient)
@@ -85,12 +106,89 @@ func (s *Server) ListApps(ctx context.Context, q *RepoAppsQuery) (*RepoAppsRespo
 		return nil, err
 	}
 
+	kustomizeDirs, err := s.listDirs(ctx, repo, revision, repoClient, "*kustomization.yaml")
+	if err != nil {
+		return nil, err
+	}
+
+	manifestDirs, err := s.listDirs(ctx, repo, revision, repoClient, "*.yaml", "*.yml", "*.json")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*AppInfo, 0)
+	appDirs := make([]string, 0)
+	for _, app := range ksonnetApps {
+		items = append(items, &AppInfo{Type: string(repository.AppSourceKsonnet), Path: path.Dir(app.Path)})
+		appDirs = append(appDirs, path.Dir(app.Path))
+	}
+	for _, app := range helmApps {
+		items = append(items, &AppInfo{Type: string(repository.AppSourceHelm), Path: path.Dir(app.Path)})
+		appDirs = append(appDirs, path.Dir(app.Path))
+	}
+	for _, dir := range kustomizeDirs {
+		items = append(items, &AppInfo{Type: string(repository.AppSourceKustomize), Path: dir})
+	}
+	appDirs = append(appDirs, kustomizeDirs...)
+	for _, dir := range manifestDirs {
+		if !isInAnyDir(dir, appDirs) {
+			items = append(items, &AppInfo{Type: string(repository.AppSourceDirectory), Path: dir})
+		}
+	}
+
 	return &RepoAppsResponse{
 		KsonnetApps: ksonnetApps,
 		HelmApps:    helmApps,
+		Items:       items,
 	}, nil
 }
 
+// getFiles retrieves the contents of the files at the specified paths in a single request
+func (s *Server) getFiles(ctx context.Context, repo *appsv1.Repository, revision string, paths []string, repoClient repository.RepositoryServiceClient) (*repository.GetFilesResponse, error) {
+	if len(paths) == 0 {
+		return &repository.GetFilesResponse{}, nil
+	}
+	return repoClient.GetFiles(ctx, &repository.GetFilesRequest{
+		Repo:     repo,
+		Revision: revision,
+		Paths:    paths,
+	})
+}
+
+// listDirs returns the sorted, distinct list of directories containing files matching any of the specified patterns
+func (s *Server) listDirs(ctx context.Context, repo *appsv1.Repository, revision string, repoClient repository.RepositoryServiceClient, patterns ...string) ([]string, error) {
+	dirSet := make(map[string]bool)
+	for _, pattern := range patterns {
+		getRes, err := repoClient.ListDir(ctx, &repository.ListDirRequest{
+			Repo:     repo,
+			Revision: revision,
+			Path:     pattern,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, filePath := range getRes.Items {
+			dirSet[path.Dir(filePath)] = true
+		}
+	}
+	dirs := make([]string, 0, len(dirSet))
+	for dir := range dirSet {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+// isInAnyDir returns whether the specified directory is one of, or nested in one of, the given directories
+func isInAnyDir(dir string, parents []string) bool {
+	for _, parent := range parents {
+		if parent == "." || dir == parent || strings.HasPrefix(dir, parent+"/") {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Server) listHelmApps(ctx context.Context, repo *appsv1.Repository, revision string, repoClient repository.RepositoryServiceClient) ([]*HelmAppSpec, error) {
 	req := repository.ListDirRequest{
 		Repo:     repo,
@@ -102,20 +200,16 @@ func (s *Server) listHelmApps(ctx context.Context, repo *appsv1.Repository, revi
 		return nil, err
 	}
 
+	getFilesRes, err := s.getFiles(ctx, repo, revision, getRes.Items, repoClient)
+	if err != nil {
+		return nil, err
+	}
+
 	helmApps := make([]*HelmAppSpec, 0)
 	for _, path := range getRes.Items {
-		getFileRes, err := repoClient.GetFile(ctx, &repository.GetFileRequest{
-			Repo:     repo,
-			Revision: revision,
-			Path:     path,
-		})
-		if err != nil {
-			return nil, err
-		}
-
 		var appSpec HelmAppSpec
 		appSpec.Path = path
-		err = yaml.Unmarshal(getFileRes.Data, &appSpec)
+		err = yaml.Unmarshal(getFilesRes.Files[path], &appSpec)
 		if err == nil && appSpec.Name != "" {
 			helmApps = append(helmApps, &appSpec)
 		}
@@ -134,20 +228,16 @@ func (s *Server) listKsonnetApps(ctx context.Context, repo *appsv1.Repository, r
 		return nil, err
 	}
 
+	getFilesRes, err := s.getFiles(ctx, repo, revision, getRes.Items, repoClient)
+	if err != nil {
+		return nil, err
+	}
+
 	ksonnetApps := make([]*KsonnetAppSpec, 0)
 	for _, path := range getRes.Items {
-		getFileRes, err := repoClient.GetFile(ctx, &repository.GetFileRequest{
-			Repo:     repo,
-			Revision: revision,
-			Path:     path,
-		})
-		if err != nil {
-			return nil, err
-		}
-
 		var appSpec KsonnetAppSpec
 		appSpec.Path = path
-		err = yaml.Unmarshal(getFileRes.Data, &appSpec)
+		err = yaml.Unmarshal(getFilesRes.Files[path], &appSpec)
 		if err == nil && appSpec.Name != "" && len(appSpec.Environments) > 0 {
 			ksonnetApps = append(ksonnetApps, &appSpec)
 		}
@@ -206,6 +296,41 @@ func (s *Server) Update(ctx context.Context, q *RepoUpdateRequest) (*appsv1.Repo
 	return redact(repo), err
 }
 
+// UpdateCredentials replaces the credentials of a repository, e.g. to rotate an access token. The
+// existing credentials are kept if the repository is not accessible with the new ones. Applications
+// sourced from the repository are hard refreshed, so that comparisons which failed with the previous
+// credentials are retried right away, with manifests generated using the new credentials.
+func (s *Server) UpdateCredentials(ctx context.Context, q *RepoUpdateCredentialsRequest) (*appsv1.Repository, error) {
+	if !s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "update", q.Repo) {
+		return nil, grpc.ErrPermissionDenied
+	}
+	repo, err := s.db.UpdateRepositoryCredentials(ctx, q.Repo, q.Username, q.Password, q.SshPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	s.refreshRepoApps(repo.Repo)
+	return redact(repo), nil
+}
+
+// refreshRepoApps hard refreshes the applications sourced from the repository
+func (s *Server) refreshRepoApps(repoURL string) {
+	appIf := s.appclientset.ArgoprojV1alpha1().Applications(s.ns)
+	apps, err := appIf.List(metav1.ListOptions{})
+	if err != nil {
+		log.Warnf("Failed to list applications to refresh after updating credentials of %s: %v", repoURL, err)
+		return
+	}
+	for _, app := range apps.Items {
+		if !git.SameURL(app.Spec.Source.RepoURL, repoURL) {
+			continue
+		}
+		_, err = argo.RefreshApp(appIf, app.Name, appsv1.RefreshTypeHard)
+		if err != nil {
+			log.Warnf("Failed to refresh application '%s': %v", app.Name, err)
+		}
+	}
+}
+
 // Delete updates a repository
 func (s *Server) Delete(ctx context.Context, q *RepoQuery) (*RepoResponse, error) {
 	if !s.enf.EnforceClaims(ctx.Value("claims"), "repositories", "delete", q.Repo) {
@@ -14,6 +14,7 @@
 	It has these top-level messages:
 		RepoAppsQuery
 		RepoAppsResponse
+		AppInfo
 		KsonnetAppSpec
 		HelmAppSpec
 		KsonnetEnvironment
@@ -22,6 +23,7 @@
 		RepoResponse
 		RepoCreateRequest
 		RepoUpdateRequest
+		RepoUpdateCredentialsRequest
 */
 package repository
 
@@ -78,6 +80,7 @@ func (m *RepoAppsQuery) GetRevision() string {
 type RepoAppsResponse struct {
 	KsonnetApps []*KsonnetAppSpec `protobuf:"bytes,1,rep,name=ksonnetApps" json:"ksonnetApps,omitempty"`
 	HelmApps    []*HelmAppSpec    `protobuf:"bytes,2,rep,name=helmApps" json:"helmApps,omitempty"`
+	Items       []*AppInfo        `protobuf:"bytes,3,rep,name=items" json:"items,omitempty"`
 }
 
 func (m *RepoAppsResponse) Reset()                    { *m = RepoAppsResponse{} }
@@ -99,6 +102,39 @@ func (m *RepoAppsResponse) GetHelmApps() []*HelmAppSpec {
 	return nil
 }
 
+func (m *RepoAppsResponse) GetItems() []*AppInfo {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// AppInfo contains the type of an application discovered in the source repo, and the path to its directory
+type AppInfo struct {
+	// Type is the application source type (one of: ksonnet, helm, kustomize, directory)
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *AppInfo) Reset()                    { *m = AppInfo{} }
+func (m *AppInfo) String() string            { return proto.CompactTextString(m) }
+func (*AppInfo) ProtoMessage()               {}
+func (*AppInfo) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{2} }
+
+func (m *AppInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AppInfo) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
 // KsonnetAppSpec contains Ksonnet app response
 // This roughly reflects: ksonnet/ksonnet/metadata/app/schema.go
 type KsonnetAppSpec struct {
@@ -110,7 +146,7 @@ type KsonnetAppSpec struct {
 func (m *KsonnetAppSpec) Reset()                    { *m = KsonnetAppSpec{} }
 func (m *KsonnetAppSpec) String() string            { return proto.CompactTextString(m) }
 func (*KsonnetAppSpec) ProtoMessage()               {}
-func (*KsonnetAppSpec) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{2} }
+func (*KsonnetAppSpec) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{3} }
 
 func (m *KsonnetAppSpec) GetName() string {
 	if m != nil {
@@ -142,7 +178,7 @@ type HelmAppSpec struct {
 func (m *HelmAppSpec) Reset()                    { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string            { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()               {}
-func (*HelmAppSpec) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{3} }
+func (*HelmAppSpec) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{4} }
 
 func (m *HelmAppSpec) GetName() string {
 	if m != nil {
@@ -172,7 +208,7 @@ type KsonnetEnvironment struct {
 func (m *KsonnetEnvironment) Reset()                    { *m = KsonnetEnvironment{} }
 func (m *KsonnetEnvironment) String() string            { return proto.CompactTextString(m) }
 func (*KsonnetEnvironment) ProtoMessage()               {}
-func (*KsonnetEnvironment) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{4} }
+func (*KsonnetEnvironment) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{5} }
 
 func (m *KsonnetEnvironment) GetName() string {
 	if m != nil {
@@ -213,7 +249,7 @@ func (m *KsonnetEnvironmentDestination) Reset()         { *m = KsonnetEnvironmen
 func (m *KsonnetEnvironmentDestination) String() string { return proto.CompactTextString(m) }
 func (*KsonnetEnvironmentDestination) ProtoMessage()    {}
 func (*KsonnetEnvironmentDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptorRepository, []int{5}
+	return fileDescriptorRepository, []int{6}
 }
 
 func (m *KsonnetEnvironmentDestination) GetServer() string {
@@ -233,12 +269,14 @@ func (m *KsonnetEnvironmentDestination) GetNamespace() string {
 // RepoQuery is a query for Repository resources
 type RepoQuery struct {
 	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Selector is a label selector (e.g. team=payments,env!=dev) restricting the listed repositories
+	Selector string `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
 }
 
 func (m *RepoQuery) Reset()                    { *m = RepoQuery{} }
 func (m *RepoQuery) String() string            { return proto.CompactTextString(m) }
 func (*RepoQuery) ProtoMessage()               {}
-func (*RepoQuery) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{6} }
+func (*RepoQuery) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{7} }
 
 func (m *RepoQuery) GetRepo() string {
 	if m != nil {
@@ -247,13 +285,20 @@ func (m *RepoQuery) GetRepo() string {
 	return ""
 }
 
+func (m *RepoQuery) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
 type RepoResponse struct {
 }
 
 func (m *RepoResponse) Reset()                    { *m = RepoResponse{} }
 func (m *RepoResponse) String() string            { return proto.CompactTextString(m) }
 func (*RepoResponse) ProtoMessage()               {}
-func (*RepoResponse) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{7} }
+func (*RepoResponse) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{8} }
 
 type RepoCreateRequest struct {
 	Repo   *github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
@@ -263,7 +308,7 @@ type RepoCreateRequest struct {
 func (m *RepoCreateRequest) Reset()                    { *m = RepoCreateRequest{} }
 func (m *RepoCreateRequest) String() string            { return proto.CompactTextString(m) }
 func (*RepoCreateRequest) ProtoMessage()               {}
-func (*RepoCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{8} }
+func (*RepoCreateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{9} }
 
 func (m *RepoCreateRequest) GetRepo() *github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository {
 	if m != nil {
@@ -286,7 +331,7 @@ type RepoUpdateRequest struct {
 func (m *RepoUpdateRequest) Reset()                    { *m = RepoUpdateRequest{} }
 func (m *RepoUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*RepoUpdateRequest) ProtoMessage()               {}
-func (*RepoUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{9} }
+func (*RepoUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRepository, []int{10} }
 
 func (m *RepoUpdateRequest) GetRepo() *github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository {
 	if m != nil {
@@ -295,9 +340,53 @@ func (m *RepoUpdateRequest) GetRepo() *github_com_argoproj_argo_cd_pkg_apis_appl
 	return nil
 }
 
+// RepoUpdateCredentialsRequest is a request to replace the credentials of a repository
+type RepoUpdateCredentialsRequest struct {
+	Repo          string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	SshPrivateKey string `protobuf:"bytes,4,opt,name=sshPrivateKey,proto3" json:"sshPrivateKey,omitempty"`
+}
+
+func (m *RepoUpdateCredentialsRequest) Reset()         { *m = RepoUpdateCredentialsRequest{} }
+func (m *RepoUpdateCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*RepoUpdateCredentialsRequest) ProtoMessage()    {}
+func (*RepoUpdateCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRepository, []int{11}
+}
+
+func (m *RepoUpdateCredentialsRequest) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *RepoUpdateCredentialsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RepoUpdateCredentialsRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *RepoUpdateCredentialsRequest) GetSshPrivateKey() string {
+	if m != nil {
+		return m.SshPrivateKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*RepoAppsQuery)(nil), "repository.RepoAppsQuery")
 	proto.RegisterType((*RepoAppsResponse)(nil), "repository.RepoAppsResponse")
+	proto.RegisterType((*AppInfo)(nil), "repository.AppInfo")
 	proto.RegisterType((*KsonnetAppSpec)(nil), "repository.KsonnetAppSpec")
 	proto.RegisterType((*HelmAppSpec)(nil), "repository.HelmAppSpec")
 	proto.RegisterType((*KsonnetEnvironment)(nil), "repository.KsonnetEnvironment")
@@ -306,6 +395,7 @@ func init() {
 	proto.RegisterType((*RepoResponse)(nil), "repository.RepoResponse")
 	proto.RegisterType((*RepoCreateRequest)(nil), "repository.RepoCreateRequest")
 	proto.RegisterType((*RepoUpdateRequest)(nil), "repository.RepoUpdateRequest")
+	proto.RegisterType((*RepoUpdateCredentialsRequest)(nil), "repository.RepoUpdateCredentialsRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -321,7 +411,7 @@ const _ = grpc.SupportPackageIsVersion4
 type RepositoryServiceClient interface {
 	// List returns list of repos
 	List(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.RepositoryList, error)
-	// ListKsonnetApps returns list of Ksonnet apps in the repo
+	// ListApps returns list of apps in the repo
 	ListApps(ctx context.Context, in *RepoAppsQuery, opts ...grpc.CallOption) (*RepoAppsResponse, error)
 	// Create creates a repo
 	Create(ctx context.Context, in *RepoCreateRequest, opts ...grpc.CallOption) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository, error)
@@ -329,6 +419,8 @@ type RepositoryServiceClient interface {
 	Get(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository, error)
 	// Update updates a repo
 	Update(ctx context.Context, in *RepoUpdateRequest, opts ...grpc.CallOption) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository, error)
+	// UpdateCredentials replaces the credentials of a repo, after verifying that the repo is accessible with them
+	UpdateCredentials(ctx context.Context, in *RepoUpdateCredentialsRequest, opts ...grpc.CallOption) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository, error)
 	// Delete deletes a repo
 	Delete(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*RepoResponse, error)
 }
@@ -386,6 +478,15 @@ func (c *repositoryServiceClient) Update(ctx context.Context, in *RepoUpdateRequ
 	return out, nil
 }
 
+func (c *repositoryServiceClient) UpdateCredentials(ctx context.Context, in *RepoUpdateCredentialsRequest, opts ...grpc.CallOption) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository, error) {
+	out := new(github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository)
+	err := grpc.Invoke(ctx, "/repository.RepositoryService/UpdateCredentials", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) Delete(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*RepoResponse, error) {
 	out := new(RepoResponse)
 	err := grpc.Invoke(ctx, "/repository.RepositoryService/Delete", in, out, c.cc, opts...)
@@ -400,7 +501,7 @@ func (c *repositoryServiceClient) Delete(ctx context.Context, in *RepoQuery, opt
 type RepositoryServiceServer interface {
 	// List returns list of repos
 	List(context.Context, *RepoQuery) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.RepositoryList, error)
-	// ListKsonnetApps returns list of Ksonnet apps in the repo
+	// ListApps returns list of apps in the repo
 	ListApps(context.Context, *RepoAppsQuery) (*RepoAppsResponse, error)
 	// Create creates a repo
 	Create(context.Context, *RepoCreateRequest) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository, error)
@@ -408,6 +509,8 @@ type RepositoryServiceServer interface {
 	Get(context.Context, *RepoQuery) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository, error)
 	// Update updates a repo
 	Update(context.Context, *RepoUpdateRequest) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository, error)
+	// UpdateCredentials replaces the credentials of a repo, after verifying that the repo is accessible with them
+	UpdateCredentials(context.Context, *RepoUpdateCredentialsRequest) (*github_com_argoproj_argo_cd_pkg_apis_application_v1alpha1.Repository, error)
 	// Delete deletes a repo
 	Delete(context.Context, *RepoQuery) (*RepoResponse, error)
 }
@@ -506,6 +609,24 @@ func _RepositoryService_Update_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_UpdateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoUpdateCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).UpdateCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/UpdateCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).UpdateCredentials(ctx, req.(*RepoUpdateCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoQuery)
 	if err := dec(in); err != nil {
@@ -548,6 +669,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _RepositoryService_Update_Handler,
 		},
+		{
+			MethodName: "UpdateCredentials",
+			Handler:    _RepositoryService_UpdateCredentials_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _RepositoryService_Delete_Handler,
@@ -626,6 +751,48 @@ func (m *RepoAppsResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintRepository(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *AppInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Path) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Path)))
+		i += copy(dAtA[i:], m.Path)
+	}
 	return i, nil
 }
 
@@ -814,6 +981,12 @@ func (m *RepoQuery) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
 		i += copy(dAtA[i:], m.Repo)
 	}
+	if len(m.Selector) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Selector)))
+		i += copy(dAtA[i:], m.Selector)
+	}
 	return i, nil
 }
 
@@ -901,6 +1074,48 @@ func (m *RepoUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RepoUpdateCredentialsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoUpdateCredentialsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
+	}
+	if len(m.Username) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
+	}
+	if len(m.Password) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Password)))
+		i += copy(dAtA[i:], m.Password)
+	}
+	if len(m.SshPrivateKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.SshPrivateKey)))
+		i += copy(dAtA[i:], m.SshPrivateKey)
+	}
+	return i, nil
+}
+
 func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -939,6 +1154,26 @@ func (m *RepoAppsResponse) Size() (n int) {
 			n += 1 + l + sovRepository(uint64(l))
 		}
 	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AppInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	return n
 }
 
@@ -1026,6 +1261,10 @@ func (m *RepoQuery) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	return n
 }
 
@@ -1058,6 +1297,28 @@ func (m *RepoUpdateRequest) Size() (n int) {
 	return n
 }
 
+func (m *RepoUpdateCredentialsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.SshPrivateKey)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	return n
+}
+
 func sovRepository(x uint64) (n int) {
 	for {
 		n++
@@ -1270,6 +1531,145 @@ func (m *RepoAppsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &AppInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -1966,6 +2366,35 @@ func (m *RepoQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -2223,6 +2652,172 @@ func (m *RepoUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RepoUpdateCredentialsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoUpdateCredentialsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoUpdateCredentialsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SshPrivateKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SshPrivateKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRepository(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2331,53 +2926,62 @@ var (
 func init() { proto.RegisterFile("server/repository/repository.proto", fileDescriptorRepository) }
 
 var fileDescriptorRepository = []byte{
-	// 765 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0x13, 0x4f,
-	0x14, 0x67, 0x93, 0x7e, 0x43, 0x3a, 0xe9, 0xb7, 0xb4, 0x43, 0xbf, 0xfd, 0xc6, 0x35, 0x8d, 0x65,
-	0xbc, 0x44, 0xb1, 0xbb, 0x34, 0x55, 0x28, 0x55, 0x10, 0xb5, 0x45, 0x4b, 0x3d, 0xe8, 0x96, 0x0a,
-	0x7a, 0xb0, 0x6c, 0x37, 0x8f, 0xcd, 0x9a, 0x64, 0x67, 0x9c, 0x99, 0x2c, 0x04, 0xe9, 0x45, 0xb0,
-	0x78, 0xd6, 0xbb, 0x77, 0xc1, 0x3f, 0xc4, 0xa3, 0xe0, 0x3f, 0x20, 0xc5, 0x9b, 0xff, 0x84, 0xcc,
-	0xec, 0x66, 0xb3, 0x69, 0x7e, 0xe0, 0x21, 0x78, 0x7b, 0xf3, 0xe6, 0xfd, 0xf8, 0xbc, 0xcf, 0x7b,
-	0x6f, 0x76, 0x11, 0x11, 0xc0, 0x23, 0xe0, 0x36, 0x07, 0x46, 0x45, 0x20, 0x29, 0xef, 0x65, 0x44,
-	0x8b, 0x71, 0x2a, 0x29, 0x46, 0x03, 0x8d, 0xb9, 0xe2, 0x53, 0x9f, 0x6a, 0xb5, 0xad, 0xa4, 0xd8,
-	0xc2, 0xac, 0xf8, 0x94, 0xfa, 0x6d, 0xb0, 0x5d, 0x16, 0xd8, 0x6e, 0x18, 0x52, 0xe9, 0xca, 0x80,
-	0x86, 0x22, 0xb9, 0x25, 0xad, 0x6d, 0x61, 0x05, 0x54, 0xdf, 0x7a, 0x94, 0x83, 0x1d, 0x6d, 0xda,
-	0x3e, 0x84, 0xc0, 0x5d, 0x09, 0x8d, 0xc4, 0x66, 0xdf, 0x0f, 0x64, 0xb3, 0x7b, 0x62, 0x79, 0xb4,
-	0x63, 0xbb, 0x5c, 0xa7, 0x78, 0xa5, 0x85, 0x0d, 0xaf, 0x61, 0xb3, 0x96, 0xaf, 0x9c, 0x85, 0xed,
-	0x32, 0xd6, 0x0e, 0x3c, 0x1d, 0xdc, 0x8e, 0x36, 0xdd, 0x36, 0x6b, 0xba, 0x23, 0xa1, 0xc8, 0x5d,
-	0xf4, 0xaf, 0x03, 0x8c, 0xde, 0x63, 0x4c, 0x3c, 0xed, 0x02, 0xef, 0x61, 0x8c, 0xe6, 0x54, 0x05,
-	0x65, 0x63, 0xdd, 0xa8, 0xcd, 0x3b, 0x5a, 0xc6, 0x26, 0x2a, 0x72, 0x88, 0x02, 0x11, 0xd0, 0xb0,
-	0x9c, 0xd3, 0xfa, 0xf4, 0x4c, 0xde, 0x19, 0x68, 0xa9, 0x1f, 0xc1, 0x01, 0xc1, 0x68, 0x28, 0x00,
-	0xdf, 0x41, 0xa5, 0x96, 0xa0, 0x61, 0x08, 0x52, 0xa9, 0xcb, 0xc6, 0x7a, 0xbe, 0x56, 0xaa, 0x9b,
-	0x56, 0x86, 0xac, 0x83, 0xf4, 0xfa, 0x90, 0x81, 0xe7, 0x64, 0xcd, 0xf1, 0x16, 0x2a, 0x36, 0xa1,
-	0xdd, 0xd1, 0xae, 0x39, 0xed, 0xfa, 0x7f, 0xd6, 0xf5, 0x51, 0x7c, 0xa7, 0xfd, 0x52, 0x43, 0xf2,
-	0xcb, 0x40, 0x8b, 0xc3, 0x41, 0x55, 0x29, 0xa1, 0xdb, 0x81, 0x7e, 0x29, 0x4a, 0x56, 0x3a, 0xe6,
-	0xca, 0x66, 0x52, 0x86, 0x96, 0xf1, 0x13, 0xb4, 0x00, 0x61, 0x14, 0x70, 0x1a, 0x76, 0x20, 0x94,
-	0xa2, 0x9c, 0xd7, 0x39, 0x6f, 0x4c, 0x86, 0x6b, 0xed, 0x65, 0xcc, 0xf7, 0x42, 0xc9, 0x7b, 0xce,
-	0x50, 0x04, 0xf3, 0x18, 0x2d, 0x8f, 0x98, 0xe0, 0x25, 0x94, 0x6f, 0x41, 0x2f, 0x41, 0xa3, 0x44,
-	0x7c, 0x13, 0xfd, 0x13, 0xb9, 0xed, 0x2e, 0x68, 0x34, 0xa5, 0x7a, 0x75, 0x4c, 0xc6, 0x4c, 0x18,
-	0x27, 0x36, 0xde, 0xc9, 0x6d, 0x1b, 0xe4, 0x16, 0x2a, 0x65, 0x68, 0xf8, 0xd3, 0x4a, 0xc9, 0x67,
-	0x03, 0xe1, 0xd1, 0xc0, 0x63, 0xdd, 0xab, 0x08, 0xb5, 0xb6, 0xc5, 0x33, 0xe0, 0x99, 0xae, 0x67,
-	0x34, 0x69, 0xf8, 0x7c, 0x86, 0xc8, 0x03, 0x54, 0x6a, 0x80, 0x90, 0x41, 0xa8, 0x87, 0xae, 0x3c,
-	0xa7, 0xab, 0xba, 0x36, 0xbd, 0xaa, 0xdd, 0x81, 0x83, 0x93, 0xf5, 0x26, 0x47, 0x68, 0x6d, 0xaa,
-	0x35, 0x5e, 0x45, 0x85, 0x78, 0x1f, 0x13, 0xdc, 0xc9, 0x09, 0x57, 0xd0, 0xbc, 0xaa, 0x40, 0x30,
-	0xd7, 0x83, 0x04, 0xf8, 0x40, 0x41, 0xae, 0xa0, 0x79, 0x35, 0xae, 0x13, 0x87, 0x9d, 0x2c, 0xa2,
-	0x05, 0x65, 0xd0, 0x9f, 0x65, 0x72, 0x66, 0xa0, 0x65, 0xa5, 0x78, 0xc0, 0xc1, 0x95, 0xe0, 0xc0,
-	0xeb, 0x2e, 0x08, 0x89, 0x9f, 0x67, 0x3c, 0x4b, 0xf5, 0x3d, 0x6b, 0xb0, 0x91, 0x56, 0x7f, 0x23,
-	0xb5, 0x70, 0xec, 0x35, 0x2c, 0xd6, 0xf2, 0x2d, 0xb5, 0x91, 0x56, 0x66, 0x23, 0xad, 0xfe, 0x46,
-	0x5a, 0x4e, 0xca, 0x4e, 0xb2, 0x6d, 0xab, 0xa8, 0xd0, 0x65, 0x02, 0xb8, 0xd4, 0xe0, 0x8b, 0x4e,
-	0x72, 0x22, 0x61, 0x8c, 0xe3, 0x88, 0x35, 0xfe, 0x0a, 0x8e, 0xfa, 0x97, 0x42, 0x9c, 0x30, 0x56,
-	0x1e, 0x02, 0x8f, 0x02, 0x0f, 0xf0, 0x99, 0x81, 0xe6, 0x1e, 0x07, 0x42, 0xe2, 0xff, 0xb2, 0x7d,
-	0x4d, 0x29, 0x35, 0xf7, 0x67, 0x02, 0x41, 0x65, 0x20, 0x95, 0xb7, 0xdf, 0x7f, 0x7e, 0xcc, 0xad,
-	0xe2, 0x15, 0xfd, 0x18, 0x46, 0x9b, 0x83, 0xc7, 0x36, 0x00, 0x81, 0x3b, 0xa8, 0xa8, 0xac, 0xf4,
-	0x8b, 0x71, 0xe9, 0x22, 0x96, 0xf4, 0x3d, 0x33, 0x2b, 0xe3, 0xae, 0xd2, 0xe6, 0xd6, 0x74, 0x0a,
-	0x82, 0xd7, 0xc7, 0xa5, 0xb0, 0xdf, 0xa8, 0xd3, 0xa9, 0x7a, 0x48, 0x05, 0xfe, 0x60, 0xa0, 0x42,
-	0x3c, 0x02, 0x78, 0xed, 0x62, 0xc8, 0xa1, 0xd1, 0x30, 0x67, 0xd3, 0x04, 0x42, 0x34, 0xb4, 0x0a,
-	0x19, 0x5b, 0xfd, 0x4e, 0x3c, 0x2a, 0xef, 0x0d, 0x94, 0x7f, 0x08, 0x13, 0x7b, 0x31, 0x23, 0x24,
-	0x57, 0x35, 0x92, 0x35, 0x7c, 0x79, 0x0a, 0x49, 0xf8, 0x93, 0x81, 0x0a, 0xf1, 0x68, 0x8e, 0xf2,
-	0x33, 0x34, 0xb2, 0xb3, 0x42, 0x65, 0x69, 0x54, 0x35, 0x73, 0x4a, 0xeb, 0x34, 0x8e, 0xd3, 0x84,
-	0xab, 0x97, 0xa8, 0xb0, 0x0b, 0x6d, 0x90, 0x30, 0x89, 0xad, 0xf2, 0x45, 0x75, 0x3a, 0x25, 0x09,
-	0x01, 0xd7, 0xa7, 0x11, 0x70, 0xff, 0xf6, 0xd7, 0xf3, 0xaa, 0xf1, 0xed, 0xbc, 0x6a, 0xfc, 0x38,
-	0xaf, 0x1a, 0x2f, 0x36, 0xa6, 0x7d, 0xa2, 0x47, 0x7e, 0x23, 0x4e, 0x0a, 0xfa, 0x6b, 0xbc, 0xf5,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0x13, 0x94, 0x59, 0x8c, 0x62, 0x08, 0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xd7, 0xc4, 0xa9, 0x9b, 0x3c, 0xb7, 0x55, 0x33, 0x94, 0x60, 0x16, 0xc7, 0x8a, 0x06, 0x24,
+	0xdc, 0xaa, 0xdd, 0x55, 0x5c, 0x90, 0xa2, 0x14, 0x09, 0x95, 0x36, 0x82, 0x28, 0x1c, 0xca, 0x56,
+	0x45, 0x82, 0x03, 0xd5, 0x76, 0xfd, 0xb0, 0x17, 0xdb, 0x33, 0xc3, 0xcc, 0x78, 0x91, 0x85, 0x7a,
+	0xe1, 0x50, 0x71, 0x44, 0x70, 0xe7, 0xce, 0x8d, 0x23, 0x1f, 0x81, 0x23, 0x12, 0x5f, 0x00, 0x45,
+	0x48, 0x1c, 0xf8, 0x12, 0x68, 0x66, 0xff, 0x78, 0x1d, 0x3b, 0x16, 0x07, 0xab, 0xb7, 0x37, 0x6f,
+	0xde, 0x9f, 0xdf, 0x7b, 0xf3, 0x7b, 0x6f, 0x17, 0x98, 0x46, 0x95, 0xa2, 0x0a, 0x14, 0x4a, 0xa1,
+	0x13, 0x23, 0xd4, 0xb4, 0x22, 0xfa, 0x52, 0x09, 0x23, 0x28, 0xcc, 0x34, 0xde, 0x8d, 0xbe, 0xe8,
+	0x0b, 0xa7, 0x0e, 0xac, 0x94, 0x59, 0x78, 0xad, 0xbe, 0x10, 0xfd, 0x11, 0x06, 0x91, 0x4c, 0x82,
+	0x88, 0x73, 0x61, 0x22, 0x93, 0x08, 0xae, 0xf3, 0x5b, 0x36, 0x3c, 0xd4, 0x7e, 0x22, 0xdc, 0x6d,
+	0x2c, 0x14, 0x06, 0xe9, 0x41, 0xd0, 0x47, 0x8e, 0x2a, 0x32, 0xd8, 0xcb, 0x6d, 0x4e, 0xfa, 0x89,
+	0x19, 0x4c, 0x9e, 0xf9, 0xb1, 0x18, 0x07, 0x91, 0x72, 0x29, 0xbe, 0x72, 0xc2, 0x9d, 0xb8, 0x17,
+	0xc8, 0x61, 0xdf, 0x3a, 0xeb, 0x20, 0x92, 0x72, 0x94, 0xc4, 0x2e, 0x78, 0x90, 0x1e, 0x44, 0x23,
+	0x39, 0x88, 0x16, 0x42, 0xb1, 0xf7, 0xe1, 0x6a, 0x88, 0x52, 0xdc, 0x97, 0x52, 0x7f, 0x32, 0x41,
+	0x35, 0xa5, 0x14, 0x36, 0x6d, 0x05, 0x4d, 0xb2, 0x4f, 0x3a, 0xdb, 0xa1, 0x93, 0xa9, 0x07, 0x5b,
+	0x0a, 0xd3, 0x44, 0x27, 0x82, 0x37, 0x37, 0x9c, 0xbe, 0x3c, 0xb3, 0x5f, 0x09, 0x5c, 0x2f, 0x22,
+	0x84, 0xa8, 0xa5, 0xe0, 0x1a, 0xe9, 0x7b, 0xd0, 0x18, 0x6a, 0xc1, 0x39, 0x1a, 0xab, 0x6e, 0x92,
+	0xfd, 0x5a, 0xa7, 0xd1, 0xf5, 0xfc, 0x4a, 0xb3, 0x4e, 0xcb, 0xeb, 0xc7, 0x12, 0xe3, 0xb0, 0x6a,
+	0x4e, 0xef, 0xc2, 0xd6, 0x00, 0x47, 0x63, 0xe7, 0xba, 0xe1, 0x5c, 0x5f, 0xab, 0xba, 0x7e, 0x94,
+	0xdd, 0x39, 0xbf, 0xd2, 0x90, 0xde, 0x84, 0x4b, 0x89, 0xc1, 0xb1, 0x6e, 0xd6, 0x9c, 0xc7, 0x2b,
+	0x55, 0x8f, 0xfb, 0x52, 0x9e, 0xf0, 0x2f, 0x45, 0x98, 0x59, 0xb0, 0x03, 0xb8, 0x9c, 0x6b, 0x6c,
+	0xb5, 0x66, 0x2a, 0xb1, 0xa8, 0xd6, 0xca, 0x56, 0x27, 0x23, 0x33, 0xc8, 0x2b, 0x75, 0x32, 0xfb,
+	0x97, 0xc0, 0xb5, 0x79, 0xc8, 0xd6, 0x8c, 0x47, 0xe3, 0xd2, 0xd5, 0xca, 0xcb, 0x5c, 0xe9, 0x23,
+	0xb8, 0x82, 0x3c, 0x4d, 0x94, 0xe0, 0x63, 0xe4, 0xa6, 0xc0, 0x77, 0xfb, 0xe2, 0x66, 0xf8, 0xc7,
+	0x15, 0xf3, 0x63, 0x6e, 0xd4, 0x34, 0x9c, 0x8b, 0xe0, 0x3d, 0x85, 0x9d, 0x05, 0x13, 0x7a, 0x1d,
+	0x6a, 0x43, 0x9c, 0xe6, 0x68, 0xac, 0x48, 0xdf, 0x81, 0x4b, 0x69, 0x34, 0x9a, 0xa0, 0x43, 0xd3,
+	0xe8, 0xb6, 0x97, 0x64, 0xac, 0x84, 0x09, 0x33, 0xe3, 0xa3, 0x8d, 0x43, 0xc2, 0xde, 0x85, 0x46,
+	0xa5, 0xc9, 0xff, 0xb7, 0x52, 0xf6, 0x0b, 0x01, 0xba, 0x18, 0x78, 0xa9, 0x7b, 0x1b, 0x60, 0x78,
+	0xa8, 0x3f, 0x45, 0x55, 0xe1, 0x54, 0x45, 0x53, 0x86, 0xaf, 0x55, 0x1a, 0x79, 0x0a, 0x8d, 0x1e,
+	0x6a, 0x93, 0x70, 0x47, 0xe9, 0xe6, 0xa6, 0xab, 0xea, 0xe6, 0xea, 0xaa, 0x1e, 0xce, 0x1c, 0xc2,
+	0xaa, 0x37, 0x7b, 0x02, 0x7b, 0x2b, 0xad, 0xe9, 0x2e, 0xd4, 0xb3, 0x69, 0xcf, 0x71, 0xe7, 0x27,
+	0xda, 0x82, 0x6d, 0x5b, 0x81, 0x96, 0x51, 0x8c, 0x39, 0xf0, 0x99, 0x82, 0xdd, 0x83, 0x6d, 0x3b,
+	0x0c, 0x2b, 0x47, 0x49, 0xe3, 0x08, 0x63, 0x23, 0x54, 0x31, 0x4a, 0xc5, 0x99, 0x5d, 0x83, 0x2b,
+	0xd6, 0xb9, 0x98, 0x22, 0xf6, 0x82, 0xc0, 0x8e, 0x55, 0x3c, 0x50, 0x18, 0x19, 0x0c, 0xf1, 0xeb,
+	0x09, 0x6a, 0x43, 0x3f, 0xab, 0x44, 0x6d, 0x74, 0x8f, 0xfd, 0xd9, 0x2e, 0xf0, 0x8b, 0x5d, 0xe0,
+	0x84, 0xa7, 0x71, 0xcf, 0x97, 0xc3, 0xbe, 0x6f, 0x77, 0x81, 0x5f, 0xd9, 0x05, 0x7e, 0xb1, 0x0b,
+	0xfc, 0xb0, 0xec, 0x5c, 0x0e, 0x6e, 0x17, 0xea, 0x13, 0xa9, 0x51, 0x19, 0x07, 0x6d, 0x2b, 0xcc,
+	0x4f, 0x8c, 0x67, 0x38, 0x9e, 0xc8, 0xde, 0x4b, 0xc1, 0xc1, 0x7e, 0x20, 0xd0, 0x9a, 0x25, 0x7c,
+	0xa0, 0xb0, 0x87, 0xdc, 0x24, 0xd1, 0x48, 0x17, 0xb9, 0x2f, 0xe8, 0xec, 0x44, 0xa3, 0x72, 0x54,
+	0xcb, 0x3b, 0x5b, 0x9c, 0xed, 0x9d, 0x8c, 0xb4, 0xfe, 0x46, 0xa8, 0x5e, 0x4e, 0xa9, 0xf2, 0x4c,
+	0xdf, 0x82, 0xab, 0x5a, 0x0f, 0x1e, 0xa9, 0x24, 0x8d, 0x0c, 0x9e, 0xe2, 0xd4, 0x11, 0x6b, 0x3b,
+	0x9c, 0x57, 0x76, 0xff, 0xb9, 0x9c, 0xf5, 0x20, 0xc3, 0xf9, 0x18, 0x55, 0x9a, 0xc4, 0x48, 0x5f,
+	0x10, 0xd8, 0xfc, 0x38, 0xd1, 0x86, 0xbe, 0x5a, 0xa5, 0x61, 0xc9, 0x00, 0xef, 0x64, 0x2d, 0x5d,
+	0xb1, 0x19, 0x58, 0xeb, 0xbb, 0x3f, 0xff, 0xfe, 0x69, 0x63, 0x97, 0xde, 0x70, 0x5f, 0x86, 0xf4,
+	0x60, 0xf6, 0xe5, 0x49, 0x50, 0xd3, 0x31, 0x6c, 0x59, 0x2b, 0xb7, 0x09, 0x5f, 0x3f, 0x8f, 0xa5,
+	0x5c, 0xee, 0x5e, 0x6b, 0xd9, 0x55, 0xc9, 0xb7, 0x8e, 0x4b, 0xc1, 0xe8, 0xfe, 0xb2, 0x14, 0xc1,
+	0xb7, 0xf6, 0xf4, 0xdc, 0x7e, 0x55, 0x34, 0xfd, 0x91, 0x40, 0x3d, 0x63, 0x25, 0xdd, 0x3b, 0x1f,
+	0x72, 0x8e, 0xad, 0xde, 0x7a, 0x78, 0xc1, 0x98, 0x83, 0xd6, 0x62, 0x4b, 0xab, 0x3f, 0xca, 0x08,
+	0xf0, 0x3d, 0x81, 0xda, 0x87, 0x78, 0xe1, 0x5b, 0xac, 0x09, 0xc9, 0x9b, 0x0e, 0xc9, 0x1e, 0x7d,
+	0x63, 0x45, 0x93, 0xe8, 0xcf, 0x04, 0xea, 0x19, 0x79, 0x17, 0xfb, 0x33, 0x37, 0x45, 0xeb, 0x42,
+	0xe5, 0x3b, 0x54, 0x1d, 0x6f, 0xc5, 0xd3, 0x39, 0x1c, 0xcf, 0xf3, 0x5e, 0xfd, 0x46, 0x60, 0x67,
+	0x61, 0xba, 0x68, 0x67, 0x39, 0xd6, 0xc5, 0x01, 0x5c, 0x17, 0xec, 0xae, 0x83, 0x7d, 0xdb, 0x7b,
+	0x7b, 0x15, 0xe3, 0xe2, 0x59, 0xfa, 0x23, 0x72, 0x8b, 0x7e, 0x01, 0xf5, 0x87, 0x38, 0x42, 0x83,
+	0x17, 0x3d, 0x74, 0xf3, 0xbc, 0xba, 0x24, 0x78, 0xfe, 0x76, 0xb7, 0x56, 0xbd, 0xdd, 0x07, 0xf7,
+	0x7e, 0x3f, 0x6b, 0x93, 0x3f, 0xce, 0xda, 0xe4, 0xaf, 0xb3, 0x36, 0xf9, 0xfc, 0xce, 0xaa, 0x5f,
+	0xad, 0x85, 0xdf, 0xc1, 0x67, 0x75, 0xf7, 0x57, 0x75, 0xf7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0xdf, 0x0f, 0xde, 0x39, 0x2a, 0x0a, 0x00, 0x00,
 }
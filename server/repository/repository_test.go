@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/errors"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	apps "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
+	mockrepo "github.com/argoproj/argo-cd/reposerver/mocks"
+	"github.com/argoproj/argo-cd/reposerver/repository"
+	mockreposerver "github.com/argoproj/argo-cd/reposerver/repository/mocks"
+	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/db"
+	"github.com/argoproj/argo-cd/util/rbac"
+)
+
+const (
+	testNamespace = "default"
+	fakeRepoURL   = "https://git.com/repo.git"
+)
+
+type fakeCloser struct{}
+
+func (f fakeCloser) Close() error {
+	return nil
+}
+
+func listDirRequest(pattern string) interface{} {
+	return mock.MatchedBy(func(req *repository.ListDirRequest) bool {
+		return req.Path == pattern
+	})
+}
+
+// return a RepositoryServiceServer backed by a repo server which returns the specified files
+func newTestRepoServer(files map[string][]string) RepositoryServiceServer {
+	kubeclientset := fake.NewSimpleClientset()
+	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enforcer.SetBuiltinPolicy(test.BuiltinPolicy)
+	enforcer.SetDefaultRole("role:admin")
+
+	db := db.NewDB(testNamespace, kubeclientset)
+	_, err := db.CreateRepository(context.Background(), &appsv1.Repository{Repo: fakeRepoURL})
+	errors.CheckError(err)
+
+	mockRepoServiceClient := mockreposerver.RepositoryServiceClient{}
+	for _, pattern := range []string{"*app.yaml", "*Chart.yaml", "*kustomization.yaml", "*.yaml", "*.yml", "*.json"} {
+		mockRepoServiceClient.On("ListDir", mock.Anything, listDirRequest(pattern)).Return(&repository.FileList{Items: files[pattern]}, nil)
+	}
+	mockRepoServiceClient.On("GetFiles", mock.Anything, mock.Anything).Return(&repository.GetFilesResponse{
+		Files: map[string][]byte{"charts/my-chart/Chart.yaml": []byte("name: my-chart")},
+	}, nil)
+
+	mockRepoClient := &mockrepo.Clientset{}
+	mockRepoClient.On("NewRepositoryClient").Return(&fakeCloser{}, &mockRepoServiceClient, nil)
+
+	return NewServer(testNamespace, apps.NewSimpleClientset(), mockRepoClient, db, enforcer)
+}
+
+func TestListApps(t *testing.T) {
+	repoServer := newTestRepoServer(map[string][]string{
+		"*Chart.yaml":         {"charts/my-chart/Chart.yaml"},
+		"*kustomization.yaml": {"kustomize/base/kustomization.yaml"},
+		"*.yaml": {
+			"charts/my-chart/Chart.yaml",
+			"charts/my-chart/templates/deployment.yaml",
+			"kustomize/base/kustomization.yaml",
+			"kustomize/base/service.yaml",
+			"manifests/guestbook/deployment.yaml",
+		},
+		"*.json": {"manifests/guestbook/service.json", "manifests/other/service.json"},
+	})
+
+	res, err := repoServer.ListApps(context.Background(), &RepoAppsQuery{Repo: fakeRepoURL})
+	assert.Nil(t, err)
+	assert.Len(t, res.HelmApps, 1)
+	assert.Equal(t, []*AppInfo{
+		{Type: "helm", Path: "charts/my-chart"},
+		{Type: "kustomize", Path: "kustomize/base"},
+		{Type: "directory", Path: "manifests/guestbook"},
+		{Type: "directory", Path: "manifests/other"},
+	}, res.Items)
+}
+
+func TestIsInAnyDir(t *testing.T) {
+	assert.True(t, isInAnyDir("charts/my-chart", []string{"charts/my-chart"}))
+	assert.True(t, isInAnyDir("charts/my-chart/templates", []string{"charts/my-chart"}))
+	assert.True(t, isInAnyDir("charts/my-chart", []string{"."}))
+	assert.False(t, isInAnyDir("charts/my-chart-2", []string{"charts/my-chart"}))
+	assert.False(t, isInAnyDir("charts", []string{"charts/my-chart"}))
+}
+
+func TestListReposBySelector(t *testing.T) {
+	repoServer := newTestRepoServer(nil)
+	_, err := repoServer.(*Server).db.CreateRepository(context.Background(), &appsv1.Repository{
+		Repo:   "https://git.com/payments.git",
+		Labels: map[string]string{"team": "payments"},
+	})
+	assert.Nil(t, err)
+
+	res, err := repoServer.List(context.Background(), &RepoQuery{Selector: "team=payments"})
+	assert.Nil(t, err)
+	assert.Len(t, res.Items, 1)
+	assert.Equal(t, "https://git.com/payments.git", res.Items[0].Repo)
+
+	res, err = repoServer.List(context.Background(), &RepoQuery{})
+	assert.Nil(t, err)
+	assert.Len(t, res.Items, 2)
+
+	_, err = repoServer.List(context.Background(), &RepoQuery{Selector: "team in payments"})
+	assert.NotNil(t, err)
+}
+
+func TestUpdateCredentials(t *testing.T) {
+	repoServer := newTestRepoServer(nil)
+	repoDB := repoServer.(*Server).db
+	_, err := repoDB.CreateRepository(context.Background(), &appsv1.Repository{
+		Repo:     "file:///does/not/exist",
+		Username: "admin",
+		Password: "old-token",
+	})
+	assert.Nil(t, err)
+
+	_, err = repoServer.UpdateCredentials(context.Background(), &RepoUpdateCredentialsRequest{Repo: "https://git.com/missing.git", Password: "new-token"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// the old credentials are kept if the repository cannot be accessed with the new ones
+	_, err = repoServer.UpdateCredentials(context.Background(), &RepoUpdateCredentialsRequest{Repo: "file:///does/not/exist", Username: "admin", Password: "new-token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	repo, err := repoDB.GetRepository(context.Background(), "file:///does/not/exist")
+	assert.Nil(t, err)
+	assert.Equal(t, "old-token", repo.Password)
+}
@@ -22,31 +22,42 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd"
 	"github.com/argoproj/argo-cd/common"
 	"github.com/argoproj/argo-cd/errors"
 	"github.com/argoproj/argo-cd/pkg/apiclient"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/reposerver"
 	"github.com/argoproj/argo-cd/server/account"
 	"github.com/argoproj/argo-cd/server/application"
 	"github.com/argoproj/argo-cd/server/cluster"
+	"github.com/argoproj/argo-cd/server/guardrail"
 	"github.com/argoproj/argo-cd/server/project"
 	"github.com/argoproj/argo-cd/server/repository"
 	"github.com/argoproj/argo-cd/server/session"
 	"github.com/argoproj/argo-cd/server/settings"
 	"github.com/argoproj/argo-cd/server/version"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/cors"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/dex"
 	dexutil "github.com/argoproj/argo-cd/util/dex"
 	grpc_util "github.com/argoproj/argo-cd/util/grpc"
+	"github.com/argoproj/argo-cd/util/healthz"
 	jsonutil "github.com/argoproj/argo-cd/util/json"
 	"github.com/argoproj/argo-cd/util/rbac"
 	util_session "github.com/argoproj/argo-cd/util/session"
@@ -96,13 +107,31 @@ type ArgoCDServer struct {
 }
 
 type ArgoCDServerOpts struct {
-	DisableAuth     bool
-	Insecure        bool
-	Namespace       string
-	StaticAssetsDir string
-	KubeClientset   kubernetes.Interface
-	AppClientset    appclientset.Interface
-	RepoClientset   reposerver.Clientset
+	DisableAuth         bool
+	Insecure            bool
+	Namespace           string
+	StaticAssetsDir     string
+	KubeClientset       kubernetes.Interface
+	AppClientset        appclientset.Interface
+	RepoClientset       reposerver.Clientset
+	TerminalIdleTimeout time.Duration
+	// RateLimit is the number of requests per second each client may send to rate limited methods, zero disables rate limiting
+	RateLimit float64
+	// RateLimitBurst is the number of requests each client may burst over the rate limit
+	RateLimitBurst int
+	// AuditEvents records the audited API calls of applications and projects as Kubernetes events, in addition to the logs
+	AuditEvents bool
+	// CORSAllowedOrigins are the origins allowed to call the REST API from browsers, none disables CORS
+	CORSAllowedOrigins []string
+	// CORSAllowCredentials allows the cross-origin requests of the allowed origins to include credentials
+	CORSAllowCredentials bool
+	// StreamKeepaliveInterval is the interval of the keepalive pings of idle gRPC connections and of the
+	// keepalive comments of idle server-sent event streams, zero disables keepalives
+	StreamKeepaliveInterval time.Duration
+	// MetricsPort is the port on which the Prometheus metrics of the guardrails are served. The port is
+	// not exposed by the argocd-server service, since the metrics are for admins only. Zero disables
+	// the metrics.
+	MetricsPort int
 }
 
 // initializeSettings sets default secret settings (password set to hostname)
@@ -147,10 +176,10 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int) {
 	var httpS *http.Server
 	var httpsS *http.Server
 	if a.useTLS() {
-		httpS = newRedirectServer(port)
-		httpsS = a.newHTTPServer(ctx, port)
+		httpS = a.newRedirectServer(port)
+		httpsS = a.newHTTPServer(ctx, port, grpcS)
 	} else {
-		httpS = a.newHTTPServer(ctx, port)
+		httpS = a.newHTTPServer(ctx, port, grpcS)
 	}
 
 	// Start listener
@@ -181,10 +210,7 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int) {
 
 		// If not matched, we assume that its TLS.
 		tlsl := tcpm.Match(cmux.Any())
-		tlsConfig := tls.Config{
-			Certificates: []tls.Certificate{*a.settings.Certificate},
-		}
-		tlsl = tls.NewListener(tlsl, &tlsConfig)
+		tlsl = tls.NewListener(tlsl, a.serverTLSConfig())
 
 		// Now, we build another mux recursively to match HTTPS and gRPC.
 		tlsm = cmux.New(tlsl)
@@ -202,12 +228,22 @@ func (a *ArgoCDServer) Run(ctx context.Context, port int) {
 		go func() { a.checkServeErr("tlsm", tlsm.Serve()) }()
 	}
 	go a.watchSettings(ctx)
+	go a.watchRepositories(ctx)
 	go a.rbacPolicyLoader(ctx)
 	go func() { a.checkServeErr("tcpm", tcpm.Serve()) }()
+	var metricsS *http.Server
+	if a.MetricsPort > 0 {
+		metricsS = a.newMetricsServer(a.MetricsPort)
+		log.Infof("Serving metrics on port %d", a.MetricsPort)
+		go func() { a.checkServeErr("metricsS", metricsS.ListenAndServe()) }()
+	}
 
 	a.stopCh = make(chan struct{})
 	<-a.stopCh
 	errors.CheckError(conn.Close())
+	if metricsS != nil {
+		errors.CheckError(metricsS.Close())
+	}
 }
 
 // checkServeErr checks the error from a .Serve() call to decide if it was a graceful shutdown
@@ -245,9 +281,10 @@ func (a *ArgoCDServer) watchSettings(ctx context.Context) {
 	prevGitHubSecret := a.settings.WebhookGitHubSecret
 	prevGitLabSecret := a.settings.WebhookGitLabSecret
 	prevBitBucketUUID := a.settings.WebhookBitbucketUUID
+	prevUseTLS := a.useTLS()
 	var prevCert, prevCertKey string
-	if a.settings.Certificate != nil {
-		prevCert, prevCertKey = tlsutil.EncodeX509KeyPairString(*a.settings.Certificate)
+	if cert := a.settings.ServerCertificate(); cert != nil {
+		prevCert, prevCertKey = tlsutil.EncodeX509KeyPairString(*cert)
 	}
 
 	for {
@@ -270,19 +307,76 @@ func (a *ArgoCDServer) watchSettings(ctx context.Context) {
 			log.Infof("bitbucket uuid modified. restarting")
 			break
 		}
+		if prevUseTLS != a.useTLS() {
+			log.Infof("tls enabled or disabled. restarting")
+			break
+		}
 		var newCert, newCertKey string
-		if a.settings.Certificate != nil {
-			newCert, newCertKey = tlsutil.EncodeX509KeyPairString(*a.settings.Certificate)
+		if cert := a.settings.ServerCertificate(); cert != nil {
+			newCert, newCertKey = tlsutil.EncodeX509KeyPairString(*cert)
 		}
 		if newCert != prevCert || newCertKey != prevCertKey {
-			log.Infof("tls certificate modified. restarting")
-			break
+			// the certificate is read on every handshake, so new connections use it without a restart
+			log.Infof("tls certificate modified. reloading")
+			prevCert, prevCertKey = newCert, newCertKey
 		}
 	}
 	log.Info("shutting down settings watch")
 	a.Shutdown()
 	a.settingsMgr.Unsubscribe(updateCh)
-	close(updateCh)
+}
+
+// watchRepositories reconciles the declaratively configured repositories into the database on
+// startup, and again whenever the settings or the secrets holding their credentials are updated.
+func (a *ArgoCDServer) watchRepositories(ctx context.Context) {
+	updateCh := make(chan struct{}, 1)
+	a.settingsMgr.Subscribe(updateCh)
+	defer a.settingsMgr.Unsubscribe(updateCh)
+
+	notifySecret := func(secret *v1.Secret) {
+		for _, repoCreds := range a.settings.GetRepositories() {
+			if repoCreds.ReferencesSecret(secret.Name) {
+				select {
+				case updateCh <- struct{}{}:
+				default:
+				}
+				return
+			}
+		}
+	}
+	secretInformer := coreinformers.NewSecretInformer(a.KubeClientset, a.Namespace, 3*time.Minute, cache.Indexers{})
+	secretInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if secret, ok := obj.(*v1.Secret); ok {
+				notifySecret(secret)
+			}
+		},
+		UpdateFunc: func(old, new interface{}) {
+			oldSecret := old.(*v1.Secret)
+			newSecret := new.(*v1.Secret)
+			if oldSecret.ResourceVersion != newSecret.ResourceVersion {
+				notifySecret(newSecret)
+			}
+		},
+	})
+	go secretInformer.Run(ctx.Done())
+
+	argoDB := db.NewDB(a.Namespace, a.KubeClientset)
+	for {
+		repos := a.settings.GetRepositories()
+		if len(repos) > 0 {
+			log.Infof("Reconciling %d declarative repositories", len(repos))
+			err := argoDB.ReconcileRepositories(ctx, repos)
+			if err != nil {
+				log.Warn(err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-updateCh:
+		}
+	}
 }
 
 func (a *ArgoCDServer) rbacPolicyLoader(ctx context.Context) {
@@ -291,18 +385,53 @@ func (a *ArgoCDServer) rbacPolicyLoader(ctx context.Context) {
 }
 
 func (a *ArgoCDServer) useTLS() bool {
-	if a.Insecure || a.settings.Certificate == nil {
+	if a.Insecure || a.settings.ServerCertificate() == nil {
 		return false
 	}
 	return true
 }
 
+// serverTLSConfig returns the TLS configuration of the server. The certificate, minimum TLS version
+// and cipher suites are read from the settings on every handshake, under the lock the settings
+// notifier updates them with, so that changes to them apply to new connections without a restart.
+func (a *ArgoCDServer) serverTLSConfig() *tls.Config {
+	getCertificate := func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		cert := a.settings.ServerCertificate()
+		if cert == nil {
+			return nil, fmt.Errorf("no tls certificate configured")
+		}
+		return cert, nil
+	}
+	return &tls.Config{
+		GetCertificate: getCertificate,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			minVersion, cipherSuites := a.settings.TLSOptions()
+			return &tls.Config{
+				GetCertificate: getCertificate,
+				MinVersion:     minVersion,
+				CipherSuites:   cipherSuites,
+			}, nil
+		},
+	}
+}
+
 func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	var sOpts []grpc.ServerOption
 	sensitiveMethods := map[string]bool{
 		"/session.SessionService/Create":         true,
 		"/account.AccountService/UpdatePassword": true,
 	}
+	// rateLimitedMethods are the expensive methods throttled per remote IP and per authenticated subject
+	rateLimitedMethods := []string{
+		"/application.ApplicationService/Sync",
+		"/application.ApplicationService/BatchSync",
+		"/application.ApplicationService/GetManifests",
+		"/repository.RepositoryService/List",
+		"/repository.RepositoryService/ListApps",
+	}
+	rateLimiter := grpc_util.NewRateLimiter(rateLimitedMethods, a.RateLimit, a.RateLimitBurst, func(ctx netCtx.Context) string {
+		return util_session.Username(ctx)
+	})
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
 	// This is because TLS handshaking occurs in cmux handling
 	sOpts = append(sOpts, grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
@@ -318,6 +447,8 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 		bug21955WorkaroundInterceptor,
 		grpc_logrus.UnaryServerInterceptor(a.log),
 		grpc_auth.UnaryServerInterceptor(a.authenticate),
+		rateLimiter.UnaryServerInterceptor(),
+		a.newAuditor(sensitiveMethods).UnaryServerInterceptor(),
 		grpc_util.PayloadUnaryServerInterceptor(a.log, true, func(ctx netCtx.Context, fullMethodName string, servingObject interface{}) bool {
 			return !sensitiveMethods[fullMethodName]
 		}),
@@ -325,17 +456,24 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 		grpc_util.PanicLoggerUnaryServerInterceptor(a.log),
 	)))
 
+	if a.StreamKeepaliveInterval > 0 {
+		// Pings keep the connections of long-lived streams, e.g. watches and logs, open through
+		// load balancers which close idle connections
+		sOpts = append(sOpts, grpc.KeepaliveParams(keepalive.ServerParameters{Time: a.StreamKeepaliveInterval}))
+	}
+
 	grpcS := grpc.NewServer(sOpts...)
 	db := db.NewDB(a.Namespace, a.KubeClientset)
 	clusterService := cluster.NewServer(db, a.enf)
-	repoService := repository.NewServer(a.RepoClientset, db, a.enf)
-	sessionService := session.NewServer(a.sessionMgr)
+	repoService := repository.NewServer(a.Namespace, a.AppClientset, a.RepoClientset, db, a.enf)
+	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a.enf, a.authenticate)
 	projectLock := util.NewKeyLock()
-	applicationService := application.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.RepoClientset, db, a.enf, projectLock)
+	applicationService := application.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.RepoClientset, db, a.enf, projectLock, a.settings)
 	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock)
 	settingsService := settings.NewServer(a.settingsMgr)
 	accountService := account.NewServer(a.sessionMgr, a.settingsMgr)
-	version.RegisterVersionServiceServer(grpcS, &version.Server{})
+	guardrailService := guardrail.NewServer(a.Namespace, a.KubeClientset, a.RepoClientset, a.enf)
+	version.RegisterVersionServiceServer(grpcS, version.NewServer(reposerver.NewToolVersionsGetter(a.RepoClientset)))
 	cluster.RegisterClusterServiceServer(grpcS, clusterService)
 	application.RegisterApplicationServiceServer(grpcS, applicationService)
 	repository.RegisterRepositoryServiceServer(grpcS, repoService)
@@ -343,6 +481,7 @@ func (a *ArgoCDServer) newGRPCServer() *grpc.Server {
 	settings.RegisterSettingsServiceServer(grpcS, settingsService)
 	project.RegisterProjectServiceServer(grpcS, projectService)
 	account.RegisterAccountServiceServer(grpcS, accountService)
+	guardrail.RegisterGuardrailServiceServer(grpcS, guardrailService)
 	// Register reflection service on gRPC server.
 	reflection.Register(grpcS)
 	return grpcS
@@ -361,14 +500,60 @@ func (a *ArgoCDServer) translateGrpcCookieHeader(ctx context.Context, w http.Res
 	return nil
 }
 
+// newAuditor returns the auditor of the mutating API calls. The changes of applications and projects
+// are recorded as diff, and recorded as Kubernetes events if enabled.
+func (a *ArgoCDServer) newAuditor(sensitiveMethods map[string]bool) *grpc_util.Auditor {
+	auditor := grpc_util.NewAuditor(a.log, func(ctx netCtx.Context) string {
+		return util_session.Username(ctx)
+	}, sensitiveMethods)
+	auditor.RegisterGetter("applications", func(name string) (interface{}, error) {
+		return a.AppClientset.ArgoprojV1alpha1().Applications(a.Namespace).Get(name, metav1.GetOptions{})
+	})
+	auditor.RegisterGetter("projects", func(name string) (interface{}, error) {
+		return a.AppClientset.ArgoprojV1alpha1().AppProjects(a.Namespace).Get(name, metav1.GetOptions{})
+	})
+	if a.AuditEvents {
+		auditLogger := argo.NewAuditLogger(a.Namespace, a.KubeClientset, "argocd-server")
+		kinds := map[string]schema.GroupVersionKind{
+			"applications": v1alpha1.ApplicationSchemaGroupVersionKind,
+			"projects":     v1alpha1.AppProjectSchemaGroupVersionKind,
+		}
+		auditor.AddRecorder(func(record grpc_util.AuditRecord) {
+			gvk, ok := kinds[record.Resource]
+			if !ok || record.Name == "" {
+				return
+			}
+			eventType := v1.EventTypeNormal
+			if record.Decision == grpc_util.AuditDecisionDenied || record.Error != "" {
+				eventType = v1.EventTypeWarning
+			}
+			action := fmt.Sprintf("%s (%s)", record.Action, record.Decision)
+			auditLogger.LogObjectEvent(gvk, record.Name, argo.EventInfo{Action: action, Reason: argo.EventReasonAPICall, Username: record.Subject}, eventType)
+		})
+	}
+	return auditor
+}
+
+// retryAfterHTTPError replies to failed gateway requests like the default error handler, and sets the
+// Retry-After header of rate limited requests from the retry-after metadata of the gRPC server
+func retryAfterHTTPError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		if retryAfter := md.HeaderMD[grpc_util.RetryAfterMetadataKey]; len(retryAfter) > 0 {
+			w.Header().Set("Retry-After", retryAfter[0])
+		}
+	}
+	runtime.DefaultHTTPError(ctx, mux, marshaler, w, r, err)
+}
+
 // newHTTPServer returns the HTTP server to serve HTTP/HTTPS requests. This is implemented
-// using grpc-gateway as a proxy to the gRPC server.
-func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int) *http.Server {
+// using grpc-gateway as a proxy to the gRPC server. gRPC-web requests are served by the gRPC
+// server directly.
+func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcS *grpc.Server) *http.Server {
 	endpoint := fmt.Sprintf("localhost:%d", port)
 	mux := http.NewServeMux()
 	httpS := http.Server{
 		Addr:    endpoint,
-		Handler: &bug21955Workaround{handler: mux},
+		Handler: grpc_util.NewGRPCWebHandler(grpcS, &bug21955Workaround{handler: mux}),
 	}
 	var dOpts []grpc.DialOption
 	if a.useTLS() {
@@ -393,7 +578,14 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int) *http.Server
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(jsonutil.JSONMarshaler))
 	gwCookieOpts := runtime.WithForwardResponseOption(a.translateGrpcCookieHeader)
 	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts)
-	mux.Handle("/api/", gwmux)
+	runtime.HTTPError = retryAfterHTTPError
+	application.SetSSEKeepaliveInterval(a.StreamKeepaliveInterval)
+	var gwHandler http.Handler = gwmux
+	corsPolicy := cors.Policy{AllowedOrigins: a.CORSAllowedOrigins, AllowCredentials: a.CORSAllowCredentials}
+	if corsPolicy.Enabled() {
+		gwHandler = cors.NewHandler(corsPolicy, gwmux)
+	}
+	mux.Handle("/api/", gwHandler)
 	mustRegisterGWHandler(version.RegisterVersionServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(cluster.RegisterClusterServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(application.RegisterApplicationServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
@@ -401,12 +593,20 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int) *http.Server
 	mustRegisterGWHandler(session.RegisterSessionServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(settings.RegisterSettingsServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 	mustRegisterGWHandler(project.RegisterProjectServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
+	mustRegisterGWHandler(guardrail.RegisterGuardrailServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dOpts)
 
 	swagger.ServeSwaggerUI(mux, packr.NewBox("."), "/swagger-ui")
 
+	// Liveness and readiness probes
+	a.registerHealthChecks(mux)
+
 	// Dex reverse proxy and client app and OAuth2 login/callback
 	a.registerDexHandlers(mux)
 
+	// Interactive shells in the pods of applications
+	terminalHandler := application.NewTerminalHandler(a.Namespace, a.KubeClientset, a.AppClientset, db.NewDB(a.Namespace, a.KubeClientset), a.enf, a.sessionMgr, a.DisableAuth, a.TerminalIdleTimeout)
+	mux.Handle("/terminal", terminalHandler)
+
 	// Webhook handler for git events
 	acdWebhookHandler := webhook.NewHandler(a.Namespace, a.AppClientset, a.settings)
 	mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
@@ -449,17 +649,47 @@ func (a *ArgoCDServer) registerDexHandlers(mux *http.ServeMux) {
 	mux.HandleFunc(common.CallbackEndpoint, a.ssoClientApp.HandleCallback)
 }
 
-// newRedirectServer returns an HTTP server which does a 307 redirect to the HTTPS server
-func newRedirectServer(port int) *http.Server {
+// newMetricsServer returns an HTTP server which serves the Prometheus metrics of the guardrails of all
+// components. The metrics are served without authentication on their own port, rather than on the API
+// port, so that only the pods and admins which can reach the port can scrape them.
+func (a *ArgoCDServer) newMetricsServer(port int) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", guardrail.NewServer(a.Namespace, a.KubeClientset, a.RepoClientset, a.enf).ServeMetrics)
+	return &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: mux,
+	}
+}
+
+// registerHealthChecks registers the /healthz and /readyz endpoints. The server is live while it
+// serves them, and ready while the Kubernetes API is reachable. The status of the repo server and, if
+// SSO is configured, dex is reported as well.
+func (a *ArgoCDServer) registerHealthChecks(mux *http.ServeMux) {
+	healthz.ServeHealthChecks(mux,
+		nil,
+		[]healthz.Check{healthz.NewKubeAPICheck(a.KubeClientset)},
+		[]healthz.Check{
+			reposerver.NewHealthCheck(a.RepoClientset),
+			healthz.NewHTTPCheck("dex", dexutil.DexReverseProxyAddr+common.DexAPIEndpoint+"/.well-known/openid-configuration", a.settings.IsSSOConfigured),
+		},
+	)
+}
+
+// newRedirectServer returns an HTTP server which does a 307 redirect to the HTTPS server. The health
+// checks are served without redirect, so that probes need not use HTTPS.
+func (a *ArgoCDServer) newRedirectServer(port int) *http.Server {
+	mux := http.NewServeMux()
+	a.registerHealthChecks(mux)
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		target := "https://" + req.Host + req.URL.Path
+		if len(req.URL.RawQuery) > 0 {
+			target += "?" + req.URL.RawQuery
+		}
+		http.Redirect(w, req, target, http.StatusTemporaryRedirect)
+	})
 	return &http.Server{
-		Addr: fmt.Sprintf("localhost:%d", port),
-		Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-			target := "https://" + req.Host + req.URL.Path
-			if len(req.URL.RawQuery) > 0 {
-				target += "?" + req.URL.RawQuery
-			}
-			http.Redirect(w, req, target, http.StatusTemporaryRedirect)
-		}),
+		Addr:    fmt.Sprintf("localhost:%d", port),
+		Handler: mux,
 	}
 }
 
@@ -502,6 +732,12 @@ func getToken(md metadata.MD) string {
 	if ok && len(tokens) > 0 {
 		return tokens[0]
 	}
+	// check the bearer token of the HTTP authorization header, as sent by clients generated from the swagger spec
+	for _, auth := range md["authorization"] {
+		if strings.HasPrefix(auth, "Bearer ") {
+			return strings.TrimPrefix(auth, "Bearer ")
+		}
+	}
 	// check the HTTP cookie
 	for _, cookieToken := range md["grpcgateway-cookie"] {
 		header := http.Header{}
@@ -522,7 +758,7 @@ type bug21955Workaround struct {
 
 func (bf *bug21955Workaround) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	paths := map[string][]string{
-		"/api/v1/repositories/": {"apps"},
+		"/api/v1/repositories/": {"apps", "credentials"},
 		"/api/v1/clusters/":     {},
 	}
 	for path, subPaths := range paths {
@@ -561,6 +797,12 @@ func bug21955WorkaroundInterceptor(ctx context.Context, req interface{}, _ *grpc
 			return nil, err
 		}
 		ru.Repo.Repo = repo
+	} else if rc, ok := req.(*repository.RepoUpdateCredentialsRequest); ok {
+		repo, err := url.QueryUnescape(rc.Repo)
+		if err != nil {
+			return nil, err
+		}
+		rc.Repo = repo
 	} else if cq, ok := req.(*cluster.ClusterQuery); ok {
 		server, err := url.QueryUnescape(cq.Server)
 		if err != nil {
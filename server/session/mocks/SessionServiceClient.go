@@ -70,3 +70,33 @@ func (_m *SessionServiceClient) Delete(ctx context.Context, in *session.SessionD
 
 	return r0, r1
 }
+
+// Revoke provides a mock function with given fields: ctx, in, opts
+func (_m *SessionServiceClient) Revoke(ctx context.Context, in *session.SessionRevokeRequest, opts ...grpc.CallOption) (*session.SessionRevokeResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *session.SessionRevokeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *session.SessionRevokeRequest, ...grpc.CallOption) *session.SessionRevokeResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.SessionRevokeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *session.SessionRevokeRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
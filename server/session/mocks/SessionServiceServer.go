@@ -55,3 +55,26 @@ func (_m *SessionServiceServer) Delete(_a0 context.Context, _a1 *session.Session
 
 	return r0, r1
 }
+
+// Revoke provides a mock function with given fields: _a0, _a1
+func (_m *SessionServiceServer) Revoke(_a0 context.Context, _a1 *session.SessionRevokeRequest) (*session.SessionRevokeResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *session.SessionRevokeResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *session.SessionRevokeRequest) *session.SessionRevokeResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.SessionRevokeResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *session.SessionRevokeRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
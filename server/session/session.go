@@ -2,22 +2,36 @@ package session
 
 import (
 	"context"
+	"time"
 
+	jwt "github.com/dgrijalva/jwt-go"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/argoproj/argo-cd/util/grpc"
+	jwtutil "github.com/argoproj/argo-cd/util/jwt"
+	"github.com/argoproj/argo-cd/util/rbac"
 	sessionmgr "github.com/argoproj/argo-cd/util/session"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
 // Server provides a Session service
 type Server struct {
-	mgr *sessionmgr.SessionManager
+	mgr          *sessionmgr.SessionManager
+	settingsMgr  *settings.SettingsManager
+	enf          *rbac.Enforcer
+	authenticate func(ctx context.Context) (context.Context, error)
 }
 
-// NewServer returns a new instance of the Session service
-func NewServer(mgr *sessionmgr.SessionManager) *Server {
+// NewServer returns a new instance of the Session service. Revoking tokens requires authentication,
+// which is performed with the authenticate function of the API server.
+func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer, authenticate func(ctx context.Context) (context.Context, error)) *Server {
 	return &Server{
-		mgr: mgr,
+		mgr:          mgr,
+		settingsMgr:  settingsMgr,
+		enf:          enf,
+		authenticate: authenticate,
 	}
 }
 
@@ -42,14 +56,77 @@ func (s *Server) Create(ctx context.Context, q *SessionCreateRequest) (*SessionR
 }
 
 // Delete an authentication cookie from the client.  This makes sense only for the Web client.
+// The token of the session is revoked too if the request is authenticated, so that copies of the
+// token can no longer be used. Tokens issued by dex can only be revoked with Revoke.
 func (s *Server) Delete(ctx context.Context, q *SessionDeleteRequest) (*SessionResponse, error) {
+	if claims, ok := ctx.Value("claims").(jwt.Claims); ok {
+		mapClaims, err := jwtutil.MapClaims(claims)
+		if err != nil {
+			return nil, err
+		}
+		id, subject, issuedAt := sessionmgr.TokenInfo(mapClaims)
+		if id != "" {
+			err = s.settingsMgr.UpdateSessionRevocations(func(revocations *settings.SessionRevocations) {
+				revocations.RevokeSession(id, subject, issuedAt, sessionmgr.TokenExpiry(mapClaims))
+			})
+			if err != nil {
+				return nil, err
+			}
+			log.Infof("Revoked token %s of %s", id, subject)
+		}
+	}
 	return &SessionResponse{""}, nil
 }
 
+// Revoke revokes the tokens of an account issued before a time, which defaults to now. Revoking
+// the tokens of other accounts requires the delete action on sessions.
+func (s *Server) Revoke(ctx context.Context, q *SessionRevokeRequest) (*SessionRevokeResponse, error) {
+	username := sessionmgr.Username(ctx)
+	if q.Username != "" && q.Username != username {
+		if !s.enf.EnforceClaims(ctx.Value("claims"), "sessions", "delete", q.Username) {
+			return nil, grpc.ErrPermissionDenied
+		}
+		username = q.Username
+	}
+	if username == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username is required")
+	}
+	now := time.Now().UTC().Truncate(time.Second)
+	revokedBefore := now
+	if q.IssuedBefore != 0 {
+		revokedBefore = time.Unix(q.IssuedBefore, 0).UTC()
+		if revokedBefore.After(now) {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot revoke tokens issued in the future")
+		}
+	}
+	err := s.settingsMgr.UpdateSessionRevocations(func(revocations *settings.SessionRevocations) {
+		revocations.RevokeBefore(username, revokedBefore)
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Revoked tokens of %s issued before %s", username, revokedBefore.Format(time.RFC3339))
+	return &SessionRevokeResponse{}, nil
+}
+
 // AuthFuncOverride overrides the authentication function and let us not require auth to receive auth.
 // Without this function here, ArgoCDServer.authenticate would be invoked and credentials checked.
 // Since this service is generally invoked when the user has _no_ credentials, that would create a
 // chicken-and-egg situation if we didn't place this here to allow traffic to pass through.
+// Logging out does not require a valid session either, but revokes the session if there is one.
 func (s *Server) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
-	return ctx, nil
+	if s.authenticate == nil {
+		return ctx, nil
+	}
+	switch fullMethodName {
+	case "/session.SessionService/Create":
+		return ctx, nil
+	case "/session.SessionService/Delete":
+		if authCtx, err := s.authenticate(ctx); err == nil {
+			return authCtx, nil
+		}
+		return ctx, nil
+	default:
+		return s.authenticate(ctx)
+	}
 }
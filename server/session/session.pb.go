@@ -15,6 +15,8 @@
 		SessionCreateRequest
 		SessionDeleteRequest
 		SessionResponse
+		SessionRevokeRequest
+		SessionRevokeResponse
 */
 package session
 
@@ -101,10 +103,48 @@ func (m *SessionResponse) GetToken() string {
 	return ""
 }
 
+// SessionRevokeRequest is for revoking all the tokens of an account issued before a time.
+type SessionRevokeRequest struct {
+	// Username is the account whose tokens are revoked, defaults to the authenticated account
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// IssuedBefore is the unix time before which the issued tokens are revoked, defaults to now
+	IssuedBefore int64 `protobuf:"varint,2,opt,name=issuedBefore,proto3" json:"issuedBefore,omitempty"`
+}
+
+func (m *SessionRevokeRequest) Reset()                    { *m = SessionRevokeRequest{} }
+func (m *SessionRevokeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SessionRevokeRequest) ProtoMessage()               {}
+func (*SessionRevokeRequest) Descriptor() ([]byte, []int) { return fileDescriptorSession, []int{3} }
+
+func (m *SessionRevokeRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SessionRevokeRequest) GetIssuedBefore() int64 {
+	if m != nil {
+		return m.IssuedBefore
+	}
+	return 0
+}
+
+// SessionRevokeResponse is returned once the tokens are revoked.
+type SessionRevokeResponse struct {
+}
+
+func (m *SessionRevokeResponse) Reset()                    { *m = SessionRevokeResponse{} }
+func (m *SessionRevokeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SessionRevokeResponse) ProtoMessage()               {}
+func (*SessionRevokeResponse) Descriptor() ([]byte, []int) { return fileDescriptorSession, []int{4} }
+
 func init() {
 	proto.RegisterType((*SessionCreateRequest)(nil), "session.SessionCreateRequest")
 	proto.RegisterType((*SessionDeleteRequest)(nil), "session.SessionDeleteRequest")
 	proto.RegisterType((*SessionResponse)(nil), "session.SessionResponse")
+	proto.RegisterType((*SessionRevokeRequest)(nil), "session.SessionRevokeRequest")
+	proto.RegisterType((*SessionRevokeResponse)(nil), "session.SessionRevokeResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -122,6 +162,8 @@ type SessionServiceClient interface {
 	Create(ctx context.Context, in *SessionCreateRequest, opts ...grpc.CallOption) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP.
 	Delete(ctx context.Context, in *SessionDeleteRequest, opts ...grpc.CallOption) (*SessionResponse, error)
+	// Revoke all the tokens of an account issued before a time, e.g. after its credentials leaked.
+	Revoke(ctx context.Context, in *SessionRevokeRequest, opts ...grpc.CallOption) (*SessionRevokeResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -150,6 +192,15 @@ func (c *sessionServiceClient) Delete(ctx context.Context, in *SessionDeleteRequ
 	return out, nil
 }
 
+func (c *sessionServiceClient) Revoke(ctx context.Context, in *SessionRevokeRequest, opts ...grpc.CallOption) (*SessionRevokeResponse, error) {
+	out := new(SessionRevokeResponse)
+	err := grpc.Invoke(ctx, "/session.SessionService/Revoke", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for SessionService service
 
 type SessionServiceServer interface {
@@ -157,6 +208,8 @@ type SessionServiceServer interface {
 	Create(context.Context, *SessionCreateRequest) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP.
 	Delete(context.Context, *SessionDeleteRequest) (*SessionResponse, error)
+	// Revoke all the tokens of an account issued before a time, e.g. after its credentials leaked.
+	Revoke(context.Context, *SessionRevokeRequest) (*SessionRevokeResponse, error)
 }
 
 func RegisterSessionServiceServer(s *grpc.Server, srv SessionServiceServer) {
@@ -199,6 +252,24 @@ func _SessionService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/Revoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).Revoke(ctx, req.(*SessionRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SessionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "session.SessionService",
 	HandlerType: (*SessionServiceServer)(nil),
@@ -211,6 +282,10 @@ var _SessionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _SessionService_Delete_Handler,
 		},
+		{
+			MethodName: "Revoke",
+			Handler:    _SessionService_Revoke_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/session/session.proto",
@@ -294,6 +369,53 @@ func (m *SessionResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SessionRevokeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionRevokeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Username) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
+	}
+	if m.IssuedBefore != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintSession(dAtA, i, uint64(m.IssuedBefore))
+	}
+	return i, nil
+}
+
+func (m *SessionRevokeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionRevokeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
 func encodeVarintSession(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -337,6 +459,25 @@ func (m *SessionResponse) Size() (n int) {
 	return n
 }
 
+func (m *SessionRevokeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.IssuedBefore != 0 {
+		n += 1 + sovSession(uint64(m.IssuedBefore))
+	}
+	return n
+}
+
+func (m *SessionRevokeResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
 func sovSession(x uint64) (n int) {
 	for {
 		n++
@@ -616,6 +757,154 @@ func (m *SessionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SessionRevokeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionRevokeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionRevokeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedBefore", wireType)
+			}
+			m.IssuedBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IssuedBefore |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionRevokeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionRevokeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionRevokeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSession(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -724,28 +1013,31 @@ var (
 func init() { proto.RegisterFile("server/session/session.proto", fileDescriptorSession) }
 
 var fileDescriptorSession = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xb1, 0x4e, 0xeb, 0x30,
-	0x14, 0x86, 0xe5, 0x5e, 0xdd, 0xde, 0x7b, 0x3d, 0xdc, 0x8a, 0x28, 0x82, 0x28, 0x2a, 0x15, 0xca,
-	0x02, 0xaa, 0x44, 0xac, 0xc2, 0x52, 0x31, 0x02, 0x0b, 0x6b, 0xbb, 0x55, 0x62, 0x70, 0x93, 0xa3,
-	0xd4, 0x34, 0xf5, 0x31, 0xb6, 0x1b, 0x76, 0x5e, 0x81, 0x97, 0x42, 0x62, 0x41, 0xe2, 0x05, 0x50,
-	0xc5, 0x83, 0xa0, 0x3a, 0x49, 0xa1, 0x2d, 0xea, 0x14, 0xff, 0xfe, 0x9d, 0xef, 0x3f, 0x3e, 0xc7,
-	0xb4, 0x6d, 0x40, 0x17, 0xa0, 0x99, 0x01, 0x63, 0x04, 0xca, 0xfa, 0x1b, 0x2b, 0x8d, 0x16, 0xbd,
-	0x3f, 0x95, 0x0c, 0xfd, 0x0c, 0x33, 0x74, 0x7b, 0x6c, 0xb9, 0x2a, 0xed, 0xb0, 0x9d, 0x21, 0x66,
-	0x39, 0x30, 0xae, 0x04, 0xe3, 0x52, 0xa2, 0xe5, 0x56, 0xa0, 0x34, 0x95, 0x1b, 0x4d, 0xfb, 0x26,
-	0x16, 0xe8, 0xdc, 0x04, 0x35, 0xb0, 0xa2, 0xc7, 0x32, 0x90, 0xa0, 0xb9, 0x85, 0xb4, 0x3a, 0x73,
-	0x93, 0x09, 0x3b, 0x99, 0x8f, 0xe3, 0x04, 0x67, 0x8c, 0x6b, 0x17, 0x71, 0xe7, 0x16, 0xa7, 0x49,
-	0xca, 0xd4, 0x34, 0x5b, 0xfe, 0x6c, 0x18, 0x57, 0x2a, 0x17, 0x89, 0x83, 0xb3, 0xa2, 0xc7, 0x73,
-	0x35, 0xe1, 0x5b, 0xa8, 0x28, 0xa5, 0xfe, 0xb0, 0xac, 0xf6, 0x4a, 0x03, 0xb7, 0x30, 0x80, 0xfb,
-	0x39, 0x18, 0xeb, 0x85, 0xf4, 0xef, 0xdc, 0x80, 0x96, 0x7c, 0x06, 0x01, 0x39, 0x22, 0x27, 0xff,
-	0x06, 0x2b, 0xbd, 0xf4, 0x14, 0x37, 0xe6, 0x01, 0x75, 0x1a, 0x34, 0x4a, 0xaf, 0xd6, 0x9e, 0x4f,
-	0x7f, 0x5b, 0x9c, 0x82, 0x0c, 0x7e, 0x39, 0xa3, 0x14, 0xd1, 0xfe, 0x2a, 0xe5, 0x1a, 0x72, 0x58,
-	0xa5, 0x44, 0xc7, 0xb4, 0x55, 0xed, 0x0f, 0xc0, 0x28, 0x94, 0x06, 0xbe, 0x00, 0xe4, 0x1b, 0xe0,
-	0xec, 0x85, 0xd0, 0xff, 0xd5, 0xc9, 0x21, 0xe8, 0x42, 0x24, 0xe0, 0xdd, 0xd2, 0x66, 0x59, 0xb2,
-	0x77, 0x18, 0xd7, 0xfd, 0xff, 0xe9, 0x2a, 0x61, 0xb0, 0x69, 0xd7, 0x59, 0x51, 0xf8, 0xf8, 0xf6,
-	0xf1, 0xd4, 0xf0, 0xa3, 0x96, 0xeb, 0x76, 0xd1, 0xab, 0xe7, 0x78, 0x41, 0xba, 0xde, 0x88, 0x36,
-	0xcb, 0x5a, 0xb7, 0xf1, 0x6b, 0x77, 0xd8, 0x81, 0x3f, 0x70, 0xf8, 0xbd, 0xee, 0x26, 0xfe, 0xb2,
-	0xff, 0xbc, 0xe8, 0x90, 0xd7, 0x45, 0x87, 0xbc, 0x2f, 0x3a, 0x64, 0xd4, 0xdd, 0x35, 0xcd, 0xf5,
-	0x87, 0x36, 0x6e, 0xba, 0xa9, 0x9d, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x28, 0x53, 0xc6,
-	0x81, 0x02, 0x00, 0x00,
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8d, 0x53, 0x4b, 0x4e, 0xc3, 0x30,
+	0x10, 0x55, 0x5a, 0x51, 0xc0, 0x42, 0x54, 0x44, 0xa1, 0x8d, 0x42, 0xa9, 0xc0, 0x1b, 0x50, 0x25,
+	0x62, 0x01, 0x9b, 0x8a, 0x65, 0x61, 0xc3, 0x36, 0x95, 0x58, 0x54, 0x62, 0xe1, 0x26, 0x43, 0x9a,
+	0x7e, 0xe2, 0x60, 0x27, 0x65, 0xcf, 0x15, 0x38, 0x0c, 0x57, 0x60, 0x89, 0xc4, 0x05, 0x10, 0xe2,
+	0x20, 0xb8, 0x4e, 0x5a, 0x48, 0x0a, 0x15, 0x0b, 0x27, 0x9e, 0x79, 0x93, 0xf7, 0xc6, 0x6f, 0x1c,
+	0xd4, 0x10, 0xc0, 0xa7, 0xc0, 0x89, 0x00, 0x21, 0x02, 0x16, 0xce, 0xdf, 0x76, 0xc4, 0x59, 0xcc,
+	0xf4, 0xf5, 0x2c, 0xb4, 0x0c, 0x9f, 0xf9, 0x4c, 0xe5, 0xc8, 0x6c, 0x97, 0xc2, 0x56, 0xc3, 0x67,
+	0xcc, 0x1f, 0x03, 0xa1, 0x51, 0x40, 0x68, 0x18, 0xb2, 0x98, 0xc6, 0xb2, 0x58, 0x64, 0x28, 0x1e,
+	0xb5, 0x85, 0x1d, 0x30, 0x85, 0xba, 0x8c, 0x03, 0x99, 0x9e, 0x12, 0x1f, 0x42, 0xe0, 0x34, 0x06,
+	0x2f, 0xab, 0xb9, 0xf6, 0x83, 0x78, 0x90, 0xf4, 0x6d, 0x97, 0x4d, 0x08, 0xe5, 0x4a, 0x62, 0xa8,
+	0x36, 0x27, 0xae, 0x47, 0xa2, 0x91, 0x3f, 0xfb, 0x58, 0xc8, 0x47, 0x34, 0x0e, 0x5c, 0x45, 0x2e,
+	0x49, 0xe8, 0x38, 0x1a, 0xd0, 0x25, 0x2a, 0xec, 0x21, 0xa3, 0x9b, 0x76, 0x7b, 0xc9, 0x41, 0xe6,
+	0x1d, 0xb8, 0x4f, 0x40, 0xc4, 0xba, 0x85, 0x36, 0x12, 0x79, 0xc8, 0x90, 0x4e, 0xc0, 0xd4, 0x0e,
+	0xb4, 0xe3, 0x4d, 0x67, 0x11, 0xcf, 0xb0, 0x88, 0x0a, 0xf1, 0xc0, 0xb8, 0x67, 0x96, 0x52, 0x6c,
+	0x1e, 0xeb, 0x06, 0x5a, 0x8b, 0xd9, 0x08, 0x42, 0xb3, 0xac, 0x80, 0x34, 0xc0, 0xb5, 0x85, 0xca,
+	0x15, 0x8c, 0x61, 0xa1, 0x82, 0x8f, 0x50, 0x35, 0xcb, 0x3b, 0x20, 0x22, 0x69, 0x02, 0x7c, 0x13,
+	0x68, 0x3f, 0x09, 0x6e, 0x16, 0x04, 0x0e, 0x4c, 0x65, 0xe6, 0x3f, 0x6d, 0x62, 0xb4, 0x15, 0x08,
+	0x91, 0x80, 0xd7, 0x81, 0x3b, 0x69, 0xa4, 0x6a, 0xb5, 0xec, 0xe4, 0x72, 0xb8, 0x8e, 0x76, 0x0b,
+	0xbc, 0x69, 0x1b, 0x67, 0xcf, 0x25, 0xb4, 0x9d, 0x21, 0x5d, 0x39, 0xeb, 0xc0, 0x05, 0xfd, 0x16,
+	0x55, 0x52, 0x8f, 0xf4, 0x7d, 0x7b, 0x3e, 0xf0, 0xdf, 0xbc, 0xb3, 0xcc, 0x22, 0x3c, 0x67, 0xc5,
+	0xd6, 0xe3, 0xdb, 0xe7, 0x53, 0xc9, 0xc0, 0x55, 0x35, 0x5e, 0x39, 0xd9, 0xac, 0xf0, 0x42, 0x6b,
+	0xe9, 0x3d, 0x54, 0x49, 0xcd, 0x59, 0xa6, 0xcf, 0x99, 0xb6, 0x82, 0xbe, 0xae, 0xe8, 0x77, 0x5a,
+	0x45, 0x7a, 0x7d, 0x88, 0x2a, 0xe9, 0xf9, 0x96, 0xb9, 0x73, 0x7e, 0x5a, 0xcd, 0xbf, 0xe0, 0x4c,
+	0xe1, 0x50, 0x29, 0xec, 0xe1, 0x5a, 0x41, 0x81, 0x70, 0x55, 0x27, 0xcf, 0xd1, 0x69, 0xbf, 0x7c,
+	0x34, 0xb5, 0x57, 0xb9, 0xde, 0xe5, 0xea, 0xb5, 0x56, 0x5d, 0xd5, 0xfc, 0x5f, 0xd4, 0xaf, 0xa8,
+	0x2b, 0x79, 0xfe, 0x05, 0xf3, 0x4b, 0x4d, 0x7f, 0x5e, 0x03, 0x00, 0x00,
 }
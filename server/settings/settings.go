@@ -1,11 +1,58 @@
 package settings
 
 import (
-	"github.com/argoproj/argo-cd/util/settings"
 	"github.com/ghodss/yaml"
 	"golang.org/x/net/context"
+
+	"github.com/argoproj/argo-cd"
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/settings"
 )
 
+// Features reported by the Capabilities API. Features which are always enabled are reported too, so
+// that clients can tell them apart from older servers which do not support them.
+const (
+	// FeatureSSO is enabled when single sign on is configured
+	FeatureSSO = "sso"
+	// FeatureSecretReferences is enabled when backends of secret references are configured
+	FeatureSecretReferences = "secretReferences"
+	// features which are always enabled
+	FeatureAutomatedSync     = "automatedSync"
+	FeatureSelectiveSync     = "selectiveSync"
+	FeatureBulkApply         = "bulkApply"
+	FeatureBatchSync         = "batchSync"
+	FeatureCompareRevisions  = "compareRevisions"
+	FeatureHookOutput        = "hookOutput"
+	FeatureIgnoreDifferences = "ignoreDifferences"
+	FeatureManifestFormats   = "manifestFormats"
+	FeatureGuardrails        = "guardrails"
+	FeatureServerDiff        = "serverDiff"
+	FeatureValidateApp       = "validateApp"
+	FeatureTerminal          = "terminal"
+	FeatureResourceTree      = "resourceTree"
+	FeatureResourceEvents    = "resourceEvents"
+	FeatureResourceManifest  = "resourceManifest"
+)
+
+// alwaysEnabledFeatures are the features which do not depend on the configuration of the server
+var alwaysEnabledFeatures = []string{
+	FeatureAutomatedSync,
+	FeatureSelectiveSync,
+	FeatureBulkApply,
+	FeatureBatchSync,
+	FeatureCompareRevisions,
+	FeatureHookOutput,
+	FeatureIgnoreDifferences,
+	FeatureManifestFormats,
+	FeatureGuardrails,
+	FeatureServerDiff,
+	FeatureValidateApp,
+	FeatureTerminal,
+	FeatureResourceTree,
+	FeatureResourceEvents,
+	FeatureResourceManifest,
+}
+
 // Server provides a Settings service
 type Server struct {
 	mgr *settings.SettingsManager
@@ -25,16 +72,56 @@ func (s *Server) Get(ctx context.Context, q *SettingsQuery) (*Settings, error) {
 		return nil, err
 	}
 	set := Settings{
-		URL: argoCDSettings.URL,
+		URL:      argoCDSettings.URL,
+		Features: enabledFeatures(argoCDSettings),
 	}
 	var cfg DexConfig
 	err = yaml.Unmarshal([]byte(argoCDSettings.DexConfig), &cfg)
 	if err == nil {
 		set.DexConfig = &cfg
 	}
+	if argoCDSettings.IsSSOConfigured() {
+		set.Issuer = argoCDSettings.IssuerURL()
+		set.CLIClientID = common.ArgoCDCLIClientAppID
+	}
 	return &set, nil
 }
 
+// Capabilities returns the features enabled on the ArgoCD server, and its configured defaults
+func (s *Server) Capabilities(ctx context.Context, q *CapabilitiesQuery) (*Capabilities, error) {
+	argoCDSettings, err := s.mgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	return &Capabilities{
+		Version:        argocd.GetVersion().Version,
+		Features:       enabledFeatures(argoCDSettings),
+		ManifestFormat: argoCDSettings.ManifestFormat,
+	}, nil
+}
+
+// enabledFeatures returns the names of the features enabled by the settings
+func enabledFeatures(argoCDSettings *settings.ArgoCDSettings) []string {
+	features := make([]string, 0)
+	if argoCDSettings.IsSSOConfigured() {
+		features = append(features, FeatureSSO)
+	}
+	if len(argoCDSettings.SecretBackends) > 0 {
+		features = append(features, FeatureSecretReferences)
+	}
+	return append(features, alwaysEnabledFeatures...)
+}
+
+// HasFeature returns whether or not the feature is enabled
+func (c *Capabilities) HasFeature(feature string) bool {
+	for _, f := range c.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
 // AuthFuncOverride disables authentication for settings service
 func (s *Server) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
 	return ctx, nil
@@ -16,6 +16,8 @@
 		Settings
 		DexConfig
 		Connector
+		CapabilitiesQuery
+		Capabilities
 */
 package settings
 
@@ -53,6 +55,12 @@ func (*SettingsQuery) Descriptor() ([]byte, []int) { return fileDescriptorSettin
 type Settings struct {
 	URL       string     `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	DexConfig *DexConfig `protobuf:"bytes,2,opt,name=dexConfig" json:"dexConfig,omitempty"`
+	// Issuer is the URL of the OIDC issuer which clients authenticate with, if single sign on is configured
+	Issuer string `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// CLIClientID is the OAuth2 client ID of the CLI at the issuer
+	CLIClientID string `protobuf:"bytes,4,opt,name=cliClientID,proto3" json:"cliClientID,omitempty"`
+	// Features are the names of the enabled features, as reported by the Capabilities API
+	Features []string `protobuf:"bytes,5,rep,name=features" json:"features,omitempty"`
 }
 
 func (m *Settings) Reset()                    { *m = Settings{} }
@@ -74,6 +82,27 @@ func (m *Settings) GetDexConfig() *DexConfig {
 	return nil
 }
 
+func (m *Settings) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Settings) GetCLIClientID() string {
+	if m != nil {
+		return m.CLIClientID
+	}
+	return ""
+}
+
+func (m *Settings) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 type DexConfig struct {
 	Connectors []*Connector `protobuf:"bytes,1,rep,name=connectors" json:"connectors,omitempty"`
 }
@@ -114,11 +143,57 @@ func (m *Connector) GetType() string {
 	return ""
 }
 
+// CapabilitiesQuery is a query for the capabilities of the ArgoCD server
+type CapabilitiesQuery struct {
+}
+
+func (m *CapabilitiesQuery) Reset()                    { *m = CapabilitiesQuery{} }
+func (m *CapabilitiesQuery) String() string            { return proto.CompactTextString(m) }
+func (*CapabilitiesQuery) ProtoMessage()               {}
+func (*CapabilitiesQuery) Descriptor() ([]byte, []int) { return fileDescriptorSettings, []int{4} }
+
+// Capabilities are the features enabled on the ArgoCD server, and its configured defaults
+type Capabilities struct {
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Features are the names of the enabled features. Clients should hide the features which are not listed.
+	Features []string `protobuf:"bytes,2,rep,name=features" json:"features,omitempty"`
+	// ManifestFormat is the default format of the manifests returned by the GetManifests API, if any
+	ManifestFormat string `protobuf:"bytes,3,opt,name=manifestFormat,proto3" json:"manifestFormat,omitempty"`
+}
+
+func (m *Capabilities) Reset()                    { *m = Capabilities{} }
+func (m *Capabilities) String() string            { return proto.CompactTextString(m) }
+func (*Capabilities) ProtoMessage()               {}
+func (*Capabilities) Descriptor() ([]byte, []int) { return fileDescriptorSettings, []int{5} }
+
+func (m *Capabilities) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Capabilities) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *Capabilities) GetManifestFormat() string {
+	if m != nil {
+		return m.ManifestFormat
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SettingsQuery)(nil), "cluster.SettingsQuery")
 	proto.RegisterType((*Settings)(nil), "cluster.Settings")
 	proto.RegisterType((*DexConfig)(nil), "cluster.DexConfig")
 	proto.RegisterType((*Connector)(nil), "cluster.Connector")
+	proto.RegisterType((*CapabilitiesQuery)(nil), "cluster.CapabilitiesQuery")
+	proto.RegisterType((*Capabilities)(nil), "cluster.Capabilities")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -134,6 +209,8 @@ const _ = grpc.SupportPackageIsVersion4
 type SettingsServiceClient interface {
 	// Get returns ArgoCD settings
 	Get(ctx context.Context, in *SettingsQuery, opts ...grpc.CallOption) (*Settings, error)
+	// Capabilities returns the features enabled on the ArgoCD server
+	Capabilities(ctx context.Context, in *CapabilitiesQuery, opts ...grpc.CallOption) (*Capabilities, error)
 }
 
 type settingsServiceClient struct {
@@ -153,11 +230,22 @@ func (c *settingsServiceClient) Get(ctx context.Context, in *SettingsQuery, opts
 	return out, nil
 }
 
+func (c *settingsServiceClient) Capabilities(ctx context.Context, in *CapabilitiesQuery, opts ...grpc.CallOption) (*Capabilities, error) {
+	out := new(Capabilities)
+	err := grpc.Invoke(ctx, "/cluster.SettingsService/Capabilities", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for SettingsService service
 
 type SettingsServiceServer interface {
 	// Get returns ArgoCD settings
 	Get(context.Context, *SettingsQuery) (*Settings, error)
+	// Capabilities returns the features enabled on the ArgoCD server
+	Capabilities(context.Context, *CapabilitiesQuery) (*Capabilities, error)
 }
 
 func RegisterSettingsServiceServer(s *grpc.Server, srv SettingsServiceServer) {
@@ -182,6 +270,24 @@ func _SettingsService_Get_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettingsService_Capabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapabilitiesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsServiceServer).Capabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.SettingsService/Capabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsServiceServer).Capabilities(ctx, req.(*CapabilitiesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SettingsService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cluster.SettingsService",
 	HandlerType: (*SettingsServiceServer)(nil),
@@ -190,6 +296,10 @@ var _SettingsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _SettingsService_Get_Handler,
 		},
+		{
+			MethodName: "Capabilities",
+			Handler:    _SettingsService_Capabilities_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/settings/settings.proto",
@@ -244,6 +354,33 @@ func (m *Settings) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if len(m.Issuer) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Issuer)))
+		i += copy(dAtA[i:], m.Issuer)
+	}
+	if len(m.CLIClientID) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.CLIClientID)))
+		i += copy(dAtA[i:], m.CLIClientID)
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -307,6 +444,69 @@ func (m *Connector) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *CapabilitiesQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CapabilitiesQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *Capabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Capabilities) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.ManifestFormat) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.ManifestFormat)))
+		i += copy(dAtA[i:], m.ManifestFormat)
+	}
+	return i, nil
+}
+
 func encodeVarintSettings(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -333,6 +533,20 @@ func (m *Settings) Size() (n int) {
 		l = m.DexConfig.Size()
 		n += 1 + l + sovSettings(uint64(l))
 	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.CLIClientID)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -362,6 +576,32 @@ func (m *Connector) Size() (n int) {
 	return n
 }
 
+func (m *CapabilitiesQuery) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Capabilities) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovSettings(uint64(l))
+		}
+	}
+	l = len(m.ManifestFormat)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	return n
+}
+
 func sovSettings(x uint64) (n int) {
 	for {
 		n++
@@ -516,6 +756,93 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CLIClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CLIClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
@@ -726,6 +1053,193 @@ func (m *Connector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CapabilitiesQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CapabilitiesQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CapabilitiesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Capabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Capabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Capabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManifestFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManifestFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSettings(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -834,26 +1348,34 @@ var (
 func init() { proto.RegisterFile("server/settings/settings.proto", fileDescriptorSettings) }
 
 var fileDescriptorSettings = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x41, 0x4b, 0xc3, 0x40,
-	0x10, 0x85, 0xd9, 0x46, 0xac, 0x19, 0x91, 0xea, 0x22, 0x12, 0x8b, 0xc4, 0x92, 0x53, 0x41, 0x4c,
-	0xb4, 0x3d, 0x79, 0x12, 0x5a, 0x41, 0x10, 0x2f, 0xa6, 0x88, 0x20, 0x78, 0x48, 0xd3, 0x71, 0x8d,
-	0xb4, 0x3b, 0x65, 0xb3, 0x29, 0xf6, 0xea, 0x5f, 0xf0, 0x4f, 0x79, 0x14, 0xbc, 0x8b, 0x04, 0x7f,
-	0x88, 0x74, 0xdb, 0x44, 0xab, 0xb7, 0xc7, 0xf7, 0x66, 0x92, 0xb7, 0xf3, 0xc0, 0x4d, 0x51, 0x4d,
-	0x50, 0x05, 0x29, 0x6a, 0x9d, 0x48, 0x91, 0x96, 0xc2, 0x1f, 0x2b, 0xd2, 0xc4, 0xab, 0xf1, 0x30,
-	0x4b, 0x35, 0xaa, 0xfa, 0xb6, 0x20, 0x41, 0x86, 0x05, 0x33, 0x35, 0xb7, 0xeb, 0x7b, 0x82, 0x48,
-	0x0c, 0x31, 0x88, 0xc6, 0x49, 0x10, 0x49, 0x49, 0x3a, 0xd2, 0x09, 0xc9, 0xc5, 0xb2, 0x57, 0x83,
-	0x8d, 0xde, 0xe2, 0x73, 0x57, 0x19, 0xaa, 0xa9, 0x77, 0x03, 0x6b, 0x05, 0xe0, 0xbb, 0x60, 0x65,
-	0x6a, 0xe8, 0xb0, 0x06, 0x6b, 0xda, 0x9d, 0x6a, 0xfe, 0xb1, 0x6f, 0x5d, 0x87, 0x97, 0xe1, 0x8c,
-	0xf1, 0x23, 0xb0, 0x07, 0xf8, 0xd4, 0x25, 0x79, 0x9f, 0x08, 0xa7, 0xd2, 0x60, 0xcd, 0xf5, 0x16,
-	0xf7, 0x17, 0x41, 0xfc, 0xb3, 0xc2, 0x09, 0x7f, 0x86, 0xbc, 0x53, 0xb0, 0x4b, 0xce, 0x5b, 0x00,
-	0x31, 0x49, 0x89, 0xb1, 0x26, 0x95, 0x3a, 0xac, 0x61, 0x2d, 0xed, 0x77, 0x0b, 0x2b, 0xfc, 0x35,
-	0xe5, 0xb5, 0xc1, 0x2e, 0x0d, 0xce, 0x61, 0x45, 0x46, 0x23, 0x9c, 0x67, 0x0b, 0x8d, 0x9e, 0x31,
-	0x3d, 0x1d, 0xa3, 0x89, 0x63, 0x87, 0x46, 0xb7, 0xee, 0xa0, 0x56, 0x3c, 0xa7, 0x87, 0x6a, 0x92,
-	0xc4, 0xc8, 0x2f, 0xc0, 0x3a, 0x47, 0xcd, 0x77, 0xca, 0xdf, 0x2d, 0x1d, 0xa0, 0xbe, 0xf5, 0x8f,
-	0x7b, 0xce, 0xf3, 0xfb, 0xd7, 0x4b, 0x85, 0xf3, 0x4d, 0x73, 0xc4, 0xc9, 0x71, 0xd9, 0x40, 0xe7,
-	0xe4, 0x35, 0x77, 0xd9, 0x5b, 0xee, 0xb2, 0xcf, 0xdc, 0x65, 0xb7, 0x07, 0x22, 0xd1, 0x0f, 0x59,
-	0xdf, 0x8f, 0x69, 0x14, 0x44, 0xca, 0x74, 0xf1, 0x68, 0xc4, 0x61, 0x3c, 0x08, 0xfe, 0xb4, 0xd8,
-	0x5f, 0x35, 0x05, 0xb4, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xef, 0x0e, 0xd5, 0xb9, 0xdf, 0x01,
-	0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6d, 0x53, 0xc1, 0x6e, 0xd4, 0x30,
+	0x10, 0x55, 0x36, 0xa5, 0xdb, 0x9d, 0x05, 0x96, 0x0e, 0x50, 0x85, 0x08, 0xda, 0x2a, 0x12, 0x08,
+	0x09, 0xb1, 0xa1, 0xdb, 0x13, 0x27, 0xa4, 0xa6, 0x02, 0x15, 0xf5, 0x42, 0x2a, 0x2e, 0xdc, 0xbc,
+	0xe9, 0x6c, 0x6a, 0x94, 0xb5, 0x57, 0xb6, 0x53, 0xd1, 0x2b, 0xbf, 0xc0, 0xff, 0x70, 0x85, 0x23,
+	0x12, 0x77, 0x84, 0x2a, 0x3e, 0x04, 0xc7, 0x4d, 0xd2, 0xdd, 0xc0, 0xc1, 0xd2, 0xcc, 0x9b, 0xf1,
+	0x1b, 0xbf, 0x99, 0x31, 0x6c, 0x6b, 0x52, 0xe7, 0xa4, 0x62, 0x4d, 0xc6, 0x70, 0x91, 0xeb, 0xd6,
+	0x18, 0x2f, 0x94, 0x34, 0x12, 0xfb, 0x59, 0x51, 0x6a, 0x43, 0x2a, 0xbc, 0x97, 0xcb, 0x5c, 0x3a,
+	0x2c, 0xae, 0xac, 0xab, 0x70, 0xf8, 0x30, 0x97, 0x32, 0x2f, 0x28, 0x66, 0x0b, 0x1e, 0x33, 0x21,
+	0xa4, 0x61, 0x86, 0x4b, 0x51, 0x5f, 0x8e, 0x46, 0x70, 0xeb, 0xa4, 0xa6, 0x7b, 0x57, 0x92, 0xba,
+	0x88, 0xbe, 0x7a, 0xb0, 0xd1, 0x20, 0xf8, 0x00, 0xfc, 0x52, 0x15, 0x81, 0xb7, 0xeb, 0x3d, 0x1d,
+	0x1c, 0xf4, 0x2f, 0x7f, 0xed, 0xf8, 0xef, 0xd3, 0xe3, 0xb4, 0xc2, 0xf0, 0x05, 0x0c, 0x4e, 0xe9,
+	0x53, 0x22, 0xc5, 0x8c, 0xe7, 0x41, 0xcf, 0x26, 0x0c, 0x27, 0x38, 0xae, 0x5f, 0x32, 0x3e, 0x6c,
+	0x22, 0xe9, 0x75, 0x12, 0x6e, 0xc1, 0x3a, 0xd7, 0xda, 0x16, 0x09, 0xfc, 0x8a, 0x2f, 0xad, 0x3d,
+	0xdc, 0x83, 0x61, 0x56, 0xf0, 0xa4, 0xe0, 0x24, 0xcc, 0xd1, 0x61, 0xb0, 0xe6, 0x8a, 0x8d, 0x6c,
+	0xb1, 0x61, 0x72, 0x7c, 0xd4, 0xc0, 0xe9, 0x72, 0x0e, 0x86, 0xb0, 0x31, 0x23, 0x66, 0x4a, 0x45,
+	0x3a, 0xb8, 0xb1, 0xeb, 0x5b, 0xb2, 0xd6, 0x8f, 0x5e, 0xc1, 0xa0, 0x2d, 0x8f, 0x13, 0x80, 0x4c,
+	0x0a, 0x41, 0x99, 0x91, 0x4a, 0x5b, 0x1d, 0xfe, 0xca, 0x33, 0x93, 0x26, 0x94, 0x2e, 0x65, 0x45,
+	0xfb, 0x30, 0x68, 0x03, 0x88, 0xb0, 0x26, 0xd8, 0x9c, 0xae, 0x5a, 0x90, 0x3a, 0xbb, 0xc2, 0xcc,
+	0xc5, 0x82, 0x9c, 0x6a, 0x8b, 0x55, 0x76, 0x74, 0x17, 0x36, 0x13, 0xb6, 0x60, 0x53, 0x5e, 0x70,
+	0xc3, 0xa9, 0xee, 0x65, 0x01, 0x37, 0x97, 0x41, 0x0c, 0xa0, 0x6f, 0x07, 0xa9, 0x6d, 0xfb, 0x6b,
+	0xbe, 0xc6, 0x5d, 0x11, 0xd4, 0x5b, 0x15, 0x84, 0x4f, 0xe0, 0xf6, 0x9c, 0x09, 0x3e, 0x23, 0x6d,
+	0x5e, 0x4b, 0x35, 0x67, 0xa6, 0xee, 0x5f, 0x07, 0x9d, 0x7c, 0xf3, 0x60, 0xd4, 0x4c, 0xee, 0xc4,
+	0xae, 0x0c, 0xcf, 0x08, 0xdf, 0x82, 0xff, 0x86, 0x0c, 0x6e, 0xb5, 0x92, 0x57, 0x86, 0x1d, 0x6e,
+	0xfe, 0x83, 0x47, 0xc1, 0xe7, 0x9f, 0x7f, 0xbe, 0xf4, 0x10, 0xef, 0xb8, 0x85, 0x39, 0xdf, 0x6b,
+	0xb7, 0x0d, 0xcf, 0x3a, 0x6a, 0xc2, 0xeb, 0x3e, 0x76, 0x95, 0x87, 0xf7, 0xff, 0x1b, 0x8b, 0x1e,
+	0x3b, 0xf2, 0x1d, 0x7c, 0xd4, 0x25, 0x8f, 0xb3, 0xa5, 0xb4, 0x83, 0x97, 0xdf, 0x2f, 0xb7, 0xbd,
+	0x1f, 0xf6, 0xfc, 0xb6, 0xe7, 0xc3, 0xb3, 0x9c, 0x9b, 0xb3, 0x72, 0x3a, 0xce, 0xe4, 0x3c, 0x66,
+	0xca, 0x6d, 0xf8, 0x47, 0x67, 0x3c, 0xcf, 0x4e, 0xe3, 0xce, 0xdf, 0x98, 0xae, 0xbb, 0xb5, 0xde,
+	0xff, 0x0b, 0x6c, 0x61, 0x7f, 0x34, 0x35, 0x03, 0x00, 0x00,
 }
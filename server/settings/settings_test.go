@@ -0,0 +1,61 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+func newTestServer(data map[string]string) *Server {
+	kubeclientset := fake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+		Data:       data,
+	}, &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: "argocd"},
+		Data:       map[string][]byte{"admin.password": []byte("hash"), "server.secretkey": []byte("key")},
+	})
+	return NewServer(settings.NewSettingsManager(kubeclientset, "argocd"))
+}
+
+func TestCapabilities(t *testing.T) {
+	capabilities, err := newTestServer(nil).Capabilities(context.Background(), &CapabilitiesQuery{})
+	assert.Nil(t, err)
+	assert.True(t, capabilities.HasFeature(FeatureBulkApply))
+	assert.False(t, capabilities.HasFeature(FeatureSSO))
+	assert.False(t, capabilities.HasFeature(FeatureSecretReferences))
+	assert.Empty(t, capabilities.ManifestFormat)
+
+	capabilities, err = newTestServer(map[string]string{
+		"manifests.format": "json",
+		"secretBackends":   "- name: vault\n  vault:\n    address: https://vault:8200\n",
+	}).Capabilities(context.Background(), &CapabilitiesQuery{})
+	assert.Nil(t, err)
+	assert.True(t, capabilities.HasFeature(FeatureSecretReferences))
+	assert.Equal(t, "json", capabilities.ManifestFormat)
+}
+
+func TestGet(t *testing.T) {
+	set, err := newTestServer(nil).Get(context.Background(), &SettingsQuery{})
+	assert.Nil(t, err)
+	assert.Empty(t, set.Issuer)
+	assert.Empty(t, set.CLIClientID)
+	assert.Contains(t, set.Features, FeatureBulkApply)
+	assert.NotContains(t, set.Features, FeatureSSO)
+
+	set, err = newTestServer(map[string]string{
+		"url":        "https://argocd.example.com",
+		"dex.config": "connectors:\n- type: github\n  name: GitHub\n",
+	}).Get(context.Background(), &SettingsQuery{})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://argocd.example.com/api/dex", set.Issuer)
+	assert.Equal(t, common.ArgoCDCLIClientAppID, set.CLIClientID)
+	assert.Contains(t, set.Features, FeatureSSO)
+	assert.Len(t, set.DexConfig.Connectors, 1)
+}
@@ -1,34 +1,66 @@
 package version
 
 import (
-	argocd "github.com/argoproj/argo-cd"
-	ksutil "github.com/argoproj/argo-cd/util/ksonnet"
+	"sync"
+
 	"github.com/golang/protobuf/ptypes/empty"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+
+	argocd "github.com/argoproj/argo-cd"
+	"github.com/argoproj/argo-cd/reposerver/repository"
 )
 
-type Server struct{}
+// Server returns the version of the API server, and of the tools the repo server renders manifests with
+type Server struct {
+	getToolVersions func(ctx context.Context) (*repository.ToolVersionsResponse, error)
+	lock            sync.Mutex
+	toolVersions    *repository.ToolVersionsResponse
+}
 
-// Version returns the version of the API server
-func (s *Server) Version(context.Context, *empty.Empty) (*VersionMessage, error) {
+// NewServer returns a new instance of the Version service. The tool versions are queried with
+// getToolVersions until it succeeds once, and then cached, since the tools are bundled with the repo
+// server.
+func NewServer(getToolVersions func(ctx context.Context) (*repository.ToolVersionsResponse, error)) *Server {
+	return &Server{getToolVersions: getToolVersions}
+}
+
+// Version returns the version of the API server, and of the tools the repo server renders manifests with
+func (s *Server) Version(ctx context.Context, _ *empty.Empty) (*VersionMessage, error) {
 	vers := argocd.GetVersion()
-	ksonnetVersion, err := ksutil.KsonnetVersion()
-	if err != nil {
-		return nil, err
-	}
+	toolVersions := s.cachedToolVersions(ctx)
 	return &VersionMessage{
-		Version:        vers.Version,
-		BuildDate:      vers.BuildDate,
-		GitCommit:      vers.GitCommit,
-		GitTag:         vers.GitTag,
-		GitTreeState:   vers.GitTreeState,
-		GoVersion:      vers.GoVersion,
-		Compiler:       vers.Compiler,
-		Platform:       vers.Platform,
-		KsonnetVersion: ksonnetVersion,
+		Version:          vers.Version,
+		BuildDate:        vers.BuildDate,
+		GitCommit:        vers.GitCommit,
+		GitTag:           vers.GitTag,
+		GitTreeState:     vers.GitTreeState,
+		GoVersion:        vers.GoVersion,
+		Compiler:         vers.Compiler,
+		Platform:         vers.Platform,
+		KsonnetVersion:   toolVersions.KsonnetVersion,
+		HelmVersion:      toolVersions.HelmVersion,
+		KubectlVersion:   toolVersions.KubectlVersion,
+		KustomizeVersion: toolVersions.KustomizeVersion,
 	}, nil
 }
 
+// cachedToolVersions returns the cached tool versions, and queries them if they are not cached yet.
+// The versions are left empty if the repo server cannot be queried.
+func (s *Server) cachedToolVersions(ctx context.Context) repository.ToolVersionsResponse {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.toolVersions == nil {
+		toolVersions, err := s.getToolVersions(ctx)
+		if err != nil {
+			log.Warnf("Failed to get the tool versions of the repo server: %v", err)
+			return repository.ToolVersionsResponse{}
+		}
+		s.toolVersions = toolVersions
+	}
+	return *s.toolVersions
+}
+
 // AuthFuncOverride allows the version to be returned without auth
 func (s *Server) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
 	return ctx, nil
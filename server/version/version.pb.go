@@ -40,15 +40,18 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // VersionMessage represents version of the ArgoCD API server
 type VersionMessage struct {
-	Version        string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
-	BuildDate      string `protobuf:"bytes,2,opt,name=BuildDate,proto3" json:"BuildDate,omitempty"`
-	GitCommit      string `protobuf:"bytes,3,opt,name=GitCommit,proto3" json:"GitCommit,omitempty"`
-	GitTag         string `protobuf:"bytes,4,opt,name=GitTag,proto3" json:"GitTag,omitempty"`
-	GitTreeState   string `protobuf:"bytes,5,opt,name=GitTreeState,proto3" json:"GitTreeState,omitempty"`
-	GoVersion      string `protobuf:"bytes,6,opt,name=GoVersion,proto3" json:"GoVersion,omitempty"`
-	Compiler       string `protobuf:"bytes,7,opt,name=Compiler,proto3" json:"Compiler,omitempty"`
-	Platform       string `protobuf:"bytes,8,opt,name=Platform,proto3" json:"Platform,omitempty"`
-	KsonnetVersion string `protobuf:"bytes,9,opt,name=KsonnetVersion,proto3" json:"KsonnetVersion,omitempty"`
+	Version          string `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	BuildDate        string `protobuf:"bytes,2,opt,name=BuildDate,proto3" json:"BuildDate,omitempty"`
+	GitCommit        string `protobuf:"bytes,3,opt,name=GitCommit,proto3" json:"GitCommit,omitempty"`
+	GitTag           string `protobuf:"bytes,4,opt,name=GitTag,proto3" json:"GitTag,omitempty"`
+	GitTreeState     string `protobuf:"bytes,5,opt,name=GitTreeState,proto3" json:"GitTreeState,omitempty"`
+	GoVersion        string `protobuf:"bytes,6,opt,name=GoVersion,proto3" json:"GoVersion,omitempty"`
+	Compiler         string `protobuf:"bytes,7,opt,name=Compiler,proto3" json:"Compiler,omitempty"`
+	Platform         string `protobuf:"bytes,8,opt,name=Platform,proto3" json:"Platform,omitempty"`
+	KsonnetVersion   string `protobuf:"bytes,9,opt,name=KsonnetVersion,proto3" json:"KsonnetVersion,omitempty"`
+	HelmVersion      string `protobuf:"bytes,10,opt,name=HelmVersion,proto3" json:"HelmVersion,omitempty"`
+	KubectlVersion   string `protobuf:"bytes,11,opt,name=KubectlVersion,proto3" json:"KubectlVersion,omitempty"`
+	KustomizeVersion string `protobuf:"bytes,12,opt,name=KustomizeVersion,proto3" json:"KustomizeVersion,omitempty"`
 }
 
 func (m *VersionMessage) Reset()                    { *m = VersionMessage{} }
@@ -119,6 +122,27 @@ func (m *VersionMessage) GetKsonnetVersion() string {
 	return ""
 }
 
+func (m *VersionMessage) GetHelmVersion() string {
+	if m != nil {
+		return m.HelmVersion
+	}
+	return ""
+}
+
+func (m *VersionMessage) GetKubectlVersion() string {
+	if m != nil {
+		return m.KubectlVersion
+	}
+	return ""
+}
+
+func (m *VersionMessage) GetKustomizeVersion() string {
+	if m != nil {
+		return m.KustomizeVersion
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*VersionMessage)(nil), "version.VersionMessage")
 }
@@ -266,6 +290,24 @@ func (m *VersionMessage) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintVersion(dAtA, i, uint64(len(m.KsonnetVersion)))
 		i += copy(dAtA[i:], m.KsonnetVersion)
 	}
+	if len(m.HelmVersion) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.HelmVersion)))
+		i += copy(dAtA[i:], m.HelmVersion)
+	}
+	if len(m.KubectlVersion) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.KubectlVersion)))
+		i += copy(dAtA[i:], m.KubectlVersion)
+	}
+	if len(m.KustomizeVersion) > 0 {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.KustomizeVersion)))
+		i += copy(dAtA[i:], m.KustomizeVersion)
+	}
 	return i, nil
 }
 
@@ -317,6 +359,18 @@ func (m *VersionMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVersion(uint64(l))
 	}
+	l = len(m.HelmVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	l = len(m.KubectlVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	l = len(m.KustomizeVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
 	return n
 }
 
@@ -623,6 +677,93 @@ func (m *VersionMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.KsonnetVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubectlVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubectlVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KustomizeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVersion(dAtA[iNdEx:])
@@ -752,27 +893,29 @@ var (
 func init() { proto.RegisterFile("server/version/version.proto", fileDescriptorVersion) }
 
 var fileDescriptorVersion = []byte{
-	// 343 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xcf, 0x4a, 0xc3, 0x40,
-	0x10, 0xc6, 0x49, 0xd5, 0xfe, 0x59, 0x4a, 0x0f, 0x8b, 0xd4, 0x25, 0x96, 0x22, 0x3d, 0x88, 0x08,
-	0x26, 0xa0, 0x17, 0xcf, 0xad, 0xd2, 0x83, 0x08, 0xc5, 0x8a, 0x07, 0x6f, 0x9b, 0x76, 0x1a, 0x57,
-	0x92, 0x4c, 0xd8, 0x4c, 0x0b, 0x5e, 0x7d, 0x05, 0x5f, 0xc0, 0xc7, 0xf1, 0x28, 0xf8, 0x02, 0x52,
-	0x7c, 0x10, 0xc9, 0x26, 0x1b, 0x89, 0xa7, 0xcc, 0xf7, 0xfd, 0x86, 0x2f, 0xe1, 0x9b, 0xb0, 0x41,
-	0x06, 0x7a, 0x03, 0xda, 0xdf, 0x80, 0xce, 0x14, 0x26, 0xf6, 0xe9, 0xa5, 0x1a, 0x09, 0x79, 0xab,
-	0x94, 0xee, 0x20, 0x44, 0x0c, 0x23, 0xf0, 0x65, 0xaa, 0x7c, 0x99, 0x24, 0x48, 0x92, 0x14, 0x26,
-	0x59, 0xb1, 0xe6, 0x1e, 0x96, 0xd4, 0xa8, 0x60, 0xbd, 0xf2, 0x21, 0x4e, 0xe9, 0xa5, 0x80, 0xa3,
-	0xf7, 0x06, 0xeb, 0x3d, 0x14, 0x31, 0xb7, 0x90, 0x65, 0x32, 0x04, 0x2e, 0x58, 0xab, 0x74, 0x84,
-	0x73, 0xe4, 0x9c, 0x74, 0xee, 0xac, 0xe4, 0x03, 0xd6, 0x19, 0xaf, 0x55, 0xb4, 0xbc, 0x92, 0x04,
-	0xa2, 0x61, 0xd8, 0x9f, 0x91, 0xd3, 0xa9, 0xa2, 0x09, 0xc6, 0xb1, 0x22, 0xb1, 0x53, 0xd0, 0xca,
-	0xe0, 0x7d, 0xd6, 0x9c, 0x2a, 0xba, 0x97, 0xa1, 0xd8, 0x35, 0xa8, 0x54, 0x7c, 0xc4, 0xba, 0xf9,
-	0xa4, 0x01, 0xe6, 0x94, 0xc7, 0xee, 0x19, 0x5a, 0xf3, 0x4c, 0x32, 0xda, 0x6f, 0x6a, 0x96, 0xc9,
-	0xd6, 0xe0, 0x2e, 0x6b, 0x4f, 0x30, 0x4e, 0x55, 0x04, 0x5a, 0xb4, 0x0c, 0xac, 0x74, 0xce, 0x66,
-	0x91, 0xa4, 0x15, 0xea, 0x58, 0xb4, 0x0b, 0x66, 0x35, 0x3f, 0x66, 0xbd, 0x9b, 0x0c, 0x93, 0x04,
-	0xc8, 0x46, 0x77, 0xcc, 0xc6, 0x3f, 0xf7, 0x3c, 0xa8, 0x1a, 0x9a, 0x83, 0xde, 0xa8, 0x05, 0xf0,
-	0x59, 0xd5, 0x10, 0xef, 0x7b, 0x45, 0xbb, 0x9e, 0x6d, 0xd7, 0xbb, 0xce, 0xdb, 0x75, 0x0f, 0x3c,
-	0x7b, 0xab, 0x7a, 0xbb, 0xa3, 0xfd, 0xd7, 0xaf, 0x9f, 0xb7, 0x46, 0x8f, 0x77, 0xcd, 0xb5, 0xca,
-	0xa5, 0xf1, 0xe5, 0xc7, 0x76, 0xe8, 0x7c, 0x6e, 0x87, 0xce, 0xf7, 0x76, 0xe8, 0x3c, 0x9e, 0x86,
-	0x8a, 0x9e, 0xd6, 0x81, 0xb7, 0xc0, 0xd8, 0x97, 0x3a, 0xc4, 0x54, 0xe3, 0xb3, 0x19, 0xce, 0x16,
-	0x4b, 0xbf, 0xfe, 0x4b, 0x04, 0x4d, 0xf3, 0xe2, 0x8b, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82,
-	0x85, 0x6d, 0x31, 0x2b, 0x02, 0x00, 0x00,
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x65, 0x52, 0x4d, 0x4b, 0xc3, 0x30,
+	0x18, 0x66, 0x9b, 0xee, 0x23, 0x1b, 0x43, 0x82, 0xcc, 0x52, 0x87, 0x8c, 0x1d, 0x44, 0x06, 0xb6,
+	0xa0, 0x17, 0xcf, 0x53, 0x51, 0x18, 0xc2, 0x70, 0xe2, 0xc1, 0x5b, 0xda, 0xbd, 0xab, 0x91, 0xa6,
+	0x29, 0x4d, 0x2a, 0xe8, 0xd1, 0xbf, 0x20, 0xf8, 0x9b, 0x3c, 0x0a, 0xfe, 0x01, 0x11, 0x7f, 0x88,
+	0x69, 0xda, 0x54, 0xab, 0x87, 0x97, 0xe4, 0xf9, 0xe0, 0x49, 0xe0, 0x79, 0xd1, 0x50, 0x40, 0x72,
+	0x0f, 0x89, 0xab, 0x46, 0x50, 0x1e, 0x99, 0xd3, 0x89, 0x13, 0x2e, 0x39, 0x6e, 0x15, 0xd0, 0x1e,
+	0x06, 0x9c, 0x07, 0x21, 0xb8, 0x24, 0xa6, 0x2e, 0x89, 0x22, 0x2e, 0x89, 0x54, 0xb4, 0xc8, 0x6d,
+	0xf6, 0x76, 0xa1, 0x6a, 0xe4, 0xa5, 0x2b, 0x17, 0x58, 0x2c, 0x1f, 0x72, 0x71, 0xfc, 0xd2, 0x40,
+	0xfd, 0xeb, 0x3c, 0xe6, 0x02, 0x84, 0x20, 0x01, 0x60, 0x0b, 0xb5, 0x0a, 0xc6, 0xaa, 0x8d, 0x6a,
+	0x7b, 0x9d, 0x4b, 0x03, 0xf1, 0x10, 0x75, 0xa6, 0x29, 0x0d, 0x97, 0x27, 0x44, 0x82, 0x55, 0xd7,
+	0xda, 0x0f, 0x91, 0xa9, 0x67, 0x54, 0x1e, 0x73, 0xc6, 0xa8, 0xb4, 0x1a, 0xb9, 0x5a, 0x12, 0x78,
+	0x80, 0x9a, 0x0a, 0x5c, 0x91, 0xc0, 0x5a, 0xd3, 0x52, 0x81, 0xf0, 0x18, 0xf5, 0xb2, 0x5b, 0x02,
+	0xb0, 0x90, 0x59, 0xec, 0xba, 0x56, 0x2b, 0x9c, 0x4e, 0xe6, 0xe6, 0x4f, 0xcd, 0x22, 0xd9, 0x10,
+	0xd8, 0x46, 0x6d, 0xf5, 0x46, 0x4c, 0x43, 0x48, 0xac, 0x96, 0x16, 0x4b, 0x9c, 0x69, 0xf3, 0x90,
+	0xc8, 0x15, 0x4f, 0x98, 0xd5, 0xce, 0x35, 0x83, 0xf1, 0x2e, 0xea, 0xcf, 0x04, 0x8f, 0x22, 0x90,
+	0x26, 0xba, 0xa3, 0x1d, 0x7f, 0x58, 0x3c, 0x42, 0xdd, 0x73, 0x08, 0x99, 0x31, 0x21, 0x6d, 0xfa,
+	0x4d, 0xe9, 0xa4, 0xd4, 0x03, 0x5f, 0x86, 0xc6, 0xd4, 0x2d, 0x92, 0x2a, 0x2c, 0x9e, 0xa0, 0x8d,
+	0x59, 0x2a, 0x24, 0x67, 0xf4, 0x11, 0x8c, 0xb3, 0xa7, 0x9d, 0xff, 0xf8, 0x03, 0xaf, 0xec, 0x65,
+	0xa1, 0x76, 0x80, 0xfa, 0x80, 0xe7, 0x65, 0x2f, 0x78, 0xe0, 0xe4, 0x9d, 0x3a, 0xa6, 0x53, 0xe7,
+	0x34, 0xeb, 0xd4, 0xde, 0x72, 0xcc, 0x86, 0x54, 0x3b, 0x1d, 0x6f, 0x3e, 0xbd, 0x7f, 0x3d, 0xd7,
+	0xfb, 0xb8, 0xa7, 0x77, 0xa4, 0x30, 0x4d, 0x8f, 0x5e, 0x3f, 0x77, 0x6a, 0x6f, 0x6a, 0x3e, 0xd4,
+	0xdc, 0x4c, 0x02, 0x2a, 0x6f, 0x53, 0xcf, 0xf1, 0x39, 0x73, 0x49, 0x12, 0x70, 0x95, 0x7d, 0xa7,
+	0x2f, 0xfb, 0xfe, 0xd2, 0xad, 0x2e, 0xa2, 0xd7, 0xd4, 0x0f, 0x1f, 0x7e, 0x03, 0x8d, 0xb6, 0xbb,
+	0xc2, 0xa1, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,35 @@
+package version
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+
+	"github.com/argoproj/argo-cd/reposerver/repository"
+)
+
+func TestVersionCachesToolVersions(t *testing.T) {
+	queries := 0
+	s := NewServer(func(ctx context.Context) (*repository.ToolVersionsResponse, error) {
+		queries++
+		if queries == 1 {
+			return nil, fmt.Errorf("repo server unavailable")
+		}
+		return &repository.ToolVersionsResponse{HelmVersion: "v2.11.0", KustomizeVersion: "1.0.10"}, nil
+	})
+
+	vers, err := s.Version(context.Background(), &empty.Empty{})
+	assert.Nil(t, err)
+	assert.Equal(t, "", vers.HelmVersion)
+
+	for i := 0; i < 2; i++ {
+		vers, err = s.Version(context.Background(), &empty.Empty{})
+		assert.Nil(t, err)
+		assert.Equal(t, "v2.11.0", vers.HelmVersion)
+		assert.Equal(t, "1.0.10", vers.KustomizeVersion)
+	}
+	assert.Equal(t, 2, queries)
+}
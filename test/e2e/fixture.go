@@ -37,6 +37,7 @@ import (
 	"github.com/argoproj/argo-cd/server/cluster"
 	"github.com/argoproj/argo-cd/test"
 	"github.com/argoproj/argo-cd/util"
+	"github.com/argoproj/argo-cd/util/argo"
 	"github.com/argoproj/argo-cd/util/cache"
 	"github.com/argoproj/argo-cd/util/db"
 	"github.com/argoproj/argo-cd/util/git"
@@ -126,7 +127,7 @@ func (f *Fixture) setup() error {
 	}
 
 	memCache := cache.NewInMemoryCache(repository.DefaultRepoCacheExpiration)
-	repoServerGRPC := reposerver.NewServer(&FakeGitClientFactory{}, memCache).CreateGRPC()
+	repoServerGRPC := reposerver.NewServer(&FakeGitClientFactory{}, memCache, nil, repository.DefaultManifestGenerateTimeout, nil).CreateGRPC()
 	repoServerListener, err := net.Listen("tcp", "127.0.0.1:0")
 	if err != nil {
 		return err
@@ -303,7 +304,8 @@ func (f *Fixture) CreateApp(t *testing.T, application *v1alpha1.Application) *v1
 // createController creates new controller instance
 func (f *Fixture) createController() *controller.ApplicationController {
 	appStateManager := controller.NewAppStateManager(
-		f.DB, f.AppClient, reposerver.NewRepositoryServerClientset(f.RepoServerAddress), f.Namespace)
+		f.DB, f.AppClient, reposerver.NewRepositoryServerClientset(f.RepoServerAddress), f.Namespace,
+		&controller.AppStateManagerConfig{ResourceTracking: argo.ResourceTracking{Namespace: f.Namespace}})
 
 	return controller.NewApplicationController(
 		f.Namespace,
@@ -426,3 +428,7 @@ func (c *FakeGitClient) LsFiles(s string) ([]string, error) {
 func (c *FakeGitClient) CommitSHA() (string, error) {
 	return "abcdef123456890", nil
 }
+
+func (c *FakeGitClient) VerifyCommitSignature(commitSHA string) (string, error) {
+	return "", nil
+}
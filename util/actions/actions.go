@@ -0,0 +1,133 @@
+package actions
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/util/kube"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+// RestartedAtAnnotation is the pod template annotation set by the restart action, which is the same
+// as the one of `kubectl rollout restart`
+const RestartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// Action is an action which can be run on a resource
+type Action struct {
+	// Name is the name of the action
+	Name string
+	// Params are the names of the parameters of the action
+	Params []string
+	// run modifies a copy of the resource and returns it
+	run func(obj *unstructured.Unstructured, params map[string]string) (*unstructured.Unstructured, error)
+}
+
+// GetActions returns the actions available for the resource, i.e. the built-in actions of its kind
+// and the custom actions of its API group and kind. Custom actions override built-in actions of the
+// same name.
+func GetActions(obj *unstructured.Unstructured, customActions []settings.ResourceAction) []Action {
+	actions := make([]Action, 0)
+	custom := make(map[string]bool)
+	for i := range customActions {
+		customAction := customActions[i]
+		if customAction.Group != obj.GroupVersionKind().Group || customAction.Kind != obj.GetKind() {
+			continue
+		}
+		custom[customAction.Name] = true
+		actions = append(actions, Action{
+			Name:   customAction.Name,
+			Params: customAction.Params,
+			run: func(obj *unstructured.Unstructured, params map[string]string) (*unstructured.Unstructured, error) {
+				return runLuaScript(obj, customAction.Script, params)
+			},
+		})
+	}
+	for _, action := range getBuiltInActions(obj) {
+		if !custom[action.Name] {
+			actions = append(actions, action)
+		}
+	}
+	return actions
+}
+
+// RunAction runs the action on a copy of the resource, and returns the modified resource which needs
+// to be updated in the cluster
+func RunAction(obj *unstructured.Unstructured, name string, params map[string]string, customActions []settings.ResourceAction) (*unstructured.Unstructured, error) {
+	for _, action := range GetActions(obj, customActions) {
+		if action.Name != name {
+			continue
+		}
+		for _, param := range action.Params {
+			if _, ok := params[param]; !ok {
+				return nil, fmt.Errorf("action %s requires parameter %s", name, param)
+			}
+		}
+		newObj, err := action.run(obj.DeepCopy(), params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run action %s: %v", name, err)
+		}
+		if newObj.GetAPIVersion() != obj.GetAPIVersion() || newObj.GetKind() != obj.GetKind() || newObj.GetNamespace() != obj.GetNamespace() || newObj.GetName() != obj.GetName() {
+			return nil, fmt.Errorf("action %s must not change the API version, kind, namespace or name of the resource", name)
+		}
+		return newObj, nil
+	}
+	return nil, fmt.Errorf("action %s is not available for %s %s", name, obj.GetKind(), obj.GetName())
+}
+
+func getBuiltInActions(obj *unstructured.Unstructured) []Action {
+	switch obj.GetKind() {
+	case kube.DeploymentKind, kube.StatefulSetKind:
+		return []Action{{Name: "restart", run: restart}, {Name: "scale", Params: []string{"replicas"}, run: scale}}
+	case kube.DaemonSetKind:
+		return []Action{{Name: "restart", run: restart}}
+	case kube.CronJobKind:
+		suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend")
+		if suspended {
+			return []Action{{Name: "resume", run: setSuspended(false)}}
+		}
+		return []Action{{Name: "suspend", run: setSuspended(true)}}
+	}
+	return nil
+}
+
+// restart triggers a rollout of the pods of the resource by annotating its pod template
+func restart(obj *unstructured.Unstructured, params map[string]string) (*unstructured.Unstructured, error) {
+	annotations, _, err := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "annotations")
+	if err != nil {
+		return nil, err
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[RestartedAtAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	err = unstructured.SetNestedStringMap(obj.Object, annotations, "spec", "template", "metadata", "annotations")
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func scale(obj *unstructured.Unstructured, params map[string]string) (*unstructured.Unstructured, error) {
+	replicas, err := strconv.ParseInt(params["replicas"], 10, 32)
+	if err != nil || replicas < 0 {
+		return nil, fmt.Errorf("invalid number of replicas '%s'", params["replicas"])
+	}
+	err = unstructured.SetNestedField(obj.Object, replicas, "spec", "replicas")
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func setSuspended(suspended bool) func(obj *unstructured.Unstructured, params map[string]string) (*unstructured.Unstructured, error) {
+	return func(obj *unstructured.Unstructured, params map[string]string) (*unstructured.Unstructured, error) {
+		err := unstructured.SetNestedField(obj.Object, suspended, "spec", "suspend")
+		if err != nil {
+			return nil, err
+		}
+		return obj, nil
+	}
+}
@@ -0,0 +1,168 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+func unmarshalObj(t *testing.T, yamlStr string) *unstructured.Unstructured {
+	var obj unstructured.Unstructured
+	err := yaml.Unmarshal([]byte(yamlStr), &obj)
+	assert.Nil(t, err)
+	return &obj
+}
+
+const deploymentYAML = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook-ui
+  namespace: default
+spec:
+  replicas: 1
+  template:
+    metadata:
+      labels:
+        app: guestbook-ui
+    spec:
+      containers:
+      - name: guestbook-ui
+        image: gcr.io/heptio-images/ks-guestbook-demo:0.2
+`
+
+const cronJobYAML = `
+apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: hello
+  namespace: default
+spec:
+  schedule: "*/1 * * * *"
+`
+
+func actionNames(actions []Action) []string {
+	names := make([]string, 0)
+	for _, action := range actions {
+		names = append(names, action.Name)
+	}
+	return names
+}
+
+func TestRestart(t *testing.T) {
+	obj := unmarshalObj(t, deploymentYAML)
+	newObj, err := RunAction(obj, "restart", nil, nil)
+	assert.Nil(t, err)
+	annotations, _, _ := unstructured.NestedStringMap(newObj.Object, "spec", "template", "metadata", "annotations")
+	assert.NotEmpty(t, annotations[RestartedAtAnnotation])
+	// the resource is not modified
+	_, ok, _ := unstructured.NestedStringMap(obj.Object, "spec", "template", "metadata", "annotations")
+	assert.False(t, ok)
+}
+
+func TestScale(t *testing.T) {
+	obj := unmarshalObj(t, deploymentYAML)
+	_, err := RunAction(obj, "scale", nil, nil)
+	assert.EqualError(t, err, "action scale requires parameter replicas")
+	_, err = RunAction(obj, "scale", map[string]string{"replicas": "-1"}, nil)
+	assert.NotNil(t, err)
+
+	newObj, err := RunAction(obj, "scale", map[string]string{"replicas": "3"}, nil)
+	assert.Nil(t, err)
+	replicas, _, _ := unstructured.NestedInt64(newObj.Object, "spec", "replicas")
+	assert.Equal(t, int64(3), replicas)
+}
+
+func TestSuspendResume(t *testing.T) {
+	obj := unmarshalObj(t, cronJobYAML)
+	assert.Equal(t, []string{"suspend"}, actionNames(GetActions(obj, nil)))
+	_, err := RunAction(obj, "resume", nil, nil)
+	assert.NotNil(t, err)
+
+	obj, err = RunAction(obj, "suspend", nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"resume"}, actionNames(GetActions(obj, nil)))
+	obj, err = RunAction(obj, "resume", nil, nil)
+	assert.Nil(t, err)
+	suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend")
+	assert.False(t, suspended)
+}
+
+func TestCustomActions(t *testing.T) {
+	customActions := []settings.ResourceAction{{
+		Group:  "apps",
+		Kind:   "Deployment",
+		Name:   "pause",
+		Script: "obj.spec.paused = true\nreturn obj",
+	}, {
+		Group:  "apps",
+		Kind:   "Deployment",
+		Name:   "scale",
+		Params: []string{"replicas"},
+		Script: "obj.spec.replicas = tonumber(params.replicas) * 2\nreturn obj",
+	}, {
+		Kind:   "Service",
+		Name:   "pause",
+		Script: "return obj",
+	}}
+	obj := unmarshalObj(t, deploymentYAML)
+	assert.Equal(t, []string{"pause", "scale", "restart"}, actionNames(GetActions(obj, customActions)))
+
+	newObj, err := RunAction(obj, "pause", nil, customActions)
+	assert.Nil(t, err)
+	paused, _, _ := unstructured.NestedBool(newObj.Object, "spec", "paused")
+	assert.True(t, paused)
+	containers, _, _ := unstructured.NestedSlice(newObj.Object, "spec", "template", "spec", "containers")
+	assert.Len(t, containers, 1)
+
+	newObj, err = RunAction(obj, "scale", map[string]string{"replicas": "2"}, customActions)
+	assert.Nil(t, err)
+	replicas, _, _ := unstructured.NestedInt64(newObj.Object, "spec", "replicas")
+	assert.Equal(t, int64(4), replicas)
+}
+
+func TestCustomActionErrors(t *testing.T) {
+	obj := unmarshalObj(t, deploymentYAML)
+	for _, script := range []string{
+		"return 1",
+		"obj.metadata.name = 'other'\nreturn obj",
+		"error('failed')",
+		"while true do end",
+		"dofile('/etc/passwd')",
+		"print('hello')\nreturn obj",
+		"load('return 1')()\nreturn obj",
+		"loadstring('return 1')()\nreturn obj",
+	} {
+		customActions := []settings.ResourceAction{{Group: "apps", Kind: "Deployment", Name: "test", Script: script}}
+		_, err := RunAction(obj, "test", nil, customActions)
+		assert.NotNil(t, err, script)
+	}
+}
+
+func TestCustomActionEmptyTables(t *testing.T) {
+	customActions := []settings.ResourceAction{{
+		Group:  "apps",
+		Kind:   "Deployment",
+		Name:   "clear",
+		Script: "obj.spec.template.spec.containers = {}\nobj.spec.template.metadata.labels = {}\nobj.spec.selector = {}\nreturn obj",
+	}}
+	newObj, err := RunAction(unmarshalObj(t, deploymentYAML), "clear", nil, customActions)
+	assert.Nil(t, err)
+	// empty tables are lists where the resource had lists, and objects otherwise
+	containers, found, err := unstructured.NestedSlice(newObj.Object, "spec", "template", "spec", "containers")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Empty(t, containers)
+	labels, found, err := unstructured.NestedMap(newObj.Object, "spec", "template", "metadata", "labels")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Empty(t, labels)
+	selector, found, err := unstructured.NestedMap(newObj.Object, "spec", "selector")
+	assert.Nil(t, err)
+	assert.True(t, found)
+	assert.Empty(t, selector)
+}
@@ -0,0 +1,138 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	lua "github.com/yuin/gopher-lua"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// luaScriptTimeout is the maximum duration of the script of a custom action
+const luaScriptTimeout = 1 * time.Second
+
+// runLuaScript runs the script of a custom action with the resource as the global `obj` and the
+// parameters as the global `params`, and returns the resource returned by the script. Only the
+// libraries without access to the file system, the process or the logs of the controller are
+// available to the script, which cannot load other code either.
+func runLuaScript(obj *unstructured.Unstructured, script string, params map[string]string) (*unstructured.Unstructured, error) {
+	l := lua.NewState(lua.Options{SkipOpenLibs: true})
+	defer l.Close()
+	for _, lib := range []struct {
+		name string
+		open lua.LGFunction
+	}{
+		{lua.BaseLibName, lua.OpenBase},
+		{lua.TabLibName, lua.OpenTable},
+		{lua.StringLibName, lua.OpenString},
+		{lua.MathLibName, lua.OpenMath},
+	} {
+		err := l.CallByParam(lua.P{Fn: l.NewFunction(lib.open), NRet: 0, Protect: true}, lua.LString(lib.name))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range []string{"dofile", "loadfile", "load", "loadstring", "print"} {
+		l.SetGlobal(name, lua.LNil)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), luaScriptTimeout)
+	defer cancel()
+	l.SetContext(ctx)
+
+	l.SetGlobal("obj", toLuaValue(l, obj.Object))
+	luaParams := l.NewTable()
+	for name, value := range params {
+		luaParams.RawSetString(name, lua.LString(value))
+	}
+	l.SetGlobal("params", luaParams)
+	err := l.DoString(script)
+	if err != nil {
+		return nil, err
+	}
+	ret := l.Get(-1)
+	table, ok := ret.(*lua.LTable)
+	if !ok {
+		return nil, fmt.Errorf("script returned a %s instead of the resource", ret.Type())
+	}
+	newObj, ok := fromLuaValue(table, obj.Object).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("script returned a list instead of the resource")
+	}
+	return &unstructured.Unstructured{Object: newObj}, nil
+}
+
+func toLuaValue(l *lua.LState, value interface{}) lua.LValue {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		table := l.NewTable()
+		for key, item := range v {
+			table.RawSetString(key, toLuaValue(l, item))
+		}
+		return table
+	case []interface{}:
+		table := l.NewTable()
+		for _, item := range v {
+			table.Append(toLuaValue(l, item))
+		}
+		return table
+	case string:
+		return lua.LString(v)
+	case bool:
+		return lua.LBool(v)
+	case int64:
+		return lua.LNumber(v)
+	case int:
+		return lua.LNumber(v)
+	case float64:
+		return lua.LNumber(v)
+	case nil:
+		return lua.LNil
+	}
+	return lua.LString(fmt.Sprintf("%v", value))
+}
+
+// fromLuaValue converts a Lua value to the value of an unstructured object. Tables with elements at
+// consecutive integer keys are lists, and other tables are objects. Lua does not distinguish empty
+// lists from empty objects, so an empty table is a list if the original value at the same place of
+// the resource is a list, and an object otherwise.
+func fromLuaValue(value lua.LValue, original interface{}) interface{} {
+	switch v := value.(type) {
+	case *lua.LTable:
+		originalList, isList := original.([]interface{})
+		if n := v.MaxN(); n > 0 || (isList && isEmptyTable(v)) {
+			list := make([]interface{}, 0, n)
+			for i := 1; i <= n; i++ {
+				var originalItem interface{}
+				if i <= len(originalList) {
+					originalItem = originalList[i-1]
+				}
+				list = append(list, fromLuaValue(v.RawGetInt(i), originalItem))
+			}
+			return list
+		}
+		originalObject, _ := original.(map[string]interface{})
+		object := make(map[string]interface{})
+		v.ForEach(func(key, item lua.LValue) {
+			object[key.String()] = fromLuaValue(item, originalObject[key.String()])
+		})
+		return object
+	case lua.LString:
+		return string(v)
+	case lua.LBool:
+		return bool(v)
+	case lua.LNumber:
+		if f := float64(v); f == math.Trunc(f) {
+			return int64(f)
+		}
+		return float64(v)
+	}
+	return nil
+}
+
+// isEmptyTable returns whether the table has no element at all
+func isEmptyTable(table *lua.LTable) bool {
+	key, _ := table.Next(lua.LNil)
+	return key == lua.LNil
+}
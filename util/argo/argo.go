@@ -86,14 +86,19 @@ func CheckValidParam(appMap map[string]map[string]bool, newParam argoappv1.Compo
 	return false
 }
 
-// RefreshApp updates the refresh annotation of an application to coerce the controller to process it
-func RefreshApp(appIf v1alpha1.ApplicationInterface, name string) (*argoappv1.Application, error) {
+// RefreshApp updates the refresh annotation of an application to coerce the controller to process it.
+// A hard refresh also updates the hard refresh annotation, so that the manifests are regenerated.
+func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType) (*argoappv1.Application, error) {
 	refreshString := time.Now().UTC().Format(time.RFC3339)
+	annotations := map[string]string{
+		common.AnnotationKeyRefresh: refreshString,
+	}
+	if refreshType == argoappv1.RefreshTypeHard {
+		annotations[common.AnnotationKeyHardRefresh] = refreshString
+	}
 	metadata := map[string]interface{}{
 		"metadata": map[string]interface{}{
-			"annotations": map[string]string{
-				common.AnnotationKeyRefresh: refreshString,
-			},
+			"annotations": annotations,
 		},
 		"status": map[string]interface{}{
 			"comparisonResult": map[string]interface{}{
@@ -113,7 +118,7 @@ func RefreshApp(appIf v1alpha1.ApplicationInterface, name string) (*argoappv1.Ap
 				return nil, err
 			}
 		} else {
-			log.Infof("Refreshed app '%s' for controller reprocessing (%s)", name, refreshString)
+			log.Infof("Refreshed app '%s' for controller reprocessing (%s, %s)", name, refreshType, refreshString)
 			return app, nil
 		}
 		time.Sleep(100 * time.Millisecond)
@@ -121,6 +126,21 @@ func RefreshApp(appIf v1alpha1.ApplicationInterface, name string) (*argoappv1.Ap
 	return nil, err
 }
 
+// GetManifestGenerateTimeoutSeconds returns the manifest generation timeout configured in the
+// application annotations, or zero if the application does not override the repo server default
+func GetManifestGenerateTimeoutSeconds(app *argoappv1.Application) int64 {
+	timeoutStr, ok := app.Annotations[common.AnnotationKeyManifestGenerateTimeout]
+	if !ok {
+		return 0
+	}
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout < time.Second {
+		log.Warnf("Ignoring invalid manifest generation timeout '%s' of app '%s'", timeoutStr, app.Name)
+		return 0
+	}
+	return int64(timeout / time.Second)
+}
+
 // WaitForRefresh watches an application until its comparison timestamp is after the refresh timestamp
 func WaitForRefresh(appIf v1alpha1.ApplicationInterface, name string, timeout *time.Duration) (*argoappv1.Application, error) {
 	ctx := context.Background()
@@ -178,6 +198,7 @@ func WaitForRefresh(appIf v1alpha1.ApplicationInterface, name string, timeout *t
 // * the specified environment exists
 // * the referenced cluster has been added to ArgoCD
 // * the app source repo and destination namespace/cluster are permitted in app project
+// * the ignored differences are valid
 func GetSpecErrors(
 	ctx context.Context, spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, repoClientset reposerver.Clientset, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
 
@@ -220,6 +241,11 @@ func GetSpecErrors(
 				Type:    argoappv1.ApplicationConditionInvalidSpecError,
 				Message: fmt.Sprintf("Unable to determine app source type: %v", err),
 			})
+		} else if !proj.IsSourceToolPermitted(string(appSourceType)) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application source type '%s' is not permitted in project '%s'", appSourceType, proj.Name),
+			})
 		} else {
 			switch appSourceType {
 			case repository.AppSourceKsonnet:
@@ -246,6 +272,53 @@ func GetSpecErrors(
 		spec.Project = common.DefaultAppProjectName
 	}
 
+	for _, ignore := range spec.IgnoreDifferences {
+		if ignore.Kind == "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "ignored differences must specify the kind of the resources",
+			})
+		}
+		for _, pointer := range ignore.JSONPointers {
+			if !strings.HasPrefix(pointer, "/") {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("invalid JSON pointer '%s' of ignored differences: must start with '/'", pointer),
+				})
+			}
+		}
+	}
+
+	if spec.SyncPolicy != nil && spec.SyncPolicy.Retry != nil {
+		if err := spec.SyncPolicy.Retry.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid sync retry strategy: %v", err),
+			})
+		}
+	}
+
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "revision history limit must not be negative",
+		})
+	}
+
+	if spec.SyncPolicy != nil && spec.SyncPolicy.ManagedNamespaceMetadata != nil && spec.Destination.Namespace == "" {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "managed namespace metadata requires a destination namespace",
+		})
+	}
+
+	if spec.SyncPolicy.HasSyncOption(argoappv1.SyncOptionCreateNamespace) && spec.Destination.Namespace == "" {
+		conditions = append(conditions, argoappv1.ApplicationCondition{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: "sync option CreateNamespace=true requires a destination namespace",
+		})
+	}
+
 	if !proj.IsSourcePermitted(spec.Source) {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
@@ -302,6 +375,7 @@ func queryAppSourceType(ctx context.Context, spec *argoappv1.ApplicationSpec, re
 		req.Repo.Username = repoRes.Username
 		req.Repo.Password = repoRes.Password
 		req.Repo.SSHPrivateKey = repoRes.SSHPrivateKey
+		req.Repo.DefaultRevision = repoRes.DefaultRevision
 	}
 	getRes, err := repoClient.ListDir(ctx, &req)
 	if err != nil {
@@ -334,6 +408,7 @@ func verifyAppYAML(ctx context.Context, repoRes *argoappv1.Repository, spec *arg
 		req.Repo.Username = repoRes.Username
 		req.Repo.Password = repoRes.Password
 		req.Repo.SSHPrivateKey = repoRes.SSHPrivateKey
+		req.Repo.DefaultRevision = repoRes.DefaultRevision
 	}
 	getRes, err := repoClient.GetFile(ctx, &req)
 	var conditions []argoappv1.ApplicationCondition
@@ -351,11 +426,6 @@ func verifyAppYAML(ctx context.Context, repoRes *argoappv1.Repository, spec *arg
 				Message: "app.yaml is not a valid ksonnet app spec",
 			})
 		} else {
-			// Default revision to HEAD if unspecified
-			if spec.Source.TargetRevision == "" {
-				spec.Source.TargetRevision = "HEAD"
-			}
-
 			// Verify the specified environment is defined in it
 			envSpec, ok := appSpec.Environments[spec.Source.Environment]
 			if !ok || envSpec == nil {
@@ -399,6 +469,7 @@ func verifyHelmChart(ctx context.Context, repoRes *argoappv1.Repository, spec *a
 		req.Repo.Username = repoRes.Username
 		req.Repo.Password = repoRes.Password
 		req.Repo.SSHPrivateKey = repoRes.SSHPrivateKey
+		req.Repo.DefaultRevision = repoRes.DefaultRevision
 	}
 	_, err := repoClient.GetFile(ctx, &req)
 	if err != nil {
@@ -430,6 +501,7 @@ func verifyManifestDirectory(ctx context.Context, repoRes *argoappv1.Repository,
 		req.Repo.Username = repoRes.Username
 		req.Repo.Password = repoRes.Password
 		req.Repo.SSHPrivateKey = repoRes.SSHPrivateKey
+		req.Repo.DefaultRevision = repoRes.DefaultRevision
 	}
 	manRes, err := repoClient.GenerateManifest(ctx, &req)
 	if err != nil {
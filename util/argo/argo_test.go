@@ -21,7 +21,7 @@ func TestRefreshApp(t *testing.T) {
 	testApp.Namespace = "default"
 	appClientset := appclientset.NewSimpleClientset(&testApp)
 	appIf := appClientset.ArgoprojV1alpha1().Applications("default")
-	_, err := RefreshApp(appIf, "test-app")
+	_, err := RefreshApp(appIf, "test-app", argoappv1.RefreshTypeNormal)
 	assert.Nil(t, err)
 	// For some reason, the fake Application inferface doesn't reflect the patch status after Patch(),
 	// so can't verify it was set in unit tests.
@@ -77,3 +77,14 @@ func TestWaitForRefresh(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, app)
 }
+
+func TestGetManifestGenerateTimeoutSeconds(t *testing.T) {
+	var testApp argoappv1.Application
+	assert.Equal(t, int64(0), GetManifestGenerateTimeoutSeconds(&testApp))
+
+	testApp.Annotations = map[string]string{common.AnnotationKeyManifestGenerateTimeout: "5m"}
+	assert.Equal(t, int64(300), GetManifestGenerateTimeoutSeconds(&testApp))
+
+	testApp.Annotations[common.AnnotationKeyManifestGenerateTimeout] = "invalid"
+	assert.Equal(t, int64(0), GetManifestGenerateTimeoutSeconds(&testApp))
+}
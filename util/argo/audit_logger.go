@@ -30,6 +30,11 @@ const (
 	EventReasonResourceCreated = "ResourceCreated"
 	EventReasonResourceUpdated = "ResourceUpdated"
 	EventReasonResourceDeleted = "ResourceDeleted"
+
+	EventReasonTerminalSessionStarted = "TerminalSessionStarted"
+	EventReasonTerminalSessionEnded   = "TerminalSessionEnded"
+
+	EventReasonAPICall = "APICall"
 )
 
 func (l *AuditLogger) logEvent(objMeta metav1.ObjectMeta, gvk schema.GroupVersionKind, info EventInfo, eventType string) {
@@ -76,6 +81,12 @@ func (l *AuditLogger) LogAppProjEvent(proj *v1alpha1.AppProject, info EventInfo,
 	l.logEvent(proj.ObjectMeta, v1alpha1.AppProjectSchemaGroupVersionKind, info, eventType)
 }
 
+// LogObjectEvent records an event of the object of the given kind and name in the namespace of the
+// logger, e.g. of an application which no longer exists
+func (l *AuditLogger) LogObjectEvent(gvk schema.GroupVersionKind, name string, info EventInfo, eventType string) {
+	l.logEvent(metav1.ObjectMeta{Name: name, Namespace: l.ns}, gvk, info, eventType)
+}
+
 func NewAuditLogger(ns string, kIf kubernetes.Interface, component string) *AuditLogger {
 	return &AuditLogger{
 		ns:        ns,
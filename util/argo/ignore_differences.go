@@ -0,0 +1,38 @@
+package argo
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	jsonutil "github.com/argoproj/argo-cd/util/json"
+)
+
+// RemoveIgnoredDifferences returns copies of the objects without the fields ignored by the
+// application, so that they are not compared. Objects without ignored fields are returned as is.
+func RemoveIgnoredDifferences(ignored []argoappv1.ResourceIgnoreDifferences, objs []*unstructured.Unstructured) ([]*unstructured.Unstructured, error) {
+	if len(ignored) == 0 {
+		return objs, nil
+	}
+	result := make([]*unstructured.Unstructured, len(objs))
+	for i, obj := range objs {
+		result[i] = obj
+		if obj == nil {
+			continue
+		}
+		for _, ignore := range ignored {
+			if !ignore.Matches(obj) {
+				continue
+			}
+			if result[i] == obj {
+				result[i] = obj.DeepCopy()
+			}
+			for _, pointer := range ignore.JSONPointers {
+				err := jsonutil.RemoveJSONPointer(result[i].Object, pointer)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,78 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/diff"
+)
+
+func newDeployment(name string, replicas int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":        name,
+			"annotations": map[string]interface{}{"example.com/injected": "true"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app"},
+						map[string]interface{}{"name": "sidecar"},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func TestRemoveIgnoredDifferences(t *testing.T) {
+	ignored := []argoappv1.ResourceIgnoreDifferences{{
+		Group:        "apps",
+		Kind:         "Deployment",
+		Name:         "guestbook",
+		JSONPointers: []string{"/spec/replicas", "/metadata/annotations/example.com~1injected", "/spec/template/spec/containers/1", "/status"},
+	}}
+	guestbook := newDeployment("guestbook", 3)
+	other := newDeployment("other", 3)
+	objs, err := RemoveIgnoredDifferences(ignored, []*unstructured.Unstructured{guestbook, other, nil})
+	assert.Nil(t, err)
+	assert.Len(t, objs, 3)
+
+	_, ok := objs[0].Object["spec"].(map[string]interface{})["replicas"]
+	assert.False(t, ok)
+	assert.Empty(t, objs[0].GetAnnotations())
+	containers, _, _ := unstructured.NestedSlice(objs[0].Object, "spec", "template", "spec", "containers")
+	assert.Len(t, containers, 1)
+	// the original object is left untouched, and other resources are not modified
+	assert.Equal(t, newDeployment("guestbook", 3), guestbook)
+	assert.True(t, objs[1] == other)
+	assert.Nil(t, objs[2])
+
+	_, err = RemoveIgnoredDifferences([]argoappv1.ResourceIgnoreDifferences{{Group: "apps", Kind: "Deployment", JSONPointers: []string{"spec"}}}, []*unstructured.Unstructured{guestbook})
+	assert.NotNil(t, err)
+}
+
+func TestRemoveIgnoredDifferencesDiff(t *testing.T) {
+	ignored := []argoappv1.ResourceIgnoreDifferences{{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}}}
+	targetObjs := []*unstructured.Unstructured{newDeployment("guestbook", 1)}
+	liveObjs := []*unstructured.Unstructured{newDeployment("guestbook", 5)}
+
+	diffResults, err := diff.DiffArray(targetObjs, liveObjs)
+	assert.Nil(t, err)
+	assert.True(t, diffResults.Modified)
+
+	targetObjs, err = RemoveIgnoredDifferences(ignored, targetObjs)
+	assert.Nil(t, err)
+	liveObjs, err = RemoveIgnoredDifferences(ignored, liveObjs)
+	assert.Nil(t, err)
+	diffResults, err = diff.DiffArray(targetObjs, liveObjs)
+	assert.Nil(t, err)
+	assert.False(t, diffResults.Modified)
+}
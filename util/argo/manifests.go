@@ -0,0 +1,110 @@
+package argo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/ghodss/yaml"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// ManifestFormatYAML formats manifests as a multi-document YAML stream
+	ManifestFormatYAML = "yaml"
+	// ManifestFormatJSON formats manifests as a JSON array
+	ManifestFormatJSON = "json"
+	// ManifestFormatJSONLines formats manifests as one JSON document per line
+	ManifestFormatJSONLines = "jsonl"
+)
+
+// IsValidManifestFormat returns whether or not the format is one of the supported manifest formats
+func IsValidManifestFormat(format string) bool {
+	switch format {
+	case ManifestFormatYAML, ManifestFormatJSON, ManifestFormatJSONLines:
+		return true
+	}
+	return false
+}
+
+// GetManifestFormat returns the manifest format configured in the application annotations, or the
+// given default if the application does not override it
+func GetManifestFormat(app *argoappv1.Application, defaultFormat string) string {
+	format, ok := app.Annotations[common.AnnotationKeyManifestFormat]
+	if !ok {
+		return defaultFormat
+	}
+	if !IsValidManifestFormat(format) {
+		log.Warnf("Ignoring invalid manifest format '%s' of app '%s'", format, app.Name)
+		return defaultFormat
+	}
+	return format
+}
+
+// SortManifests sorts JSON manifests by group, kind, namespace and name, so that the manifests of an
+// application are always returned in the same order
+func SortManifests(manifests []string) ([]*unstructured.Unstructured, error) {
+	objs := make([]*unstructured.Unstructured, len(manifests))
+	for i, manifest := range manifests {
+		obj, err := argoappv1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, err
+		}
+		objs[i] = obj
+	}
+	sort.SliceStable(objs, func(i, j int) bool {
+		return manifestSortKey(objs[i]) < manifestSortKey(objs[j])
+	})
+	return objs, nil
+}
+
+func manifestSortKey(obj *unstructured.Unstructured) string {
+	if obj == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", obj.GroupVersionKind().Group, obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
+
+// FormatManifests returns the JSON manifests sorted and formatted in the given format
+func FormatManifests(manifests []string, format string) (string, error) {
+	objs, err := SortManifests(manifests)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	switch format {
+	case ManifestFormatYAML:
+		for _, obj := range objs {
+			yamlBytes, err := yaml.Marshal(obj)
+			if err != nil {
+				return "", err
+			}
+			buf.WriteString("---\n")
+			buf.Write(yamlBytes)
+		}
+	case ManifestFormatJSON:
+		jsonBytes, err := json.MarshalIndent(objs, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		buf.Write(jsonBytes)
+		buf.WriteString("\n")
+	case ManifestFormatJSONLines:
+		for _, obj := range objs {
+			jsonBytes, err := json.Marshal(obj)
+			if err != nil {
+				return "", err
+			}
+			buf.Write(jsonBytes)
+			buf.WriteString("\n")
+		}
+	default:
+		return "", fmt.Errorf("unknown manifest format '%s'", format)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,59 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/common"
+	argoappv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+var testManifests = []string{
+	`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui","namespace":"default"}}`,
+	`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook-ui","namespace":"default"}}`,
+	`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"default"}}`,
+}
+
+func TestFormatManifests(t *testing.T) {
+	out, err := FormatManifests(testManifests, ManifestFormatJSONLines)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"default"}}
+{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui","namespace":"default"}}
+{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook-ui","namespace":"default"}}
+`, out)
+
+	out, err = FormatManifests(testManifests[:1], ManifestFormatYAML)
+	assert.Nil(t, err)
+	assert.Equal(t, `---
+apiVersion: v1
+kind: Service
+metadata:
+  name: guestbook-ui
+  namespace: default
+`, out)
+
+	out, err = FormatManifests(testManifests, ManifestFormatJSON)
+	assert.Nil(t, err)
+	assert.Contains(t, out, `"kind": "ConfigMap"`)
+
+	// the order of the manifests does not depend on the order they were generated in
+	reordered, err := FormatManifests([]string{testManifests[2], testManifests[0], testManifests[1]}, ManifestFormatJSON)
+	assert.Nil(t, err)
+	assert.Equal(t, out, reordered)
+
+	_, err = FormatManifests(testManifests, "xml")
+	assert.NotNil(t, err)
+}
+
+func TestGetManifestFormat(t *testing.T) {
+	app := &argoappv1.Application{}
+	assert.Equal(t, ManifestFormatYAML, GetManifestFormat(app, ManifestFormatYAML))
+
+	app.ObjectMeta = metav1.ObjectMeta{Annotations: map[string]string{common.AnnotationKeyManifestFormat: "jsonl"}}
+	assert.Equal(t, ManifestFormatJSONLines, GetManifestFormat(app, ManifestFormatYAML))
+
+	app.Annotations[common.AnnotationKeyManifestFormat] = "xml"
+	assert.Equal(t, ManifestFormatYAML, GetManifestFormat(app, ManifestFormatYAML))
+}
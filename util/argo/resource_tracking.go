@@ -0,0 +1,163 @@
+package argo
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+const (
+	// TrackingMethodLabel tracks the resources of applications with the application name label
+	TrackingMethodLabel = "label"
+	// TrackingMethodAnnotation tracks the resources of applications with the tracking id annotation
+	TrackingMethodAnnotation = "annotation"
+	// TrackingMethodAnnotationAndLabel tracks the resources of applications with the tracking id
+	// annotation, and also sets the application name label for the tools which rely on it
+	TrackingMethodAnnotationAndLabel = "annotation+label"
+)
+
+// IsValidTrackingMethod returns whether or not the method is one of the supported tracking methods
+func IsValidTrackingMethod(method string) bool {
+	switch method {
+	case TrackingMethodLabel, TrackingMethodAnnotation, TrackingMethodAnnotationAndLabel:
+		return true
+	}
+	return false
+}
+
+// ResourceTracking identifies the application which a resource belongs to
+type ResourceTracking struct {
+	// Method is the tracking method. An empty method tracks resources with the label.
+	Method string
+	// Namespace is the namespace of the applications, which is encoded in the tracking ids so that
+	// several ArgoCD instances can manage the same cluster
+	Namespace string
+}
+
+// NewResourceTracking returns the tracking of the resources of the applications in the namespace.
+// Invalid methods fall back to tracking by label.
+func NewResourceTracking(method, namespace string) ResourceTracking {
+	if method != "" && !IsValidTrackingMethod(method) {
+		log.Warnf("Ignoring invalid resource tracking method '%s'", method)
+		method = TrackingMethodLabel
+	}
+	return ResourceTracking{Method: method, Namespace: namespace}
+}
+
+// UsesLabel returns whether tracked resources have the application name label
+func (rt ResourceTracking) UsesLabel() bool {
+	return rt.Method != TrackingMethodAnnotation
+}
+
+// UsesAnnotation returns whether resources are tracked by their tracking id annotation
+func (rt ResourceTracking) UsesAnnotation() bool {
+	return rt.Method == TrackingMethodAnnotation || rt.Method == TrackingMethodAnnotationAndLabel
+}
+
+// trackingID returns the tracking id of the object in the application. The id identifies the
+// object, so that the annotation is ignored when copied to another resource (e.g. pods of a
+// deployment, or a resource cloned by another tool).
+func (rt ResourceTracking) trackingID(appName string, obj *unstructured.Unstructured, namespace string) string {
+	gvk := obj.GroupVersionKind()
+	if obj.GetNamespace() != "" {
+		namespace = obj.GetNamespace()
+	}
+	return fmt.Sprintf("%s/%s:%s/%s:%s/%s", rt.Namespace, appName, gvk.Group, gvk.Kind, namespace, obj.GetName())
+}
+
+// parseTrackingID returns the application name encoded in the tracking id, if the id identifies
+// the object. The namespace of cluster scoped objects is not compared. Resource names may contain
+// colons (e.g. RBAC resources), so the id is split on its first two colons only.
+func (rt ResourceTracking) parseTrackingID(id string, obj *unstructured.Unstructured) string {
+	parts := strings.SplitN(id, ":", 3)
+	if len(parts) != 3 {
+		return ""
+	}
+	app := strings.SplitN(parts[0], "/", 2)
+	resource := strings.SplitN(parts[2], "/", 2)
+	if len(app) != 2 || len(resource) != 2 || app[0] != rt.Namespace {
+		return ""
+	}
+	gvk := obj.GroupVersionKind()
+	if parts[1] != gvk.Group+"/"+gvk.Kind || resource[1] != obj.GetName() {
+		return ""
+	}
+	if obj.GetNamespace() != "" && resource[0] != obj.GetNamespace() {
+		return ""
+	}
+	return app[1]
+}
+
+// GetAppName returns the name of the application which the object belongs to, or an empty string
+func (rt ResourceTracking) GetAppName(obj *unstructured.Unstructured) string {
+	if !rt.UsesAnnotation() {
+		return obj.GetLabels()[common.LabelApplicationName]
+	}
+	id, ok := obj.GetAnnotations()[common.AnnotationKeyAppInstance]
+	if !ok {
+		return ""
+	}
+	return rt.parseTrackingID(id, obj)
+}
+
+// SetAppInstance marks the object as a resource of the application. Objects without namespace are
+// expected to be created in the given namespace.
+func (rt ResourceTracking) SetAppInstance(obj *unstructured.Unstructured, appName, namespace string) error {
+	if rt.UsesLabel() {
+		err := kube.SetLabel(obj, common.LabelApplicationName, appName)
+		if err != nil {
+			return err
+		}
+	}
+	if rt.UsesAnnotation() {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[common.AnnotationKeyAppInstance] = rt.trackingID(appName, obj, namespace)
+		obj.SetAnnotations(annotations)
+	}
+	return nil
+}
+
+// GetAppResources returns the live resources of the application in the namespace of the cluster,
+// except the ones excluded by the filter. Resources tracked by annotation only are found by listing
+// all resources of the namespace.
+func (rt ResourceTracking) GetAppResources(config *rest.Config, namespace, appName string, filter kube.ResourceFilter) ([]*unstructured.Unstructured, error) {
+	if !rt.UsesAnnotation() {
+		return kube.GetResourcesWithLabel(config, namespace, common.LabelApplicationName, appName, filter)
+	}
+	labelSelector := ""
+	if rt.UsesLabel() {
+		labelSelector = fmt.Sprintf("%s=%s", common.LabelApplicationName, appName)
+	}
+	return kube.GetResources(config, namespace, labelSelector, func(obj *unstructured.Unstructured) bool {
+		return rt.GetAppName(obj) == appName
+	}, filter)
+}
+
+// DeleteAppResources deletes the resources of the application in the namespace of the cluster,
+// whose live state is given when resources are tracked by annotation
+func (rt ResourceTracking) DeleteAppResources(config *rest.Config, namespace, appName string, liveObjs []*unstructured.Unstructured) error {
+	if !rt.UsesAnnotation() {
+		return kube.DeleteResourceWithLabel(config, namespace, common.LabelApplicationName, appName)
+	}
+	for _, obj := range liveObjs {
+		if obj.GetDeletionTimestamp() != nil {
+			continue
+		}
+		err := kube.DeleteResource(config, obj, obj.GetNamespace(), metav1.DeletePropagationForeground)
+		if err != nil && !apierr.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
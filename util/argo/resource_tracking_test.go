@@ -0,0 +1,77 @@
+package argo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/common"
+)
+
+func newTrackedObj(apiVersion, kind, namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestResourceTrackingByLabel(t *testing.T) {
+	rt := NewResourceTracking("", "argocd")
+	obj := newTrackedObj("v1", "Service", "", "guestbook")
+	assert.NoError(t, rt.SetAppInstance(obj, "guestbook", "default"))
+	assert.Equal(t, "guestbook", obj.GetLabels()[common.LabelApplicationName])
+	assert.Empty(t, obj.GetAnnotations())
+	assert.Equal(t, "guestbook", rt.GetAppName(obj))
+}
+
+func TestResourceTrackingByAnnotation(t *testing.T) {
+	rt := NewResourceTracking(TrackingMethodAnnotation, "argocd")
+	obj := newTrackedObj("apps/v1", "Deployment", "", "guestbook")
+	assert.NoError(t, rt.SetAppInstance(obj, "guestbook", "default"))
+	assert.Empty(t, obj.GetLabels())
+	assert.Equal(t, "argocd/guestbook:apps/Deployment:default/guestbook", obj.GetAnnotations()[common.AnnotationKeyAppInstance])
+
+	live := newTrackedObj("apps/v1", "Deployment", "default", "guestbook")
+	live.SetAnnotations(obj.GetAnnotations())
+	assert.Equal(t, "guestbook", rt.GetAppName(live))
+
+	// the annotation is ignored when copied to another resource
+	copied := newTrackedObj("v1", "Pod", "default", "guestbook-5d8f9")
+	copied.SetAnnotations(obj.GetAnnotations())
+	assert.Empty(t, rt.GetAppName(copied))
+	copied = newTrackedObj("apps/v1", "Deployment", "staging", "guestbook")
+	copied.SetAnnotations(obj.GetAnnotations())
+	assert.Empty(t, rt.GetAppName(copied))
+
+	// resources of applications of another instance are not tracked
+	assert.Empty(t, NewResourceTracking(TrackingMethodAnnotation, "argocd-staging").GetAppName(live))
+
+	// labels are ignored
+	labeled := newTrackedObj("apps/v1", "Deployment", "default", "guestbook")
+	labeled.SetLabels(map[string]string{common.LabelApplicationName: "guestbook"})
+	assert.Empty(t, rt.GetAppName(labeled))
+
+	// names may contain colons
+	role := newTrackedObj("rbac.authorization.k8s.io/v1", "ClusterRole", "", "system:guestbook")
+	assert.NoError(t, rt.SetAppInstance(role, "guestbook", "default"))
+	assert.Equal(t, "argocd/guestbook:rbac.authorization.k8s.io/ClusterRole:default/system:guestbook", role.GetAnnotations()[common.AnnotationKeyAppInstance])
+	assert.Equal(t, "guestbook", rt.GetAppName(role))
+}
+
+func TestResourceTrackingByAnnotationAndLabel(t *testing.T) {
+	rt := NewResourceTracking(TrackingMethodAnnotationAndLabel, "argocd")
+	obj := newTrackedObj("rbac.authorization.k8s.io/v1", "ClusterRole", "", "guestbook")
+	assert.NoError(t, rt.SetAppInstance(obj, "guestbook", "default"))
+	assert.Equal(t, "guestbook", obj.GetLabels()[common.LabelApplicationName])
+	assert.Equal(t, "guestbook", rt.GetAppName(obj))
+}
+
+func TestNewResourceTrackingInvalidMethod(t *testing.T) {
+	rt := NewResourceTracking("uid", "argocd")
+	assert.Equal(t, TrackingMethodLabel, rt.Method)
+	assert.True(t, rt.UsesLabel())
+	assert.False(t, rt.UsesAnnotation())
+}
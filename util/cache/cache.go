@@ -18,3 +18,9 @@ type Cache interface {
 	Set(item *Item) error
 	Get(key string, obj interface{}) error
 }
+
+// Sizer is implemented by caches which hold their items in the memory of the process
+type Sizer interface {
+	// Size returns the number of bytes used by the keys and encoded objects of the unexpired items
+	Size() int64
+}
@@ -32,3 +32,19 @@ func TestCache(t *testing.T) {
 	assert.EqualValues(t, string(obj.Bar), "bar")
 
 }
+
+func TestInMemoryCacheSize(t *testing.T) {
+	c := NewInMemoryCache(time.Hour)
+	sizer, ok := c.(Sizer)
+	assert.True(t, ok)
+	assert.Equal(t, int64(0), sizer.Size())
+
+	err := c.Set(&Item{Key: "key", Object: &testStruct{Foo: "foo", Bar: []byte("bar")}})
+	assert.Nil(t, err)
+	size := sizer.Size()
+	assert.True(t, size > int64(len("key")))
+
+	err = c.Set(&Item{Key: "other", Object: &testStruct{Foo: "foo", Bar: []byte("bar")}})
+	assert.Nil(t, err)
+	assert.True(t, sizer.Size() > size)
+}
@@ -36,3 +36,14 @@ func (i *inMemoryCache) Get(key string, obj interface{}) error {
 	buf := bufIf.(bytes.Buffer)
 	return gob.NewDecoder(&buf).Decode(obj)
 }
+
+func (i *inMemoryCache) Size() int64 {
+	var size int64
+	for key, item := range i.memCache.Items() {
+		size += int64(len(key))
+		if buf, ok := item.Object.(bytes.Buffer); ok {
+			size += int64(buf.Len())
+		}
+	}
+	return size
+}
@@ -0,0 +1,95 @@
+package cors
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// allowedMethods are the methods of the REST API which cross-origin requests may use
+	allowedMethods = "GET, POST, PUT, PATCH, DELETE"
+	// exposedHeaders are the response headers which cross-origin clients may read
+	exposedHeaders = "Retry-After, Grpc-Metadata-Content-Type"
+	// preflightMaxAge is the number of seconds browsers may cache the result of preflight requests
+	preflightMaxAge = 600
+)
+
+// Policy is the cross-origin resource sharing policy of the REST API
+type Policy struct {
+	// AllowedOrigins are the origins, e.g. https://dashboard.example.com, allowed to call the API from
+	// browsers. The * origin allows any origin.
+	AllowedOrigins []string
+	// AllowCredentials allows the requests of the allowed origins to include the cookies and the
+	// authorization header of the user
+	AllowCredentials bool
+}
+
+// Enabled returns whether or not any origin is allowed
+func (p Policy) Enabled() bool {
+	return len(p.AllowedOrigins) > 0
+}
+
+// Validate returns an error if the policy allows any origin to make credentialed requests, since any
+// website visited by a logged in user could then call the API on their behalf
+func (p Policy) Validate() error {
+	if !p.AllowCredentials {
+		return nil
+	}
+	for _, allowed := range p.AllowedOrigins {
+		if allowed == "*" {
+			return fmt.Errorf("the * origin cannot be allowed to make credentialed requests")
+		}
+	}
+	return nil
+}
+
+// IsOriginAllowed returns whether or not the origin is allowed by the policy
+func (p Policy) IsOriginAllowed(origin string) bool {
+	if origin == "" {
+		return false
+	}
+	for _, allowed := range p.AllowedOrigins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewHandler returns a handler which applies the policy to the requests of the handler. The allowed
+// origin is echoed rather than set to *, since browsers reject wildcards in credentialed responses.
+// Preflight requests are answered without calling the handler, and fail with 403 if the origin is
+// not allowed. Other requests of origins which are not allowed are served without CORS headers, so
+// browsers do not expose their response.
+func NewHandler(policy Policy, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		w.Header().Add("Vary", "Origin")
+		if !policy.IsOriginAllowed(origin) {
+			if preflight {
+				http.Error(w, "Origin not allowed", http.StatusForbidden)
+				return
+			}
+			handler.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		if policy.AllowCredentials {
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+		if preflight {
+			w.Header().Set("Access-Control-Allow-Methods", allowedMethods)
+			if headers := r.Header.Get("Access-Control-Request-Headers"); headers != "" {
+				w.Header().Set("Access-Control-Allow-Headers", headers)
+			}
+			w.Header().Set("Access-Control-Max-Age", strconv.Itoa(preflightMaxAge))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Access-Control-Expose-Headers", exposedHeaders)
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,67 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsOriginAllowed(t *testing.T) {
+	policy := Policy{AllowedOrigins: []string{"https://dashboard.example.com/"}}
+	assert.True(t, policy.IsOriginAllowed("https://dashboard.example.com"))
+	assert.True(t, policy.IsOriginAllowed("https://Dashboard.example.com"))
+	assert.False(t, policy.IsOriginAllowed("https://evil.example.com"))
+	assert.False(t, policy.IsOriginAllowed(""))
+	assert.True(t, Policy{AllowedOrigins: []string{"*"}}.IsOriginAllowed("https://evil.example.com"))
+	assert.False(t, Policy{}.Enabled())
+}
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, Policy{AllowedOrigins: []string{"*"}}.Validate())
+	assert.Nil(t, Policy{AllowedOrigins: []string{"https://dashboard.example.com"}, AllowCredentials: true}.Validate())
+	assert.NotNil(t, Policy{AllowedOrigins: []string{"https://dashboard.example.com", "*"}, AllowCredentials: true}.Validate())
+}
+
+func TestHandler(t *testing.T) {
+	served := false
+	handler := NewHandler(Policy{AllowedOrigins: []string{"https://dashboard.example.com"}, AllowCredentials: true}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = true
+	}))
+
+	req := httptest.NewRequest("OPTIONS", "/api/v1/applications", nil)
+	req.Header.Set("Origin", "https://dashboard.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	req.Header.Set("Access-Control-Request-Headers", "Authorization, Content-Type")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.False(t, served)
+	assert.Equal(t, "https://dashboard.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "true", w.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Equal(t, "Authorization, Content-Type", w.Header().Get("Access-Control-Allow-Headers"))
+
+	req = httptest.NewRequest("OPTIONS", "/api/v1/applications", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.False(t, served)
+
+	req = httptest.NewRequest("GET", "/api/v1/applications", nil)
+	req.Header.Set("Origin", "https://dashboard.example.com")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.True(t, served)
+	assert.Equal(t, "https://dashboard.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+
+	served = false
+	req = httptest.NewRequest("GET", "/api/v1/applications", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	assert.True(t, served)
+	assert.Empty(t, w.Header().Get("Access-Control-Allow-Origin"))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/argoproj/argo-cd/common"
 	appv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,8 +34,12 @@ type ArgoDB interface {
 	GetRepository(ctx context.Context, name string) (*appv1.Repository, error)
 	// UpdateRepository updates a repository
 	UpdateRepository(ctx context.Context, r *appv1.Repository) (*appv1.Repository, error)
+	// UpdateRepositoryCredentials replaces the credentials of a repository
+	UpdateRepositoryCredentials(ctx context.Context, repo, username, password, sshPrivateKey string) (*appv1.Repository, error)
 	// DeleteRepository updates a repository
 	DeleteRepository(ctx context.Context, name string) error
+	// ReconcileRepositories creates or updates the given declaratively configured repositories
+	ReconcileRepositories(ctx context.Context, repos []settings.RepoCredentials) error
 }
 
 type db struct {
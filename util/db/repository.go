@@ -8,6 +8,7 @@ import (
 	"github.com/argoproj/argo-cd/common"
 	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/util/git"
+	"github.com/argoproj/argo-cd/util/settings"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -47,18 +48,20 @@ func (s *db) CreateRepository(ctx context.Context, r *appsv1.Repository) (*appsv
 	r = &shallowCopy
 	r.Repo = git.NormalizeGitURL(r.Repo)
 	r.Username = strings.TrimSpace(r.Username)
+	err := validateRepoMetadata(r)
+	if err != nil {
+		return nil, err
+	}
 	secName := repoURLToSecretName(r.Repo)
 	repoSecret := &apiv1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: secName,
-			Labels: map[string]string{
-				common.LabelKeySecretType: common.SecretTypeRepository,
-			},
 		},
 	}
 	repoSecret.Data = repoToData(r)
-	repoSecret.Annotations = AnnotationsFromConnectionState(&r.ConnectionState)
-	repoSecret, err := s.kubeclientset.CoreV1().Secrets(s.ns).Create(repoSecret)
+	repoSecret.Labels = repoToLabels(r)
+	repoSecret.Annotations = repoToAnnotations(r, AnnotationsFromConnectionState(&r.ConnectionState))
+	repoSecret, err = s.kubeclientset.CoreV1().Secrets(s.ns).Create(repoSecret)
 	if err != nil {
 		if apierr.IsAlreadyExists(err) {
 			return nil, status.Errorf(codes.AlreadyExists, "repository '%s' already exists", r.Repo)
@@ -79,7 +82,11 @@ func (s *db) GetRepository(ctx context.Context, name string) (*appsv1.Repository
 
 // UpdateRepository updates a repository
 func (s *db) UpdateRepository(ctx context.Context, r *appsv1.Repository) (*appsv1.Repository, error) {
-	err := git.TestRepo(r.Repo, r.Username, r.Password, r.SSHPrivateKey)
+	err := validateRepoMetadata(r)
+	if err != nil {
+		return nil, err
+	}
+	err = git.TestRepo(r.Repo, r.Username, r.Password, r.SSHPrivateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +95,8 @@ func (s *db) UpdateRepository(ctx context.Context, r *appsv1.Repository) (*appsv
 		return nil, err
 	}
 	repoSecret.Data = repoToData(r)
+	repoSecret.Labels = repoToLabels(r)
+	repoSecret.Annotations = repoToAnnotations(r, repoSecret.Annotations)
 	repoSecret, err = s.kubeclientset.CoreV1().Secrets(s.ns).Update(repoSecret)
 	if err != nil {
 		return nil, err
@@ -95,12 +104,121 @@ func (s *db) UpdateRepository(ctx context.Context, r *appsv1.Repository) (*appsv
 	return SecretToRepo(repoSecret), nil
 }
 
+// UpdateRepositoryCredentials replaces the credentials of a repository, leaving the rest of its
+// settings untouched. The repository is tested with the new credentials first, so that the existing
+// credentials are kept if the new ones do not work. Fails with codes.Aborted if the repository was
+// modified concurrently.
+func (s *db) UpdateRepositoryCredentials(ctx context.Context, repo, username, password, sshPrivateKey string) (*appsv1.Repository, error) {
+	repoSecret, err := s.getRepoSecret(repo)
+	if err != nil {
+		return nil, err
+	}
+	r := SecretToRepo(repoSecret)
+	r.Username = strings.TrimSpace(username)
+	r.Password = password
+	r.SSHPrivateKey = sshPrivateKey
+	err = git.TestRepo(r.Repo, r.Username, r.Password, r.SSHPrivateKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to access repository with the new credentials: %v", err)
+	}
+	if repoSecret.Data == nil {
+		repoSecret.Data = make(map[string][]byte)
+	}
+	repoSecret.Data["username"] = []byte(r.Username)
+	repoSecret.Data["password"] = []byte(r.Password)
+	repoSecret.Data["sshPrivateKey"] = []byte(r.SSHPrivateKey)
+	now := metav1.Now()
+	connectionState := appsv1.ConnectionState{Status: appsv1.ConnectionStatusSuccessful, ModifiedAt: &now}
+	if repoSecret.Annotations == nil {
+		repoSecret.Annotations = make(map[string]string)
+	}
+	for k, v := range AnnotationsFromConnectionState(&connectionState) {
+		repoSecret.Annotations[k] = v
+	}
+	// the update fails with a conflict if the secret changed since it was read
+	repoSecret, err = s.kubeclientset.CoreV1().Secrets(s.ns).Update(repoSecret)
+	if err != nil {
+		if apierr.IsConflict(err) {
+			return nil, status.Errorf(codes.Aborted, "repository '%s' was modified concurrently, please retry", repo)
+		}
+		return nil, err
+	}
+	return SecretToRepo(repoSecret), nil
+}
+
 // Delete updates a repository
 func (s *db) DeleteRepository(ctx context.Context, name string) error {
 	secName := repoURLToSecretName(name)
 	return s.kubeclientset.CoreV1().Secrets(s.ns).Delete(secName, &metav1.DeleteOptions{})
 }
 
+// ReconcileRepositories creates or updates the given declaratively configured repositories, resolving
+// their credentials from the referenced secrets. Repositories which are not declared are left untouched.
+func (s *db) ReconcileRepositories(ctx context.Context, repos []settings.RepoCredentials) error {
+	var errs []string
+	for _, repoCreds := range repos {
+		err := s.reconcileRepository(ctx, repoCreds)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", repoCreds.URL, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to reconcile repositories: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *db) reconcileRepository(ctx context.Context, repoCreds settings.RepoCredentials) error {
+	if repoCreds.URL == "" {
+		return fmt.Errorf("repository url is missing")
+	}
+	desired := appsv1.Repository{Repo: git.NormalizeGitURL(repoCreds.URL)}
+	var err error
+	if desired.Username, err = s.getSecretValue(repoCreds.UsernameSecret); err != nil {
+		return err
+	}
+	if desired.Password, err = s.getSecretValue(repoCreds.PasswordSecret); err != nil {
+		return err
+	}
+	if desired.SSHPrivateKey, err = s.getSecretValue(repoCreds.SSHPrivateKeySecret); err != nil {
+		return err
+	}
+	existing, err := s.GetRepository(ctx, desired.Repo)
+	if err != nil {
+		if status.Convert(err).Code() != codes.NotFound {
+			return err
+		}
+		_, err = s.CreateRepository(ctx, &desired)
+		return err
+	}
+	if existing.Username == strings.TrimSpace(desired.Username) && existing.Password == desired.Password && existing.SSHPrivateKey == desired.SSHPrivateKey {
+		return nil
+	}
+	// only the credentials are configured declaratively, the other settings of the repository are kept
+	updated := *existing
+	updated.Username = desired.Username
+	updated.Password = desired.Password
+	updated.SSHPrivateKey = desired.SSHPrivateKey
+	_, err = s.UpdateRepository(ctx, &updated)
+	return err
+}
+
+// getSecretValue returns the value referenced by the secret selector, or an empty string if selector is nil
+func (s *db) getSecretValue(selector *apiv1.SecretKeySelector) (string, error) {
+	if selector == nil {
+		return "", nil
+	}
+	secret, err := s.kubeclientset.CoreV1().Secrets(s.ns).Get(selector.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("key '%s' not found in secret '%s'", selector.Key, selector.Name)
+	}
+	return string(value), nil
+}
+
 func (s *db) getRepoSecret(repo string) (*apiv1.Secret, error) {
 	secName := repoURLToSecretName(repo)
 	repoSecret, err := s.kubeclientset.CoreV1().Secrets(s.ns).Get(secName, metav1.GetOptions{})
@@ -127,11 +245,73 @@ func repoURLToSecretName(repo string) string {
 // repoToData converts a repository object to secret data for serialization to a secret
 func repoToData(r *appsv1.Repository) map[string][]byte {
 	return map[string][]byte{
-		"repository":    []byte(r.Repo),
-		"username":      []byte(r.Username),
-		"password":      []byte(r.Password),
-		"sshPrivateKey": []byte(r.SSHPrivateKey),
+		"repository":      []byte(r.Repo),
+		"username":        []byte(r.Username),
+		"password":        []byte(r.Password),
+		"sshPrivateKey":   []byte(r.SSHPrivateKey),
+		"defaultRevision": []byte(r.DefaultRevision),
+	}
+}
+
+// isReservedMetadataKey returns whether the label or annotation key is reserved for use by ArgoCD
+func isReservedMetadataKey(key string) bool {
+	return strings.HasPrefix(key, common.MetadataPrefix+"/")
+}
+
+// validateRepoMetadata verifies the repository labels and annotations do not use keys reserved by ArgoCD
+func validateRepoMetadata(r *appsv1.Repository) error {
+	for key := range r.Labels {
+		if isReservedMetadataKey(key) {
+			return status.Errorf(codes.InvalidArgument, "repository label '%s' is reserved", key)
+		}
+	}
+	for key := range r.Annotations {
+		if isReservedMetadataKey(key) {
+			return status.Errorf(codes.InvalidArgument, "repository annotation '%s' is reserved", key)
+		}
+	}
+	return nil
+}
+
+// repoToLabels returns the secret labels of a repository, i.e. its own labels plus the secret type
+func repoToLabels(r *appsv1.Repository) map[string]string {
+	labels := map[string]string{
+		common.LabelKeySecretType: common.SecretTypeRepository,
+	}
+	for k, v := range r.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+// repoToAnnotations returns the secret annotations of a repository, i.e. its own annotations plus
+// the reserved ones (e.g. connection state) of the given annotations
+func repoToAnnotations(r *appsv1.Repository, annotations map[string]string) map[string]string {
+	res := make(map[string]string)
+	for k, v := range annotations {
+		if isReservedMetadataKey(k) {
+			res[k] = v
+		}
+	}
+	for k, v := range r.Annotations {
+		res[k] = v
+	}
+	return res
+}
+
+// unreservedMetadata returns the labels or annotations which are not reserved by ArgoCD, or nil if there are none
+func unreservedMetadata(metadata map[string]string) map[string]string {
+	var res map[string]string
+	for k, v := range metadata {
+		if isReservedMetadataKey(k) {
+			continue
+		}
+		if res == nil {
+			res = make(map[string]string)
+		}
+		res[k] = v
 	}
+	return res
 }
 
 // SecretToRepo converts a secret into a repository object, optionally redacting sensitive information
@@ -141,7 +321,10 @@ func SecretToRepo(s *apiv1.Secret) *appsv1.Repository {
 		Username:        string(s.Data["username"]),
 		Password:        string(s.Data["password"]),
 		SSHPrivateKey:   string(s.Data["sshPrivateKey"]),
+		DefaultRevision: string(s.Data["defaultRevision"]),
 		ConnectionState: ConnectionStateFromAnnotations(s.Annotations),
+		Labels:          unreservedMetadata(s.Labels),
+		Annotations:     unreservedMetadata(s.Annotations),
 	}
 	return &repo
 }
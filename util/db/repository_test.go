@@ -1,6 +1,22 @@
 package db
 
-import "testing"
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	appsv1 "github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+const testNamespace = "default"
 
 func TestRepoURLToSecretName(t *testing.T) {
 	tables := map[string]string{
@@ -18,3 +34,67 @@ func TestRepoURLToSecretName(t *testing.T) {
 		}
 	}
 }
+
+func TestReconcileRepositories(t *testing.T) {
+	credsSecret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "repo-creds", Namespace: testNamespace},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("secret"),
+		},
+	}
+	kubeclientset := fake.NewSimpleClientset(credsSecret)
+	argoDB := NewDB(testNamespace, kubeclientset)
+
+	err := argoDB.ReconcileRepositories(context.Background(), []settings.RepoCredentials{{
+		URL:            "https://github.com/argoproj/argo-cd",
+		UsernameSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "repo-creds"}, Key: "username"},
+		PasswordSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "repo-creds"}, Key: "password"},
+	}})
+	assert.Nil(t, err)
+
+	repo, err := argoDB.GetRepository(context.Background(), "https://github.com/argoproj/argo-cd")
+	assert.Nil(t, err)
+	assert.Equal(t, "admin", repo.Username)
+	assert.Equal(t, "secret", repo.Password)
+
+	// reconciling unchanged repositories is a no-op
+	err = argoDB.ReconcileRepositories(context.Background(), []settings.RepoCredentials{{
+		URL:            "https://github.com/argoproj/argo-cd",
+		UsernameSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "repo-creds"}, Key: "username"},
+		PasswordSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "repo-creds"}, Key: "password"},
+	}})
+	assert.Nil(t, err)
+
+	err = argoDB.ReconcileRepositories(context.Background(), []settings.RepoCredentials{{
+		URL:            "https://github.com/argoproj/other-repo",
+		PasswordSecret: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "repo-creds"}, Key: "missing"},
+	}})
+	assert.NotNil(t, err)
+}
+
+func TestRepositoryLabels(t *testing.T) {
+	argoDB := NewDB(testNamespace, fake.NewSimpleClientset())
+
+	_, err := argoDB.CreateRepository(context.Background(), &appsv1.Repository{
+		Repo:            "https://github.com/argoproj/argo-cd",
+		Labels:          map[string]string{"team": "payments"},
+		Annotations:     map[string]string{"owner": "payments@example.com"},
+		DefaultRevision: "develop",
+	})
+	assert.Nil(t, err)
+
+	repos, err := argoDB.ListRepositories(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, repos.Items, 1)
+	assert.Equal(t, map[string]string{"team": "payments"}, repos.Items[0].Labels)
+	assert.Equal(t, map[string]string{"owner": "payments@example.com"}, repos.Items[0].Annotations)
+	assert.Equal(t, appsv1.ConnectionStatusUnknown, repos.Items[0].ConnectionState.Status)
+	assert.Equal(t, "develop", repos.Items[0].DefaultRevision)
+
+	_, err = argoDB.CreateRepository(context.Background(), &appsv1.Repository{
+		Repo:   "https://github.com/argoproj/other-repo",
+		Labels: map[string]string{common.LabelKeySecretType: "cluster"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+}
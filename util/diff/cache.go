@@ -0,0 +1,135 @@
+package diff
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DefaultCacheMaxEntries is the default maximum number of diff results held by a cache
+const DefaultCacheMaxEntries = 100000
+
+// Cache holds the results of the diffs of live resources with their target state, so that
+// comparisons of unchanged resources skip the diff. A result is reused as long as the resource
+// version of the live resource and the hash of the target manifest are unchanged.
+type Cache struct {
+	lock       sync.Mutex
+	entries    map[string]cacheEntry
+	maxEntries int
+	hits       int64
+	misses     int64
+}
+
+type cacheEntry struct {
+	resourceVersion string
+	configHash      string
+	result          DiffResult
+}
+
+// NewCache returns a diff cache holding at most maxEntries results. The cache is cleared once it
+// is full, which also drops the results of resources which no longer exist.
+func NewCache(maxEntries int) *Cache {
+	return &Cache{
+		entries:    make(map[string]cacheEntry),
+		maxEntries: maxEntries,
+	}
+}
+
+// DiffArray performs a diff on a list of unstructured objects like DiffArray, reusing the cached
+// results of unchanged resources. The normalization key identifies how the objects were normalized
+// before the diff (e.g. the application and its ignored differences), since it changes the result.
+// A nil cache performs every diff.
+func (c *Cache) DiffArray(configArray, liveArray []*unstructured.Unstructured, normalizationKey string) (*DiffResultList, error) {
+	if c == nil {
+		return DiffArray(configArray, liveArray)
+	}
+	numItems := len(configArray)
+	if len(liveArray) != numItems {
+		return nil, fmt.Errorf("left and right arrays have mismatched lengths")
+	}
+
+	diffResultList := DiffResultList{
+		Diffs: make([]DiffResult, numItems),
+	}
+	for i := 0; i < numItems; i++ {
+		diffRes := c.diff(configArray[i], liveArray[i], normalizationKey)
+		diffResultList.Diffs[i] = *diffRes
+		if diffRes.Modified {
+			diffResultList.Modified = true
+		}
+	}
+	return &diffResultList, nil
+}
+
+// diff returns the cached diff result of the objects, or performs the diff. Missing objects and
+// live objects without resource version are never cached.
+func (c *Cache) diff(config, live *unstructured.Unstructured, normalizationKey string) *DiffResult {
+	if config == nil || live == nil || live.GetUID() == "" || live.GetResourceVersion() == "" {
+		return Diff(config, live)
+	}
+	configHash, err := hashObject(config)
+	if err != nil {
+		return Diff(config, live)
+	}
+	key := fmt.Sprintf("%s|%s", normalizationKey, live.GetUID())
+	c.lock.Lock()
+	entry, ok := c.entries[key]
+	c.lock.Unlock()
+	if ok && entry.resourceVersion == live.GetResourceVersion() && entry.configHash == configHash {
+		atomic.AddInt64(&c.hits, 1)
+		result := entry.result
+		return &result
+	}
+	atomic.AddInt64(&c.misses, 1)
+	diffRes := Diff(config, live)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.entries) >= c.maxEntries {
+		c.entries = make(map[string]cacheEntry)
+	}
+	c.entries[key] = cacheEntry{resourceVersion: live.GetResourceVersion(), configHash: configHash, result: *diffRes}
+	return diffRes
+}
+
+func hashObject(obj *unstructured.Unstructured) (string, error) {
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Hits returns the number of diffs whose result was reused
+func (c *Cache) Hits() int64 {
+	return atomic.LoadInt64(&c.hits)
+}
+
+// Misses returns the number of diffs which were performed because no result was cached
+func (c *Cache) Misses() int64 {
+	return atomic.LoadInt64(&c.misses)
+}
+
+// WriteMetrics writes the hits and misses of the cache as counters in the Prometheus text format
+func (c *Cache) WriteMetrics(w io.Writer) error {
+	metrics := []struct {
+		name  string
+		help  string
+		value int64
+	}{
+		{"argocd_diff_cache_hits_total", "Number of resource diffs whose cached result was reused.", c.Hits()},
+		{"argocd_diff_cache_misses_total", "Number of resource diffs performed because no result was cached.", c.Misses()},
+	}
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n%s %d\n", metric.name, metric.help, metric.name, metric.name, metric.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package diff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/test"
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+func TestCacheDiffArray(t *testing.T) {
+	cache := NewCache(10)
+	config := kube.MustToUnstructured(test.DemoDeployment())
+	live := config.DeepCopy()
+	live.SetUID(types.UID("3f1d7a4e"))
+	live.SetResourceVersion("1")
+
+	diffRes, err := cache.DiffArray([]*unstructured.Unstructured{config}, []*unstructured.Unstructured{live}, "guestbook")
+	assert.Nil(t, err)
+	assert.False(t, diffRes.Modified)
+	assert.Equal(t, int64(0), cache.Hits())
+	assert.Equal(t, int64(1), cache.Misses())
+
+	// unchanged objects reuse the result
+	_, err = cache.DiffArray([]*unstructured.Unstructured{config}, []*unstructured.Unstructured{live}, "guestbook")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), cache.Hits())
+
+	// changes of the target manifest, the live resource version or the normalization are diffed
+	modified := config.DeepCopy()
+	modified.SetLabels(map[string]string{"tier": "web"})
+	diffRes, err = cache.DiffArray([]*unstructured.Unstructured{modified}, []*unstructured.Unstructured{live}, "guestbook")
+	assert.Nil(t, err)
+	assert.True(t, diffRes.Modified)
+	live.SetResourceVersion("2")
+	_, err = cache.DiffArray([]*unstructured.Unstructured{modified}, []*unstructured.Unstructured{live}, "guestbook")
+	assert.Nil(t, err)
+	_, err = cache.DiffArray([]*unstructured.Unstructured{modified}, []*unstructured.Unstructured{live}, "other")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), cache.Hits())
+	assert.Equal(t, int64(4), cache.Misses())
+
+	// missing objects are not cached
+	_, err = cache.DiffArray([]*unstructured.Unstructured{config}, []*unstructured.Unstructured{nil}, "guestbook")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), cache.Misses())
+
+	var out bytes.Buffer
+	assert.Nil(t, cache.WriteMetrics(&out))
+	assert.Contains(t, out.String(), "argocd_diff_cache_hits_total 1\n")
+	assert.Contains(t, out.String(), "argocd_diff_cache_misses_total 4\n")
+}
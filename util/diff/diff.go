@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/ghodss/yaml"
+	"github.com/pmezard/go-difflib/difflib"
 	log "github.com/sirupsen/logrus"
 	"github.com/yudai/gojsondiff"
 	"github.com/yudai/gojsondiff/formatter"
@@ -230,3 +232,49 @@ func (d *DiffResult) ASCIIFormat(left *unstructured.Unstructured, formatOpts for
 	asciiFmt := formatter.NewAsciiFormatter(left.Object, formatOpts)
 	return asciiFmt.Format(d.Diff)
 }
+
+// UnifiedFormat returns the unified diff of the YAML of a resource, from its live state to its config,
+// i.e. the changes a sync would make. As in Diff, the fields of the live object which are neither in
+// its last applied configuration, nor in the config if it has none, are left out. An empty string is
+// returned if both are equal.
+func UnifiedFormat(name string, config, live *unstructured.Unstructured) (string, error) {
+	var configObj, liveObj map[string]interface{}
+	if config != nil {
+		configObj = config.Object
+	}
+	if live != nil {
+		liveObj = live.Object
+		if orig := getLastAppliedConfigAnnotation(live); orig != nil && config != nil {
+			liveObj = jsonutil.RemoveMapFields(removeNamespaceAnnotation(orig).Object, liveObj)
+		} else if config != nil {
+			liveObj = jsonutil.RemoveMapFields(configObj, liveObj)
+		}
+	}
+	configYAML, err := marshalYAML(configObj)
+	if err != nil {
+		return "", err
+	}
+	liveYAML, err := marshalYAML(liveObj)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveYAML),
+		B:        difflib.SplitLines(configYAML),
+		FromFile: "live/" + name,
+		ToFile:   "target/" + name,
+		Context:  3,
+	})
+}
+
+// marshalYAML returns the YAML of the object, or an empty string if there is no object
+func marshalYAML(obj map[string]interface{}) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -208,3 +208,25 @@ func TestDiffActualExample(t *testing.T) {
 	}
 
 }
+
+func TestUnifiedFormat(t *testing.T) {
+	configUn := kube.MustToUnstructured(test.DemoDeployment())
+	out, err := UnifiedFormat("Deployment/demo", configUn, configUn)
+	assert.Nil(t, err)
+	assert.Equal(t, "", out)
+
+	liveDep := test.DemoDeployment()
+	liveDep.Status.Replicas = 1
+	*liveDep.Spec.Replicas = 3
+	liveUn := kube.MustToUnstructured(liveDep)
+	out, err = UnifiedFormat("Deployment/demo", configUn, liveUn)
+	assert.Nil(t, err)
+	assert.Contains(t, out, "--- live/Deployment/demo\n+++ target/Deployment/demo\n")
+	assert.Contains(t, out, "\n-  replicas: 3\n")
+	// fields which are only in the live object, such as the replicas of the status, are left out
+	assert.NotContains(t, out, "replicas: 1")
+
+	out, err = UnifiedFormat("Deployment/demo", nil, liveUn)
+	assert.Nil(t, err)
+	assert.Contains(t, out, "-kind: Deployment\n")
+}
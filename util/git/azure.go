@@ -0,0 +1,60 @@
+package git
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+const (
+	azureDevOpsHost    = "dev.azure.com"
+	azureDevOpsSSHHost = "ssh.dev.azure.com"
+	// visualStudioHostSuffix is the host suffix of legacy Azure DevOps organizations (formerly
+	// Visual Studio Team Services), e.g. org.visualstudio.com
+	visualStudioHostSuffix = ".visualstudio.com"
+	visualStudioSSHHost    = "vs-ssh.visualstudio.com"
+	azureDevOpsSSHVersion  = "v3"
+	azureDevOpsGitSegment  = "_git"
+	azureDevOpsCollection  = "defaultcollection"
+)
+
+// isAzureDevOpsHost returns whether or not the lower case host belongs to Azure DevOps
+func isAzureDevOpsHost(host string) bool {
+	return host == azureDevOpsHost || host == azureDevOpsSSHHost || strings.HasSuffix(host, visualStudioHostSuffix)
+}
+
+// isAzureDevOpsURL returns whether or not the git URL refers to an Azure DevOps repository
+func isAzureDevOpsURL(repo string) bool {
+	host, _, _ := splitRepoURL(repo)
+	return isAzureDevOpsHost(host)
+}
+
+// azureDevOpsRepoIdentity returns the identity of an Azure DevOps repository, which is served under
+// different paths depending on the protocol and the age of the organization:
+// * https://dev.azure.com/org/project/_git/repo
+// * git@ssh.dev.azure.com:v3/org/project/repo
+// * https://org.visualstudio.com/DefaultCollection/project/_git/repo
+// * org@vs-ssh.visualstudio.com:v3/org/project/repo
+// All of them identify dev.azure.com/org/project/repo.
+func azureDevOpsRepoIdentity(host string, repoPath string) string {
+	var segments []string
+	if host != azureDevOpsHost && host != azureDevOpsSSHHost && host != visualStudioSSHHost {
+		// the organization is part of the host name of legacy HTTP clone URLs
+		segments = append(segments, strings.TrimSuffix(host, visualStudioHostSuffix))
+	}
+	for i, segment := range strings.Split(strings.Trim(strings.ToLower(repoPath), "/"), "/") {
+		if segment == azureDevOpsGitSegment || segment == azureDevOpsCollection || (i == 0 && segment == azureDevOpsSSHVersion) {
+			continue
+		}
+		segments = append(segments, segment)
+	}
+	return azureDevOpsHost + "/" + strings.Join(segments, "/")
+}
+
+// azureDevOpsAuthHeader returns the value of the http.extraheader git config which authenticates
+// against Azure DevOps with a personal access token. Azure DevOps ignores the user name, which is
+// therefore typically left empty. Unlike credentials embedded in the URL, an empty user name does
+// not cause git to prompt for one.
+func azureDevOpsAuthHeader(username string, password string) string {
+	credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return "AUTHORIZATION: basic " + credentials
+}
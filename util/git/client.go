@@ -1,6 +1,7 @@
 package git
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"net/url"
@@ -21,6 +22,7 @@ type Client interface {
 	LsRemote(revision string) (string, error)
 	LsFiles(path string) ([]string, error)
 	CommitSHA() (string, error)
+	VerifyCommitSignature(commitSHA string) (string, error)
 	Reset() error
 }
 
@@ -95,7 +97,13 @@ func (m *nativeGitClient) Init() error {
 
 // setCredentials sets a local credentials file to connect to a remote git repository
 func (m *nativeGitClient) setCredentials() error {
-	if m.password != "" {
+	if m.password != "" && isAzureDevOpsURL(m.repoURL) {
+		log.Debug("Setting Azure DevOps personal access token")
+		_, err := m.runCmd("git", "config", "--local", "http.extraheader", azureDevOpsAuthHeader(m.username, m.password))
+		if err != nil {
+			return err
+		}
+	} else if m.password != "" {
 		log.Debug("Setting password credentials")
 		gitCredentialsFile := path.Join(m.root, ".git", "credentials")
 		urlObj, err := url.ParseRequestURI(m.repoURL)
@@ -192,17 +200,29 @@ func (m *nativeGitClient) Checkout(revision string) error {
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
+	if IsFullyQualifiedRef(revision) {
+		// refs outside of refs/heads and refs/tags (e.g. pull request refs) are not fetched by
+		// default, so fetch the ref explicitly before checking it out
+		if _, err := m.runCmd("git", "fetch", "origin", revision); err != nil {
+			return err
+		}
+		revision = "FETCH_HEAD"
+	}
 	if _, err := m.runCmd("git", "checkout", revision); err != nil {
 		return err
 	}
 	return nil
 }
 
-// LsRemote returns the commit SHA of a specific branch, tag, or HEAD
+// LsRemote returns the commit SHA of a specific branch, tag, or HEAD. If the revision does not
+// exist in the remote and is a semver constraint (e.g. `1.2.*`), it is resolved to the commit of
+// the highest matching tag.
 func (m *nativeGitClient) LsRemote(revision string) (string, error) {
 	var args []string
 	if revision == "" || revision == "HEAD" {
 		args = []string{"ls-remote", "origin", "HEAD"}
+	} else if IsFullyQualifiedRef(revision) {
+		args = []string{"ls-remote", "origin", revision}
 	} else {
 		args = []string{"ls-remote", "--head", "--tags", "origin", revision}
 
@@ -212,11 +232,33 @@ func (m *nativeGitClient) LsRemote(revision string) (string, error) {
 		return "", err
 	}
 	if out == "" {
+		if IsSemverConstraint(revision) {
+			return m.resolveSemverConstraint(revision)
+		}
 		// if doesn't exist in remote, assume revision is a commit sha and return it
 		return revision, nil
 	}
 	// 3f4ec0ab2263038ba91d3b594b2188fc108fc8d7	refs/heads/master
-	return strings.Fields(out)[0], nil
+	return parseLsRemoteSHA(out), nil
+}
+
+// resolveSemverConstraint returns the commit SHA of the highest remote tag matching the constraint
+func (m *nativeGitClient) resolveSemverConstraint(constraint string) (string, error) {
+	out, err := m.runCmd("git", "ls-remote", "--tags", "origin")
+	if err != nil {
+		return "", err
+	}
+	tagSHAs := parseLsRemoteTags(out)
+	tags := make([]string, 0, len(tagSHAs))
+	for tag := range tagSHAs {
+		tags = append(tags, tag)
+	}
+	tag, err := ResolveSemverConstraint(constraint, tags)
+	if err != nil {
+		return "", err
+	}
+	log.Debugf("Resolved constraint '%s' of %s to tag %s", constraint, m.repoURL, tag)
+	return tagSHAs[tag], nil
 }
 
 // CommitSHA returns current commit sha from `git rev-parse HEAD`
@@ -228,6 +270,52 @@ func (m *nativeGitClient) CommitSHA() (string, error) {
 	return strings.TrimSpace(out), nil
 }
 
+// VerifyCommitSignature verifies the GPG signature of the commit with `git verify-commit`, using the
+// keyring of the process, and returns the fingerprint of the primary key which signed it. An empty
+// fingerprint is returned if the commit is unsigned or the signature is not good.
+func (m *nativeGitClient) VerifyCommitSignature(commitSHA string) (string, error) {
+	cmd := exec.Command("git", "verify-commit", "--raw", commitSHA)
+	log.Debug(strings.Join(cmd.Args, " "))
+	cmd.Dir = m.root
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); !ok {
+			return "", fmt.Errorf("'%s' failed: %v", strings.Join(cmd.Args, " "), err)
+		}
+	}
+	return parseGoodSignatureFingerprint(stderr.String()), nil
+}
+
+// parseGoodSignatureFingerprint returns the fingerprint of the primary key of the VALIDSIG status
+// line of the raw output of `git verify-commit`, or an empty string if the signature is not good.
+// The fingerprint of the signing key is returned if the primary key is not reported.
+func parseGoodSignatureFingerprint(status string) string {
+	good := false
+	fingerprint := ""
+	for _, line := range strings.Split(status, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[0] != "[GNUPG:]" {
+			continue
+		}
+		switch fields[1] {
+		case "GOODSIG":
+			good = true
+		case "VALIDSIG":
+			// VALIDSIG <fpr> <date> <timestamp> <expire> <version> <reserved> <algo> <hash> <class> [<primary fpr>]
+			fingerprint = fields[2]
+			if len(fields) >= 12 {
+				fingerprint = fields[11]
+			}
+		}
+	}
+	if !good {
+		return ""
+	}
+	return fingerprint
+}
+
 // runCmd is a convenience function to run a command in a given directory and return its output
 func (m *nativeGitClient) runCmd(command string, args ...string) (string, error) {
 	cmd := exec.Command(command, args...)
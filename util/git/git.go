@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -34,10 +35,21 @@ func IsCommitSHA(sha string) bool {
 	return commitSHARegex.MatchString(sha)
 }
 
+// IsFullyQualifiedRef returns whether or not a revision is a fully qualified ref, such as
+// refs/heads/master or the refs/pull-requests/1/from ref of a BitBucket Server pull request
+func IsFullyQualifiedRef(revision string) bool {
+	return strings.HasPrefix(revision, "refs/")
+}
+
 // NormalizeGitURL normalizes a git URL for lookup and storage
 func NormalizeGitURL(repo string) string {
 	// preprocess
-	repo = ensureSuffix(repo, ".git")
+	repo = strings.TrimSuffix(repo, "/")
+	azureDevOps := isAzureDevOpsURL(repo)
+	if !azureDevOps {
+		// Azure DevOps does not serve repositories under a .git suffix
+		repo = ensureSuffix(repo, ".git")
+	}
 	if IsSSHURL(repo) {
 		repo = ensurePrefix(repo, "ssh://")
 	}
@@ -50,10 +62,115 @@ func NormalizeGitURL(repo string) string {
 
 	// postprocess
 	repoURL.Host = strings.ToLower(repoURL.Host)
+	if azureDevOps && repoURL.Scheme != "ssh" {
+		// Azure DevOps clone URLs include the organization as user name (e.g. https://org@dev.azure.com/org/project/_git/repo)
+		repoURL.User = nil
+	}
 	normalized := repoURL.String()
 	return strings.TrimPrefix(normalized, "ssh://")
 }
 
+// gitProvider is a git provider whose clone URLs need to be normalized to identify the repository
+type gitProvider int
+
+const (
+	providerGeneric gitProvider = iota
+	// providerBitbucketServer serves SSH clones on port 7999 and HTTP clones under /scm/, and its
+	// project keys are case insensitive
+	providerBitbucketServer
+	// providerGerrit serves SSH clones on port 29418 and authenticated HTTP clones under /a/
+	providerGerrit
+)
+
+const (
+	bitbucketServerSSHPort = "7999"
+	gerritSSHPort          = "29418"
+)
+
+// providerPathPrefixes are the clone URL path prefixes required by git providers, which do not
+// identify the repository
+var providerPathPrefixes = map[gitProvider]string{
+	providerBitbucketServer: "/scm/",
+	providerGerrit:          "/a/",
+}
+
+// splitRepoURL splits a git URL into its lower case host, its port and its path, without the protocol,
+// credentials and .git suffix
+func splitRepoURL(repo string) (string, string, string) {
+	repo = strings.TrimSuffix(strings.TrimSuffix(strings.TrimSpace(repo), "/"), ".git")
+	if i := strings.Index(repo, "://"); i >= 0 {
+		repo = repo[i+len("://"):]
+	}
+	slash := strings.Index(repo, "/")
+	if slash < 0 {
+		slash = len(repo)
+	}
+	// strip credentials (e.g. git@ or user:password@)
+	host, repoPath := repo[strings.LastIndex(repo[:slash], "@")+1:slash], repo[slash:]
+	port := ""
+	if i := strings.Index(host, ":"); i >= 0 {
+		// either a port, or the beginning of the path of an scp-like URL (e.g. git@github.com:argoproj/argo-cd)
+		if _, err := strconv.Atoi(host[i+1:]); err != nil {
+			repoPath = "/" + host[i+1:] + repoPath
+		} else {
+			port = host[i+1:]
+		}
+		host = host[:i]
+	}
+	return strings.ToLower(host), port, repoPath
+}
+
+// detectProvider returns the git provider a clone URL is known to belong to by its SSH port, or
+// providerGeneric if unknown. Path prefixes such as /scm/ or /a/ do not identify a provider, since any
+// other host may serve repositories under them.
+func detectProvider(port string) gitProvider {
+	switch port {
+	case bitbucketServerSSHPort:
+		return providerBitbucketServer
+	case gerritSSHPort:
+		return providerGerrit
+	}
+	return providerGeneric
+}
+
+// providerRepoPath returns the path identifying the repository of a clone URL of the provider
+func providerRepoPath(provider gitProvider, repoPath string) string {
+	if prefix, ok := providerPathPrefixes[provider]; ok && strings.HasPrefix(repoPath, prefix) {
+		repoPath = strings.TrimPrefix(repoPath, prefix[:len(prefix)-1])
+	}
+	if provider == providerBitbucketServer {
+		repoPath = strings.ToLower(repoPath)
+	}
+	return repoPath
+}
+
+// SameURL returns whether or not the two git URLs refer to the same repository, regardless of the
+// protocol and credentials of the URLs. The provider specific path prefixes, ports and case of
+// BitBucket Server and Gerrit clone URLs are ignored only if either URL uses the SSH port of one of
+// them, e.g. https://bitbucket.example.com/scm/PROJ/repo.git and
+// ssh://git@bitbucket.example.com:7999/proj/repo.git refer to the same repository. The URLs of other
+// hosts must have the same port and path.
+func SameURL(leftRepo, rightRepo string) bool {
+	leftHost, leftPort, leftPath := splitRepoURL(leftRepo)
+	rightHost, rightPort, rightPath := splitRepoURL(rightRepo)
+	if isAzureDevOpsHost(leftHost) && isAzureDevOpsHost(rightHost) {
+		return azureDevOpsRepoIdentity(leftHost, leftPath) == azureDevOpsRepoIdentity(rightHost, rightPath)
+	}
+	if leftHost != rightHost {
+		return false
+	}
+	provider := detectProvider(leftPort)
+	if rightProvider := detectProvider(rightPort); provider == providerGeneric {
+		provider = rightProvider
+	} else if rightProvider != providerGeneric && rightProvider != provider {
+		return false
+	}
+	if provider == providerGeneric {
+		return leftPort == rightPort && leftPath == rightPath
+	}
+	return providerRepoPath(provider, leftPath) == providerRepoPath(provider, rightPath)
+}
+
 // IsSSHURL returns true if supplied URL is SSH URL
 func IsSSHURL(url string) bool {
 	return strings.HasPrefix(url, "git@") || strings.HasPrefix(url, "ssh://")
@@ -90,7 +207,12 @@ func GetGitCommandEnvAndURL(repo, username, password string, sshPrivateKey strin
 			return "", nil, err
 		}
 
-		repoURL.User = url.UserPassword(username, password)
+		if isAzureDevOpsURL(repo) && password != "" {
+			// credentials are passed in a header instead, see azureDevOpsAuthHeader
+			repoURL.User = nil
+		} else {
+			repoURL.User = url.UserPassword(username, password)
+		}
 		cmdURL = repoURL.String()
 	}
 	return cmdURL, env, nil
@@ -102,7 +224,11 @@ func TestRepo(repo, username, password string, sshPrivateKey string) error {
 	if err != nil {
 		return err
 	}
-	cmd := exec.Command("git", "ls-remote", repo, "HEAD")
+	args := []string{"ls-remote", repo, "HEAD"}
+	if isAzureDevOpsURL(repo) && password != "" {
+		args = append([]string{"-c", "http.extraheader=" + azureDevOpsAuthHeader(username, password)}, args...)
+	}
+	cmd := exec.Command("git", args...)
 	cmd.Env = env
 	_, err = cmd.Output()
 	if err != nil {
@@ -0,0 +1,95 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver"
+)
+
+const peeledTagSuffix = "^{}"
+
+// parseSemverConstraint parses a semver constraint such as `1.2.*`, `v1.x` or `>=1.2.0 <2.0.0`.
+// Wildcards may be specified either as `*` or `x` and versions may have a leading `v`.
+func parseSemverConstraint(constraint string) (semver.Range, error) {
+	fields := strings.Fields(strings.Replace(constraint, "*", "x", -1))
+	for i, field := range fields {
+		version := strings.TrimLeft(field, "<>=!")
+		fields[i] = field[:len(field)-len(version)] + strings.TrimPrefix(version, "v")
+	}
+	return semver.ParseRange(strings.Join(fields, " "))
+}
+
+// IsSemverConstraint returns whether or not a revision is a semver constraint (e.g. `1.2.*`),
+// rather than a branch, tag or commit SHA
+func IsSemverConstraint(revision string) bool {
+	if revision == "" || IsCommitSHA(revision) {
+		return false
+	}
+	_, err := parseSemverConstraint(revision)
+	return err == nil
+}
+
+// ResolveSemverConstraint returns the tag with the highest version satisfying the constraint.
+// Tags which are not valid semantic versions are ignored.
+func ResolveSemverConstraint(constraint string, tags []string) (string, error) {
+	versionRange, err := parseSemverConstraint(constraint)
+	if err != nil {
+		return "", fmt.Errorf("invalid semver constraint '%s': %v", constraint, err)
+	}
+	var bestTag string
+	var bestVersion semver.Version
+	for _, tag := range tags {
+		version, err := semver.ParseTolerant(tag)
+		if err != nil || !versionRange(version) {
+			continue
+		}
+		if bestTag == "" || version.GT(bestVersion) {
+			bestTag = tag
+			bestVersion = version
+		}
+	}
+	if bestTag == "" {
+		return "", fmt.Errorf("no tag matches constraint '%s'", constraint)
+	}
+	return bestTag, nil
+}
+
+// parseLsRemoteTags parses the output of `git ls-remote --tags` into a map of tag names to commit
+// SHAs. Annotated tags are resolved to the commit they point to rather than the tag object.
+func parseLsRemoteTags(out string) map[string]string {
+	tags := make(map[string]string)
+	for _, line := range strings.Split(out, "\n") {
+		// 3f4ec0ab2263038ba91d3b594b2188fc108fc8d7	refs/tags/v1.0.0^{}
+		fields := strings.Fields(line)
+		if len(fields) != 2 || !strings.HasPrefix(fields[1], "refs/tags/") {
+			continue
+		}
+		sha, ref := fields[0], strings.TrimPrefix(fields[1], "refs/tags/")
+		if strings.HasSuffix(ref, peeledTagSuffix) {
+			tags[strings.TrimSuffix(ref, peeledTagSuffix)] = sha
+		} else if _, ok := tags[ref]; !ok {
+			tags[ref] = sha
+		}
+	}
+	return tags
+}
+
+// parseLsRemoteSHA returns the commit SHA from the output of `git ls-remote` for a single
+// revision, preferring the peeled commit of an annotated tag over the tag object itself
+func parseLsRemoteSHA(out string) string {
+	var sha string
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if strings.HasSuffix(fields[1], peeledTagSuffix) {
+			return fields[0]
+		}
+		if sha == "" {
+			sha = fields[0]
+		}
+	}
+	return sha
+}
@@ -0,0 +1,50 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSemverConstraint(t *testing.T) {
+	assert.True(t, IsSemverConstraint("1.2.*"))
+	assert.True(t, IsSemverConstraint("v1.x"))
+	assert.True(t, IsSemverConstraint(">=1.2.0 <2.0.0"))
+	assert.False(t, IsSemverConstraint(""))
+	assert.False(t, IsSemverConstraint("master"))
+	assert.False(t, IsSemverConstraint("HEAD"))
+	assert.False(t, IsSemverConstraint("release-1.2"))
+	assert.False(t, IsSemverConstraint("9d921f65f3c5373b682e2eb4b37afba6592e8f8b"))
+}
+
+func TestResolveSemverConstraint(t *testing.T) {
+	tags := []string{"v1.1.0", "v1.2.0", "v1.2.3", "v1.10.0", "v2.0.0", "latest"}
+
+	tag, err := ResolveSemverConstraint("1.2.*", tags)
+	assert.Nil(t, err)
+	assert.Equal(t, "v1.2.3", tag)
+
+	tag, err = ResolveSemverConstraint("v1.x", tags)
+	assert.Nil(t, err)
+	assert.Equal(t, "v1.10.0", tag)
+
+	tag, err = ResolveSemverConstraint(">=1.1.0 <1.2.0", tags)
+	assert.Nil(t, err)
+	assert.Equal(t, "v1.1.0", tag)
+
+	_, err = ResolveSemverConstraint("3.*", tags)
+	assert.NotNil(t, err)
+}
+
+func TestParseLsRemote(t *testing.T) {
+	out := `1111111111111111111111111111111111111111	refs/tags/v1.0.0
+2222222222222222222222222222222222222222	refs/tags/v1.0.0^{}
+3333333333333333333333333333333333333333	refs/tags/v1.1.0
+`
+	assert.Equal(t, map[string]string{
+		"v1.0.0": "2222222222222222222222222222222222222222",
+		"v1.1.0": "3333333333333333333333333333333333333333",
+	}, parseLsRemoteTags(out))
+	assert.Equal(t, "2222222222222222222222222222222222222222", parseLsRemoteSHA(out))
+	assert.Equal(t, "3333333333333333333333333333333333333333", parseLsRemoteSHA("3333333333333333333333333333333333333333	refs/heads/master\n"))
+}
@@ -0,0 +1,287 @@
+package grpc
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// AuditDecisionAllowed is the RBAC decision of audited calls which were not denied
+	AuditDecisionAllowed = "allowed"
+	// AuditDecisionDenied is the RBAC decision of audited calls which failed with PermissionDenied
+	AuditDecisionDenied = "denied"
+)
+
+// readActions are the method name prefixes of the read-only calls, which are not audited. Every other
+// call is audited, so that new mutating methods are audited without having to be listed.
+var readActions = []string{"Get", "List", "Watch"}
+
+// readMethods are the read-only methods whose names do not start with a read action
+var readMethods = map[string]bool{
+	"/application.ApplicationService/ManagedResources": true,
+	"/application.ApplicationService/ResourceTree":     true,
+	"/application.ApplicationService/CompareRevisions": true,
+	"/application.ApplicationService/Diff":             true,
+	"/application.ApplicationService/HookOutput":       true,
+	"/application.ApplicationService/History":          true,
+	"/application.ApplicationService/PodLogs":          true,
+	"/project.ProjectService/SimulatePolicy":           true,
+	"/settings.SettingsService/Capabilities":           true,
+	"/version.VersionService/Version":                  true,
+}
+
+// actionPrefixes are the method name prefixes which name the action of the audited calls, e.g. update
+// for UpdateSpec. The action of other audited calls is their lower cased method name.
+var actionPrefixes = []string{"Create", "Update", "Patch", "Delete", "Sync", "BatchSync", "Rollback", "Terminate"}
+
+// serviceResources maps the proto packages of the services to the RBAC resources they serve
+var serviceResources = map[string]string{
+	"account":     "accounts",
+	"application": "applications",
+	"cluster":     "clusters",
+	"project":     "projects",
+	"repository":  "repositories",
+	"session":     "sessions",
+}
+
+// resourceNamePaths are the JSON paths of the name of the resource in the request messages
+var resourceNamePaths = [][]string{
+	{"name"},
+	{"application", "metadata", "name"},
+	{"project", "metadata", "name"},
+	{"repo", "repo"},
+	{"repo"},
+	{"cluster", "server"},
+	{"server"},
+}
+
+// AuditRecord is the audit record of a mutating API call
+type AuditRecord struct {
+	Method   string
+	Action   string
+	Subject  string
+	Decision string
+	Resource string
+	Name     string
+	// Diff is the JSON merge patch from the state of the resource before the call to its state after the call
+	Diff  string
+	Error string
+}
+
+// AuditObjectGetter returns the current state of the resource with the given name
+type AuditObjectGetter func(name string) (interface{}, error)
+
+// Auditor records the mutating API calls, i.e. every call except the Get, List and Watch calls and the
+// other read-only methods, with the subject, RBAC decision and identity of the resource, to the logs
+// and to the added recorders. The changes of update calls are recorded as diff of resources with a
+// getter.
+type Auditor struct {
+	log       *logrus.Entry
+	subject   func(ctx context.Context) string
+	sensitive map[string]bool
+	getters   map[string]AuditObjectGetter
+	recorders []func(record AuditRecord)
+}
+
+// NewAuditor returns a new auditor. The subject function returns the authenticated subject of a call,
+// and the changes made by sensitive methods are never recorded.
+func NewAuditor(entry *logrus.Entry, subject func(ctx context.Context) string, sensitiveMethods map[string]bool) *Auditor {
+	return &Auditor{
+		log:       entry,
+		subject:   subject,
+		sensitive: sensitiveMethods,
+		getters:   make(map[string]AuditObjectGetter),
+	}
+}
+
+// RegisterGetter registers the getter of the state of the resources, e.g. applications, used to
+// record the diff of update calls
+func (a *Auditor) RegisterGetter(resource string, getter AuditObjectGetter) {
+	a.getters[resource] = getter
+}
+
+// AddRecorder adds a function which receives the record of every audited call, e.g. to create events
+func (a *Auditor) AddRecorder(recorder func(record AuditRecord)) {
+	a.recorders = append(a.recorders, recorder)
+}
+
+// UnaryServerInterceptor records the audited calls once they complete. It must be chained after the
+// authentication interceptor, so that the subject of calls is known.
+func (a *Auditor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		service, action := auditedAction(info.FullMethod)
+		if action == "" {
+			return handler(ctx, req)
+		}
+		record := AuditRecord{
+			Method:   info.FullMethod,
+			Action:   strings.ToLower(action),
+			Subject:  a.subject(ctx),
+			Resource: serviceResources[service],
+			Name:     resourceName(req),
+		}
+		if record.Resource == "" {
+			record.Resource = service
+		}
+		var before interface{}
+		getter, diffable := a.getters[record.Resource]
+		diffable = diffable && record.Name != "" && !a.sensitive[info.FullMethod] && (action == "Update" || action == "Patch")
+		if diffable {
+			before, _ = getter(record.Name)
+		}
+
+		resp, err := handler(ctx, req)
+
+		record.Decision = AuditDecisionAllowed
+		if err != nil {
+			record.Error = err.Error()
+			if status.Code(err) == codes.PermissionDenied {
+				record.Decision = AuditDecisionDenied
+			}
+		} else if diffable && before != nil {
+			if diff, err := jsonMergePatch(before, resp); err == nil {
+				record.Diff = diff
+			} else {
+				a.log.Warnf("Failed to compute audit diff of %s '%s': %v", record.Resource, record.Name, err)
+			}
+		}
+		a.logRecord(record)
+		for _, recorder := range a.recorders {
+			recorder(record)
+		}
+		return resp, err
+	}
+}
+
+func (a *Auditor) logRecord(record AuditRecord) {
+	entry := a.log.WithFields(logrus.Fields{
+		"audit.method":   record.Method,
+		"audit.action":   record.Action,
+		"audit.subject":  record.Subject,
+		"audit.decision": record.Decision,
+		"audit.resource": record.Resource,
+		"audit.name":     record.Name,
+	})
+	if record.Diff != "" {
+		entry = entry.WithField("audit.diff", record.Diff)
+	}
+	if record.Error != "" {
+		entry = entry.WithField("audit.error", record.Error)
+	}
+	entry.Info("audit")
+}
+
+// auditedAction returns the proto package of the service and the action of the method, or an empty
+// action if the method is read-only and not audited
+func auditedAction(fullMethod string) (string, string) {
+	parts := strings.Split(strings.TrimPrefix(fullMethod, "/"), "/")
+	if len(parts) != 2 {
+		return "", ""
+	}
+	service := parts[0]
+	if i := strings.Index(service, "."); i >= 0 {
+		service = service[:i]
+	}
+	if readMethods[fullMethod] {
+		return service, ""
+	}
+	for _, read := range readActions {
+		if strings.HasPrefix(parts[1], read) {
+			return service, ""
+		}
+	}
+	for _, action := range actionPrefixes {
+		if strings.HasPrefix(parts[1], action) {
+			return service, action
+		}
+	}
+	return service, parts[1]
+}
+
+// resourceName returns the name of the resource of a request message, looked up in its JSON form
+func resourceName(req interface{}) string {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return ""
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return ""
+	}
+	for _, path := range resourceNamePaths {
+		var value interface{} = obj
+		for _, key := range path {
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				value = nil
+				break
+			}
+			value = m[key]
+		}
+		if name, ok := value.(string); ok && name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// jsonMergePatch returns the JSON merge patch (RFC 7386) which turns the before object into the after object
+func jsonMergePatch(before interface{}, after interface{}) (string, error) {
+	beforeObj, err := toJSONObject(before)
+	if err != nil {
+		return "", err
+	}
+	afterObj, err := toJSONObject(after)
+	if err != nil {
+		return "", err
+	}
+	patch := diffObjects(beforeObj, afterObj)
+	if len(patch) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func toJSONObject(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj map[string]interface{}
+	err = json.Unmarshal(data, &obj)
+	return obj, err
+}
+
+func diffObjects(before map[string]interface{}, after map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{})
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			patch[key] = nil
+		}
+	}
+	for key, afterValue := range after {
+		beforeValue, ok := before[key]
+		if ok && reflect.DeepEqual(beforeValue, afterValue) {
+			continue
+		}
+		beforeMap, beforeIsMap := beforeValue.(map[string]interface{})
+		afterMap, afterIsMap := afterValue.(map[string]interface{})
+		if ok && beforeIsMap && afterIsMap {
+			patch[key] = diffObjects(beforeMap, afterMap)
+		} else {
+			patch[key] = afterValue
+		}
+	}
+	return patch
+}
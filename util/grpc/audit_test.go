@@ -0,0 +1,160 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testObject struct {
+	Name   string            `json:"name,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Image  string            `json:"image,omitempty"`
+}
+
+func TestAuditedAction(t *testing.T) {
+	service, action := auditedAction("/application.ApplicationService/Sync")
+	assert.Equal(t, "application", service)
+	assert.Equal(t, "Sync", action)
+
+	_, action = auditedAction("/application.ApplicationService/BatchSync")
+	assert.Equal(t, "BatchSync", action)
+
+	_, action = auditedAction("/application.ApplicationService/Get")
+	assert.Equal(t, "", action)
+
+	_, action = auditedAction("/application.ApplicationService/RunResourceAction")
+	assert.Equal(t, "RunResourceAction", action)
+}
+
+func TestAuditedActionMutatingMethods(t *testing.T) {
+	mutating := []string{
+		"/account.AccountService/UpdatePassword",
+		"/application.ApplicationService/Create",
+		"/application.ApplicationService/BulkApply",
+		"/application.ApplicationService/Update",
+		"/application.ApplicationService/UpdateSpec",
+		"/application.ApplicationService/Patch",
+		"/application.ApplicationService/Delete",
+		"/application.ApplicationService/Sync",
+		"/application.ApplicationService/BatchSync",
+		"/application.ApplicationService/Rollback",
+		"/application.ApplicationService/TerminateOperation",
+		"/application.ApplicationService/DeletePod",
+		"/application.ApplicationService/RunResourceAction",
+		"/cluster.ClusterService/Create",
+		"/cluster.ClusterService/Update",
+		"/cluster.ClusterService/Delete",
+		"/cluster.ClusterService/RotateAuth",
+		"/project.ProjectService/Create",
+		"/project.ProjectService/Update",
+		"/project.ProjectService/Delete",
+		"/repository.RepositoryService/Create",
+		"/repository.RepositoryService/Update",
+		"/repository.RepositoryService/UpdateCredentials",
+		"/repository.RepositoryService/Delete",
+		"/session.SessionService/Create",
+		"/session.SessionService/Delete",
+		"/session.SessionService/Revoke",
+	}
+	for _, method := range mutating {
+		_, action := auditedAction(method)
+		assert.NotEqual(t, "", action, "%s is not audited", method)
+	}
+
+	readOnly := []string{
+		"/application.ApplicationService/List",
+		"/application.ApplicationService/ListResourceEvents",
+		"/application.ApplicationService/ListResourceActions",
+		"/application.ApplicationService/Watch",
+		"/application.ApplicationService/Get",
+		"/application.ApplicationService/GetManifests",
+		"/application.ApplicationService/GetResource",
+		"/application.ApplicationService/ManagedResources",
+		"/application.ApplicationService/ResourceTree",
+		"/application.ApplicationService/Diff",
+		"/application.ApplicationService/PodLogs",
+		"/project.ProjectService/SimulatePolicy",
+		"/repository.RepositoryService/ListApps",
+		"/settings.SettingsService/Capabilities",
+		"/version.VersionService/Version",
+	}
+	for _, method := range readOnly {
+		_, action := auditedAction(method)
+		assert.Equal(t, "", action, "%s is audited", method)
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	assert.Equal(t, "guestbook", resourceName(map[string]interface{}{"name": "guestbook"}))
+	assert.Equal(t, "guestbook", resourceName(map[string]interface{}{
+		"application": map[string]interface{}{"metadata": map[string]interface{}{"name": "guestbook"}},
+	}))
+	assert.Equal(t, "https://kubernetes.default.svc", resourceName(map[string]interface{}{"server": "https://kubernetes.default.svc"}))
+	assert.Equal(t, "", resourceName(map[string]interface{}{}))
+}
+
+func TestJSONMergePatch(t *testing.T) {
+	diff, err := jsonMergePatch(
+		testObject{Name: "guestbook", Labels: map[string]string{"team": "a", "env": "dev"}, Image: "nginx"},
+		testObject{Name: "guestbook", Labels: map[string]string{"team": "b", "env": "dev"}},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"image":null,"labels":{"team":"b"}}`, diff)
+
+	diff, err = jsonMergePatch(testObject{Name: "guestbook"}, testObject{Name: "guestbook"})
+	assert.Nil(t, err)
+	assert.Equal(t, "", diff)
+}
+
+func TestAuditorUnaryServerInterceptor(t *testing.T) {
+	auditor := NewAuditor(logrus.NewEntry(logrus.New()), func(ctx context.Context) string {
+		return "admin"
+	}, nil)
+	auditor.RegisterGetter("applications", func(name string) (interface{}, error) {
+		return testObject{Name: name, Image: "nginx:1.14"}, nil
+	})
+	var records []AuditRecord
+	auditor.AddRecorder(func(record AuditRecord) {
+		records = append(records, record)
+	})
+	interceptor := auditor.UnaryServerInterceptor()
+
+	_, err := interceptor(context.Background(), testObject{Name: "guestbook"}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Update"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return testObject{Name: "guestbook", Image: "nginx:1.15"}, nil
+		})
+	assert.Nil(t, err)
+
+	_, err = interceptor(context.Background(), testObject{Name: "guestbook"}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Delete"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		})
+	assert.NotNil(t, err)
+
+	_, err = interceptor(context.Background(), testObject{Name: "guestbook"}, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Get"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+	assert.Nil(t, err)
+
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, AuditRecord{
+			Method:   "/application.ApplicationService/Update",
+			Action:   "update",
+			Subject:  "admin",
+			Decision: AuditDecisionAllowed,
+			Resource: "applications",
+			Name:     "guestbook",
+			Diff:     `{"image":"nginx:1.15"}`,
+		}, records[0])
+		assert.Equal(t, "delete", records[1].Action)
+		assert.Equal(t, AuditDecisionDenied, records[1].Decision)
+		assert.Equal(t, "", records[1].Diff)
+	}
+}
@@ -0,0 +1,183 @@
+package grpc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/textproto"
+	"strings"
+)
+
+const (
+	// GRPCWebContentType is the content type of gRPC-web requests and responses carrying protobuf messages
+	GRPCWebContentType = "application/grpc-web+proto"
+	// GRPCWebTextContentType is the content type of gRPC-web requests and responses with a base64 encoded
+	// body, which browsers send since they cannot read binary streaming responses
+	GRPCWebTextContentType = "application/grpc-web-text+proto"
+	// GRPCWebTrailerFlag is set in the header of the gRPC-web frame carrying the trailers of a response
+	GRPCWebTrailerFlag byte = 0x80
+
+	grpcContentType  = "application/grpc+proto"
+	grpcWebFrameSize = 5
+)
+
+// IsGRPCWebRequest returns whether or not the request is a gRPC-web request
+func IsGRPCWebRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc-web")
+}
+
+// isGRPCWebTextRequest returns whether or not the body of the gRPC-web request is base64 encoded
+func isGRPCWebTextRequest(r *http.Request) bool {
+	return strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc-web-text")
+}
+
+// WriteGRPCWebFrame writes a length-prefixed gRPC-web frame with the given flags
+func WriteGRPCWebFrame(w io.Writer, flags byte, data []byte) error {
+	header := make([]byte, grpcWebFrameSize)
+	header[0] = flags
+	binary.BigEndian.PutUint32(header[1:], uint32(len(data)))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// ReadGRPCWebFrame reads a length-prefixed gRPC-web frame, returning its flags and payload.
+// Returns io.EOF if there are no more frames.
+func ReadGRPCWebFrame(r io.Reader) (byte, []byte, error) {
+	header := make([]byte, grpcWebFrameSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+	data := make([]byte, binary.BigEndian.Uint32(header[1:]))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return 0, nil, fmt.Errorf("truncated gRPC-web frame: %v", err)
+	}
+	return header[0], data, nil
+}
+
+// ParseGRPCWebTrailers parses the payload of a gRPC-web trailer frame
+func ParseGRPCWebTrailers(data []byte) (http.Header, error) {
+	trailers, err := textproto.NewReader(bufio.NewReader(io.MultiReader(bytes.NewReader(data), strings.NewReader("\r\n")))).ReadMIMEHeader()
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return http.Header(trailers), nil
+}
+
+// NewGRPCWebHandler returns a handler which serves gRPC-web requests with the gRPC server, and all
+// other requests with the given handler. gRPC-web requests are sent over HTTP/1.1, which allows
+// clients to reach the server through proxies which do not support HTTP/2. Only unary and server
+// streaming calls are supported. Requests of the application/grpc-web-text content type, as sent by
+// browsers, are served with a base64 encoded response.
+func NewGRPCWebHandler(grpcServer http.Handler, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !IsGRPCWebRequest(r) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		// the gRPC server only serves HTTP/2 requests, so present the request as such
+		grpcReq := *r
+		grpcReq.ProtoMajor, grpcReq.ProtoMinor, grpcReq.Proto = 2, 0, "HTTP/2.0"
+		grpcReq.Header = make(http.Header)
+		for k, v := range r.Header {
+			grpcReq.Header[k] = v
+		}
+		grpcReq.Header.Set("Content-Type", grpcContentType)
+		grpcWriter := newGRPCWebResponseWriter(w)
+		if isGRPCWebTextRequest(r) {
+			grpcReq.Body = ioutil.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+			grpcReq.ContentLength = -1
+			grpcReq.Header.Del("Content-Length")
+			grpcWriter.text = true
+		}
+		grpcServer.ServeHTTP(grpcWriter, &grpcReq)
+		grpcWriter.writeTrailers()
+	})
+}
+
+// grpcWebResponseWriter converts a gRPC response into a gRPC-web response by sending the trailers
+// as the last frame of the body. In text mode, every write is sent as separately padded base64 chunk,
+// so that streamed messages are not held back.
+type grpcWebResponseWriter struct {
+	w           http.ResponseWriter
+	header      http.Header
+	sentHeaders map[string]bool
+	text        bool
+}
+
+func newGRPCWebResponseWriter(w http.ResponseWriter) *grpcWebResponseWriter {
+	return &grpcWebResponseWriter{w: w, header: make(http.Header)}
+}
+
+func (g *grpcWebResponseWriter) Header() http.Header {
+	return g.header
+}
+
+func (g *grpcWebResponseWriter) WriteHeader(code int) {
+	if g.sentHeaders != nil {
+		return
+	}
+	g.sentHeaders = make(map[string]bool)
+	for k, v := range g.header {
+		// trailers are sent in the body once the call completes
+		if k == "Trailer" || strings.HasPrefix(k, http.TrailerPrefix) {
+			continue
+		}
+		g.w.Header()[k] = v
+		g.sentHeaders[k] = true
+	}
+	if g.text {
+		g.w.Header().Set("Content-Type", GRPCWebTextContentType)
+	} else {
+		g.w.Header().Set("Content-Type", GRPCWebContentType)
+	}
+	g.w.WriteHeader(code)
+}
+
+func (g *grpcWebResponseWriter) Write(b []byte) (int, error) {
+	g.WriteHeader(http.StatusOK)
+	if g.text {
+		if _, err := io.WriteString(g.w, base64.StdEncoding.EncodeToString(b)); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	return g.w.Write(b)
+}
+
+func (g *grpcWebResponseWriter) Flush() {
+	g.WriteHeader(http.StatusOK)
+	if flusher, ok := g.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (g *grpcWebResponseWriter) CloseNotify() <-chan bool {
+	if notifier, ok := g.w.(http.CloseNotifier); ok {
+		return notifier.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+// writeTrailers writes the headers which were set after the response headers were sent as trailer frame
+func (g *grpcWebResponseWriter) writeTrailers() {
+	g.WriteHeader(http.StatusOK)
+	var trailers bytes.Buffer
+	for k, v := range g.header {
+		if k == "Trailer" || g.sentHeaders[k] {
+			continue
+		}
+		for _, value := range v {
+			_, _ = fmt.Fprintf(&trailers, "%s: %s\r\n", strings.ToLower(strings.TrimPrefix(k, http.TrailerPrefix)), value)
+		}
+	}
+	_ = WriteGRPCWebFrame(g, GRPCWebTrailerFlag, trailers.Bytes())
+	g.Flush()
+}
@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestGRPCWebFrames(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, WriteGRPCWebFrame(&buf, 0, []byte("message")))
+	assert.Nil(t, WriteGRPCWebFrame(&buf, GRPCWebTrailerFlag, []byte("grpc-status: 0\r\ngrpc-message: \r\n")))
+
+	flags, data, err := ReadGRPCWebFrame(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(0), flags)
+	assert.Equal(t, "message", string(data))
+
+	flags, data, err = ReadGRPCWebFrame(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, GRPCWebTrailerFlag, flags)
+	trailers, err := ParseGRPCWebTrailers(data)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", trailers.Get("Grpc-Status"))
+
+	_, _, err = ReadGRPCWebFrame(&buf)
+	assert.Equal(t, io.EOF, err)
+}
+
+// callGRPCWeb sends a gRPC-web request for the health check of the service, and returns the
+// response messages and trailers
+func callGRPCWeb(t *testing.T, serverURL string, service string) ([][]byte, http.Header) {
+	req, err := proto.Marshal(&healthpb.HealthCheckRequest{Service: service})
+	assert.Nil(t, err)
+	var body bytes.Buffer
+	assert.Nil(t, WriteGRPCWebFrame(&body, 0, req))
+	resp, err := http.Post(serverURL+"/grpc.health.v1.Health/Check", GRPCWebContentType, &body)
+	assert.Nil(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, GRPCWebContentType, resp.Header.Get("Content-Type"))
+
+	var messages [][]byte
+	for {
+		flags, data, err := ReadGRPCWebFrame(resp.Body)
+		assert.Nil(t, err)
+		if err != nil {
+			return messages, nil
+		}
+		if flags&GRPCWebTrailerFlag != 0 {
+			trailers, err := ParseGRPCWebTrailers(data)
+			assert.Nil(t, err)
+			return messages, trailers
+		}
+		messages = append(messages, data)
+	}
+}
+
+func TestGRPCWebHandler(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("argocd", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	server := httptest.NewServer(NewGRPCWebHandler(grpcServer, http.NotFoundHandler()))
+	defer server.Close()
+
+	messages, trailers := callGRPCWeb(t, server.URL, "argocd")
+	assert.Equal(t, "0", trailers.Get("Grpc-Status"))
+	if assert.Len(t, messages, 1) {
+		var resp healthpb.HealthCheckResponse
+		assert.Nil(t, proto.Unmarshal(messages[0], &resp))
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+	}
+
+	// NotFound
+	messages, trailers = callGRPCWeb(t, server.URL, "unknown")
+	assert.Empty(t, messages)
+	assert.Equal(t, "5", trailers.Get("Grpc-Status"))
+
+	// other requests are served by the fallback handler
+	resp, err := http.Get(server.URL + "/grpc.health.v1.Health/Check")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestGRPCWebHandlerText(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("argocd", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	server := httptest.NewServer(NewGRPCWebHandler(grpcServer, http.NotFoundHandler()))
+	defer server.Close()
+
+	req, err := proto.Marshal(&healthpb.HealthCheckRequest{Service: "argocd"})
+	assert.Nil(t, err)
+	var frame bytes.Buffer
+	assert.Nil(t, WriteGRPCWebFrame(&frame, 0, req))
+	resp, err := http.Post(server.URL+"/grpc.health.v1.Health/Check", "application/grpc-web-text", strings.NewReader(base64.StdEncoding.EncodeToString(frame.Bytes())))
+	assert.Nil(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	assert.Equal(t, GRPCWebTextContentType, resp.Header.Get("Content-Type"))
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+
+	// the response is a sequence of padded base64 chunks, which decode independently in groups of 4
+	var decoded bytes.Buffer
+	for i := 0; i+4 <= len(body); i += 4 {
+		data, err := base64.StdEncoding.DecodeString(string(body[i : i+4]))
+		assert.Nil(t, err)
+		decoded.Write(data)
+	}
+	flags, data, err := ReadGRPCWebFrame(&decoded)
+	assert.Nil(t, err)
+	assert.Equal(t, byte(0), flags)
+	var healthResp healthpb.HealthCheckResponse
+	assert.Nil(t, proto.Unmarshal(data, &healthResp))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthResp.Status)
+
+	flags, data, err = ReadGRPCWebFrame(&decoded)
+	assert.Nil(t, err)
+	assert.Equal(t, GRPCWebTrailerFlag, flags)
+	trailers, err := ParseGRPCWebTrailers(data)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", trailers.Get("Grpc-Status"))
+}
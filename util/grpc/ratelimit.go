@@ -0,0 +1,161 @@
+package grpc
+
+import (
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// RetryAfterMetadataKey is the header metadata key holding the seconds after which rate limited clients may retry
+	RetryAfterMetadataKey = "retry-after"
+
+	// rateLimiterExpiry is the duration after which the limiters of idle clients are discarded
+	rateLimiterExpiry = 10 * time.Minute
+)
+
+// RateLimiter throttles the requests to expensive methods. Every request counts against the limit of
+// the remote IP of the client and, if authenticated, against the limit of its subject, so neither a
+// single host nor a single account can exceed the limit.
+type RateLimiter struct {
+	methods  map[string]bool
+	limit    rate.Limit
+	burst    int
+	subject  func(ctx context.Context) string
+	lock     sync.Mutex
+	limiters map[string]*clientLimiter
+	lastGC   time.Time
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewRateLimiter returns a rate limiter allowing every client rps requests per second to the given
+// methods, with bursts of up to burst requests. A zero rate disables rate limiting. The subject
+// function returns the authenticated subject of a request, or an empty string for anonymous requests.
+func NewRateLimiter(methods []string, rps float64, burst int, subject func(ctx context.Context) string) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	r := RateLimiter{
+		methods:  make(map[string]bool),
+		limit:    rate.Limit(rps),
+		burst:    burst,
+		subject:  subject,
+		limiters: make(map[string]*clientLimiter),
+		lastGC:   time.Now(),
+	}
+	for _, method := range methods {
+		r.methods[method] = true
+	}
+	return &r
+}
+
+// Allow returns whether or not the request to the method is allowed, and if not, the duration after
+// which the client may retry
+func (r *RateLimiter) Allow(ctx context.Context, method string) (bool, time.Duration) {
+	if r.limit <= 0 || !r.methods[method] {
+		return true, 0
+	}
+	keys := []string{"ip:" + RemoteIP(ctx)}
+	if r.subject != nil {
+		if sub := r.subject(ctx); sub != "" {
+			keys = append(keys, "sub:"+sub)
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := time.Now()
+	r.gc(now)
+	var reservations []*rate.Reservation
+	var delay time.Duration
+	for _, key := range keys {
+		res := r.getLimiter(key, now).ReserveN(now, 1)
+		reservations = append(reservations, res)
+		if d := res.DelayFrom(now); d > delay {
+			delay = d
+		}
+	}
+	if delay > 0 {
+		// the request is rejected, so give back the tokens of the other limiters
+		for _, res := range reservations {
+			res.CancelAt(now)
+		}
+		return false, delay
+	}
+	return true, 0
+}
+
+func (r *RateLimiter) getLimiter(key string, now time.Time) *rate.Limiter {
+	l, ok := r.limiters[key]
+	if !ok {
+		l = &clientLimiter{limiter: rate.NewLimiter(r.limit, r.burst)}
+		r.limiters[key] = l
+	}
+	l.lastSeen = now
+	return l.limiter
+}
+
+// gc discards the limiters of clients which were idle longer than the expiry
+func (r *RateLimiter) gc(now time.Time) {
+	if now.Sub(r.lastGC) < time.Minute {
+		return
+	}
+	r.lastGC = now
+	for key, l := range r.limiters {
+		if now.Sub(l.lastSeen) > rateLimiterExpiry {
+			delete(r.limiters, key)
+		}
+	}
+}
+
+// UnaryServerInterceptor rejects throttled requests with ResourceExhausted, and sets the retry-after
+// header metadata to the number of seconds after which the client may retry
+func (r *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if ok, delay := r.Allow(ctx, info.FullMethod); !ok {
+			seconds := int(math.Ceil(delay.Seconds()))
+			_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterMetadataKey, strconv.Itoa(seconds)))
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s, retry after %d seconds", info.FullMethod, seconds)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RemoteIP returns the IP address of the client of a request. Requests of the HTTP gateway are proxied
+// over the loopback interface, so their client is the last entry of the x-forwarded-for metadata.
+func RemoteIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if forwarded := md["x-forwarded-for"]; len(forwarded) > 0 {
+				entries := strings.Split(forwarded[len(forwarded)-1], ",")
+				if client := strings.TrimSpace(entries[len(entries)-1]); client != "" {
+					return client
+				}
+			}
+		}
+	}
+	return host
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func peerContext(addr string) context.Context {
+	tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr})
+}
+
+func TestRemoteIP(t *testing.T) {
+	assert.Equal(t, "10.0.0.1", RemoteIP(peerContext("10.0.0.1:51234")))
+
+	ctx := metadata.NewIncomingContext(peerContext("127.0.0.1:51234"), metadata.Pairs("x-forwarded-for", "10.0.0.2, 10.0.0.3"))
+	assert.Equal(t, "10.0.0.3", RemoteIP(ctx))
+
+	assert.Equal(t, "", RemoteIP(context.Background()))
+}
+
+func TestRateLimiter(t *testing.T) {
+	subjects := map[string]string{}
+	limiter := NewRateLimiter([]string{"/application.ApplicationService/Sync"}, 0.001, 2, func(ctx context.Context) string {
+		return subjects[RemoteIP(ctx)]
+	})
+
+	ctx := peerContext("10.0.0.1:51234")
+	for i := 0; i < 2; i++ {
+		ok, _ := limiter.Allow(ctx, "/application.ApplicationService/Sync")
+		assert.True(t, ok)
+	}
+	ok, retryAfter := limiter.Allow(ctx, "/application.ApplicationService/Sync")
+	assert.False(t, ok)
+	assert.True(t, retryAfter > 0)
+
+	// methods which are not rate limited are always allowed
+	ok, _ = limiter.Allow(ctx, "/application.ApplicationService/Get")
+	assert.True(t, ok)
+
+	// the limit of a subject applies to all of its IPs
+	subjects["10.0.0.2"] = "admin"
+	subjects["10.0.0.3"] = "admin"
+	for i := 0; i < 2; i++ {
+		ok, _ = limiter.Allow(peerContext("10.0.0.2:51234"), "/application.ApplicationService/Sync")
+		assert.True(t, ok)
+	}
+	ok, _ = limiter.Allow(peerContext("10.0.0.3:51234"), "/application.ApplicationService/Sync")
+	assert.False(t, ok)
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	limiter := NewRateLimiter([]string{"/application.ApplicationService/Sync"}, 0, 0, nil)
+	for i := 0; i < 10; i++ {
+		ok, _ := limiter.Allow(peerContext("10.0.0.1:51234"), "/application.ApplicationService/Sync")
+		assert.True(t, ok)
+	}
+}
@@ -0,0 +1,255 @@
+package guardrail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// ConfigMapName is the name of the config map to which components without an API publish their
+	// guardrail states
+	ConfigMapName = "argocd-guardrails"
+	// WarningThreshold is the fraction of the limit above which a guardrail is at warning level
+	WarningThreshold = 0.8
+	// CriticalThreshold is the fraction of the limit above which a guardrail is at critical level
+	CriticalThreshold = 0.95
+	// DefaultCheckInterval is the default interval at which guardrails are measured
+	DefaultCheckInterval = 1 * time.Minute
+
+	UnitBytes        = "bytes"
+	UnitMilliseconds = "milliseconds"
+)
+
+// Check is a guardrail measured by a watchdog
+type Check struct {
+	// Name is the name of the guardrail
+	Name string
+	// Limit is the value at which the guardrail is exceeded. Checks without limit are skipped.
+	Limit int64
+	// Unit is the unit of the value and the limit
+	Unit string
+	// Measure returns the current value. Checks without Measure function are observed by the
+	// component itself, see Watchdog.Observe.
+	Measure func() (int64, error)
+}
+
+// Watchdog periodically measures the guardrails of a component
+type Watchdog struct {
+	component string
+	checks    map[string]Check
+	states    map[string]v1alpha1.GuardrailState
+	lock      *sync.Mutex
+}
+
+// NewWatchdog returns a new watchdog measuring the given guardrails of a component
+func NewWatchdog(component string, checks ...Check) *Watchdog {
+	w := &Watchdog{
+		component: component,
+		checks:    make(map[string]Check),
+		states:    make(map[string]v1alpha1.GuardrailState),
+		lock:      &sync.Mutex{},
+	}
+	for _, check := range checks {
+		if check.Limit > 0 {
+			w.checks[check.Name] = check
+		}
+	}
+	return w
+}
+
+// Run measures the guardrails at the given interval until the context is done. onChecked, if not
+// nil, is called with the states after each round.
+func (w *Watchdog) Run(ctx context.Context, interval time.Duration, onChecked func(states []v1alpha1.GuardrailState)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		w.measure()
+		if onChecked != nil {
+			onChecked(w.States())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Watchdog) measure() {
+	for _, check := range w.checks {
+		if check.Measure == nil {
+			continue
+		}
+		value, err := check.Measure()
+		if err != nil {
+			log.Warnf("Failed to measure guardrail %s: %v", check.Name, err)
+			continue
+		}
+		w.Observe(check.Name, value)
+	}
+}
+
+// Observe records the value of a guardrail. Values of unknown guardrails are ignored.
+func (w *Watchdog) Observe(name string, value int64) {
+	check, ok := w.checks[name]
+	if !ok {
+		return
+	}
+	state := NewState(w.component, check, value)
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if prev, ok := w.states[name]; !ok || prev.Level != state.Level {
+		switch state.Level {
+		case v1alpha1.GuardrailLevelOK:
+			if ok {
+				log.Infof("Guardrail %s recovered: %s", name, state.Message)
+			}
+		default:
+			log.Warnf("Guardrail %s raised to %s: %s", name, state.Level, state.Message)
+		}
+	}
+	w.states[name] = state
+}
+
+// States returns the last measured states, ordered by name
+func (w *Watchdog) States() []v1alpha1.GuardrailState {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	states := make([]v1alpha1.GuardrailState, 0, len(w.states))
+	for _, state := range w.states {
+		states = append(states, state)
+	}
+	SortStates(states)
+	return states
+}
+
+// NewState returns the state of a guardrail with the given value
+func NewState(component string, check Check, value int64) v1alpha1.GuardrailState {
+	now := metav1.Now()
+	state := v1alpha1.GuardrailState{
+		Name:       check.Name,
+		Component:  component,
+		Level:      Level(value, check.Limit),
+		Value:      value,
+		Limit:      check.Limit,
+		Unit:       check.Unit,
+		ModifiedAt: &now,
+	}
+	state.Message = fmt.Sprintf("%d of %d %s used (%d%%)", value, check.Limit, check.Unit, value*100/check.Limit)
+	return state
+}
+
+// Level returns the level of a guardrail with the given value and limit
+func Level(value int64, limit int64) v1alpha1.GuardrailLevel {
+	ratio := float64(value) / float64(limit)
+	switch {
+	case ratio >= CriticalThreshold:
+		return v1alpha1.GuardrailLevelCritical
+	case ratio >= WarningThreshold:
+		return v1alpha1.GuardrailLevelWarning
+	}
+	return v1alpha1.GuardrailLevelOK
+}
+
+// SortStates orders states by component and name
+func SortStates(states []v1alpha1.GuardrailState) {
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].Component != states[j].Component {
+			return states[i].Component < states[j].Component
+		}
+		return states[i].Name < states[j].Name
+	})
+}
+
+// Publish stores the guardrail states of a component in the guardrails config map
+func Publish(kubeclientset kubernetes.Interface, namespace string, component string, states []v1alpha1.GuardrailState) error {
+	data, err := json.Marshal(states)
+	if err != nil {
+		return err
+	}
+	configMaps := kubeclientset.CoreV1().ConfigMaps(namespace)
+	cm, err := configMaps.Get(ConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			return err
+		}
+		cm = &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName},
+			Data:       map[string]string{component: string(data)},
+		}
+		_, err = configMaps.Create(cm)
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[component] = string(data)
+	_, err = configMaps.Update(cm)
+	return err
+}
+
+// Load returns the guardrail states published by all components
+func Load(kubeclientset kubernetes.Interface, namespace string) ([]v1alpha1.GuardrailState, error) {
+	cm, err := kubeclientset.CoreV1().ConfigMaps(namespace).Get(ConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var states []v1alpha1.GuardrailState
+	for component, data := range cm.Data {
+		var componentStates []v1alpha1.GuardrailState
+		if err := json.Unmarshal([]byte(data), &componentStates); err != nil {
+			log.Warnf("Failed to parse guardrail states of %s: %v", component, err)
+			continue
+		}
+		states = append(states, componentStates...)
+	}
+	SortStates(states)
+	return states, nil
+}
+
+// levelValues are the values of the argocd_guardrail_level metric
+var levelValues = map[v1alpha1.GuardrailLevel]int{
+	v1alpha1.GuardrailLevelOK:       0,
+	v1alpha1.GuardrailLevelWarning:  1,
+	v1alpha1.GuardrailLevelCritical: 2,
+}
+
+// WriteMetrics writes the states as gauges in the Prometheus text format
+func WriteMetrics(w io.Writer, states []v1alpha1.GuardrailState) error {
+	metrics := []struct {
+		name  string
+		help  string
+		value func(state v1alpha1.GuardrailState) int64
+	}{
+		{"argocd_guardrail_value", "Measured value of the guardrail.", func(state v1alpha1.GuardrailState) int64 { return state.Value }},
+		{"argocd_guardrail_limit", "Value at which the guardrail is exceeded.", func(state v1alpha1.GuardrailState) int64 { return state.Limit }},
+		{"argocd_guardrail_level", "Level of the guardrail (0 = OK, 1 = Warning, 2 = Critical).", func(state v1alpha1.GuardrailState) int64 { return int64(levelValues[state.Level]) }},
+	}
+	for _, metric := range metrics {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n", metric.name, metric.help, metric.name); err != nil {
+			return err
+		}
+		for _, state := range states {
+			if _, err := fmt.Fprintf(w, "%s{component=%q,name=%q} %d\n", metric.name, state.Component, state.Name, metric.value(state)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package guardrail
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+)
+
+func TestLevel(t *testing.T) {
+	assert.Equal(t, v1alpha1.GuardrailLevelOK, Level(0, 100))
+	assert.Equal(t, v1alpha1.GuardrailLevelOK, Level(79, 100))
+	assert.Equal(t, v1alpha1.GuardrailLevelWarning, Level(80, 100))
+	assert.Equal(t, v1alpha1.GuardrailLevelCritical, Level(95, 100))
+	assert.Equal(t, v1alpha1.GuardrailLevelCritical, Level(200, 100))
+}
+
+func TestWatchdog(t *testing.T) {
+	measured := int64(90)
+	w := NewWatchdog("test",
+		Check{Name: "measured", Limit: 100, Unit: UnitBytes, Measure: func() (int64, error) { return measured, nil }},
+		Check{Name: "observed", Limit: 1000, Unit: UnitMilliseconds},
+		Check{Name: "disabled", Measure: func() (int64, error) { return 1, nil }},
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w.Run(ctx, time.Minute, nil)
+	w.Observe("observed", 10)
+	w.Observe("disabled", 10)
+
+	states := w.States()
+	assert.Len(t, states, 2)
+	assert.Equal(t, "measured", states[0].Name)
+	assert.Equal(t, "test", states[0].Component)
+	assert.Equal(t, v1alpha1.GuardrailLevelWarning, states[0].Level)
+	assert.Equal(t, "90 of 100 bytes used (90%)", states[0].Message)
+	assert.Equal(t, "observed", states[1].Name)
+	assert.Equal(t, v1alpha1.GuardrailLevelOK, states[1].Level)
+}
+
+func TestPublishAndLoad(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	states, err := Load(kubeclientset, "default")
+	assert.Nil(t, err)
+	assert.Empty(t, states)
+
+	err = Publish(kubeclientset, "default", "repo-server", []v1alpha1.GuardrailState{{Name: "disk-usage", Component: "repo-server", Value: 1, Limit: 2}})
+	assert.Nil(t, err)
+	err = Publish(kubeclientset, "default", "application-controller", []v1alpha1.GuardrailState{{Name: "app-object-size", Component: "application-controller", Value: 3, Limit: 4}})
+	assert.Nil(t, err)
+
+	states, err = Load(kubeclientset, "default")
+	assert.Nil(t, err)
+	assert.Len(t, states, 2)
+	assert.Equal(t, "application-controller", states[0].Component)
+	assert.Equal(t, int64(3), states[0].Value)
+	assert.Equal(t, "repo-server", states[1].Component)
+}
+
+func TestWriteMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteMetrics(&buf, []v1alpha1.GuardrailState{{Name: "cache-memory", Component: "repo-server", Level: v1alpha1.GuardrailLevelCritical, Value: 97, Limit: 100}})
+	assert.Nil(t, err)
+	assert.Contains(t, buf.String(), "# TYPE argocd_guardrail_value gauge\n")
+	assert.Contains(t, buf.String(), `argocd_guardrail_value{component="repo-server",name="cache-memory"} 97`)
+	assert.Contains(t, buf.String(), `argocd_guardrail_limit{component="repo-server",name="cache-memory"} 100`)
+	assert.Contains(t, buf.String(), `argocd_guardrail_level{component="repo-server",name="cache-memory"} 2`)
+}
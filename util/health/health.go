@@ -5,6 +5,8 @@ import (
 
 	"k8s.io/api/apps/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	coreV1 "k8s.io/api/core/v1"
 	extv1beta1 "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -33,13 +35,23 @@ func GetAppHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
 		health, err = getReplicaSetHealth(obj)
 	case kube.DaemonSetKind:
 		health, err = getDaemonSetHealth(obj)
+	case kube.PersistentVolumeClaimKind:
+		health, err = getPVCHealth(obj)
+	case kube.JobKind:
+		health, err = getJobHealth(obj)
+	case kube.CronJobKind:
+		health, err = getCronJobHealth(obj)
+	case kube.APIServiceKind:
+		health, err = getAPIServiceHealth(obj)
 	default:
 		health = &appv1.HealthStatus{Status: appv1.HealthStatusHealthy}
 	}
 
 	if err != nil {
-		health.Status = appv1.HealthStatusUnknown
-		health.StatusDetails = err.Error()
+		health = &appv1.HealthStatus{
+			Status:        appv1.HealthStatusUnknown,
+			StatusDetails: err.Error(),
+		}
 	}
 	return health, err
 }
@@ -284,6 +296,110 @@ func getReplicaSetHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, e
 	}, nil
 }
 
+func getPVCHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	obj, err := kube.ConvertToVersion(obj, "", "v1")
+	if err != nil {
+		return nil, err
+	}
+	var pvc coreV1.PersistentVolumeClaim
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &pvc)
+	if err != nil {
+		return nil, err
+	}
+	switch pvc.Status.Phase {
+	case coreV1.ClaimBound:
+		return &appv1.HealthStatus{Status: appv1.HealthStatusHealthy}, nil
+	case coreV1.ClaimLost:
+		return &appv1.HealthStatus{
+			Status:        appv1.HealthStatusDegraded,
+			StatusDetails: fmt.Sprintf("Persistent volume claim %q lost its underlying volume", pvc.Name),
+		}, nil
+	default:
+		return &appv1.HealthStatus{
+			Status:        appv1.HealthStatusProgressing,
+			StatusDetails: fmt.Sprintf("Waiting for persistent volume claim %q to be bound...", pvc.Name),
+		}, nil
+	}
+}
+
+func getJobHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	obj, err := kube.ConvertToVersion(obj, "batch", "v1")
+	if err != nil {
+		return nil, err
+	}
+	var job batchv1.Job
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &job)
+	if err != nil {
+		return nil, err
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != coreV1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobFailed:
+			return &appv1.HealthStatus{
+				Status:        appv1.HealthStatusDegraded,
+				StatusDetails: condition.Message,
+			}, nil
+		case batchv1.JobComplete:
+			return &appv1.HealthStatus{
+				Status:        appv1.HealthStatusHealthy,
+				StatusDetails: condition.Message,
+			}, nil
+		}
+	}
+	return &appv1.HealthStatus{
+		Status:        appv1.HealthStatusProgressing,
+		StatusDetails: fmt.Sprintf("Waiting for job %q to complete: %d active, %d succeeded, %d failed pods...", job.Name, job.Status.Active, job.Status.Succeeded, job.Status.Failed),
+	}, nil
+}
+
+func getCronJobHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	obj, err := kube.ConvertToVersion(obj, "batch", "v1beta1")
+	if err != nil {
+		return nil, err
+	}
+	var cronJob batchv1beta1.CronJob
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &cronJob)
+	if err != nil {
+		return nil, err
+	}
+	// the health of the scheduled runs is assessed on the jobs themselves
+	health := appv1.HealthStatus{Status: appv1.HealthStatusHealthy}
+	if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend {
+		health.StatusDetails = fmt.Sprintf("Cron job %q is suspended", cronJob.Name)
+	}
+	return &health, nil
+}
+
+// getAPIServiceHealth assesses the health of apiregistration.k8s.io APIServices from their status
+// conditions directly, since the aggregator types are not part of the standard API
+func getAPIServiceHealth(obj *unstructured.Unstructured) (*appv1.HealthStatus, error) {
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != "Available" {
+			continue
+		}
+		if condition["status"] == string(coreV1.ConditionTrue) {
+			return &appv1.HealthStatus{Status: appv1.HealthStatusHealthy}, nil
+		}
+		message, _ := condition["message"].(string)
+		return &appv1.HealthStatus{
+			Status:        appv1.HealthStatusProgressing,
+			StatusDetails: fmt.Sprintf("API service %q is not available: %s", obj.GetName(), message),
+		}, nil
+	}
+	return &appv1.HealthStatus{
+		Status:        appv1.HealthStatusProgressing,
+		StatusDetails: fmt.Sprintf("Waiting for API service %q to become available...", obj.GetName()),
+	}, nil
+}
+
 func getDeploymentCondition(status v1.DeploymentStatus, condType v1.DeploymentConditionType) *v1.DeploymentCondition {
 	for i := range status.Conditions {
 		c := status.Conditions[i]
@@ -58,3 +58,33 @@ func TestStatefulSetHealth(t *testing.T) {
 	assert.NotNil(t, health)
 	assert.Equal(t, appv1.HealthStatusHealthy, health.Status)
 }
+
+func getHealthStatus(t *testing.T, path string) *appv1.HealthStatus {
+	yamlBytes, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	var obj unstructured.Unstructured
+	err = yaml.Unmarshal(yamlBytes, &obj)
+	assert.Nil(t, err)
+	health, err := GetAppHealth(&obj)
+	assert.Nil(t, err)
+	assert.NotNil(t, health)
+	return health
+}
+
+func TestPVCHealth(t *testing.T) {
+	assert.Equal(t, appv1.HealthStatusHealthy, getHealthStatus(t, "./testdata/pvc-bound.yaml").Status)
+	assert.Equal(t, appv1.HealthStatusProgressing, getHealthStatus(t, "./testdata/pvc-pending.yaml").Status)
+}
+
+func TestJobHealth(t *testing.T) {
+	assert.Equal(t, appv1.HealthStatusProgressing, getHealthStatus(t, "./testdata/job-running.yaml").Status)
+	health := getHealthStatus(t, "./testdata/job-failed.yaml")
+	assert.Equal(t, appv1.HealthStatusDegraded, health.Status)
+	assert.Equal(t, "Job has reached the specified backoff limit", health.StatusDetails)
+}
+
+func TestAPIServiceHealth(t *testing.T) {
+	health := getHealthStatus(t, "./testdata/apiservice-unavailable.yaml")
+	assert.Equal(t, appv1.HealthStatusProgressing, health.Status)
+	assert.Contains(t, health.StatusDetails, "have no addresses")
+}
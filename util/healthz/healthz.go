@@ -0,0 +1,120 @@
+package healthz
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
+)
+
+// DefaultCheckTimeout is the duration after which a health check which has not completed fails
+const DefaultCheckTimeout = 5 * time.Second
+
+// Check is a health check of a component or one of its dependencies
+type Check struct {
+	// Name is the name of the check, e.g. kube-api
+	Name string
+	// Check returns an error if the checked dependency is unhealthy
+	Check func() error
+}
+
+// ServeHealthChecks registers the /healthz liveness endpoint, which runs the liveness checks, and the
+// /readyz readiness endpoint, which runs the liveness and readiness checks. Liveness checks must only
+// verify the state of the process, which a restart can fix, while readiness checks verify what every
+// replica needs to serve requests and cannot share with the other replicas, e.g. the Kubernetes API.
+// The status checks of the services shared by all replicas, e.g. the repo server, are reported by
+// /readyz without failing it, since taking all the replicas out of service would not fix them.
+// Endpoints reply 200 if all of their checks pass and 503 otherwise, with the result of every check in
+// the body.
+func ServeHealthChecks(mux *http.ServeMux, liveness []Check, readiness []Check, status []Check) {
+	mux.HandleFunc("/healthz", newHandler(liveness, nil))
+	mux.HandleFunc("/readyz", newHandler(append(append([]Check{}, liveness...), readiness...), status))
+}
+
+func newHandler(checks []Check, status []Check) http.HandlerFunc {
+	running := make([]int32, len(checks)+len(status))
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body bytes.Buffer
+		healthy := true
+		for i, check := range append(append([]Check{}, checks...), status...) {
+			if err := runCheck(check, &running[i], DefaultCheckTimeout); err != nil {
+				log.Warnf("Health check %s of %s failed: %v", check.Name, r.URL.Path, err)
+				if i < len(checks) {
+					healthy = false
+					_, _ = fmt.Fprintf(&body, "[-]%s failed: %v\n", check.Name, err)
+				} else {
+					_, _ = fmt.Fprintf(&body, "[-]%s failed (status only): %v\n", check.Name, err)
+				}
+			} else {
+				_, _ = fmt.Fprintf(&body, "[+]%s ok\n", check.Name)
+			}
+		}
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if healthy {
+			body.WriteString("ok\n")
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			body.WriteString("failed\n")
+		}
+		_, _ = w.Write(body.Bytes())
+	}
+}
+
+// runCheck runs the check, failing it if it does not complete within the timeout. A check which timed
+// out keeps running in the background, so it fails without being run again until it completes, rather
+// than piling up goroutines while the dependency hangs.
+func runCheck(check Check, running *int32, timeout time.Duration) error {
+	if !atomic.CompareAndSwapInt32(running, 0, 1) {
+		return fmt.Errorf("previous check still running")
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		defer atomic.StoreInt32(running, 0)
+		errCh <- check.Check()
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("timed out after %v", timeout)
+	}
+}
+
+// NewKubeAPICheck returns a check that the Kubernetes API server is reachable with the clientset
+func NewKubeAPICheck(kubeclientset kubernetes.Interface) Check {
+	return Check{
+		Name: "kube-api",
+		Check: func() error {
+			_, err := kubeclientset.Discovery().ServerVersion()
+			return err
+		},
+	}
+}
+
+// NewHTTPCheck returns a check that a GET request to the URL succeeds with a 2xx status. The check
+// passes without a request if the enabled function returns false, e.g. when the dependency is not
+// configured.
+func NewHTTPCheck(name string, url string, enabled func() bool) Check {
+	client := http.Client{Timeout: DefaultCheckTimeout}
+	return Check{
+		Name: name,
+		Check: func() error {
+			if enabled != nil && !enabled() {
+				return nil
+			}
+			resp, err := client.Get(url)
+			if err != nil {
+				return err
+			}
+			_ = resp.Body.Close()
+			if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+				return fmt.Errorf("GET %s returned %s", url, resp.Status)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,80 @@
+package healthz
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeHealthChecks(t *testing.T) {
+	var kubeErr, repoServerErr error
+	mux := http.NewServeMux()
+	ServeHealthChecks(mux,
+		[]Check{{Name: "process", Check: func() error { return nil }}},
+		[]Check{{Name: "kube-api", Check: func() error { return kubeErr }}},
+		[]Check{{Name: "repo-server", Check: func() error { return repoServerErr }}},
+	)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/readyz", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "[+]process ok\n[+]kube-api ok\n[+]repo-server ok\nok\n", w.Body.String())
+
+	// status checks are reported without affecting readiness
+	repoServerErr = fmt.Errorf("connection refused")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/readyz", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "[-]repo-server failed (status only): connection refused")
+
+	kubeErr = fmt.Errorf("connection refused")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), "[-]kube-api failed: connection refused")
+
+	// readiness checks do not affect liveness
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/healthz", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHTTPCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ok" {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	assert.Nil(t, NewHTTPCheck("dex", server.URL+"/ok", nil).Check())
+	assert.NotNil(t, NewHTTPCheck("dex", server.URL+"/fail", nil).Check())
+	assert.Nil(t, NewHTTPCheck("dex", server.URL+"/fail", func() bool { return false }).Check())
+}
+
+func TestRunCheckTimeout(t *testing.T) {
+	var running int32
+	release := make(chan struct{})
+	var calls int32
+	check := Check{Name: "kube-api", Check: func() error {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return nil
+	}}
+
+	assert.EqualError(t, runCheck(check, &running, 10*time.Millisecond), "timed out after 10ms")
+	// the hanging check is not run again until it completes
+	assert.EqualError(t, runCheck(check, &running, 10*time.Millisecond), "previous check still running")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	close(release)
+	for atomic.LoadInt32(&running) != 0 {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Nil(t, runCheck(check, &running, time.Second))
+}
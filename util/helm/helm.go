@@ -1,6 +1,7 @@
 package helm
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os/exec"
@@ -18,9 +19,9 @@ import (
 // Helm provides wrapper functionality around the `helm` command.
 type Helm interface {
 	// Template returns a list of unstructured objects from a `helm template` command
-	Template(name string, valuesFiles []string, overrides []*argoappv1.ComponentParameter) ([]*unstructured.Unstructured, error)
+	Template(ctx context.Context, name string, valuesFiles []string, overrides []*argoappv1.ComponentParameter) ([]*unstructured.Unstructured, error)
 	// GetParameters returns a list of chart parameters taking into account values in provided YAML files.
-	GetParameters(valuesFiles []string) ([]*argoappv1.ComponentParameter, error)
+	GetParameters(ctx context.Context, valuesFiles []string) ([]*argoappv1.ComponentParameter, error)
 }
 
 // NewHelmApp create a new wrapper to run commands on the `helm` command-line tool.
@@ -32,7 +33,7 @@ type helm struct {
 	path string
 }
 
-func (h *helm) Template(name string, valuesFiles []string, overrides []*argoappv1.ComponentParameter) ([]*unstructured.Unstructured, error) {
+func (h *helm) Template(ctx context.Context, name string, valuesFiles []string, overrides []*argoappv1.ComponentParameter) ([]*unstructured.Unstructured, error) {
 	args := []string{
 		"template", h.path, "--name", name,
 	}
@@ -42,15 +43,15 @@ func (h *helm) Template(name string, valuesFiles []string, overrides []*argoappv
 	for _, p := range overrides {
 		args = append(args, "--set", fmt.Sprintf("%s=%s", p.Name, p.Value))
 	}
-	out, err := helmCmd(args...)
+	out, err := helmCmd(ctx, args...)
 	if err != nil {
 		return nil, err
 	}
 	return kube.SplitYAML(out)
 }
 
-func (h *helm) GetParameters(valuesFiles []string) ([]*argoappv1.ComponentParameter, error) {
-	out, err := helmCmd("inspect", "values", h.path)
+func (h *helm) GetParameters(ctx context.Context, valuesFiles []string) ([]*argoappv1.ComponentParameter, error) {
+	out, err := helmCmd(ctx, "inspect", "values", h.path)
 	if err != nil {
 		return nil, err
 	}
@@ -82,8 +83,19 @@ func (h *helm) GetParameters(valuesFiles []string) ([]*argoappv1.ComponentParame
 	return params, nil
 }
 
-func helmCmd(args ...string) (string, error) {
-	cmd := exec.Command("helm", args...)
+// Version returns the client version of helm
+func Version() (string, error) {
+	out, err := helmCmd(context.Background(), "version", "--client", "--short")
+	if err != nil {
+		return "", fmt.Errorf("unable to determine helm version: %v", err)
+	}
+	versionStr := strings.Split(out, "\n")[0]
+	return strings.TrimSpace(strings.TrimPrefix(versionStr, "Client:")), nil
+}
+
+// helmCmd runs a helm command, killing the process if the context is cancelled before it completes
+func helmCmd(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "helm", args...)
 	cmdStr := strings.Join(cmd.Args, " ")
 	log.Info(cmdStr)
 	outBytes, err := cmd.Output()
@@ -1,6 +1,7 @@
 package helm
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,7 +33,7 @@ func TestHelmTemplateParams(t *testing.T) {
 			Value: "1234",
 		},
 	}
-	objs, err := h.Template("test", nil, overrides)
+	objs, err := h.Template(context.Background(), "test", nil, overrides)
 	assert.Nil(t, err)
 	assert.Equal(t, 5, len(objs))
 
@@ -50,7 +51,7 @@ func TestHelmTemplateParams(t *testing.T) {
 func TestHelmTemplateValues(t *testing.T) {
 	h := NewHelmApp("./testdata/redis")
 	valuesFiles := []string{"values-production.yaml"}
-	objs, err := h.Template("test", valuesFiles, nil)
+	objs, err := h.Template(context.Background(), "test", valuesFiles, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, 8, len(objs))
 
@@ -67,7 +68,7 @@ func TestHelmTemplateValues(t *testing.T) {
 
 func TestHelmGetParams(t *testing.T) {
 	h := NewHelmApp("./testdata/redis")
-	params, err := h.GetParameters([]string{})
+	params, err := h.GetParameters(context.Background(), []string{})
 	assert.Nil(t, err)
 
 	slaveCountParam := findParameter(params, "cluster.slaveCount")
@@ -77,7 +78,7 @@ func TestHelmGetParams(t *testing.T) {
 
 func TestHelmGetParamsValueFiles(t *testing.T) {
 	h := NewHelmApp("./testdata/redis")
-	params, err := h.GetParameters([]string{"values-production.yaml"})
+	params, err := h.GetParameters(context.Background(), []string{"values-production.yaml"})
 	assert.Nil(t, err)
 
 	slaveCountParam := findParameter(params, "cluster.slaveCount")
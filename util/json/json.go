@@ -2,7 +2,10 @@ package json
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
@@ -73,3 +76,47 @@ func removeListFields(config, live []interface{}) []interface{} {
 	}
 	return result
 }
+
+// RemoveJSONPointer removes the field or list item referenced by the RFC 6901 JSON pointer from the
+// object. Pointers to fields which do not exist are ignored.
+func RemoveJSONPointer(obj map[string]interface{}, pointer string) error {
+	if !strings.HasPrefix(pointer, "/") {
+		return fmt.Errorf("invalid JSON pointer '%s': must start with '/'", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+	}
+	removePointerTokens(obj, tokens)
+	return nil
+}
+
+// removePointerTokens removes the value referenced by the tokens of a JSON pointer, and returns the
+// resulting value
+func removePointerTokens(value interface{}, tokens []string) interface{} {
+	last := len(tokens) == 1
+	switch v := value.(type) {
+	case map[string]interface{}:
+		child, ok := v[tokens[0]]
+		if !ok {
+			return v
+		}
+		if last {
+			delete(v, tokens[0])
+		} else {
+			v[tokens[0]] = removePointerTokens(child, tokens[1:])
+		}
+		return v
+	case []interface{}:
+		index, err := strconv.Atoi(tokens[0])
+		if err != nil || index < 0 || index >= len(v) {
+			return v
+		}
+		if last {
+			return append(v[:index], v[index+1:]...)
+		}
+		v[index] = removePointerTokens(v[index], tokens[1:])
+		return v
+	}
+	return value
+}
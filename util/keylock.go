@@ -46,3 +46,11 @@ func (keyLock *KeyLock) Lock(key string) {
 func (keyLock *KeyLock) Unlock(key string) {
 	keyLock.getLock(key).Unlock()
 }
+
+// Locker allows to lock by string key across processes
+type Locker interface {
+	// Lock blocks until the lock of the key is acquired, or returns an error if it cannot be acquired
+	Lock(key string) error
+	// Unlock releases the lock of the key
+	Unlock(key string)
+}
@@ -1,6 +1,7 @@
 package ksonnet
 
 import (
+	"context"
 	"fmt"
 	"os/exec"
 	"path/filepath"
@@ -31,13 +32,13 @@ type KsonnetApp interface {
 	Spec() *app.Spec
 
 	// Show returns a list of unstructured objects that would be applied to an environment
-	Show(environment string) ([]*unstructured.Unstructured, error)
+	Show(ctx context.Context, environment string) ([]*unstructured.Unstructured, error)
 
 	// ListEnvParams returns list of environment parameters
 	ListEnvParams(environment string) ([]*v1alpha1.ComponentParameter, error)
 
 	// SetComponentParams updates component parameter in specified environment.
-	SetComponentParams(environment string, component string, param string, value string) error
+	SetComponentParams(ctx context.Context, environment string, component string, param string, value string) error
 }
 
 // KsonnetVersion returns the version of ksonnet used when running ksonnet commands
@@ -78,8 +79,10 @@ func NewKsonnetApp(path string) (KsonnetApp, error) {
 	return &ksApp, nil
 }
 
-func (k *ksonnetApp) ksCmd(args ...string) (string, error) {
-	cmd := exec.Command("ks", args...)
+// ksCmd runs a ks command in the app directory, killing the process if the context is cancelled
+// before it completes
+func (k *ksonnetApp) ksCmd(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "ks", args...)
 	cmd.Dir = k.Root()
 
 	cmdStr := strings.Join(cmd.Args, " ")
@@ -114,8 +117,8 @@ func (k *ksonnetApp) Spec() *app.Spec {
 }
 
 // Show generates a concatenated list of Kubernetes manifests in the given environment.
-func (k *ksonnetApp) Show(environment string) ([]*unstructured.Unstructured, error) {
-	out, err := k.ksCmd("show", environment)
+func (k *ksonnetApp) Show(ctx context.Context, environment string) ([]*unstructured.Unstructured, error) {
+	out, err := k.ksCmd(ctx, "show", environment)
 	if err != nil {
 		return nil, fmt.Errorf("`ks show` failed: %v", err)
 	}
@@ -150,7 +153,7 @@ func (k *ksonnetApp) ListEnvParams(environment string) ([]*v1alpha1.ComponentPar
 }
 
 // SetComponentParams updates component parameter in specified environment.
-func (k *ksonnetApp) SetComponentParams(environment string, component string, param string, value string) error {
-	_, err := k.ksCmd("param", "set", component, param, value, "--env", environment)
+func (k *ksonnetApp) SetComponentParams(ctx context.Context, environment string, component string, param string, value string) error {
+	_, err := k.ksCmd(ctx, "param", "set", component, param, value, "--env", environment)
 	return err
 }
@@ -1,6 +1,7 @@
 package ksonnet
 
 import (
+	"context"
 	"encoding/json"
 	"path"
 	"reflect"
@@ -38,7 +39,7 @@ func TestKsonnet(t *testing.T) {
 func TestShow(t *testing.T) {
 	ksApp, err := NewKsonnetApp(path.Join(testDataDir, testAppName))
 	assert.Nil(t, err)
-	objs, err := ksApp.Show(testEnvName)
+	objs, err := ksApp.Show(context.Background(), testEnvName)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(objs))
 	for _, obj := range objs {
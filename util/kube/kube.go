@@ -49,17 +49,28 @@ const (
 )
 
 const (
-	ServiceKind     = "Service"
-	EndpointsKind   = "Endpoints"
-	DeploymentKind  = "Deployment"
-	ReplicaSetKind  = "ReplicaSet"
-	StatefulSetKind = "StatefulSet"
-	DaemonSetKind   = "DaemonSet"
-	IngressKind     = "Ingress"
+	ServiceKind               = "Service"
+	EndpointsKind             = "Endpoints"
+	DeploymentKind            = "Deployment"
+	ReplicaSetKind            = "ReplicaSet"
+	StatefulSetKind           = "StatefulSet"
+	DaemonSetKind             = "DaemonSet"
+	IngressKind               = "Ingress"
+	PodKind                   = "Pod"
+	SecretKind                = "Secret"
+	NamespaceKind             = "Namespace"
+	PersistentVolumeClaimKind = "PersistentVolumeClaim"
+	JobKind                   = "Job"
+	CronJobKind               = "CronJob"
+	APIServiceKind            = "APIService"
+
+	CustomResourceDefinitionKind = "CustomResourceDefinition"
 )
 
 const (
 	apiResourceCacheDuration = 10 * time.Minute
+	// fieldManager is the manager of the fields set by server-side applies
+	fieldManager = "argocd-controller"
 )
 
 var (
@@ -157,6 +168,82 @@ func SetLabel(target *unstructured.Unstructured, key, val string) error {
 	return nil
 }
 
+// podSpecPaths are the paths of the pod specs of the resource kinds which run containers
+var podSpecPaths = [][]string{
+	{"spec"},
+	{"spec", "template", "spec"},
+	{"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// GetResourceImages returns the container images of a pod, of a resource with a pod template such as
+// a deployment, or of a cron job, including the images of init containers
+func GetResourceImages(obj *unstructured.Unstructured) []string {
+	var images []string
+	for _, path := range podSpecPaths {
+		for _, field := range []string{"initContainers", "containers"} {
+			containers, ok, err := unstructured.NestedSlice(obj.Object, append(path, field)...)
+			if err != nil || !ok {
+				continue
+			}
+			for _, c := range containers {
+				container, ok := c.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if image, ok := container["image"].(string); ok && image != "" {
+					images = append(images, image)
+				}
+			}
+		}
+	}
+	return images
+}
+
+// redactedValue replaces the values of the data of redacted secrets
+const redactedValue = "********"
+
+// RedactSecret returns a copy of the resource in which the values of the data and string data of a
+// secret are masked, including in its last applied configuration annotation. Resources which are not
+// secrets are returned as is.
+func RedactSecret(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	if obj.GroupVersionKind().Group != "" || obj.GetKind() != SecretKind {
+		return obj
+	}
+	obj = obj.DeepCopy()
+	redactSecretData(obj.Object)
+	annotations := obj.GetAnnotations()
+	if lastApplied, ok := annotations[corev1.LastAppliedConfigAnnotation]; ok {
+		var config map[string]interface{}
+		if err := json.Unmarshal([]byte(lastApplied), &config); err == nil {
+			redactSecretData(config)
+			data, err := json.Marshal(config)
+			if err == nil {
+				annotations[corev1.LastAppliedConfigAnnotation] = string(data)
+			} else {
+				delete(annotations, corev1.LastAppliedConfigAnnotation)
+			}
+		} else {
+			// the annotation cannot be redacted, so it must not be revealed
+			delete(annotations, corev1.LastAppliedConfigAnnotation)
+		}
+		obj.SetAnnotations(annotations)
+	}
+	return obj
+}
+
+// redactSecretData masks the values of the data and string data fields of a secret
+func redactSecretData(secret map[string]interface{}) {
+	for _, field := range []string{"data", "stringData"} {
+		data, ok := secret[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key := range data {
+			data[key] = redactedValue
+		}
+	}
+}
+
 // GetCachedServerResources discovers API resources supported by a Kube API server.
 // Caches the results for apiResourceCacheDuration (per host)
 func GetCachedServerResources(host string, disco discovery.DiscoveryInterface) ([]*metav1.APIResourceList, error) {
@@ -204,7 +291,27 @@ func GetLiveResource(dclient dynamic.Interface, obj *unstructured.Unstructured,
 	return liveObj, nil
 }
 
-func WatchResourcesWithLabel(ctx context.Context, config *rest.Config, namespace string, labelName string) (chan watch.Event, error) {
+// ResourceFilter excludes the resources of API groups and kinds from a cluster
+type ResourceFilter interface {
+	IsExcludedResource(apiGroup, kind, cluster string) bool
+}
+
+// isExcludedResource returns whether the resources of the list are excluded by the filter. A nil
+// filter excludes nothing.
+func isExcludedResource(filter ResourceFilter, apiResourcesList *metav1.APIResourceList, apiResource metav1.APIResource, cluster string) bool {
+	if filter == nil {
+		return false
+	}
+	gv, err := schema.ParseGroupVersion(apiResourcesList.GroupVersion)
+	if err != nil {
+		return false
+	}
+	return filter.IsExcludedResource(gv.Group, apiResource.Kind, cluster)
+}
+
+// WatchResourcesWithLabel watches the resources with the label, of all kinds which support watching
+// and are not excluded by the filter
+func WatchResourcesWithLabel(ctx context.Context, config *rest.Config, namespace string, labelName string, filter ResourceFilter) (chan watch.Event, error) {
 	log.Infof("Start watching for resources changes with label %s in cluster %s", labelName, config.Host)
 	dynClientPool := dynamic.NewDynamicClientPool(config)
 	disco, err := discovery.NewDiscoveryClientForConfig(config)
@@ -220,6 +327,9 @@ func WatchResourcesWithLabel(ctx context.Context, config *rest.Config, namespace
 	for _, apiResourcesList := range serverResources {
 		for i := range apiResourcesList.APIResources {
 			apiResource := apiResourcesList.APIResources[i]
+			if isExcludedResource(filter, apiResourcesList, apiResource, config.Host) {
+				continue
+			}
 			watchSupported := false
 			for _, verb := range apiResource.Verbs {
 				if verb == watchVerb {
@@ -258,22 +368,65 @@ func WatchResourcesWithLabel(ctx context.Context, config *rest.Config, namespace
 	return ch, nil
 }
 
+// copyEventsChannel copies the events until the source is closed or the context is done. No event
+// is sent once it returns, so that the destination can be closed safely.
 func copyEventsChannel(ctx context.Context, src <-chan watch.Event, dst chan watch.Event) {
-	done := make(chan bool)
-	go func() {
-		for event := range src {
-			dst <- event
+	for {
+		select {
+		case event, ok := <-src:
+			if !ok {
+				return
+			}
+			select {
+			case dst <- event:
+			case <-ctx.Done():
+				return
+			}
+		case <-ctx.Done():
+			return
 		}
-		done <- true
-	}()
-	select {
-	case <-done:
-	case <-ctx.Done():
 	}
 }
 
-// GetResourcesWithLabel returns all kubernetes resources with specified label
-func GetResourcesWithLabel(config *rest.Config, namespace string, labelName string, labelValue string) ([]*unstructured.Unstructured, error) {
+// GetResourcesWithLabel returns all kubernetes resources with specified label, except the ones
+// excluded by the filter
+func GetResourcesWithLabel(config *rest.Config, namespace string, labelName string, labelValue string, filter ResourceFilter) ([]*unstructured.Unstructured, error) {
+	return GetResources(config, namespace, fmt.Sprintf("%s=%s", labelName, labelValue), func(item *unstructured.Unstructured) bool {
+		// apply client side filtering since not every kubernetes API supports label filtering
+		labels := item.GetLabels()
+		if labels == nil {
+			return false
+		}
+		value, ok := labels[labelName]
+		return ok && value == labelValue
+	}, filter)
+}
+
+// GetResources returns all kubernetes resources matching the label selector which are accepted by
+// the match function, except the ones excluded by the filter. An empty selector lists all resources.
+func GetResources(config *rest.Config, namespace string, labelSelector string, match func(item *unstructured.Unstructured) bool, filter ResourceFilter) ([]*unstructured.Unstructured, error) {
+	resourceInterfaces, err := getListableResourceInterfaces(config, namespace, false, filter)
+	if err != nil {
+		return nil, err
+	}
+	return listResources(resourceInterfaces, metav1.ListOptions{LabelSelector: labelSelector}, match)
+}
+
+// GetNamespacedResources returns all resources of the namespace, of all the namespaced kinds which
+// support listing and are not excluded by the filter
+func GetNamespacedResources(config *rest.Config, namespace string, filter ResourceFilter) ([]*unstructured.Unstructured, error) {
+	resourceInterfaces, err := getListableResourceInterfaces(config, namespace, true, filter)
+	if err != nil {
+		return nil, err
+	}
+	return listResources(resourceInterfaces, metav1.ListOptions{}, func(item *unstructured.Unstructured) bool {
+		return true
+	})
+}
+
+// getListableResourceInterfaces returns the resource interfaces of all kinds which support listing,
+// optionally only of the namespaced kinds. Kinds excluded by the filter are skipped.
+func getListableResourceInterfaces(config *rest.Config, namespace string, namespacedOnly bool, filter ResourceFilter) ([]dynamic.ResourceInterface, error) {
 	dynClientPool := dynamic.NewDynamicClientPool(config)
 	disco, err := discovery.NewDiscoveryClientForConfig(config)
 	if err != nil {
@@ -289,6 +442,12 @@ func GetResourcesWithLabel(config *rest.Config, namespace string, labelName stri
 	for _, apiResourcesList := range resources {
 		for i := range apiResourcesList.APIResources {
 			apiResource := apiResourcesList.APIResources[i]
+			if namespacedOnly && (!apiResource.Namespaced || strings.Contains(apiResource.Name, "/")) {
+				continue
+			}
+			if isExcludedResource(filter, apiResourcesList, apiResource, config.Host) {
+				continue
+			}
 			listSupported := false
 			for _, verb := range apiResource.Verbs {
 				if verb == listVerb {
@@ -305,9 +464,15 @@ func GetResourcesWithLabel(config *rest.Config, namespace string, labelName stri
 			}
 		}
 	}
+	return resourceInterfaces, nil
+}
 
+// listResources lists the resources of all resource interfaces concurrently, and returns the items
+// accepted by the filter
+func listResources(resourceInterfaces []dynamic.ResourceInterface, listOpts metav1.ListOptions, filter func(item *unstructured.Unstructured) bool) ([]*unstructured.Unstructured, error) {
 	var asyncErr error
 	var result []*unstructured.Unstructured
+	var lock sync.Mutex
 
 	var wg sync.WaitGroup
 	wg.Add(len(resourceInterfaces))
@@ -315,21 +480,17 @@ func GetResourcesWithLabel(config *rest.Config, namespace string, labelName stri
 		client := resourceInterfaces[i]
 		go func() {
 			defer wg.Done()
-			list, err := client.List(metav1.ListOptions{
-				LabelSelector: fmt.Sprintf("%s=%s", labelName, labelValue),
-			})
+			list, err := client.List(listOpts)
+			lock.Lock()
+			defer lock.Unlock()
 			if err != nil {
 				asyncErr = err
 				return
 			}
-			// apply client side filtering since not every kubernetes API supports label filtering
 			for i := range list.(*unstructured.UnstructuredList).Items {
 				item := list.(*unstructured.UnstructuredList).Items[i]
-				labels := item.GetLabels()
-				if labels != nil {
-					if value, ok := labels[labelName]; ok && value == labelValue {
-						result = append(result, &item)
-					}
+				if filter(&item) {
+					result = append(result, &item)
 				}
 			}
 		}()
@@ -382,6 +543,12 @@ func DeleteResourceWithLabel(config *rest.Config, namespace string, labelName st
 	}
 
 	var asyncErr error
+	var lock sync.Mutex
+	setAsyncErr := func(err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		asyncErr = err
+	}
 	propagationPolicy := metav1.DeletePropagationForeground
 
 	var wg sync.WaitGroup
@@ -394,16 +561,16 @@ func DeleteResourceWithLabel(config *rest.Config, namespace string, labelName st
 		go func() {
 			defer wg.Done()
 			if deleteCollectionSupported {
-				err = client.DeleteCollection(&metav1.DeleteOptions{
+				err := client.DeleteCollection(&metav1.DeleteOptions{
 					PropagationPolicy: &propagationPolicy,
 				}, metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", labelName, labelValue)})
 				if err != nil && !apierr.IsNotFound(err) {
-					asyncErr = err
+					setAsyncErr(err)
 				}
 			} else {
 				items, err := client.List(metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", labelName, labelValue)})
 				if err != nil {
-					asyncErr = err
+					setAsyncErr(err)
 					return
 				}
 				for _, item := range items.(*unstructured.UnstructuredList).Items {
@@ -415,7 +582,7 @@ func DeleteResourceWithLabel(config *rest.Config, namespace string, labelName st
 								PropagationPolicy: &propagationPolicy,
 							})
 							if err != nil && !apierr.IsNotFound(err) {
-								asyncErr = err
+								setAsyncErr(err)
 								return
 							}
 						}
@@ -428,6 +595,18 @@ func DeleteResourceWithLabel(config *rest.Config, namespace string, labelName st
 	return asyncErr
 }
 
+// IsCRD returns whether or not the object is a custom resource definition
+func IsCRD(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().Group == "apiextensions.k8s.io" && obj.GetKind() == CustomResourceDefinitionKind
+}
+
+// GetCRDGroupKind returns the API group and kind of the resources defined by a custom resource definition
+func GetCRDGroupKind(crd *unstructured.Unstructured) schema.GroupKind {
+	group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+	kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+	return schema.GroupKind{Group: group, Kind: kind}
+}
+
 // See: https://github.com/ksonnet/ksonnet/blob/master/utils/client.go
 func ServerResourceForGroupVersionKind(disco discovery.DiscoveryInterface, gvk schema.GroupVersionKind) (*metav1.APIResource, error) {
 	resources, err := disco.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
@@ -474,30 +653,122 @@ func deleteFile(path string) {
 	_ = os.Remove(path)
 }
 
-// DeleteResource deletes resource
-func DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) error {
+// resourceInterface returns the dynamic client of the resources of the object's group, version and kind
+func resourceInterface(config *rest.Config, obj *unstructured.Unstructured, namespace string) (dynamic.ResourceInterface, error) {
 	dynClientPool := dynamic.NewDynamicClientPool(config)
 	disco, err := discovery.NewDiscoveryClientForConfig(config)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	gvk := obj.GroupVersionKind()
 	dclient, err := dynClientPool.ClientForGroupVersionKind(gvk)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	apiResource, err := ServerResourceForGroupVersionKind(disco, gvk)
+	if err != nil {
+		return nil, err
+	}
+	return dclient.Resource(apiResource, namespace), nil
+}
+
+// DeleteResource deletes resource, deleting its dependents according to the propagation policy
+func DeleteResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, propagationPolicy metav1.DeletionPropagation) error {
+	reIf, err := resourceInterface(config, obj, namespace)
 	if err != nil {
 		return err
 	}
-	reIf := dclient.Resource(apiResource, namespace)
-	propagationPolicy := metav1.DeletePropagationForeground
 	return reIf.Delete(obj.GetName(), &metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
 }
 
-// ApplyResource performs an apply of a unstructured resource
-func ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun, force bool) (string, error) {
+// GetResource reads the live state of the resource of the object
+func GetResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error) {
+	reIf, err := resourceInterface(config, obj, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return reIf.Get(obj.GetName(), metav1.GetOptions{})
+}
+
+// UpdateResource replaces a live resource with the modified object. The update fails if the resource
+// changed since the object was read, as the resource version of the object no longer matches.
+func UpdateResource(config *rest.Config, obj *unstructured.Unstructured, namespace string) (*unstructured.Unstructured, error) {
+	reIf, err := resourceInterface(config, obj, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return reIf.Update(obj)
+}
+
+// DryRunStrategy is the way an apply is dry-run
+type DryRunStrategy int
+
+const (
+	// DryRunNone applies the resource
+	DryRunNone DryRunStrategy = iota
+	// DryRunClient only validates the resource with kubectl, without sending it to the API server
+	DryRunClient
+	// DryRunServer sends the resource to the API server, which runs admission and validation without
+	// persisting it
+	DryRunServer
+)
+
+// ApplyResource performs an apply of a unstructured resource. Schema validation of the resource by
+// kubectl is skipped unless validate is true.
+func ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, dryRun DryRunStrategy, force, validate, serverSide bool) (string, error) {
 	log.Infof("Applying resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
+	args := []string{"apply"}
+	if serverSide {
+		// fields set by other managers are taken over, as ArgoCD owns the desired state
+		args = append(args, "--server-side", "--force-conflicts", "--field-manager="+fieldManager)
+	}
+	switch {
+	case dryRun == DryRunServer || serverSide && dryRun == DryRunClient:
+		// server-side applies can only be dry-run by the API server
+		args = append(args, "--dry-run=server")
+	case dryRun == DryRunClient:
+		args = append(args, "--dry-run")
+	}
+	if force {
+		args = append(args, "--force")
+	}
+	return runKubectlWithObject(config, obj, namespace, validate, args...)
+}
+
+// ReplaceResource performs a replace of an existing unstructured resource, instead of patching it.
+// This is needed for resources which cannot be patched, e.g. when the last applied configuration
+// annotation would exceed the maximum size of annotations. A forced replace deletes and re-creates
+// the resource, which is needed to change its immutable fields.
+func ReplaceResource(config *rest.Config, obj *unstructured.Unstructured, namespace string, validate, force bool) (string, error) {
+	log.Infof("Replacing resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), config.Host, namespace)
+	args := []string{"replace"}
+	if force {
+		args = append(args, "--force")
+	}
+	return runKubectlWithObject(config, obj, namespace, validate, args...)
+}
+
+// immutableFieldErrors are the messages of the API server rejecting updates of immutable fields
+var immutableFieldErrors = []string{
+	"field is immutable",
+	"is immutable after creation",
+	"updates to statefulset spec for fields other than",
+}
+
+// IsImmutableFieldError returns whether the error of an apply or a replace was caused by changes of
+// immutable fields, e.g. of the template of a Job, or the cluster IP of a Service
+func IsImmutableFieldError(err error) bool {
+	for _, msg := range immutableFieldErrors {
+		if strings.Contains(err.Error(), msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// runKubectlWithObject runs kubectl with the given arguments against the cluster, passing the
+// object as the manifest on stdin
+func runKubectlWithObject(config *rest.Config, obj *unstructured.Unstructured, namespace string, validate bool, args ...string) (string, error) {
 	f, err := ioutil.TempFile(kubectlTempDir, "")
 	if err != nil {
 		return "", fmt.Errorf("Failed to generate temp file for kubeconfig: %v", err)
@@ -512,14 +783,12 @@ func ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespac
 	if err != nil {
 		return "", err
 	}
-	applyArgs := []string{"--kubeconfig", f.Name(), "-n", namespace, "apply", "-f", "-"}
-	if dryRun {
-		applyArgs = append(applyArgs, "--dry-run")
+	kubectlArgs := append([]string{"--kubeconfig", f.Name(), "-n", namespace}, args...)
+	kubectlArgs = append(kubectlArgs, "-f", "-")
+	if !validate {
+		kubectlArgs = append(kubectlArgs, "--validate=false")
 	}
-	if force {
-		applyArgs = append(applyArgs, "--force")
-	}
-	cmd := exec.Command("kubectl", applyArgs...)
+	cmd := exec.Command("kubectl", kubectlArgs...)
 	log.Info(cmd.Args)
 	cmd.Stdin = bytes.NewReader(manifestBytes)
 	out, err := cmd.Output()
@@ -533,6 +802,16 @@ func ApplyResource(config *rest.Config, obj *unstructured.Unstructured, namespac
 	return strings.TrimSpace(string(out)), nil
 }
 
+// KubectlVersion returns the client version of kubectl
+func KubectlVersion() (string, error) {
+	out, err := exec.Command("kubectl", "version", "--client", "--short").Output()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine kubectl version: %v", err)
+	}
+	versionStr := strings.Split(string(out), "\n")[0]
+	return strings.TrimSpace(strings.TrimPrefix(versionStr, "Client Version:")), nil
+}
+
 // cleanKubectlOutput makes the error output of kubectl a little better to read
 func cleanKubectlOutput(s string) string {
 	s = strings.TrimSpace(s)
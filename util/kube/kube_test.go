@@ -2,6 +2,7 @@ package kube
 
 import (
 	"encoding/json"
+	"errors"
 	"io/ioutil"
 	"log"
 	"testing"
@@ -233,7 +234,81 @@ func TestSetLabels(t *testing.T) {
 
 }
 
+func TestGetResourceImages(t *testing.T) {
+	var dep unstructured.Unstructured
+	err := yaml.Unmarshal([]byte(depWithSelector), &dep)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"nginx:1.7.9"}, GetResourceImages(&dep))
+
+	pod := MustToUnstructured(&apiv1.Pod{
+		Spec: apiv1.PodSpec{
+			InitContainers: []apiv1.Container{{Name: "init", Image: "busybox:1.28"}},
+			Containers:     []apiv1.Container{{Name: "nginx", Image: "nginx:1.7.9"}},
+		},
+	})
+	assert.Equal(t, []string{"busybox:1.28", "nginx:1.7.9"}, GetResourceImages(pod))
+
+	svc := MustToUnstructured(&apiv1.Service{})
+	assert.Nil(t, GetResourceImages(svc))
+}
+
+func TestRedactSecret(t *testing.T) {
+	secret := MustToUnstructured(&apiv1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: SecretKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("hunter2")},
+	})
+	lastApplied, err := json.Marshal(secret.Object)
+	assert.Nil(t, err)
+	secret.SetAnnotations(map[string]string{apiv1.LastAppliedConfigAnnotation: string(lastApplied)})
+
+	redacted := RedactSecret(secret)
+	data, _, _ := unstructured.NestedStringMap(redacted.Object, "data")
+	assert.Equal(t, map[string]string{"password": redactedValue}, data)
+	assert.NotContains(t, redacted.GetAnnotations()[apiv1.LastAppliedConfigAnnotation], "aHVudGVyMg==")
+	assert.Contains(t, redacted.GetAnnotations()[apiv1.LastAppliedConfigAnnotation], redactedValue)
+	// the original secret is left unchanged
+	data, _, _ = unstructured.NestedStringMap(secret.Object, "data")
+	assert.Equal(t, map[string]string{"password": "aHVudGVyMg=="}, data)
+
+	secret.SetAnnotations(map[string]string{apiv1.LastAppliedConfigAnnotation: "{invalid"})
+	_, ok := RedactSecret(secret).GetAnnotations()[apiv1.LastAppliedConfigAnnotation]
+	assert.False(t, ok)
+
+	cm := MustToUnstructured(&apiv1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		Data:     map[string]string{"key": "value"},
+	})
+	assert.Equal(t, cm, RedactSecret(cm))
+}
+
 func TestCleanKubectlOutput(t *testing.T) {
 	testString := `error: error validating "STDIN": error validating data: ValidationError(Deployment.spec): missing required field "selector" in io.k8s.api.apps.v1beta2.DeploymentSpec; if you choose to ignore these errors, turn validation off with --validate=false`
 	assert.Equal(t, cleanKubectlOutput(testString), `error validating data: ValidationError(Deployment.spec): missing required field "selector" in io.k8s.api.apps.v1beta2.DeploymentSpec`)
 }
+
+func TestIsImmutableFieldError(t *testing.T) {
+	assert.True(t, IsImmutableFieldError(errors.New(`The Job "migrate" is invalid: spec.template: Invalid value: core.PodTemplateSpec{}: field is immutable`)))
+	assert.True(t, IsImmutableFieldError(errors.New(`The StatefulSet "db" is invalid: spec: Forbidden: updates to statefulset spec for fields other than 'replicas', 'template', and 'updateStrategy' are forbidden.`)))
+	assert.False(t, IsImmutableFieldError(errors.New(`error validating data: ValidationError(Deployment.spec): missing required field "selector"`)))
+}
+
+func TestGetCRDGroupKind(t *testing.T) {
+	var crd unstructured.Unstructured
+	err := yaml.Unmarshal([]byte(`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: rollouts.argoproj.io
+spec:
+  group: argoproj.io
+  names:
+    kind: Rollout
+    plural: rollouts
+`), &crd)
+	assert.Nil(t, err)
+	assert.True(t, IsCRD(&crd))
+	assert.Equal(t, "argoproj.io", GetCRDGroupKind(&crd).Group)
+	assert.Equal(t, "Rollout", GetCRDGroupKind(&crd).Kind)
+	assert.False(t, IsCRD(MustToUnstructured(test.DemoService())))
+}
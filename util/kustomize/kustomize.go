@@ -0,0 +1,41 @@
+package kustomize
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/util/kube"
+)
+
+// Build builds the kustomization in the directory, and returns the resulting resources. The kustomize
+// process is killed when ctx is done.
+func Build(ctx context.Context, path string) ([]*unstructured.Unstructured, error) {
+	cmd := exec.CommandContext(ctx, "kustomize", "build", path)
+	cmdStr := strings.Join(cmd.Args, " ")
+	log.Info(cmdStr)
+	out, err := cmd.Output()
+	if err != nil {
+		if exErr, ok := err.(*exec.ExitError); ok {
+			errOutput := strings.TrimSpace(string(exErr.Stderr))
+			log.Errorf("`%s` failed: %s", cmdStr, errOutput)
+			return nil, fmt.Errorf("%s", errOutput)
+		}
+		return nil, err
+	}
+	return kube.SplitYAML(string(out))
+}
+
+// Version returns the version of kustomize
+func Version() (string, error) {
+	out, err := exec.Command("kustomize", "version").Output()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine kustomize version: %v", err)
+	}
+	versionStr := strings.Split(string(out), "\n")[0]
+	return strings.TrimSpace(strings.TrimPrefix(versionStr, "Version:")), nil
+}
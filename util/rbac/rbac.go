@@ -115,17 +115,36 @@ func (e *Enforcer) defaultEnforceClaims(rvals ...interface{}) bool {
 		return e.Enforce(vals...)
 	}
 	groups := jwtutil.GetGroups(mapClaims)
+	user := jwtutil.GetField(mapClaims, "sub")
+	return e.EnforceSubject(user, groups, rvals[1:]...)
+}
+
+// EnforceSubject enforces the policy for a user and its groups, the same way as claims are enforced.
+// Only the user falls back to the default role.
+func (e *Enforcer) EnforceSubject(user string, groups []string, rvals ...interface{}) bool {
 	for _, group := range groups {
-		vals := append([]interface{}{group}, rvals[1:]...)
+		vals := append([]interface{}{group}, rvals...)
 		if e.Enforcer.Enforce(vals...) {
 			return true
 		}
 	}
-	user := jwtutil.GetField(mapClaims, "sub")
-	vals := append([]interface{}{user}, rvals[1:]...)
+	vals := append([]interface{}{user}, rvals...)
 	return e.Enforce(vals...)
 }
 
+// WithUserPolicy returns a new enforcer with the same built-in policy, but with the specified user
+// defined policy and default role. The returned enforcer does not watch the policy configmap.
+func (e *Enforcer) WithUserPolicy(policy, defaultRole string) (*Enforcer, error) {
+	enf := NewEnforcer(e.clientset, e.namespace, e.configmap, nil)
+	enf.SetDefaultRole(defaultRole)
+	enf.builtinPolicy = e.builtinPolicy
+	err := enf.SetUserPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+	return enf, nil
+}
+
 // SetBuiltinPolicy sets a built-in policy, which augments any user defined policies
 func (e *Enforcer) SetBuiltinPolicy(policy string) error {
 	e.builtinPolicy = policy
@@ -308,3 +308,21 @@ func TestNoPolicy(t *testing.T) {
 	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfgMapName, nil)
 	assert.False(t, enf.Enforce("admin", "applications", "delete", "foo/bar"))
 }
+
+// TestWithUserPolicy verifies a candidate policy is evaluated without affecting the original enforcer
+func TestWithUserPolicy(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfgMapName, nil)
+	enf.SetBuiltinPolicy(box.String(builtinPolicyFile))
+	enf.SetUserPolicy("g, alice, role:admin")
+
+	candidate, err := enf.WithUserPolicy("g, my-org:team, role:readonly", "")
+	assert.Nil(t, err)
+	assert.False(t, candidate.EnforceSubject("alice", nil, "applications", "delete", "foo/bar"))
+	assert.True(t, candidate.EnforceSubject("bob", []string{"my-org:team"}, "applications", "get", "foo/bar"))
+	assert.False(t, candidate.EnforceSubject("bob", []string{"my-org:team"}, "applications", "delete", "foo/bar"))
+	// builtin policy is preserved
+	assert.True(t, candidate.EnforceSubject("admin", nil, "applications", "delete", "foo/bar"))
+
+	assert.True(t, enf.EnforceSubject("alice", nil, "applications", "delete", "foo/bar"))
+}
@@ -0,0 +1,120 @@
+package util
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// redisLockRetryInterval is the interval at which a held redis lock is polled until it is released
+	redisLockRetryInterval = 100 * time.Millisecond
+)
+
+var (
+	// redisUnlockScript deletes the lock key only if it is still held with the given token
+	redisUnlockScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`)
+	// redisRefreshScript extends the expiration of the lock key only if it is still held with the given token
+	redisRefreshScript = redis.NewScript(`
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("pexpire", KEYS[1], ARGV[2])
+end
+return 0`)
+)
+
+// RedisKeyLock allows to lock by string key across processes sharing the same redis. Lock
+// expirations are refreshed while held, so that locks of crashed processes are eventually released.
+type RedisKeyLock struct {
+	localLock *KeyLock
+	client    *redis.Client
+	prefix    string
+	ttl       time.Duration
+	heldLock  sync.Mutex
+	held      map[string]*redisLockHandle
+}
+
+type redisLockHandle struct {
+	token string
+	done  chan struct{}
+}
+
+// NewRedisKeyLock creates new instance of RedisKeyLock, which stores locks in redis under the given prefix
+func NewRedisKeyLock(client *redis.Client, prefix string, ttl time.Duration) *RedisKeyLock {
+	return &RedisKeyLock{
+		localLock: NewKeyLock(),
+		client:    client,
+		prefix:    prefix,
+		ttl:       ttl,
+		held:      map[string]*redisLockHandle{},
+	}
+}
+
+// Lock blocks goroutine until both the local and the redis lock of the key are acquired. Returns an
+// error without holding any lock if redis is unavailable.
+func (l *RedisKeyLock) Lock(key string) error {
+	l.localLock.Lock(key)
+	redisKey := l.prefix + key
+	tokenBytes, err := MakeSignature(16)
+	if err != nil {
+		l.localLock.Unlock(key)
+		return fmt.Errorf("failed to generate token for lock %s: %v", redisKey, err)
+	}
+	handle := &redisLockHandle{token: string(tokenBytes), done: make(chan struct{})}
+	for {
+		acquired, err := l.client.SetNX(redisKey, handle.token, l.ttl).Result()
+		if err != nil {
+			l.localLock.Unlock(key)
+			return fmt.Errorf("failed to acquire lock %s: %v", redisKey, err)
+		}
+		if acquired {
+			break
+		}
+		time.Sleep(redisLockRetryInterval)
+	}
+	l.heldLock.Lock()
+	l.held[key] = handle
+	l.heldLock.Unlock()
+	go l.refresh(redisKey, handle)
+	return nil
+}
+
+// refresh periodically extends the expiration of a held lock until it is released
+func (l *RedisKeyLock) refresh(redisKey string, handle *redisLockHandle) {
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-handle.done:
+			return
+		case <-ticker.C:
+			err := redisRefreshScript.Run(l.client, []string{redisKey}, handle.token, int64(l.ttl/time.Millisecond)).Err()
+			if err != nil {
+				log.Warnf("Failed to refresh lock %s: %v", redisKey, err)
+			}
+		}
+	}
+}
+
+// Unlock releases the redis and local lock of the key
+func (l *RedisKeyLock) Unlock(key string) {
+	l.heldLock.Lock()
+	handle, ok := l.held[key]
+	delete(l.held, key)
+	l.heldLock.Unlock()
+	if ok {
+		close(handle.done)
+		redisKey := l.prefix + key
+		err := redisUnlockScript.Run(l.client, []string{redisKey}, handle.token).Err()
+		if err != nil {
+			log.Warnf("Failed to release lock %s: %v", redisKey, err)
+		}
+	}
+	l.localLock.Unlock(key)
+}
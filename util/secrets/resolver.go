@@ -0,0 +1,285 @@
+package secrets
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+// referenceRegex matches secret references of the form <backend:path#key>
+var referenceRegex = regexp.MustCompile(`<([a-zA-Z0-9_-]+):([^<>#\s]+)#([^<>\s]+)>`)
+
+// Backend is a store of secrets which can be referenced from manifests
+type Backend interface {
+	// GetSecret returns the value of the key of the secret at the given path
+	GetSecret(path string, key string) (string, error)
+}
+
+// Resolver replaces the secret references in manifests with the values fetched from the secret
+// backends configured in the ArgoCD settings. References to unknown backends are left untouched.
+type Resolver struct {
+	settingsMgr   *settings.SettingsManager
+	kubeclientset kubernetes.Interface
+	namespace     string
+}
+
+// NewResolver returns a new resolver, which reads the secrets of the backend configurations, e.g.
+// Vault tokens, from the given namespace
+func NewResolver(settingsMgr *settings.SettingsManager, kubeclientset kubernetes.Interface, namespace string) *Resolver {
+	return &Resolver{
+		settingsMgr:   settingsMgr,
+		kubeclientset: kubeclientset,
+		namespace:     namespace,
+	}
+}
+
+// HasReferences returns whether or not the object contains any secret reference
+func HasReferences(obj *unstructured.Unstructured) bool {
+	found := false
+	walkStrings(obj.Object, func(value string) string {
+		if referenceRegex.MatchString(value) {
+			found = true
+		}
+		return value
+	})
+	return found
+}
+
+// Resolve returns a copy of the object in which the secret references are replaced with the
+// values of the secrets. Only the references permitted by the project of the application are
+// resolved. The object is returned as is if it has no references.
+func (r *Resolver) Resolve(obj *unstructured.Unstructured, proj *v1alpha1.AppProject) (*unstructured.Unstructured, error) {
+	if !HasReferences(obj) {
+		return obj, nil
+	}
+	backends, err := r.getBackends()
+	if err != nil {
+		return nil, err
+	}
+	return ResolveReferences(obj, backends, func(ref string) error {
+		if !proj.IsSecretReferencePermitted(ref) {
+			return fmt.Errorf("secret reference %s is not permitted by project %s", ref, proj.Name)
+		}
+		return nil
+	})
+}
+
+// getBackends returns the secret backends configured in the ArgoCD settings, keyed by name
+func (r *Resolver) getBackends() (map[string]Backend, error) {
+	argoSettings, err := r.settingsMgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	backends := make(map[string]Backend)
+	for _, config := range argoSettings.SecretBackends {
+		switch {
+		case config.Vault != nil:
+			token, err := r.getSecretValue(config.Vault.TokenSecret)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get token of secret backend '%s': %v", config.Name, err)
+			}
+			backends[config.Name] = NewVaultBackend(config.Vault.Address, token)
+		default:
+			return nil, fmt.Errorf("secret backend '%s' has no type", config.Name)
+		}
+	}
+	return backends, nil
+}
+
+// getSecretValue returns the value referenced by the secret selector, or an empty string if selector is nil
+func (r *Resolver) getSecretValue(selector *apiv1.SecretKeySelector) (string, error) {
+	if selector == nil {
+		return "", nil
+	}
+	secret, err := r.kubeclientset.CoreV1().Secrets(r.namespace).Get(selector.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("key '%s' not found in secret '%s'", selector.Key, selector.Name)
+	}
+	return string(value), nil
+}
+
+// ResolveReferences returns a copy of the object in which the references to the given backends are
+// replaced with the values of the secrets. Since the data of Secrets is base64 encoded, data values
+// which contained references are encoded once resolved. Each reference, in the form backend:path, is
+// checked before its secret is fetched, and fails the resolution if the check returns an error.
+func ResolveReferences(obj *unstructured.Unstructured, backends map[string]Backend, check func(ref string) error) (*unstructured.Unstructured, error) {
+	var resolveErr error
+	resolve := func(value string) string {
+		return referenceRegex.ReplaceAllStringFunc(value, func(ref string) string {
+			match := referenceRegex.FindStringSubmatch(ref)
+			backend, ok := backends[match[1]]
+			if !ok {
+				return ref
+			}
+			if err := check(match[1] + ":" + match[2]); err != nil {
+				if resolveErr == nil {
+					resolveErr = err
+				}
+				return ref
+			}
+			secret, err := backend.GetSecret(match[2], match[3])
+			if err != nil {
+				if resolveErr == nil {
+					// the error must not include the value, so only the reference is reported
+					resolveErr = fmt.Errorf("failed to resolve secret reference %s: %v", ref, err)
+				}
+				return ref
+			}
+			return secret
+		})
+	}
+	resolved := obj.DeepCopy()
+	for k, v := range resolved.Object {
+		if obj.GetKind() == "Secret" && obj.GetAPIVersion() == "v1" && k == "data" {
+			if data, ok := v.(map[string]interface{}); ok {
+				for key, value := range data {
+					if str, ok := value.(string); ok {
+						if resolvedStr := resolve(str); resolvedStr != str {
+							data[key] = base64.StdEncoding.EncodeToString([]byte(resolvedStr))
+						}
+					}
+				}
+				continue
+			}
+		}
+		resolved.Object[k] = walkValue(v, resolve)
+	}
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+	return resolved, nil
+}
+
+// MaskReferences returns copies of the target objects in which the strings containing secret
+// references are replaced with the values of the corresponding live objects, so that resolved
+// secrets are not reported as differences. Live and target objects are matched by index.
+func MaskReferences(targetObjs []*unstructured.Unstructured, liveObjs []*unstructured.Unstructured) []*unstructured.Unstructured {
+	masked := make([]*unstructured.Unstructured, len(targetObjs))
+	for i, targetObj := range targetObjs {
+		if targetObj == nil || i >= len(liveObjs) || liveObjs[i] == nil || !HasReferences(targetObj) {
+			masked[i] = targetObj
+			continue
+		}
+		obj := targetObj.DeepCopy()
+		obj.Object = maskValue(obj.Object, liveObjs[i].Object).(map[string]interface{})
+		masked[i] = obj
+	}
+	return masked
+}
+
+// RedactReferences returns a copy of the live object in which the values at the positions of the
+// strings containing secret references in the target object are replaced with these strings, so that
+// resolved secrets are not stored in the status of applications. This includes the
+// last-applied-configuration annotation, in which kubectl records the resolved object. The live
+// object is returned as is if the target object has no references.
+func RedactReferences(targetObj *unstructured.Unstructured, liveObj *unstructured.Unstructured) *unstructured.Unstructured {
+	if targetObj == nil || liveObj == nil || !HasReferences(targetObj) {
+		return liveObj
+	}
+	redacted := liveObj.DeepCopy()
+	redactValue(targetObj.Object, redacted.Object)
+	annotations := redacted.GetAnnotations()
+	if lastApplied, ok := annotations[apiv1.LastAppliedConfigAnnotation]; ok {
+		var applied map[string]interface{}
+		if err := json.Unmarshal([]byte(lastApplied), &applied); err == nil {
+			redactValue(targetObj.Object, applied)
+			data, err := json.Marshal(applied)
+			if err == nil {
+				annotations[apiv1.LastAppliedConfigAnnotation] = string(data)
+			} else {
+				delete(annotations, apiv1.LastAppliedConfigAnnotation)
+			}
+		} else {
+			delete(annotations, apiv1.LastAppliedConfigAnnotation)
+		}
+		redacted.SetAnnotations(annotations)
+	}
+	return redacted
+}
+
+// redactValue returns the live value, in which the values at the positions of the strings of the
+// target value containing references are replaced with these strings. Maps and lists are redacted in
+// place.
+func redactValue(target interface{}, live interface{}) interface{} {
+	switch t := target.(type) {
+	case string:
+		if referenceRegex.MatchString(t) && live != nil {
+			return t
+		}
+	case map[string]interface{}:
+		if liveMap, ok := live.(map[string]interface{}); ok {
+			for k, v := range t {
+				if liveValue, ok := liveMap[k]; ok {
+					liveMap[k] = redactValue(v, liveValue)
+				}
+			}
+		}
+	case []interface{}:
+		if liveList, ok := live.([]interface{}); ok {
+			for i := range t {
+				if i < len(liveList) {
+					liveList[i] = redactValue(t[i], liveList[i])
+				}
+			}
+		}
+	}
+	return live
+}
+
+func maskValue(target interface{}, live interface{}) interface{} {
+	switch t := target.(type) {
+	case string:
+		if referenceRegex.MatchString(t) && live != nil {
+			return live
+		}
+	case map[string]interface{}:
+		liveMap, _ := live.(map[string]interface{})
+		for k, v := range t {
+			t[k] = maskValue(v, liveMap[k])
+		}
+	case []interface{}:
+		liveList, _ := live.([]interface{})
+		for i, v := range t {
+			var liveItem interface{}
+			if i < len(liveList) {
+				liveItem = liveList[i]
+			}
+			t[i] = maskValue(v, liveItem)
+		}
+	}
+	return target
+}
+
+// walkStrings calls fn with every string value of the object and replaces the value with the result
+func walkStrings(obj map[string]interface{}, fn func(string) string) {
+	for k, v := range obj {
+		obj[k] = walkValue(v, fn)
+	}
+}
+
+func walkValue(value interface{}, fn func(string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return fn(v)
+	case map[string]interface{}:
+		walkStrings(v, fn)
+	case []interface{}:
+		for i := range v {
+			v[i] = walkValue(v[i], fn)
+		}
+	}
+	return value
+}
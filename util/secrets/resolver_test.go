@@ -0,0 +1,172 @@
+package secrets
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/util/settings"
+)
+
+type fakeBackend map[string]string
+
+func (b fakeBackend) GetSecret(path string, key string) (string, error) {
+	value, ok := b[path+"#"+key]
+	if !ok {
+		return "", fmt.Errorf("secret not found")
+	}
+	return value, nil
+}
+
+func newConfigMap(password string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "db"},
+		"data": map[string]interface{}{
+			"url":  "postgres://admin:" + password + "@db:5432",
+			"host": "db",
+		},
+	}}
+}
+
+func permitAll(ref string) error {
+	return nil
+}
+
+func TestResolveReferences(t *testing.T) {
+	backends := map[string]Backend{"vault": fakeBackend{"secret/db#password": "s3cr3t"}}
+
+	obj := newConfigMap("<vault:secret/db#password>")
+	resolved, err := ResolveReferences(obj, backends, permitAll)
+	assert.Nil(t, err)
+	assert.Equal(t, newConfigMap("s3cr3t").Object, resolved.Object)
+	// the original object keeps the reference
+	assert.True(t, HasReferences(obj))
+
+	// references to unknown backends are left untouched
+	resolved, err = ResolveReferences(newConfigMap("<other:secret/db#password>"), backends, permitAll)
+	assert.Nil(t, err)
+	assert.Equal(t, newConfigMap("<other:secret/db#password>").Object, resolved.Object)
+
+	_, err = ResolveReferences(newConfigMap("<vault:secret/db#username>"), backends, permitAll)
+	assert.NotNil(t, err)
+
+	// references which are not permitted are not fetched
+	_, err = ResolveReferences(obj, backends, func(ref string) error {
+		assert.Equal(t, "vault:secret/db", ref)
+		return fmt.Errorf("not permitted")
+	})
+	assert.EqualError(t, err, "not permitted")
+}
+
+func TestResolveReferencesSecretData(t *testing.T) {
+	backends := map[string]Backend{"vault": fakeBackend{"secret/db#password": "s3cr3t"}}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]interface{}{"name": "db"},
+		"data":       map[string]interface{}{"password": "<vault:secret/db#password>", "username": "YWRtaW4="},
+		"stringData": map[string]interface{}{"token": "<vault:secret/db#password>"},
+	}}
+	resolved, err := ResolveReferences(obj, backends, permitAll)
+	assert.Nil(t, err)
+	data := resolved.Object["data"].(map[string]interface{})
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("s3cr3t")), data["password"])
+	assert.Equal(t, "YWRtaW4=", data["username"])
+	assert.Equal(t, "s3cr3t", resolved.Object["stringData"].(map[string]interface{})["token"])
+}
+
+func TestMaskReferences(t *testing.T) {
+	targetObjs := []*unstructured.Unstructured{newConfigMap("<vault:secret/db#password>"), nil}
+	liveObjs := []*unstructured.Unstructured{newConfigMap("s3cr3t"), newConfigMap("s3cr3t")}
+	masked := MaskReferences(targetObjs, liveObjs)
+	assert.Len(t, masked, 2)
+	assert.Equal(t, newConfigMap("s3cr3t").Object, masked[0].Object)
+	assert.Nil(t, masked[1])
+	assert.True(t, HasReferences(targetObjs[0]))
+
+	// references are kept if the object does not exist yet
+	masked = MaskReferences(targetObjs, []*unstructured.Unstructured{nil, nil})
+	assert.True(t, HasReferences(masked[0]))
+}
+
+func TestRedactReferences(t *testing.T) {
+	target := newConfigMap("<vault:secret/db#password>")
+	live := newConfigMap("s3cr3t")
+	live.SetAnnotations(map[string]string{
+		apiv1.LastAppliedConfigAnnotation: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"db"},"data":{"url":"postgres://admin:s3cr3t@db:5432","host":"db"}}`,
+		"owner":                           "team",
+	})
+	redacted := RedactReferences(target, live)
+	assert.Equal(t, target.Object["data"], redacted.Object["data"])
+	assert.NotContains(t, redacted.GetAnnotations()[apiv1.LastAppliedConfigAnnotation], "s3cr3t")
+	assert.Contains(t, redacted.GetAnnotations()[apiv1.LastAppliedConfigAnnotation], "<vault:secret/db#password>")
+	assert.Equal(t, "team", redacted.GetAnnotations()["owner"])
+	// the live object is not modified
+	assert.Equal(t, "postgres://admin:s3cr3t@db:5432", live.Object["data"].(map[string]interface{})["url"])
+
+	// objects without references are returned as is
+	assert.Equal(t, live, RedactReferences(newConfigMap("admin"), live))
+	assert.Nil(t, RedactReferences(target, nil))
+}
+
+func TestResolver(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"data": {"password": "s3cr3t"}}}`))
+	}))
+	defer vault.Close()
+
+	kubeclientset := fake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: "argocd"},
+		Data: map[string]string{"secretBackends": fmt.Sprintf(`
+- name: vault
+  vault:
+    address: %s
+    tokenSecret:
+      name: vault-token
+      key: token
+`, vault.URL)},
+	}, &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: "argocd"},
+		Data:       map[string][]byte{"admin.password": []byte("hash"), "server.secretkey": []byte("key")},
+	}, &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: "argocd"},
+		Data:       map[string][]byte{"token": []byte("root-token")},
+	})
+	resolver := NewResolver(settings.NewSettingsManager(kubeclientset, "argocd"), kubeclientset, "argocd")
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "db"},
+		Spec:       v1alpha1.AppProjectSpec{SecretReferences: []string{"vault:secret/data/*"}},
+	}
+
+	resolved, err := resolver.Resolve(newConfigMap("<vault:secret/data/db#password>"), proj)
+	assert.Nil(t, err)
+	assert.Equal(t, newConfigMap("s3cr3t").Object, resolved.Object)
+
+	// references are only resolved if permitted by the project
+	_, err = resolver.Resolve(newConfigMap("<vault:secret/data/other/db#password>"), proj)
+	assert.EqualError(t, err, "secret reference vault:secret/data/other/db is not permitted by project db")
+	_, err = resolver.Resolve(newConfigMap("<vault:secret/data/db#password>"), &v1alpha1.AppProject{})
+	assert.NotNil(t, err)
+
+	// objects without references are returned as is
+	obj := newConfigMap("admin")
+	resolved, err = resolver.Resolve(obj, proj)
+	assert.Nil(t, err)
+	assert.Equal(t, obj, resolved)
+}
@@ -0,0 +1,65 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// vaultBackend fetches secrets from the HTTP API of a Vault server
+type vaultBackend struct {
+	address string
+	token   string
+	client  *http.Client
+}
+
+// NewVaultBackend returns a backend which reads secrets from the Vault server at the given address.
+// Both version 1 and version 2 of the key/value secrets engine are supported.
+func NewVaultBackend(address string, token string) Backend {
+	return &vaultBackend{
+		address: strings.TrimRight(address, "/"),
+		token:   token,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// GetSecret returns the value of the key of the Vault secret at the given path, e.g. secret/data/db
+func (v *vaultBackend) GetSecret(path string, key string) (string, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v1/%s", v.address, strings.TrimLeft(path, "/")), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	res, err := v.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault returned status %d for path '%s'", res.StatusCode, path)
+	}
+	var secret struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&secret); err != nil {
+		return "", fmt.Errorf("failed to decode vault secret at path '%s': %v", path, err)
+	}
+	data := secret.Data
+	// the key/value engine version 2 nests the values of the secret under data.data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, isKey := data[key]; !isKey {
+			data = nested
+		}
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", fmt.Errorf("key '%s' not found in vault secret at path '%s'", key, path)
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("key '%s' of vault secret at path '%s' is not a string", key, path)
+	}
+	return str, nil
+}
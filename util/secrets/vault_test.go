@@ -0,0 +1,41 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVaultBackend(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/secret/data/db":
+			_, _ = w.Write([]byte(`{"data": {"data": {"password": "v2-password"}, "metadata": {"version": 1}}}`))
+		case "/v1/kv/db":
+			_, _ = w.Write([]byte(`{"data": {"password": "v1-password", "port": 5432}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer vault.Close()
+	backend := NewVaultBackend(vault.URL+"/", "token")
+
+	value, err := backend.GetSecret("secret/data/db", "password")
+	assert.Nil(t, err)
+	assert.Equal(t, "v2-password", value)
+
+	value, err = backend.GetSecret("kv/db", "password")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1-password", value)
+
+	_, err = backend.GetSecret("kv/db", "username")
+	assert.NotNil(t, err)
+
+	_, err = backend.GetSecret("kv/db", "port")
+	assert.NotNil(t, err)
+
+	_, err = backend.GetSecret("kv/missing", "password")
+	assert.NotNil(t, err)
+}
@@ -2,6 +2,8 @@ package session
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"net/http"
@@ -37,6 +39,10 @@ const (
 	// SessionManagerClaimsIssuer fills the "iss" field of the token.
 	SessionManagerClaimsIssuer = "argocd"
 
+	// revokedTokenError is returned for tokens which were revoked, or issued before the tokens of
+	// their account were revoked
+	revokedTokenError = "Token has been revoked"
+
 	// invalidLoginError, for security purposes, doesn't say whether the username or password was invalid.  This does not mitigate the potential for timing attacks to determine which is which.
 	invalidLoginError  = "Invalid username or password"
 	blankPasswordError = "Blank passwords are not allowed"
@@ -76,7 +82,12 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int) (stri
 	// Create a new token object, specifying signing method and the claims
 	// you would like it to contain.
 	now := time.Now().UTC()
+	id, err := newTokenID()
+	if err != nil {
+		return "", err
+	}
 	claims := jwt.StandardClaims{
+		Id:        id,
 		IssuedAt:  now.Unix(),
 		Issuer:    SessionManagerClaimsIssuer,
 		NotBefore: now.Unix(),
@@ -89,6 +100,15 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int) (stri
 	return mgr.signClaims(claims)
 }
 
+// newTokenID returns a random ID for a token, with which the token can be revoked
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
 func (mgr *SessionManager) signClaims(claims jwt.Claims) (string, error) {
 	log.Infof("Issuing claims: %v", claims)
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -117,9 +137,42 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, error) {
 	if issuedAt.Before(mgr.settings.AdminPasswordMtime) {
 		return nil, fmt.Errorf("Password for superuser has changed since token issued")
 	}
+	revoked, err := mgr.isRevoked(claims)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to verify the token was not revoked: %v", err)
+	}
+	if revoked {
+		return nil, fmt.Errorf(revokedTokenError)
+	}
 	return token.Claims, nil
 }
 
+// isRevoked returns whether or not the token with the claims was revoked
+func (mgr *SessionManager) isRevoked(claims jwt.MapClaims) (bool, error) {
+	id, subject, issuedAt := TokenInfo(claims)
+	return mgr.settings.IsSessionRevoked(id, subject, issuedAt)
+}
+
+// TokenInfo returns the ID, the account and the issue time of the token with the claims. Tokens
+// issued by dex have no ID, and their account is the email of the user.
+func TokenInfo(claims jwt.MapClaims) (string, string, time.Time) {
+	var issuedAt time.Time
+	if iat, ok := claims["iat"].(float64); ok {
+		issuedAt = time.Unix(int64(iat), 0)
+	}
+	return jwtutil.GetField(claims, "jti"), username(claims), issuedAt
+}
+
+// TokenExpiry returns the time the token with the claims expires, or nil if it does not expire
+func TokenExpiry(claims jwt.MapClaims) *time.Time {
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return nil
+	}
+	expiresAt := time.Unix(int64(exp), 0)
+	return &expiresAt
+}
+
 // VerifyUsernamePassword verifies if a username/password combo is correct
 func (mgr *SessionManager) VerifyUsernamePassword(username, password string) error {
 	if username != common.ArgoCDAdminUsername {
@@ -163,7 +216,13 @@ func (mgr *SessionManager) VerifyToken(tokenString string) (jwt.Claims, error) {
 		}
 		var claims jwt.MapClaims
 		err = idToken.Claims(&claims)
-		return claims, err
+		if err != nil {
+			return nil, err
+		}
+		if mgr.isRevoked(claims) {
+			return nil, fmt.Errorf(revokedTokenError)
+		}
+		return claims, nil
 	}
 }
 
@@ -176,11 +235,15 @@ func Username(ctx context.Context) string {
 	if err != nil {
 		return ""
 	}
-	switch jwtutil.GetField(mapClaims, "iss") {
+	return username(mapClaims)
+}
+
+func username(claims jwt.MapClaims) string {
+	switch jwtutil.GetField(claims, "iss") {
 	case SessionManagerClaimsIssuer:
-		return jwtutil.GetField(mapClaims, "sub")
+		return jwtutil.GetField(claims, "sub")
 	default:
-		return jwtutil.GetField(mapClaims, "email")
+		return jwtutil.GetField(claims, "email")
 	}
 }
 
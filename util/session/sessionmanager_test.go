@@ -1,7 +1,9 @@
 package session
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/util/settings"
 	jwt "github.com/dgrijalva/jwt-go"
@@ -33,3 +35,98 @@ func TestSessionManager(t *testing.T) {
 		t.Errorf("Token claim subject \"%s\" does not match expected subject \"%s\".", subject, defaultSubject)
 	}
 }
+
+func TestSessionManagerRevokedToken(t *testing.T) {
+	set := settings.ArgoCDSettings{
+		ServerSignature: []byte("Hello, world!"),
+	}
+	mgr := NewSessionManager(&set)
+
+	token, err := mgr.Create("admin", 0)
+	if err != nil {
+		t.Fatalf("Could not create token: %v", err)
+	}
+	claims, err := mgr.Parse(token)
+	if err != nil {
+		t.Fatalf("Could not parse token: %v", err)
+	}
+	id, subject, issuedAt := TokenInfo(*(claims.(*jwt.MapClaims)))
+	if id == "" || subject != "admin" || issuedAt.IsZero() {
+		t.Fatalf("Unexpected token info %s, %s, %v", id, subject, issuedAt)
+	}
+
+	var revocations settings.SessionRevocations
+	revocations.RevokeSession(id, subject, issuedAt, nil)
+	set.SetSessionRevocations(revocations)
+	if _, err = mgr.Parse(token); err == nil {
+		t.Errorf("Revoked token was parsed")
+	}
+
+	revocations = settings.SessionRevocations{}
+	revocations.RevokeBefore("guest", issuedAt.Add(time.Second))
+	set.SetSessionRevocations(revocations)
+	if _, err = mgr.Parse(token); err != nil {
+		t.Errorf("Token of another account was revoked: %v", err)
+	}
+	revocations = settings.SessionRevocations{}
+	revocations.RevokeBefore("admin", issuedAt.Add(time.Second))
+	set.SetSessionRevocations(revocations)
+	if _, err = mgr.Parse(token); err == nil {
+		t.Errorf("Token issued before the revocation time was parsed")
+	}
+}
+
+func TestSessionRevocationsRevokeBefore(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	var revocations settings.SessionRevocations
+	revocations.RevokeSession("old", "admin", now.Add(-time.Hour), nil)
+	revocations.RevokeSession("new", "admin", now, nil)
+	revocations.RevokeSession("other", "guest", now.Add(-time.Hour), nil)
+	revocations.RevokeBefore("admin", now.Add(-time.Minute))
+
+	// tokens revoked by time are forgotten
+	if len(revocations.Sessions) != 2 {
+		t.Errorf("Expected 2 individually revoked tokens, got %d", len(revocations.Sessions))
+	}
+	if !revocations.IsRevoked("", "admin", now.Add(-time.Hour)) {
+		t.Errorf("Token issued before the revocation time is not revoked")
+	}
+	if !revocations.IsRevoked("new", "admin", now) || revocations.IsRevoked("", "admin", now) {
+		t.Errorf("Tokens issued after the revocation time are not revoked by ID")
+	}
+
+	// revoking before an earlier time does not unrevoke tokens
+	revocations.RevokeBefore("admin", now.Add(-2*time.Hour))
+	if !revocations.IsRevoked("", "admin", now.Add(-time.Hour)) {
+		t.Errorf("Earlier revocation time unrevoked a token")
+	}
+}
+
+func TestSessionRevocationsPrune(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	expired := now.Add(-time.Minute)
+	expires := now.Add(time.Hour)
+	var revocations settings.SessionRevocations
+	revocations.RevokeSession("expired", "admin", now.Add(-time.Hour), &expired)
+	revocations.RevokeSession("valid", "admin", now.Add(-time.Hour), &expires)
+
+	// expired tokens are forgotten
+	if _, ok := revocations.Sessions["expired"]; ok || len(revocations.Sessions) != 1 {
+		t.Errorf("Expected only the unexpired token to be revoked individually, got %v", revocations.Sessions)
+	}
+	if !revocations.IsRevoked("valid", "admin", now.Add(-time.Hour)) {
+		t.Errorf("Unexpired token is not revoked")
+	}
+
+	// the oldest tokens are revoked by time once too many tokens are revoked
+	revocations = settings.SessionRevocations{}
+	for i := 0; i <= 5000; i++ {
+		revocations.RevokeSession(fmt.Sprintf("token-%d", i), "admin", now.Add(time.Duration(i)*time.Second), nil)
+	}
+	if len(revocations.Sessions) != 5000 {
+		t.Errorf("Expected 5000 individually revoked tokens, got %d", len(revocations.Sessions))
+	}
+	if !revocations.IsRevoked("", "admin", now) || revocations.IsRevoked("", "admin", now.Add(time.Second)) {
+		t.Errorf("Expected the oldest token to be revoked by time")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"fmt"
+	"path"
 	"sync"
 	"syscall"
 	"time"
@@ -26,6 +27,7 @@ import (
 	"k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 )
 
 // ArgoCDSettings holds in-memory runtime configuration options.
@@ -43,14 +45,258 @@ type ArgoCDSettings struct {
 	// Certificate holds the certificate/private key for the ArgoCD API server.
 	// If nil, will run insecure without TLS.
 	Certificate *tls.Certificate `json:"-"`
+	// ExternalCertificate holds the certificate/private key of the argocd-server-tls secret, which
+	// takes precedence over Certificate
+	ExternalCertificate *tls.Certificate `json:"-"`
+	// TLSMinVersion is the minimum TLS version accepted by the API server. Zero means the Go default.
+	TLSMinVersion uint16 `json:"tlsMinVersion,omitempty"`
+	// TLSCipherSuites holds the cipher suites accepted by the API server. Empty means the Go defaults.
+	TLSCipherSuites []uint16 `json:"tlsCipherSuites,omitempty"`
+	// tlsLock guards the certificates and the TLS options, which are read on every TLS handshake while
+	// the notifier updates them
+	tlsLock sync.RWMutex
 	// WebhookGitLabSecret holds the shared secret for authenticating GitHub webhook events
 	WebhookGitHubSecret string `json:"webhookGitHubSecret,omitempty"`
 	// WebhookGitLabSecret holds the shared secret for authenticating GitLab webhook events
 	WebhookGitLabSecret string `json:"webhookGitLabSecret,omitempty"`
 	// WebhookBitbucketUUID holds the UUID for authenticating Bitbucket webhook events
 	WebhookBitbucketUUID string `json:"webhookBitbucketUUID,omitempty"`
+	// WebhookBitbucketServerSecret holds the shared secret for authenticating BitBucket Server webhook events
+	WebhookBitbucketServerSecret string `json:"webhookBitbucketServerSecret,omitempty"`
+	// WebhookGogsSecret holds the shared secret for authenticating Gogs webhook events
+	WebhookGogsSecret string `json:"webhookGogsSecret,omitempty"`
 	// Secrets holds all secrets in argocd-secret as a map[string]string
 	Secrets map[string]string `json:"secrets,omitempty"`
+	// Repositories holds the repositories which are declaratively configured in the ArgoCD configmap
+	Repositories []RepoCredentials `json:"repositories,omitempty"`
+	// repositoriesLock guards the repositories, which are reconciled while the notifier updates them
+	repositoriesLock sync.RWMutex
+	// SecretBackends holds the backends from which the controller resolves secret references in manifests
+	SecretBackends []SecretBackend `json:"secretBackends,omitempty"`
+	// ManifestFormat is the default format in which the API server returns the manifests of applications
+	ManifestFormat string `json:"manifestFormat,omitempty"`
+	// AppResyncPeriod is the period after which the controller compares applications to their
+	// target state again. Zero means the controller default.
+	AppResyncPeriod time.Duration `json:"appResyncPeriod,omitempty"`
+	// AppResyncJitter is the maximum delay added to the resync period of each application, so that
+	// the comparisons of applications are spread over time
+	AppResyncJitter time.Duration `json:"appResyncJitter,omitempty"`
+	// ResourceExclusions holds the API groups and kinds which the controller neither watches nor compares
+	ResourceExclusions []FilteredResource `json:"resourceExclusions,omitempty"`
+	// ResourceInclusions holds the only API groups and kinds which the controller watches and compares.
+	// An empty list includes all of them.
+	ResourceInclusions []FilteredResource `json:"resourceInclusions,omitempty"`
+	// RevisionHistoryMaxAge is the age after which deployments are removed from the history of
+	// applications. Zero keeps deployments of any age.
+	RevisionHistoryMaxAge time.Duration `json:"revisionHistoryMaxAge,omitempty"`
+	// ProgressingDeadline is the time after which resources which are still progressing are considered
+	// degraded. Zero means resources may progress forever.
+	ProgressingDeadline time.Duration `json:"progressingDeadline,omitempty"`
+	// ResourceTrackingMethod is the method with which the controller tracks the resources of
+	// applications: label (default), annotation or annotation+label
+	ResourceTrackingMethod string `json:"resourceTrackingMethod,omitempty"`
+	// ResourceActions holds the custom actions which can be run on the resources of applications, in
+	// addition to the built-in ones
+	ResourceActions []ResourceAction `json:"resourceActions,omitempty"`
+	// sessionRevocations holds the revoked session tokens, and sessionRevocationsErr the error parsing
+	// them, if they are invalid
+	sessionRevocations    SessionRevocations
+	sessionRevocationsErr error
+	// revocationsLock guards the revoked session tokens, which are read on every token verification
+	// while the notifier updates them
+	revocationsLock sync.RWMutex
+}
+
+// RepoCredentials is a declaratively configured repository, whose credentials are referenced from secrets
+type RepoCredentials struct {
+	// URL is the URL of the repository
+	URL string `json:"url,omitempty"`
+	// UsernameSecret is the secret selector to the repository username
+	UsernameSecret *apiv1.SecretKeySelector `json:"usernameSecret,omitempty"`
+	// PasswordSecret is the secret selector to the repository password
+	PasswordSecret *apiv1.SecretKeySelector `json:"passwordSecret,omitempty"`
+	// SSHPrivateKeySecret is the secret selector to the repository ssh private key
+	SSHPrivateKeySecret *apiv1.SecretKeySelector `json:"sshPrivateKeySecret,omitempty"`
+}
+
+// ReferencesSecret returns whether or not the credentials of the repository are read from the secret
+func (c *RepoCredentials) ReferencesSecret(name string) bool {
+	for _, selector := range []*apiv1.SecretKeySelector{c.UsernameSecret, c.PasswordSecret, c.SSHPrivateKeySecret} {
+		if selector != nil && selector.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRepositories returns the declaratively configured repositories
+func (a *ArgoCDSettings) GetRepositories() []RepoCredentials {
+	a.repositoriesLock.RLock()
+	defer a.repositoriesLock.RUnlock()
+	return a.Repositories
+}
+
+// setRepositories replaces the declaratively configured repositories. The previous list is never
+// modified, so that it can still be read by the callers of GetRepositories.
+func (a *ArgoCDSettings) setRepositories(repositories []RepoCredentials) {
+	a.repositoriesLock.Lock()
+	defer a.repositoriesLock.Unlock()
+	a.Repositories = repositories
+}
+
+// SecretBackend is a backend from which secret references in manifests (e.g. <vault:secret/db#password>)
+// are resolved
+type SecretBackend struct {
+	// Name is the name of the backend, which prefixes the references to its secrets
+	Name string `json:"name"`
+	// Vault is a HashiCorp Vault server
+	Vault *VaultSecretBackend `json:"vault,omitempty"`
+}
+
+// VaultSecretBackend reads secrets from the key/value secrets engine of a HashiCorp Vault server
+type VaultSecretBackend struct {
+	// Address is the URL of the Vault server
+	Address string `json:"address"`
+	// TokenSecret is the secret selector to the Vault token
+	TokenSecret *apiv1.SecretKeySelector `json:"tokenSecret,omitempty"`
+}
+
+// ResourceAction is a custom action on the resources of an API group and kind, which is defined by a
+// Lua script modifying the resource
+type ResourceAction struct {
+	// Group is the API group of the resources. The core group is an empty string.
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the resources
+	Kind string `json:"kind"`
+	// Name is the name of the action, which overrides a built-in action of the same name
+	Name string `json:"name"`
+	// Params are the names of the parameters of the action
+	Params []string `json:"params,omitempty"`
+	// Script is the Lua script, which modifies the resource available as the global `obj` and
+	// returns it. The parameters of the action are available as the global `params` table.
+	Script string `json:"script"`
+}
+
+// SessionRevocations holds the revoked session tokens of accounts. Tokens are either revoked
+// individually by their ID, or all at once by revoking the tokens issued to an account before a time.
+type SessionRevocations struct {
+	// RevokedBefore maps accounts to the time before which the tokens issued to them are revoked
+	RevokedBefore map[string]time.Time `json:"revokedBefore,omitempty"`
+	// Sessions maps the unique IDs (jti claims) of the individually revoked tokens to the tokens
+	Sessions map[string]RevokedSession `json:"sessions,omitempty"`
+}
+
+// RevokedSession is an individually revoked session token
+type RevokedSession struct {
+	// Subject is the account the token was issued to
+	Subject string `json:"subject"`
+	// IssuedAt is the time the token was issued
+	IssuedAt time.Time `json:"issuedAt"`
+	// ExpiresAt is the time the token expires, if it does
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// maxRevokedSessions is the maximum number of individually revoked tokens, which keeps the revocations
+// well below the size limit of the secret they are stored in
+const maxRevokedSessions = 5000
+
+// IsRevoked returns whether or not the token with the ID, issued to the account at the time, is revoked
+func (r *SessionRevocations) IsRevoked(id, subject string, issuedAt time.Time) bool {
+	if revokedBefore, ok := r.RevokedBefore[subject]; ok && issuedAt.Before(revokedBefore) {
+		return true
+	}
+	if id == "" {
+		return false
+	}
+	_, ok := r.Sessions[id]
+	return ok
+}
+
+// RevokeSession revokes the token with the ID, issued to the account at the time. expiresAt is nil if
+// the token does not expire. Expired tokens are forgotten, and if too many tokens are revoked, the
+// oldest ones are revoked by time instead, so that the revocations do not grow forever.
+func (r *SessionRevocations) RevokeSession(id, subject string, issuedAt time.Time, expiresAt *time.Time) {
+	if r.IsRevoked(id, subject, issuedAt) {
+		return
+	}
+	if r.Sessions == nil {
+		r.Sessions = make(map[string]RevokedSession)
+	}
+	r.Sessions[id] = RevokedSession{Subject: subject, IssuedAt: issuedAt, ExpiresAt: expiresAt}
+	r.prune(time.Now())
+}
+
+// RevokeBefore revokes the tokens issued to the account before the time. Individually revoked tokens
+// which are now revoked by time, or have expired, are forgotten.
+func (r *SessionRevocations) RevokeBefore(subject string, revokedBefore time.Time) {
+	r.revokeBefore(subject, revokedBefore)
+	r.prune(time.Now())
+}
+
+// revokeBefore revokes the tokens issued to the account before the time, unless they already are
+func (r *SessionRevocations) revokeBefore(subject string, revokedBefore time.Time) {
+	if r.RevokedBefore == nil {
+		r.RevokedBefore = make(map[string]time.Time)
+	}
+	if prev, ok := r.RevokedBefore[subject]; ok && prev.After(revokedBefore) {
+		return
+	}
+	r.RevokedBefore[subject] = revokedBefore
+}
+
+// prune forgets the individually revoked tokens which have expired or are revoked by time. If more
+// than maxRevokedSessions tokens remain, the tokens issued to the account of the oldest token up to
+// its issue time are revoked by time instead, which fails closed.
+func (r *SessionRevocations) prune(now time.Time) {
+	for {
+		var oldestID string
+		for id, session := range r.Sessions {
+			if session.ExpiresAt != nil && session.ExpiresAt.Before(now) {
+				delete(r.Sessions, id)
+				continue
+			}
+			if revokedBefore, ok := r.RevokedBefore[session.Subject]; ok && session.IssuedAt.Before(revokedBefore) {
+				delete(r.Sessions, id)
+				continue
+			}
+			if oldestID == "" || session.IssuedAt.Before(r.Sessions[oldestID].IssuedAt) {
+				oldestID = id
+			}
+		}
+		if len(r.Sessions) <= maxRevokedSessions {
+			return
+		}
+		oldest := r.Sessions[oldestID]
+		r.revokeBefore(oldest.Subject, oldest.IssuedAt.Add(time.Second))
+	}
+}
+
+// FilteredResource matches the resources of API groups and kinds, optionally only in some clusters.
+// Empty lists match everything, and values may contain glob patterns.
+type FilteredResource struct {
+	// APIGroups are the matched API groups. The core group is matched by an empty string.
+	APIGroups []string `json:"apiGroups,omitempty"`
+	// Kinds are the matched kinds
+	Kinds []string `json:"kinds,omitempty"`
+	// Clusters are the URLs of the clusters in which the resources are matched
+	Clusters []string `json:"clusters,omitempty"`
+}
+
+func matchAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if matched, err := path.Match(pattern, value); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Match returns whether the resources of the API group and kind are matched in the cluster
+func (r FilteredResource) Match(apiGroup, kind, cluster string) bool {
+	return matchAny(r.APIGroups, apiGroup) && matchAny(r.Kinds, kind) && matchAny(r.Clusters, cluster)
 }
 
 const (
@@ -58,22 +304,54 @@ const (
 	settingAdminPasswordHashKey = "admin.password"
 	// settingAdminPasswordMtimeKey designates the key for a root password mtime inside a Kubernetes secret.
 	settingAdminPasswordMtimeKey = "admin.passwordMtime"
+	// settingSessionRevocationsKey designates the key for the revoked session tokens inside a Kubernetes secret.
+	settingSessionRevocationsKey = "sessions.revocations"
 	// settingServerSignatureKey designates the key for a server secret key inside a Kubernetes secret.
 	settingServerSignatureKey = "server.secretkey"
 	// settingServerCertificate designates the key for the public cert used in TLS
 	settingServerCertificate = "server.crt"
 	// settingServerPrivateKey designates the key for the private key used in TLS
 	settingServerPrivateKey = "server.key"
+	// settingServerTLSMinVersionKey designates the key for the minimum TLS version of the API server
+	settingServerTLSMinVersionKey = "server.tls.minversion"
+	// settingServerTLSCiphersKey designates the key for the cipher suites of the API server
+	settingServerTLSCiphersKey = "server.tls.ciphers"
 	// settingURLKey designates the key where ArgoCDs external URL is set
 	settingURLKey = "url"
 	// settingDexConfigKey designates the key for the dex config
 	settingDexConfigKey = "dex.config"
+	// settingRepositoriesKey designates the key where the list of declaratively configured repositories is set
+	settingRepositoriesKey = "repositories"
+	// settingSecretBackendsKey designates the key where the list of secret backends is set
+	settingSecretBackendsKey = "secretBackends"
+	// settingManifestFormatKey designates the key for the default format of application manifests
+	settingManifestFormatKey = "manifests.format"
+	// settingAppResyncPeriodKey designates the key for the resync period of applications
+	settingAppResyncPeriodKey = "timeout.reconciliation"
+	// settingAppResyncJitterKey designates the key for the maximum jitter of the resync period of applications
+	settingAppResyncJitterKey = "timeout.reconciliation.jitter"
+	// settingResourceExclusionsKey designates the key for the list of resources excluded from the controller
+	settingResourceExclusionsKey = "resource.exclusions"
+	// settingResourceInclusionsKey designates the key for the list of the only resources included in the controller
+	settingResourceInclusionsKey = "resource.inclusions"
+	// settingRevisionHistoryMaxAgeKey designates the key for the maximum age of the deployments in the history of applications
+	settingRevisionHistoryMaxAgeKey = "application.revisionHistoryMaxAge"
+	// settingProgressingDeadlineKey designates the key for the deadline after which progressing resources are degraded
+	settingProgressingDeadlineKey = "health.progressingDeadline"
+	// settingResourceTrackingMethodKey designates the key for the method tracking the resources of applications
+	settingResourceTrackingMethodKey = "application.resourceTrackingMethod"
+	// settingResourceActionsKey designates the key for the list of custom resource actions
+	settingResourceActionsKey = "resource.actions"
 	// settingsWebhookGitHubSecret is the key for the GitHub shared webhook secret
 	settingsWebhookGitHubSecretKey = "webhook.github.secret"
 	// settingsWebhookGitLabSecret is the key for the GitLab shared webhook secret
 	settingsWebhookGitLabSecretKey = "webhook.gitlab.secret"
 	// settingsWebhookBitbucketUUID is the key for Bitbucket webhook UUID
 	settingsWebhookBitbucketUUIDKey = "webhook.bitbucket.uuid"
+	// settingsWebhookBitbucketServerSecretKey is the key for the BitBucket Server shared webhook secret
+	settingsWebhookBitbucketServerSecretKey = "webhook.bitbucketserver.secret"
+	// settingsWebhookGogsSecretKey is the key for the Gogs shared webhook secret
+	settingsWebhookGogsSecretKey = "webhook.gogs.secret"
 )
 
 // SettingsManager holds config info for a new manager with which to access Kubernetes ConfigMaps.
@@ -94,6 +372,14 @@ func (mgr *SettingsManager) GetSettings() (*ArgoCDSettings, error) {
 		return nil, err
 	}
 	updateSettingsFromConfigMap(&settings, argoCDCM)
+	tlsSecret, err := mgr.clientset.CoreV1().Secrets(mgr.namespace).Get(common.ArgoCDServerTLSSecretName, metav1.GetOptions{})
+	if err == nil {
+		if err := updateSettingsFromTLSSecret(&settings, tlsSecret); err != nil {
+			log.Warn(err)
+		}
+	} else if !apierr.IsNotFound(err) {
+		return nil, err
+	}
 	argoCDSecret, err := mgr.clientset.CoreV1().Secrets(mgr.namespace).Get(common.ArgoCDSecretName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -108,6 +394,96 @@ func (mgr *SettingsManager) GetSettings() (*ArgoCDSettings, error) {
 func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *apiv1.ConfigMap) {
 	settings.DexConfig = argoCDCM.Data[settingDexConfigKey]
 	settings.URL = argoCDCM.Data[settingURLKey]
+	settings.ManifestFormat = argoCDCM.Data[settingManifestFormatKey]
+	settings.ResourceTrackingMethod = argoCDCM.Data[settingResourceTrackingMethodKey]
+	var repositories []RepoCredentials
+	if reposStr := argoCDCM.Data[settingRepositoriesKey]; reposStr != "" {
+		err := yaml.Unmarshal([]byte(reposStr), &repositories)
+		if err != nil {
+			log.Warnf("invalid %s in %s: %v", settingRepositoriesKey, common.ArgoCDConfigMapName, err)
+			repositories = nil
+		}
+	}
+	settings.setRepositories(repositories)
+	settings.AppResyncPeriod = parseDurationSetting(argoCDCM, settingAppResyncPeriodKey)
+	settings.AppResyncJitter = parseDurationSetting(argoCDCM, settingAppResyncJitterKey)
+	settings.RevisionHistoryMaxAge = parseDurationSetting(argoCDCM, settingRevisionHistoryMaxAgeKey)
+	settings.ProgressingDeadline = parseDurationSetting(argoCDCM, settingProgressingDeadlineKey)
+	settings.ResourceExclusions = parseFilteredResourcesSetting(argoCDCM, settingResourceExclusionsKey)
+	settings.ResourceInclusions = parseFilteredResourcesSetting(argoCDCM, settingResourceInclusionsKey)
+	settings.SecretBackends = nil
+	if backendsStr := argoCDCM.Data[settingSecretBackendsKey]; backendsStr != "" {
+		var backends []SecretBackend
+		err := yaml.Unmarshal([]byte(backendsStr), &backends)
+		if err != nil {
+			log.Warnf("invalid %s in %s: %v", settingSecretBackendsKey, common.ArgoCDConfigMapName, err)
+		} else {
+			settings.SecretBackends = backends
+		}
+	}
+	var tlsMinVersion uint16
+	if minVersionStr := argoCDCM.Data[settingServerTLSMinVersionKey]; minVersionStr != "" {
+		minVersion, err := tlsutil.ParseTLSVersion(minVersionStr)
+		if err != nil {
+			log.Warnf("invalid %s in %s: %v", settingServerTLSMinVersionKey, common.ArgoCDConfigMapName, err)
+		} else {
+			tlsMinVersion = minVersion
+		}
+	}
+	var tlsCipherSuites []uint16
+	if ciphersStr := argoCDCM.Data[settingServerTLSCiphersKey]; ciphersStr != "" {
+		ciphers, err := tlsutil.ParseCipherSuites(ciphersStr)
+		if err != nil {
+			log.Warnf("invalid %s in %s: %v", settingServerTLSCiphersKey, common.ArgoCDConfigMapName, err)
+		} else {
+			tlsCipherSuites = ciphers
+		}
+	}
+	settings.tlsLock.Lock()
+	settings.TLSMinVersion = tlsMinVersion
+	settings.TLSCipherSuites = tlsCipherSuites
+	settings.tlsLock.Unlock()
+	settings.ResourceActions = nil
+	if actionsStr := argoCDCM.Data[settingResourceActionsKey]; actionsStr != "" {
+		var actions []ResourceAction
+		err := yaml.Unmarshal([]byte(actionsStr), &actions)
+		if err != nil {
+			log.Warnf("invalid %s in %s: %v", settingResourceActionsKey, common.ArgoCDConfigMapName, err)
+		} else {
+			settings.ResourceActions = actions
+		}
+	}
+}
+
+// parseDurationSetting parses the duration under the key of the config map, or returns zero if the
+// key is unset or invalid
+func parseDurationSetting(argoCDCM *apiv1.ConfigMap, key string) time.Duration {
+	durationStr := argoCDCM.Data[key]
+	if durationStr == "" {
+		return 0
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil || duration < 0 {
+		log.Warnf("invalid %s in %s: %s", key, common.ArgoCDConfigMapName, durationStr)
+		return 0
+	}
+	return duration
+}
+
+// parseFilteredResourcesSetting parses the list of filtered resources under the key of the config
+// map, or returns nil if the key is unset or invalid
+func parseFilteredResourcesSetting(argoCDCM *apiv1.ConfigMap, key string) []FilteredResource {
+	resourcesStr := argoCDCM.Data[key]
+	if resourcesStr == "" {
+		return nil
+	}
+	var resources []FilteredResource
+	err := yaml.Unmarshal([]byte(resourcesStr), &resources)
+	if err != nil {
+		log.Warnf("invalid %s in %s: %v", key, common.ArgoCDConfigMapName, err)
+		return nil
+	}
+	return resources
 }
 
 // UpdateSettingsFromSecret transfers settings from a Kubernetes secret into an ArgoCDSettings struct.
@@ -123,6 +499,8 @@ func updateSettingsFromSecret(settings *ArgoCDSettings, argoCDSecret *apiv1.Secr
 			settings.AdminPasswordMtime = adminPasswordMtime
 		}
 	}
+	revocations, revocationsErr := getSessionRevocations(argoCDSecret)
+	settings.setSessionRevocations(revocations, revocationsErr)
 	secretKey, ok := argoCDSecret.Data[settingServerSignatureKey]
 	if !ok {
 		return fmt.Errorf("server secret key not found")
@@ -137,6 +515,12 @@ func updateSettingsFromSecret(settings *ArgoCDSettings, argoCDSecret *apiv1.Secr
 	if bitbucketWebhookUUID := argoCDSecret.Data[settingsWebhookBitbucketUUIDKey]; len(bitbucketWebhookUUID) > 0 {
 		settings.WebhookBitbucketUUID = string(bitbucketWebhookUUID)
 	}
+	if bitbucketServerWebhookSecret := argoCDSecret.Data[settingsWebhookBitbucketServerSecretKey]; len(bitbucketServerWebhookSecret) > 0 {
+		settings.WebhookBitbucketServerSecret = string(bitbucketServerWebhookSecret)
+	}
+	if gogsWebhookSecret := argoCDSecret.Data[settingsWebhookGogsSecretKey]; len(gogsWebhookSecret) > 0 {
+		settings.WebhookGogsSecret = string(gogsWebhookSecret)
+	}
 
 	serverCert, certOk := argoCDSecret.Data[settingServerCertificate]
 	serverKey, keyOk := argoCDSecret.Data[settingServerPrivateKey]
@@ -145,16 +529,66 @@ func updateSettingsFromSecret(settings *ArgoCDSettings, argoCDSecret *apiv1.Secr
 		if err != nil {
 			return fmt.Errorf("invalid x509 key pair %s/%s in secret: %s", settingServerCertificate, settingServerPrivateKey, err)
 		}
+		settings.tlsLock.Lock()
 		settings.Certificate = &cert
+		settings.tlsLock.Unlock()
 	}
 	secretValues := make(map[string]string, len(argoCDSecret.Data))
 	for k, v := range argoCDSecret.Data {
 		secretValues[k] = string(v)
 	}
 	settings.Secrets = secretValues
+	return revocationsErr
+}
+
+// updateSettingsFromTLSSecret transfers the certificate of the TLS secret of the API server into the
+// settings. An invalid certificate leaves the previous one in place.
+func updateSettingsFromTLSSecret(settings *ArgoCDSettings, tlsSecret *apiv1.Secret) error {
+	cert, err := tls.X509KeyPair(tlsSecret.Data[apiv1.TLSCertKey], tlsSecret.Data[apiv1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("invalid x509 key pair %s/%s in secret %s: %s", apiv1.TLSCertKey, apiv1.TLSPrivateKeyKey, common.ArgoCDServerTLSSecretName, err)
+	}
+	settings.setExternalCertificate(&cert)
 	return nil
 }
 
+// getSessionRevocations returns the revoked session tokens stored in the secret
+func getSessionRevocations(argoCDSecret *apiv1.Secret) (SessionRevocations, error) {
+	var revocations SessionRevocations
+	if revocationsBytes, ok := argoCDSecret.Data[settingSessionRevocationsKey]; ok {
+		if err := yaml.Unmarshal(revocationsBytes, &revocations); err != nil {
+			return SessionRevocations{}, fmt.Errorf("invalid %s in %s: %v", settingSessionRevocationsKey, common.ArgoCDSecretName, err)
+		}
+	}
+	return revocations, nil
+}
+
+// UpdateSessionRevocations updates the revoked session tokens in the ArgoCD secret with the supplied
+// function, retrying it a few times on conflicts with concurrent updates of the secret
+func (mgr *SettingsManager) UpdateSessionRevocations(update func(revocations *SessionRevocations)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		argoCDSecret, err := mgr.clientset.CoreV1().Secrets(mgr.namespace).Get(common.ArgoCDSecretName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		revocations, err := getSessionRevocations(argoCDSecret)
+		if err != nil {
+			return err
+		}
+		update(&revocations)
+		revocationsBytes, err := yaml.Marshal(revocations)
+		if err != nil {
+			return err
+		}
+		if argoCDSecret.Data == nil {
+			argoCDSecret.Data = make(map[string][]byte)
+		}
+		argoCDSecret.Data[settingSessionRevocationsKey] = revocationsBytes
+		_, err = mgr.clientset.CoreV1().Secrets(mgr.namespace).Update(argoCDSecret)
+		return err
+	})
+}
+
 // SaveSettings serializes ArgoCD settings and upserts it into K8s secret/configmap
 func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 	// Upsert the config data
@@ -213,6 +647,12 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 	if settings.WebhookBitbucketUUID != "" {
 		argoCDSecret.StringData[settingsWebhookBitbucketUUIDKey] = settings.WebhookBitbucketUUID
 	}
+	if settings.WebhookBitbucketServerSecret != "" {
+		argoCDSecret.StringData[settingsWebhookBitbucketServerSecretKey] = settings.WebhookBitbucketServerSecret
+	}
+	if settings.WebhookGogsSecret != "" {
+		argoCDSecret.StringData[settingsWebhookGogsSecretKey] = settings.WebhookGogsSecret
+	}
 	if settings.Certificate != nil {
 		cert, key := tlsutil.EncodeX509KeyPairString(*settings.Certificate)
 		argoCDSecret.StringData[settingServerCertificate] = cert
@@ -241,6 +681,28 @@ func NewSettingsManager(clientset kubernetes.Interface, namespace string) *Setti
 	}
 }
 
+// IsExcludedResource returns whether the resources of the API group and kind are excluded from the
+// cluster, either explicitly, or because they are not part of the configured inclusions
+func (a *ArgoCDSettings) IsExcludedResource(apiGroup, kind, cluster string) bool {
+	if a == nil {
+		return false
+	}
+	for _, exclusion := range a.ResourceExclusions {
+		if exclusion.Match(apiGroup, kind, cluster) {
+			return true
+		}
+	}
+	if len(a.ResourceInclusions) == 0 {
+		return false
+	}
+	for _, inclusion := range a.ResourceInclusions {
+		if inclusion.Match(apiGroup, kind, cluster) {
+			return false
+		}
+	}
+	return true
+}
+
 // IsSSOConfigured returns whether or not single-sign-on is configured
 func (a *ArgoCDSettings) IsSSOConfigured() bool {
 	if a.URL == "" {
@@ -255,13 +717,64 @@ func (a *ArgoCDSettings) IsSSOConfigured() bool {
 	return len(dexCfg) > 0
 }
 
+// ServerCertificate returns the certificate of the API server: the certificate of the
+// argocd-server-tls secret if it exists, otherwise the certificate of argocd-secret
+func (a *ArgoCDSettings) ServerCertificate() *tls.Certificate {
+	a.tlsLock.RLock()
+	defer a.tlsLock.RUnlock()
+	if a.ExternalCertificate != nil {
+		return a.ExternalCertificate
+	}
+	return a.Certificate
+}
+
+// setExternalCertificate sets the certificate of the argocd-server-tls secret, or nil if the secret
+// was deleted
+func (a *ArgoCDSettings) setExternalCertificate(cert *tls.Certificate) {
+	a.tlsLock.Lock()
+	defer a.tlsLock.Unlock()
+	a.ExternalCertificate = cert
+}
+
+// SetSessionRevocations sets the revoked session tokens
+func (a *ArgoCDSettings) SetSessionRevocations(revocations SessionRevocations) {
+	a.setSessionRevocations(revocations, nil)
+}
+
+func (a *ArgoCDSettings) setSessionRevocations(revocations SessionRevocations, err error) {
+	a.revocationsLock.Lock()
+	defer a.revocationsLock.Unlock()
+	a.sessionRevocations = revocations
+	a.sessionRevocationsErr = err
+}
+
+// IsSessionRevoked returns whether or not the token with the ID, issued to the account at the time, is
+// revoked. It returns an error while the revoked session tokens are invalid, so that tokens are
+// rejected until they are fixed.
+func (a *ArgoCDSettings) IsSessionRevoked(id, subject string, issuedAt time.Time) (bool, error) {
+	a.revocationsLock.RLock()
+	defer a.revocationsLock.RUnlock()
+	if a.sessionRevocationsErr != nil {
+		return true, a.sessionRevocationsErr
+	}
+	return a.sessionRevocations.IsRevoked(id, subject, issuedAt), nil
+}
+
+// TLSOptions returns the minimum TLS version and the cipher suites accepted by the API server
+func (a *ArgoCDSettings) TLSOptions() (uint16, []uint16) {
+	a.tlsLock.RLock()
+	defer a.tlsLock.RUnlock()
+	return a.TLSMinVersion, a.TLSCipherSuites
+}
+
 // TLSConfig returns a tls.Config with the configured certificates
 func (a *ArgoCDSettings) TLSConfig() *tls.Config {
-	if a.Certificate == nil {
+	cert := a.ServerCertificate()
+	if cert == nil {
 		return nil
 	}
 	certPool := x509.NewCertPool()
-	pemCertBytes, _ := tlsutil.EncodeX509KeyPair(*a.Certificate)
+	pemCertBytes, _ := tlsutil.EncodeX509KeyPair(*cert)
 	ok := certPool.AppendCertsFromPEM(pemCertBytes)
 	if !ok {
 		panic("bad certs")
@@ -308,6 +821,15 @@ func (mgr *SettingsManager) newInformers() (cache.SharedIndexInformer, cache.Sha
 	return cmInformer, secInformer
 }
 
+// newTLSSecretInformer returns a new informer on the TLS secret of the API server
+func (mgr *SettingsManager) newTLSSecretInformer() cache.SharedIndexInformer {
+	tweakSecret := func(options *metav1.ListOptions) {
+		secFieldSelector := fields.ParseSelectorOrDie(fmt.Sprintf("metadata.name=%s", common.ArgoCDServerTLSSecretName))
+		options.FieldSelector = secFieldSelector.String()
+	}
+	return v1.NewFilteredSecretInformer(mgr.clientset, mgr.namespace, 3*time.Minute, cache.Indexers{}, tweakSecret)
+}
+
 // StartNotifier starts background goroutines to update the supplied settings instance with new updates
 func (mgr *SettingsManager) StartNotifier(ctx context.Context, a *ArgoCDSettings) {
 	log.Info("Starting settings notifier")
@@ -356,7 +878,41 @@ func (mgr *SettingsManager) StartNotifier(ctx context.Context, a *ArgoCDSettings
 			},
 		},
 	)
+	tlsInformer := mgr.newTLSSecretInformer()
+	tlsInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				if sec, ok := obj.(*apiv1.Secret); ok {
+					if err := updateSettingsFromTLSSecret(a, sec); err != nil {
+						log.Errorf("new settings had error: %v", err)
+					}
+					mgr.notifySubscribers()
+				}
+			},
+			UpdateFunc: func(old, new interface{}) {
+				oldSec := old.(*apiv1.Secret)
+				newSec := new.(*apiv1.Secret)
+				if oldSec.ResourceVersion == newSec.ResourceVersion {
+					return
+				}
+				log.Infof("%s updated", common.ArgoCDServerTLSSecretName)
+				if err := updateSettingsFromTLSSecret(a, newSec); err != nil {
+					log.Errorf("new settings had error: %v", err)
+				}
+				mgr.notifySubscribers()
+			},
+			DeleteFunc: func(obj interface{}) {
+				log.Infof("%s deleted", common.ArgoCDServerTLSSecretName)
+				a.setExternalCertificate(nil)
+				mgr.notifySubscribers()
+			},
+		},
+	)
 	log.Info("Starting configmap/secret informers")
+	go func() {
+		tlsInformer.Run(ctx.Done())
+		log.Info("tls secret informer cancelled")
+	}()
 	go func() {
 		cmInformer.Run(ctx.Done())
 		log.Info("configmap informer cancelled")
@@ -367,7 +923,8 @@ func (mgr *SettingsManager) StartNotifier(ctx context.Context, a *ArgoCDSettings
 	}()
 }
 
-// Subscribe registers a channel in which to subscribe to settings updates
+// Subscribe registers a channel in which to subscribe to settings updates. Notifications are not sent
+// while the channel is full, so a buffered channel receives at least one notification after any update.
 func (mgr *SettingsManager) Subscribe(subCh chan<- struct{}) {
 	mgr.mutex.Lock()
 	defer mgr.mutex.Unlock()
@@ -375,7 +932,8 @@ func (mgr *SettingsManager) Subscribe(subCh chan<- struct{}) {
 	log.Infof("%v subscribed to settings updates", subCh)
 }
 
-// Unsubscribe unregisters a channel from receiving of settings updates
+// Unsubscribe unregisters a channel from receiving of settings updates. A notification which was being
+// sent may still be received, so the channel must not be closed.
 func (mgr *SettingsManager) Unsubscribe(subCh chan<- struct{}) {
 	mgr.mutex.Lock()
 	defer mgr.mutex.Unlock()
@@ -388,12 +946,19 @@ func (mgr *SettingsManager) Unsubscribe(subCh chan<- struct{}) {
 	}
 }
 
+// notifySubscribers notifies the subscribers without blocking the informers on slow subscribers
 func (mgr *SettingsManager) notifySubscribers() {
 	mgr.mutex.Lock()
-	defer mgr.mutex.Unlock()
-	log.Infof("Notifying %d settings subscribers: %v", len(mgr.subscribers), mgr.subscribers)
-	for _, sub := range mgr.subscribers {
-		sub <- struct{}{}
+	subscribers := make([]chan<- struct{}, len(mgr.subscribers))
+	copy(subscribers, mgr.subscribers)
+	mgr.mutex.Unlock()
+	log.Infof("Notifying %d settings subscribers: %v", len(subscribers), subscribers)
+	for _, sub := range subscribers {
+		select {
+		case sub <- struct{}{}:
+		default:
+			// the subscriber has not received a previous notification yet
+		}
 	}
 }
 
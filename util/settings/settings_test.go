@@ -0,0 +1,29 @@
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func TestUpdateSettingsFromSecretInvalidRevocations(t *testing.T) {
+	secret := apiv1.Secret{Data: map[string][]byte{
+		settingAdminPasswordHashKey:  []byte("hash"),
+		settingServerSignatureKey:    []byte("signature"),
+		settingSessionRevocationsKey: []byte("sessions: ["),
+	}}
+	var settings ArgoCDSettings
+	assert.NotNil(t, updateSettingsFromSecret(&settings, &secret))
+	// tokens are rejected while the revocations are invalid
+	revoked, err := settings.IsSessionRevoked("id", "admin", time.Now())
+	assert.True(t, revoked)
+	assert.NotNil(t, err)
+
+	secret.Data[settingSessionRevocationsKey] = []byte("sessions: {}")
+	assert.Nil(t, updateSettingsFromSecret(&settings, &secret))
+	revoked, err = settings.IsSessionRevoked("id", "admin", time.Now())
+	assert.False(t, revoked)
+	assert.Nil(t, err)
+}
@@ -1,27 +1,35 @@
 package swagger
 
 import (
-	"fmt"
+	"encoding/json"
 	"log"
 	"net/http"
 	"path"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/gobuffalo/packr"
+
+	"github.com/argoproj/argo-cd"
 )
 
-// ServeSwaggerUI serves the Swagger UI and JSON spec.
+// ServeSwaggerUI serves the Swagger UI and JSON spec. The version of the spec is set to the version
+// of the server, so that clients generated from it can be matched to the server.
 func ServeSwaggerUI(mux *http.ServeMux, box packr.Box, uiPath string) {
 	prefix := path.Dir(uiPath)
 	specURL := path.Join(prefix, "swagger.json")
 
-	swaggerJSON, err := box.MustString("swagger.json")
+	swaggerJSON, err := box.MustBytes("swagger.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	swaggerJSON, err = setSpecVersion(swaggerJSON, argocd.GetVersion().Version)
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	mux.HandleFunc(specURL, func(w http.ResponseWriter, r *http.Request) {
-		fmt.Fprintf(w, swaggerJSON)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(swaggerJSON)
 	})
 
 	mux.Handle(uiPath, middleware.Redoc(middleware.RedocOpts{
@@ -30,3 +38,24 @@ func ServeSwaggerUI(mux *http.ServeMux, box packr.Box, uiPath string) {
 		Path:     path.Base(uiPath),
 	}, http.NotFoundHandler()))
 }
+
+// setSpecVersion returns the swagger spec with the version of its info set to the given version
+func setSpecVersion(swaggerJSON []byte, version string) ([]byte, error) {
+	var spec map[string]json.RawMessage
+	if err := json.Unmarshal(swaggerJSON, &spec); err != nil {
+		return nil, err
+	}
+	info := make(map[string]interface{})
+	if infoJSON, ok := spec["info"]; ok {
+		if err := json.Unmarshal(infoJSON, &info); err != nil {
+			return nil, err
+		}
+	}
+	info["version"] = version
+	infoJSON, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+	spec["info"] = infoJSON
+	return json.MarshalIndent(spec, "", "  ")
+}
@@ -8,6 +8,9 @@ import (
 
 	"github.com/go-openapi/loads"
 	"github.com/gobuffalo/packr"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd"
 )
 
 func TestSwaggerUI(t *testing.T) {
@@ -55,6 +58,8 @@ func TestSwaggerUI(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	assert.Equal(t, argocd.GetVersion().Version, specDoc.Spec().Info.Version)
+	assert.Contains(t, specDoc.Spec().SecurityDefinitions, "bearer")
 
 	resp, err := http.Get(server + "/swagger-ui")
 	if err != nil {
@@ -65,3 +70,12 @@ func TestSwaggerUI(t *testing.T) {
 		t.Fatalf("Was expecting status code 200 from swagger-ui, but got %d instead", resp.StatusCode)
 	}
 }
+
+func TestSetSpecVersion(t *testing.T) {
+	spec, err := setSpecVersion([]byte(`{"swagger": "2.0", "info": {"title": "ArgoCD", "version": "version not set"}}`), "v0.8.0")
+	assert.Nil(t, err)
+	var obj map[string]interface{}
+	assert.Nil(t, json.Unmarshal(spec, &obj))
+	assert.Equal(t, map[string]interface{}{"title": "ArgoCD", "version": "v0.8.0"}, obj["info"])
+	assert.Equal(t, "2.0", obj["swagger"])
+}
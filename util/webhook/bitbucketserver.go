@@ -0,0 +1,125 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// bitbucketServerSignaturePrefix is the prefix of the HMAC signature in the X-Hub-Signature header
+	bitbucketServerSignaturePrefix = "sha256="
+)
+
+// bitbucketServerRepository is the repository of a BitBucket Server event
+type bitbucketServerRepository struct {
+	Links struct {
+		Clone []struct {
+			Href string `json:"href"`
+			Name string `json:"name"`
+		} `json:"clone"`
+	} `json:"links"`
+}
+
+// cloneURL returns the first clone URL of the repository
+func (r *bitbucketServerRepository) cloneURL() string {
+	for _, link := range r.Links.Clone {
+		if link.Href != "" {
+			return link.Href
+		}
+	}
+	return ""
+}
+
+// bitbucketServerRef is a branch or tag of a BitBucket Server event
+type bitbucketServerRef struct {
+	ID         string                    `json:"id"`
+	DisplayID  string                    `json:"displayId"`
+	Repository bitbucketServerRepository `json:"repository"`
+}
+
+// bitbucketServerPayload is the payload of the BitBucket Server repo:refs_changed and pr:* events
+// See: https://confluence.atlassian.com/bitbucketserver/event-payload-938025882.html
+type bitbucketServerPayload struct {
+	EventKey   string                    `json:"eventKey"`
+	Repository bitbucketServerRepository `json:"repository"`
+	Changes    []struct {
+		Ref bitbucketServerRef `json:"ref"`
+	} `json:"changes"`
+	PullRequest *struct {
+		ID      int64              `json:"id"`
+		FromRef bitbucketServerRef `json:"fromRef"`
+		ToRef   bitbucketServerRef `json:"toRef"`
+	} `json:"pullRequest"`
+}
+
+// bitbucketServerRevisionInfo examines a BitBucket Server event payload, and extracts the repo clone
+// URL, the affected revisions, and whether or not this affected the default branch of the repository.
+// Pull request events affect the refs/pull-requests/<id>/from and refs/pull-requests/<id>/merge refs
+// of the target repository.
+func bitbucketServerRevisionInfo(payload *bitbucketServerPayload) (string, []string, bool) {
+	switch payload.EventKey {
+	case "repo:refs_changed":
+		var revisions []string
+		for _, change := range payload.Changes {
+			revisions = append(revisions, change.Ref.DisplayID, change.Ref.ID)
+		}
+		// The payload does not tell whether the default branch was updated, so let the controller check
+		return payload.Repository.cloneURL(), revisions, true
+	case "pr:opened", "pr:from_ref_updated":
+		if payload.PullRequest == nil {
+			return "", nil, false
+		}
+		prRef := fmt.Sprintf("refs/pull-requests/%d", payload.PullRequest.ID)
+		return payload.PullRequest.ToRef.Repository.cloneURL(), []string{prRef + "/from", prRef + "/merge"}, false
+	}
+	return "", nil, false
+}
+
+// verifyBitbucketServerSignature verifies the body was signed with the shared secret. Events are not
+// verified if no secret is configured.
+func verifyBitbucketServerSignature(secret string, signature string, body []byte) bool {
+	if secret == "" {
+		return true
+	}
+	if !strings.HasPrefix(signature, bitbucketServerSignaturePrefix) {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(strings.TrimPrefix(signature, bitbucketServerSignaturePrefix)), []byte(expected))
+}
+
+// handleBitbucketServerEvent handles BitBucket Server push and pull request events
+func (a *ArgoCDWebhookHandler) handleBitbucketServerEvent(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Failed to read request body", http.StatusBadRequest)
+		return
+	}
+	if !verifyBitbucketServerSignature(a.bitbucketServerSecret, r.Header.Get("X-Hub-Signature"), body) {
+		http.Error(w, "Invalid signature", http.StatusUnauthorized)
+		return
+	}
+	var payload bitbucketServerPayload
+	err = json.Unmarshal(body, &payload)
+	if err != nil {
+		http.Error(w, "Failed to parse payload", http.StatusBadRequest)
+		return
+	}
+	repoURL, revisions, touchedHead := bitbucketServerRevisionInfo(&payload)
+	if repoURL == "" {
+		log.Infof("Ignoring BitBucket Server event %s", payload.EventKey)
+		return
+	}
+	log.Infof("Received BitBucket Server event %s repo: %s, revisions: %v, touchedHead: %v", payload.EventKey, repoURL, revisions, touchedHead)
+	a.refreshApps(repoURL, revisions, touchedHead, nil)
+}
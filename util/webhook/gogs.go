@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// gogsPushPayload is the payload of the Gogs and Gitea push events
+// See: https://gogs.io/docs/features/webhook
+type gogsPushPayload struct {
+	Ref     string `json:"ref"`
+	Commits []struct {
+		Added    []string `json:"added"`
+		Removed  []string `json:"removed"`
+		Modified []string `json:"modified"`
+	} `json:"commits"`
+	Repository struct {
+		HTMLURL       string `json:"html_url"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"repository"`
+}
+
+// gogsRevisionInfo examines a Gogs push event payload, and extracts the repo web URL, the revision,
+// whether or not this affected the default branch of the repository, and the changed files, which
+// are nil if they are unknown
+func gogsRevisionInfo(payload *gogsPushPayload) (string, []string, bool, []string) {
+	refParts := strings.SplitN(payload.Ref, "/", 3)
+	revision := refParts[len(refParts)-1]
+	var changedFiles []string
+	if len(payload.Commits) > 0 && len(payload.Commits) < maxPushCommits {
+		changedFiles = []string{}
+		for _, commit := range payload.Commits {
+			changedFiles = append(changedFiles, commit.Added...)
+			changedFiles = append(changedFiles, commit.Modified...)
+			changedFiles = append(changedFiles, commit.Removed...)
+		}
+	}
+	return payload.Repository.HTMLURL, []string{revision}, payload.Repository.DefaultBranch == revision, changedFiles
+}
+
+// verifyGogsSignature verifies the body was signed with the shared secret. Gogs signs events with the
+// hex encoded HMAC-SHA256 of the body. Events are not verified if no secret is configured.
+func verifyGogsSignature(secret string, signature string, body []byte) bool {
+	if secret == "" {
+		return true
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// handleGogsEvent handles Gogs and Gitea push events
+func (a *ArgoCDWebhookHandler) handleGogsEvent(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "Failed to read request body", http.StatusBadRequest)
+		return
+	}
+	if !verifyGogsSignature(a.gogsSecret, r.Header.Get("X-Gogs-Signature"), body) {
+		http.Error(w, "Invalid signature", http.StatusUnauthorized)
+		return
+	}
+	event := r.Header.Get("X-Gogs-Event")
+	if event != "push" {
+		log.Infof("Ignoring Gogs event %s", event)
+		return
+	}
+	var payload gogsPushPayload
+	err = json.Unmarshal(body, &payload)
+	if err != nil {
+		http.Error(w, "Failed to parse payload", http.StatusBadRequest)
+		return
+	}
+	webURL, revisions, touchedHead, changedFiles := gogsRevisionInfo(&payload)
+	if webURL == "" {
+		log.Info("Ignoring Gogs event without repository")
+		return
+	}
+	log.Infof("Received Gogs push event repo: %s, revisions: %v, touchedHead: %v, changedFiles: %d", webURL, revisions, touchedHead, len(changedFiles))
+	a.refreshApps(webURL, revisions, touchedHead, changedFiles)
+}
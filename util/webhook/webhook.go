@@ -2,12 +2,14 @@ package webhook
 
 import (
 	"net/http"
-	"net/url"
-	"regexp"
+	"path"
 	"strings"
 
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/util/argo"
+	"github.com/argoproj/argo-cd/util/git"
 	"github.com/argoproj/argo-cd/util/settings"
 	log "github.com/sirupsen/logrus"
 	webhooks "gopkg.in/go-playground/webhooks.v3"
@@ -26,6 +28,10 @@ type ArgoCDWebhookHandler struct {
 	gitlabHandler    http.Handler
 	bitbucket        *bitbucket.Webhook
 	bitbucketHandler http.Handler
+	// bitbucketServerSecret is the shared secret BitBucket Server events are signed with
+	bitbucketServerSecret string
+	// gogsSecret is the shared secret Gogs events are signed with
+	gogsSecret string
 }
 
 func NewHandler(namespace string, appClientset appclientset.Interface, set *settings.ArgoCDSettings) *ArgoCDWebhookHandler {
@@ -35,6 +41,9 @@ func NewHandler(namespace string, appClientset appclientset.Interface, set *sett
 		github:       github.New(&github.Config{Secret: set.WebhookGitHubSecret}),
 		gitlab:       gitlab.New(&gitlab.Config{Secret: set.WebhookGitLabSecret}),
 		bitbucket:    bitbucket.New(&bitbucket.Config{UUID: set.WebhookBitbucketUUID}),
+
+		bitbucketServerSecret: set.WebhookBitbucketServerSecret,
+		gogsSecret:            set.WebhookGogsSecret,
 	}
 	acdWebhook.github.RegisterEvents(acdWebhook.HandleEvent, github.PushEvent)
 	acdWebhook.gitlab.RegisterEvents(acdWebhook.HandleEvent, gitlab.PushEvents, gitlab.TagEvents)
@@ -45,12 +54,20 @@ func NewHandler(namespace string, appClientset appclientset.Interface, set *sett
 	return &acdWebhook
 }
 
+const (
+	// maxPushCommits is the maximum number of commits listed in GitHub, GitLab and Gogs push events. The
+	// changed files of larger pushes are unknown.
+	maxPushCommits = 20
+)
+
 // affectedRevisionInfo examines a payload from a webhook event, and extracts the repo web URL,
-// the revision, and whether or not this affected origin/HEAD (the default branch of the repository)
-func affectedRevisionInfo(payloadIf interface{}) (string, string, bool) {
+// the revisions, whether or not this affected origin/HEAD (the default branch of the repository),
+// and the files changed by the pushed commits. The changed files are nil if they are unknown.
+func affectedRevisionInfo(payloadIf interface{}) (string, []string, bool, []string) {
 	var webURL string
-	var revision string
+	var revisions []string
 	var touchedHead bool
+	var changedFiles []string
 
 	parseRef := func(ref string) string {
 		refParts := strings.SplitN(ref, "/", 3)
@@ -61,78 +78,87 @@ func affectedRevisionInfo(payloadIf interface{}) (string, string, bool) {
 	case github.PushPayload:
 		// See: https://developer.github.com/v3/activity/events/types/#pushevent
 		webURL = payload.Repository.HTMLURL
-		revision = parseRef(payload.Ref)
-		touchedHead = bool(payload.Repository.DefaultBranch == revision)
+		revisions = []string{parseRef(payload.Ref)}
+		touchedHead = bool(payload.Repository.DefaultBranch == revisions[0])
+		// Branch creations, deletions and tag pushes do not list commits, and the commits of large
+		// pushes are truncated, so the changed files are only known for regular pushes
+		if len(payload.Commits) > 0 && len(payload.Commits) < maxPushCommits {
+			changedFiles = []string{}
+			for _, commit := range payload.Commits {
+				changedFiles = append(changedFiles, commit.Added...)
+				changedFiles = append(changedFiles, commit.Modified...)
+				changedFiles = append(changedFiles, commit.Removed...)
+			}
+		}
 	case gitlab.PushEventPayload:
 		// See: https://docs.gitlab.com/ee/user/project/integrations/webhooks.html
-		// NOTE: this is untested
 		webURL = payload.Project.WebURL
-		revision = parseRef(payload.Ref)
-		touchedHead = bool(payload.Project.DefaultBranch == revision)
+		revisions = []string{parseRef(payload.Ref)}
+		touchedHead = bool(payload.Project.DefaultBranch == revisions[0])
+		if len(payload.Commits) > 0 && len(payload.Commits) < maxPushCommits {
+			changedFiles = []string{}
+			for _, commit := range payload.Commits {
+				changedFiles = append(changedFiles, commit.Added...)
+				changedFiles = append(changedFiles, commit.Modified...)
+				changedFiles = append(changedFiles, commit.Removed...)
+			}
+		}
 	case gitlab.TagEventPayload:
 		// See: https://docs.gitlab.com/ee/user/project/integrations/webhooks.html
-		// NOTE: this is untested
 		webURL = payload.Project.WebURL
-		revision = parseRef(payload.Ref)
-		touchedHead = bool(payload.Project.DefaultBranch == revision)
+		revisions = []string{parseRef(payload.Ref)}
+		touchedHead = bool(payload.Project.DefaultBranch == revisions[0])
 	case bitbucket.RepoPushPayload:
 		// See: https://confluence.atlassian.com/bitbucket/event-payloads-740262817.html#EventPayloads-Push
-		// NOTE: this is untested
 		webURL = payload.Repository.Links.HTML.Href
-		// TODO: bitbucket includes multiple changes as part of a single event.
-		// We only pick the first but need to consider how to handle multiple
+		// A single event includes the changes of all the branches and tags of the push
 		for _, change := range payload.Push.Changes {
-			revision = change.New.Name
-			break
+			if change.New.Name != "" {
+				revisions = append(revisions, change.New.Name)
+			}
 		}
 		// Not actually sure how to check if the incoming change affected HEAD just by examining the
 		// payload alone. To be safe, we just return true and let the controller check for himself.
 		touchedHead = true
 	}
-	return webURL, revision, touchedHead
+	return webURL, revisions, touchedHead, changedFiles
 }
 
 // HandleEvent handles webhook events for repo push events
 func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}, header webhooks.Header) {
-	webURL, revision, touchedHead := affectedRevisionInfo(payload)
+	webURL, revisions, touchedHead, changedFiles := affectedRevisionInfo(payload)
 	// NOTE: the webURL does not include the .git extension
 	if webURL == "" {
 		log.Info("Ignoring webhook event")
 		return
 	}
-	log.Infof("Received push event repo: %s, revision: %s, touchedHead: %v", webURL, revision, touchedHead)
+	log.Infof("Received push event repo: %s, revisions: %v, touchedHead: %v, changedFiles: %d", webURL, revisions, touchedHead, len(changedFiles))
+	a.refreshApps(webURL, revisions, touchedHead, changedFiles)
+}
+
+// refreshApps refreshes the applications of the repository which track any of the revisions, or
+// track the default branch if it was affected. If the changed files are known, the applications which
+// list their manifest paths are only refreshed if any of the files is in one of the paths.
+func (a *ArgoCDWebhookHandler) refreshApps(repoURL string, revisions []string, touchedHead bool, changedFiles []string) {
 	appIf := a.appClientset.ArgoprojV1alpha1().Applications(a.ns)
 	apps, err := appIf.List(metav1.ListOptions{})
 	if err != nil {
 		log.Warnf("Failed to list applications: %v", err)
 		return
 	}
-	urlObj, err := url.Parse(webURL)
-	if err != nil {
-		log.Warnf("Failed to parse repoURL '%s'", webURL)
-		return
-	}
-	regexpStr := "(?i)(http://|https://|git@)" + urlObj.Host + "[:/]" + urlObj.Path[1:] + "(\\.git)?"
-	repoRegexp, err := regexp.Compile(regexpStr)
-	if err != nil {
-		log.Warn("Failed to compile repoURL regexp")
-		return
-	}
-
 	for _, app := range apps.Items {
-		if !repoRegexp.MatchString(app.Spec.Source.RepoURL) {
+		if !git.SameURL(repoURL, app.Spec.Source.RepoURL) {
 			log.Debugf("%s does not match", app.Spec.Source.RepoURL)
 			continue
 		}
-		targetRev := app.Spec.Source.TargetRevision
-		if targetRev == "HEAD" || targetRev == "" {
-			if !touchedHead {
-				continue
-			}
-		} else if targetRev != revision {
+		if !isRevisionAffected(app.Spec.Source.TargetRevision, revisions, touchedHead) {
+			continue
+		}
+		if !isPathAffected(manifestPaths(&app), changedFiles) {
+			log.Debugf("Manifest paths of app '%s' were not changed", app.ObjectMeta.Name)
 			continue
 		}
-		_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name)
+		_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name, v1alpha1.RefreshTypeNormal)
 		if err != nil {
 			log.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.ObjectMeta.Name, err)
 			continue
@@ -140,8 +166,64 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}, header webhooks.
 	}
 }
 
+// isRevisionAffected returns whether or not the target revision is one of the revisions, or tracks
+// the default branch which was affected
+func isRevisionAffected(targetRev string, revisions []string, touchedHead bool) bool {
+	if targetRev == "HEAD" || targetRev == "" {
+		return touchedHead
+	}
+	for _, revision := range revisions {
+		if targetRev == revision {
+			return true
+		}
+	}
+	return false
+}
+
+// manifestPaths returns the paths of the repository, relative to its root, which the application lists
+// in its manifest paths annotation, or nil if it does not list them
+func manifestPaths(app *v1alpha1.Application) []string {
+	var paths []string
+	for _, p := range strings.Split(app.Annotations[common.AnnotationKeyManifestPaths], ";") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.HasPrefix(p, "/") {
+			p = path.Join(app.Spec.Source.Path, p)
+		}
+		paths = append(paths, path.Clean(strings.TrimPrefix(p, "/")))
+	}
+	return paths
+}
+
+// isPathAffected returns whether or not any of the changed files is in one of the manifest paths of an
+// application. Nil manifest paths or changed files are unknown, and affect all applications.
+func isPathAffected(paths []string, changedFiles []string) bool {
+	if paths == nil || changedFiles == nil {
+		return true
+	}
+	for _, p := range paths {
+		if p == "." {
+			return true
+		}
+		for _, file := range changedFiles {
+			if file == p || strings.HasPrefix(file, p+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
-	event := r.Header.Get("X-GitHub-Event")
+	// Gitea sends the X-GitHub-Event header besides X-Gogs-Event, so Gogs events must be detected first
+	event := r.Header.Get("X-Gogs-Event")
+	if len(event) > 0 {
+		a.handleGogsEvent(w, r)
+		return
+	}
+	event = r.Header.Get("X-GitHub-Event")
 	if len(event) > 0 {
 		a.githubHandler.ServeHTTP(w, r)
 		return
@@ -156,5 +238,10 @@ func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 		a.bitbucketHandler.ServeHTTP(w, r)
 		return
 	}
+	event = r.Header.Get("X-Event-Key")
+	if len(event) > 0 {
+		a.handleBitbucketServerEvent(w, r)
+		return
+	}
 	log.Debug("Ignoring unknown webhook event")
 }
@@ -2,15 +2,23 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/argoproj/argo-cd/common"
+	"github.com/argoproj/argo-cd/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/util/settings"
 	"github.com/gobuffalo/packr"
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/go-playground/webhooks.v3/github"
 )
 
 var (
@@ -35,6 +43,42 @@ func TestGitHubCommitEvent(t *testing.T) {
 	assert.Equal(t, w.Code, http.StatusOK)
 }
 
+func TestGitHubCommitEventSignature(t *testing.T) {
+	body := box.Bytes("github-commit-event.json")
+	h := NewHandler("", appclientset.NewSimpleClientset(), &settings.ArgoCDSettings{WebhookGitHubSecret: "secret"})
+
+	req := httptest.NewRequest("POST", "/api/webhook", bytes.NewReader(body))
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-Hub-Signature", "sha1=invalid")
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.NotEqual(t, http.StatusOK, w.Code)
+
+	mac := hmac.New(sha1.New, []byte("secret"))
+	_, _ = mac.Write(body)
+	req = httptest.NewRequest("POST", "/api/webhook", bytes.NewReader(body))
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-Hub-Signature", "sha1="+hex.EncodeToString(mac.Sum(nil)))
+	w = httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGitHubCommitEventRevisionInfo(t *testing.T) {
+	var payload github.PushPayload
+	err := json.Unmarshal(box.Bytes("github-commit-event.json"), &payload)
+	assert.Nil(t, err)
+	webURL, revisions, touchedHead, changedFiles := affectedRevisionInfo(payload)
+	assert.Equal(t, "https://github.com/jessesuen/test-repo", webURL)
+	assert.Equal(t, []string{"master"}, revisions)
+	assert.True(t, touchedHead)
+	assert.Equal(t, []string{
+		"ksapps/test-app/environments/staging-argocd-demo/main.jsonnet",
+		"ksapps/test-app/environments/staging-argocd-demo/params.libsonnet",
+		"ksapps/test-app/app.yaml",
+	}, changedFiles)
+}
+
 func TestGitHubTagEvent(t *testing.T) {
 	h := NewMockHandler()
 	req := httptest.NewRequest("POST", "/api/webhook", nil)
@@ -44,3 +88,94 @@ func TestGitHubTagEvent(t *testing.T) {
 	h.Handler(w, req)
 	assert.Equal(t, w.Code, http.StatusOK)
 }
+
+func TestBitbucketServerPullRequestEvent(t *testing.T) {
+	var payload bitbucketServerPayload
+	err := json.Unmarshal(box.Bytes("bitbucketserver-pr-event.json"), &payload)
+	assert.Nil(t, err)
+	repoURL, revisions, touchedHead := bitbucketServerRevisionInfo(&payload)
+	assert.Equal(t, "ssh://git@bitbucket.example.com:7999/proj/guestbook.git", repoURL)
+	assert.Equal(t, []string{"refs/pull-requests/12/from", "refs/pull-requests/12/merge"}, revisions)
+	assert.False(t, touchedHead)
+
+	h := NewMockHandler()
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("X-Event-Key", "pr:from_ref_updated")
+	req.Body = ioutil.NopCloser(bytes.NewReader(box.Bytes("bitbucketserver-pr-event.json")))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, w.Code, http.StatusOK)
+}
+
+func TestVerifyBitbucketServerSignature(t *testing.T) {
+	body := []byte(`{"eventKey":"repo:refs_changed"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	_, _ = mac.Write(body)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	assert.True(t, verifyBitbucketServerSignature("secret", signature, body))
+	assert.False(t, verifyBitbucketServerSignature("other-secret", signature, body))
+	assert.False(t, verifyBitbucketServerSignature("secret", "", body))
+	assert.True(t, verifyBitbucketServerSignature("", "", body))
+}
+
+func TestGogsPushEvent(t *testing.T) {
+	body := box.Bytes("gogs-push-event.json")
+	var payload gogsPushPayload
+	err := json.Unmarshal(body, &payload)
+	assert.Nil(t, err)
+	webURL, revisions, touchedHead, changedFiles := gogsRevisionInfo(&payload)
+	assert.Equal(t, "https://gogs.example.com/argoproj/argocd-example-apps", webURL)
+	assert.Equal(t, []string{"master"}, revisions)
+	assert.True(t, touchedHead)
+	assert.Equal(t, []string{"guestbook/guestbook-ui-deployment.yaml"}, changedFiles)
+
+	h := NewHandler("", appclientset.NewSimpleClientset(), &settings.ArgoCDSettings{WebhookGogsSecret: "secret"})
+	req := httptest.NewRequest("POST", "/api/webhook", bytes.NewReader(body))
+	req.Header.Set("X-Gogs-Event", "push")
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-Gogs-Signature", "invalid")
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	_, _ = mac.Write(body)
+	req = httptest.NewRequest("POST", "/api/webhook", bytes.NewReader(body))
+	req.Header.Set("X-Gogs-Event", "push")
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-Gogs-Signature", hex.EncodeToString(mac.Sum(nil)))
+	w = httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestIsRevisionAffected(t *testing.T) {
+	assert.True(t, isRevisionAffected("refs/pull-requests/12/from", []string{"refs/pull-requests/12/from"}, false))
+	assert.False(t, isRevisionAffected("refs/pull-requests/13/from", []string{"refs/pull-requests/12/from"}, false))
+	assert.True(t, isRevisionAffected("", []string{"master"}, true))
+	assert.False(t, isRevisionAffected("HEAD", []string{"feature"}, false))
+}
+
+func TestManifestPaths(t *testing.T) {
+	app := v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{Source: v1alpha1.ApplicationSource{Path: "apps/guestbook"}}}
+	assert.Nil(t, manifestPaths(&app))
+
+	app.Annotations = map[string]string{common.AnnotationKeyManifestPaths: ".; ../base;/charts/common;"}
+	assert.Equal(t, []string{"apps/guestbook", "apps/base", "charts/common"}, manifestPaths(&app))
+
+	app.Annotations[common.AnnotationKeyManifestPaths] = "/"
+	assert.Equal(t, []string{"."}, manifestPaths(&app))
+}
+
+func TestIsPathAffected(t *testing.T) {
+	changedFiles := []string{"ksapps/test-app/app.yaml", "guestbook/guestbook-ui-svc.yaml"}
+	assert.True(t, isPathAffected([]string{"ksapps/test-app"}, changedFiles))
+	assert.True(t, isPathAffected([]string{"base", "guestbook"}, changedFiles))
+	assert.True(t, isPathAffected([]string{"."}, changedFiles))
+	assert.False(t, isPathAffected([]string{"ksapps/test"}, changedFiles))
+	assert.False(t, isPathAffected([]string{"helm-guestbook"}, changedFiles))
+	assert.False(t, isPathAffected([]string{"guestbook"}, []string{}))
+	assert.True(t, isPathAffected([]string{"guestbook"}, nil))
+	// applications which do not list their manifest paths are refreshed on every change
+	assert.True(t, isPathAffected(nil, changedFiles))
+}